@@ -64,6 +64,12 @@ func WithMaxImportSize(maxImportSize int64) func(*V1Controller) {
 	}
 }
 
+func WithMaxResumableUploadSize(maxResumableUploadSize int64) func(*V1Controller) {
+	return func(ctrl *V1Controller) {
+		ctrl.maxResumableUploadSize = maxResumableUploadSize
+	}
+}
+
 func WithMaxParseMemory(maxParseMemory int64) func(*V1Controller) {
 	return func(ctrl *V1Controller) {
 		ctrl.maxParseMemory = maxParseMemory
@@ -95,18 +101,19 @@ func WithURL(url string) func(*V1Controller) {
 }
 
 type V1Controller struct {
-	repo              *repo.AllRepos
-	svc               *services.AllServices
-	bus               *eventbus.EventBus
-	config            *config.Config
-	oidcProvider      *providers.OIDCProvider
-	url               string
-	maxUploadSize     int64
-	maxImportSize     int64
-	maxParseMemory    int64
-	cookieSecure      bool
-	isDemo            bool
-	allowRegistration bool
+	repo                   *repo.AllRepos
+	svc                    *services.AllServices
+	bus                    *eventbus.EventBus
+	config                 *config.Config
+	oidcProvider           *providers.OIDCProvider
+	url                    string
+	maxUploadSize          int64
+	maxImportSize          int64
+	maxResumableUploadSize int64
+	maxParseMemory         int64
+	cookieSecure           bool
+	isDemo                 bool
+	allowRegistration      bool
 }
 
 type (
@@ -221,28 +228,31 @@ func (ctrl *V1Controller) HandleCurrency() errchain.HandlerFunc {
 	}
 }
 
+// HandleCacheWS streams the group's mutation events over a websocket. Each
+// frame names the event and, where known, the action, the affected IDs and
+// the acting user. Clients may send subscribe/unsubscribe messages to limit
+// entity events to specific entities or subtrees.
 func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
-	type eventMsg struct {
-		Event string `json:"event"`
-	}
-
 	m := melody.New()
 	m.Upgrader.Subprotocols = []string{"hb-auth"}
 
 	m.HandleConnect(func(s *melody.Session) {
 		auth := services.NewContext(s.Request.Context())
 		s.Set("gid", auth.GID)
+		s.Set("subs", newWSSubscriptions())
 	})
 
-	factory := func(e string) func(data any) {
-		// The payload depends only on the event name, so marshal it once at
-		// subscription time instead of on every dispatch.
-		jsonBytes, err := json.Marshal(&eventMsg{Event: e})
-		if err != nil {
-			log.Log().Msgf("error marshaling event %q: %v", e, err)
-			return func(any) {}
+	m.HandleMessage(func(s *melody.Session, data []byte) {
+		subs, ok := s.Get("subs")
+		if !ok {
+			return
 		}
+		if ack := handleWSClientMessage(subs.(*wsSubscriptions), data); ack != nil {
+			_ = s.Write(ack)
+		}
+	})
 
+	factory := func(e string, filterable bool) func(data any) {
 		return func(data any) {
 			eventData, ok := data.(eventbus.GroupMutationEvent)
 			if !ok {
@@ -250,6 +260,15 @@ func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 				return
 			}
 
+			jsonBytes, err := json.Marshal(newWSEventMsg(e, eventData))
+			if err != nil {
+				log.Log().Msgf("error marshaling event %q: %v", e, err)
+				return
+			}
+
+			ids := entityEventIDs(eventData)
+			ancestors := ctrl.lazyAncestors(eventData.GID, ids)
+
 			_ = m.BroadcastFilter(jsonBytes, func(s *melody.Session) bool {
 				groupIDStr, ok := s.Get("gid")
 				if !ok {
@@ -257,23 +276,34 @@ func (ctrl *V1Controller) HandleCacheWS() errchain.HandlerFunc {
 				}
 
 				GID := groupIDStr.(uuid.UUID)
-				return GID == eventData.GID
+				if GID != eventData.GID {
+					return false
+				}
+
+				if !filterable {
+					return true
+				}
+				subs, ok := s.Get("subs")
+				if !ok {
+					return true
+				}
+				return subs.(*wsSubscriptions).matches(ids, ancestors)
 			})
 		}
 	}
 
-	ctrl.bus.Subscribe(eventbus.EventTagMutation, factory("tag.mutation"))
-	ctrl.bus.Subscribe(eventbus.EventEntityMutation, factory("entity.mutation"))
-	ctrl.bus.Subscribe(eventbus.EventUserMutation, factory("user.mutation"))
-	ctrl.bus.Subscribe(eventbus.EventExportMutation, factory("export.mutation"))
-	ctrl.bus.Subscribe(eventbus.EventImportMutation, factory("import.mutation"))
+	ctrl.bus.Subscribe(eventbus.EventTagMutation, factory("tag.mutation", false))
+	ctrl.bus.Subscribe(eventbus.EventEntityMutation, factory("entity.mutation", true))
+	ctrl.bus.Subscribe(eventbus.EventUserMutation, factory("user.mutation", false))
+	ctrl.bus.Subscribe(eventbus.EventExportMutation, factory("export.mutation", false))
+	ctrl.bus.Subscribe(eventbus.EventImportMutation, factory("import.mutation", false))
 
 	// Persistent asynchronous ticker that keeps all websocket connections alive with periodic pings.
 	go func() {
 		const interval = 10 * time.Second
 
 		// The ping frame never changes, so build it once rather than on every tick.
-		pingBytes, err := json.Marshal(&eventMsg{Event: "ping"})
+		pingBytes, err := json.Marshal(&wsEventMsg{Event: "ping"})
 		if err != nil {
 			log.Log().Msgf("error marshaling ping: %v", err)
 			return
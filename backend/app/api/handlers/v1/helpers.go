@@ -33,6 +33,17 @@ func allowSlowResponse(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// allowSlowRequest lifts the read and write deadlines of a request whose body
+// may take longer to arrive than web.read_timeout allows.
+func allowSlowRequest(w http.ResponseWriter, r *http.Request) {
+	if err := http.NewResponseController(w).SetReadDeadline(time.Time{}); err != nil {
+		log.Warn().Err(err).
+			Str("path", r.URL.Path).
+			Msg("could not clear read deadline; large uploads may be truncated by web.read_timeout")
+	}
+	allowSlowResponse(w, r)
+}
+
 // GetHBURL determines the base URL of the Homebox instance using the following priority:
 // 1. Configured hostname from Options.Hostname
 // 2. X-Forwarded headers (if TrustProxy is enabled)
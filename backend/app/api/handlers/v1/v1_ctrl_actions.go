@@ -97,6 +97,19 @@ func (ctrl *V1Controller) HandleCreateMissingThumbnails() errchain.HandlerFunc {
 	return actionHandlerFactory("create missing thumbnails", ctrl.repo.Attachments.CreateMissingThumbnails)
 }
 
+// HandleRebuildSearchIndex godoc
+//
+//	@Summary		Rebuild Search Index
+//	@Description	Rebuilds the full-text search index for every entity in the collection
+//	@Tags			Actions
+//	@Produce		json
+//	@Success		200	{object}	ActionAmountResult
+//	@Router			/v1/actions/rebuild-search-index [Post]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleRebuildSearchIndex() errchain.HandlerFunc {
+	return actionHandlerFactory("rebuild search index", ctrl.repo.Search.RebuildGroup)
+}
+
 // WipeInventoryOptions represents the options for wiping inventory
 type WipeInventoryOptions struct {
 	WipeTags        bool `json:"wipeTags"`
@@ -150,10 +163,10 @@ func (ctrl *V1Controller) HandleWipeInventory() errchain.HandlerFunc {
 		// Publish mutation events for wiped resources
 		if ctrl.bus != nil {
 			if options.WipeTags {
-				ctrl.bus.Publish(eventbus.EventTagMutation, eventbus.GroupMutationEvent{GID: ctx.GID})
+				ctrl.bus.Publish(eventbus.EventTagMutation, eventbus.GroupMutationEvent{GID: ctx.GID, Action: eventbus.MutationDelete, ActorID: ctx.UID})
 			}
 			if options.WipeLocations {
-				ctrl.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: ctx.GID})
+				ctrl.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: ctx.GID, Action: eventbus.MutationDelete, ActorID: ctx.UID})
 			}
 		}
 
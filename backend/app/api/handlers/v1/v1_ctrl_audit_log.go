@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleEntityHistory godoc
+//
+//	@Summary	Get Entity Change History
+//	@Tags		Entities
+//	@Produce	json
+//	@Param		id			path		string	true	"Entity ID"
+//	@Param		page		query		int		false	"page number"
+//	@Param		pageSize	query		int		false	"items per page"
+//	@Success	200			{object}	repo.PaginationResult[repo.AuditEntryOut]{}
+//	@Router		/v1/entities/{id}/history [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityHistory() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, q repo.AuditLogQuery) (repo.PaginationResult[repo.AuditEntryOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.AuditLog.GetByEntity(auth, auth.GID, ID, q.Page, q.PageSize)
+	}
+
+	return adapters.QueryID("id", fn, http.StatusOK)
+}
+
+// HandleGroupHistory godoc
+//
+//	@Summary	Get Group Change History
+//	@Tags		Group
+//	@Produce	json
+//	@Param		page		query		int	false	"page number"
+//	@Param		pageSize	query		int	false	"items per page"
+//	@Success	200			{object}	repo.PaginationResult[repo.AuditEntryOut]{}
+//	@Router		/v1/groups/history [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleGroupHistory() errchain.HandlerFunc {
+	fn := func(r *http.Request, q repo.AuditLogQuery) (repo.PaginationResult[repo.AuditEntryOut], error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.AuditLog.GetByGroup(auth, auth.GID, q.Page, q.PageSize)
+	}
+
+	return adapters.Query(fn, http.StatusOK)
+}
@@ -48,11 +48,14 @@ func startEntityCtrlSpan(ctx context.Context, name string, attrs ...attribute.Ke
 //	@Summary	Query All Entities
 //	@Tags		Entities
 //	@Produce	json
-//	@Param		q			query		string		false	"search string"
+//	@Param		q			query		string		false	"search string with optional filters, e.g. tag:garage AND purchasePrice>100 AND NOT archived"
+//	@Param		orderBy		query		string		false	"name (default), createdAt, updatedAt, assetId or relevance"
 //	@Param		page		query		int			false	"page number"
 //	@Param		pageSize	query		int			false	"items per page"
 //	@Param		tags		query		[]string	false	"tags Ids"		collectionFormat(multi)
 //	@Param		parentIds	query		[]string	false	"parent Ids"	collectionFormat(multi)
+//	@Param		onLoan		query		bool		false	"only entities currently lent out"
+//	@Param		insured		query		bool		false	"only insured entities"
 //	@Success	200			{object}	repo.EntityListResult
 //	@Router		/v1/entities [GET]
 //	@Security	Bearer
@@ -83,6 +86,8 @@ func (ctrl *V1Controller) HandleEntitiesGetAll() errchain.HandlerFunc {
 			OnlyWithoutPhoto: queryBool(params.Get("onlyWithoutPhoto")),
 			OnlyWithPhoto:    queryBool(params.Get("onlyWithPhoto")),
 			IncludeArchived:  queryBool(params.Get("includeArchived")),
+			OnLoan:           queryBool(params.Get("onLoan")),
+			Insured:          queryBool(params.Get("insured")),
 			Fields:           filterFieldItems(params["fields"]),
 			OrderBy:          params.Get("orderBy"),
 		}
@@ -133,6 +138,11 @@ func (ctrl *V1Controller) HandleEntitiesGetAll() errchain.HandlerFunc {
 
 		items, err := ctrl.repo.Entities.QueryByGroup(ctx, ctx.GID, query)
 		if err != nil {
+			var qerr *repo.EntityQueryError
+			if errors.As(err, &qerr) {
+				recordCtrlSpanError(span, err)
+				return validate.NewRequestError(err, http.StatusBadRequest)
+			}
 			if errors.Is(err, sql.ErrNoRows) {
 				span.SetAttributes(attribute.Int("response.items.count", 0))
 				return server.JSON(w, http.StatusOK, repo.PaginationResult[repo.EntitySummary]{
@@ -315,12 +325,21 @@ func (ctrl *V1Controller) HandleEntityUpdate() errchain.HandlerFunc {
 		auth := services.NewContext(spanCtx)
 		span.SetAttributes(attribute.String("group.id", auth.GID.String()))
 
+		err := ctrl.checkCurrencies(
+			currencyField{"purchaseCurrency", body.PurchaseCurrency},
+			currencyField{"soldCurrency", body.SoldCurrency},
+		)
+		if err != nil {
+			recordCtrlSpanError(span, err)
+			return repo.EntityOut{}, err
+		}
+
 		body.ID = ID
 		out, err := ctrl.repo.Entities.UpdateByGroup(auth, auth.GID, body)
 		if err != nil {
 			recordCtrlSpanError(span, err)
 		}
-		return out, err
+		return out, entityFieldError(err)
 	}
 
 	return adapters.ActionID("id", fn, http.StatusOK)
@@ -477,8 +496,10 @@ func (ctrl *V1Controller) HandleEntitiesImport() errchain.HandlerFunc {
 		spanCtx, span := startEntityCtrlSpan(r.Context(), "controller.V1.HandleEntitiesImport")
 		defer span.End()
 
+		// The body size is capped by the body-size middleware; parts beyond
+		// maxParseMemory are spooled to temporary files instead of memory.
 		_, parseSpan := startEntityCtrlSpan(spanCtx, "controller.V1.HandleEntitiesImport.parseForm")
-		err := r.ParseMultipartForm(ctrl.maxUploadSize << 20)
+		err := r.ParseMultipartForm(ctrl.maxParseMemory << 20)
 		if err != nil {
 			recordCtrlSpanError(parseSpan, err)
 			parseSpan.End()
@@ -547,7 +568,8 @@ func (ctrl *V1Controller) HandleLocationTreeQuery() errchain.HandlerFunc {
 //
 //	@Summary	Export Entities
 //	@Tags		Entities
-//	@Success	200	{string}	string	"text/csv"
+//	@Param		savedSearch	query		string	false	"only export entities matching this saved search"
+//	@Success	200			{string}	string	"text/csv"
 //	@Router		/v1/entities/export [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleEntitiesExport() errchain.HandlerFunc {
@@ -558,7 +580,13 @@ func (ctrl *V1Controller) HandleEntitiesExport() errchain.HandlerFunc {
 		ctx := services.NewContext(spanCtx)
 		span.SetAttributes(attribute.String("group.id", ctx.GID.String()))
 
-		csvData, err := ctrl.svc.Entities.ExportCSV(spanCtx, ctx.GID, GetHBURL(r, &ctrl.config.Options, ctrl.url))
+		scope, err := ctrl.savedSearchScope(ctx, ctx.GID, r)
+		if err != nil {
+			recordCtrlSpanError(span, err)
+			return err
+		}
+
+		csvData, err := ctrl.svc.Entities.ExportCSV(spanCtx, ctx.GID, GetHBURL(r, &ctrl.config.Options, ctrl.url), scope)
 		if err != nil {
 			recordCtrlSpanError(span, err)
 			log.Err(err).Msg("failed to export entities")
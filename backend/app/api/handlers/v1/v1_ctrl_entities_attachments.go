@@ -15,6 +15,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 	"go.opentelemetry.io/otel/attribute"
 
 	"gocloud.dev/blob"
@@ -33,6 +34,17 @@ func sanitizeAttachmentName(name string) string {
 	return name
 }
 
+// attachmentTypeFor is the type of an attachment uploaded without one: a
+// photo for image files, a plain attachment otherwise.
+func attachmentTypeFor(name string) attachment.Type {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".webp", ".gif", ".bmp", ".tiff", ".avif", ".ico", ".heic", ".jxl":
+		return attachment.TypePhoto
+	default:
+		return attachment.TypeAttachment
+	}
+}
+
 // HandleEntityAttachmentCreate godoc
 //
 //	@Summary	Create Entity Attachment
@@ -53,8 +65,10 @@ func (ctrl *V1Controller) HandleEntityAttachmentCreate() errchain.HandlerFunc {
 		spanCtx, span := startEntityCtrlSpan(r.Context(), "controller.V1.HandleEntityAttachmentCreate")
 		defer span.End()
 
+		// The body size is capped by the body-size middleware; parts beyond
+		// maxParseMemory are spooled to temporary files instead of memory.
 		_, parseSpan := startEntityCtrlSpan(spanCtx, "controller.V1.HandleEntityAttachmentCreate.parseForm")
-		err := r.ParseMultipartForm(ctrl.maxUploadSize << 20)
+		err := r.ParseMultipartForm(ctrl.maxParseMemory << 20)
 		if err != nil {
 			recordCtrlSpanError(parseSpan, err)
 			parseSpan.End()
@@ -96,14 +110,7 @@ func (ctrl *V1Controller) HandleEntityAttachmentCreate() errchain.HandlerFunc {
 
 		attachmentType := r.FormValue("type")
 		if attachmentType == "" {
-			ext := filepath.Ext(attachmentName)
-
-			switch strings.ToLower(ext) {
-			case ".jpg", ".jpeg", ".png", ".webp", ".gif", ".bmp", ".tiff", ".avif", ".ico", ".heic", ".jxl":
-				attachmentType = attachment.TypePhoto.String()
-			default:
-				attachmentType = attachment.TypeAttachment.String()
-			}
+			attachmentType = attachmentTypeFor(attachmentName).String()
 		}
 
 		primary, err := strconv.ParseBool(r.FormValue("primary"))
@@ -187,6 +194,45 @@ func (ctrl *V1Controller) HandleEntityAttachmentUpdate() errchain.HandlerFunc {
 	return ctrl.handleEntityAttachmentsHandler
 }
 
+// HandleEntityAttachmentApplyMetadata godoc
+//
+//	@Summary		Apply Attachment Metadata
+//	@Description	Copies the capture date of a photo to the purchase date of the entity, or its position to the Coordinates custom field.
+//	@Tags			Entities Attachments
+//	@Produce		json
+//	@Param			id				path		string							true	"Entity ID"
+//	@Param			attachment_id	path		string							true	"Attachment ID"
+//	@Param			payload			body		repo.AttachmentMetadataApply	true	"Metadata to apply"
+//	@Success		200				{object}	repo.EntityOut
+//	@Router			/v1/entities/{id}/attachments/{attachment_id}/apply-metadata [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleEntityAttachmentApplyMetadata() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ID, err := ctrl.routeID(r)
+		if err != nil {
+			return err
+		}
+		attachmentID, err := ctrl.routeUUID(r, "attachment_id")
+		if err != nil {
+			return err
+		}
+
+		body, err := adapters.DecodeBody[repo.AttachmentMetadataApply](r)
+		if err != nil {
+			return err
+		}
+
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.Entities.ApplyAttachmentMetadata(auth, auth.GID, ID, attachmentID, body)
+		if err != nil {
+			log.Err(err).Msg("failed to apply attachment metadata")
+			return entityFieldError(err)
+		}
+
+		return server.JSON(w, http.StatusOK, out)
+	}
+}
+
 func (ctrl *V1Controller) handleEntityAttachmentsHandler(w http.ResponseWriter, r *http.Request) error {
 	spanCtx, span := startEntityCtrlSpan(r.Context(), "controller.V1.handleEntityAttachmentsHandler",
 		attribute.String("http.method", r.Method))
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/hay-kot/httpkit/server"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// uploadOffsetHeader carries the offset of a chunk in requests and the bytes
+// received in responses, as in the tus protocol.
+const uploadOffsetHeader = "Upload-Offset"
+
+// uploadError maps the errors of a chunk write to their status codes.
+func uploadError(err error) error {
+	var offsetErr *repo.UploadOffsetError
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &offsetErr), errors.Is(err, repo.ErrUploadCompleted):
+		return validate.NewRequestError(err, http.StatusConflict)
+	case errors.Is(err, repo.ErrUploadTooLarge):
+		return validate.NewRequestError(err, http.StatusRequestEntityTooLarge)
+	case errors.As(err, &maxBytesErr):
+		return validate.NewRequestError(
+			fmt.Errorf("chunk exceeds the size limit of %d bytes", maxBytesErr.Limit),
+			http.StatusRequestEntityTooLarge)
+	}
+	return err
+}
+
+// HandleEntityUploadCreate godoc
+//
+//	@Summary		Create Resumable Attachment Upload
+//	@Description	Starts an upload of a file too large or a connection too flaky for a single request. Send the content in chunks with PATCH.
+//	@Tags			Entities Attachments
+//	@Produce		json
+//	@Param			id		path		string						true	"Entity ID"
+//	@Param			payload	body		repo.AttachmentUploadCreate	true	"Upload Data"
+//	@Success		201		{object}	repo.AttachmentUploadOut
+//	@Router			/v1/entities/{id}/uploads [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleEntityUploadCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.AttachmentUploadCreate) (repo.AttachmentUploadOut, error) {
+		if limit := ctrl.maxResumableUploadSize << 20; limit > 0 && body.Size > limit {
+			return repo.AttachmentUploadOut{}, validate.NewFieldErrors().
+				Append("size", fmt.Sprintf("size exceeds the upload limit of %d bytes", limit))
+		}
+
+		body.Title = sanitizeAttachmentName(body.Title)
+		if body.Type == "" {
+			body.Type = attachmentTypeFor(body.Title).String()
+		}
+
+		auth := services.NewContext(r.Context())
+		return ctrl.svc.Entities.AttachmentUploadCreate(auth, ID, body)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleEntityUploadGet godoc
+//
+//	@Summary		Get Resumable Attachment Upload
+//	@Description	Returns the bytes received so far, the offset to resume the upload from.
+//	@Tags			Entities Attachments
+//	@Produce		json
+//	@Param			id			path		string	true	"Entity ID"
+//	@Param			upload_id	path		string	true	"Upload ID"
+//	@Success		200			{object}	repo.AttachmentUploadOut
+//	@Router			/v1/entities/{id}/uploads/{upload_id} [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleEntityUploadGet() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ID, uploadID, err := ctrl.uploadRouteIDs(r)
+		if err != nil {
+			return err
+		}
+
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.Attachments.GetUpload(auth, auth.GID, ID, uploadID)
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set(uploadOffsetHeader, strconv.FormatInt(out.Received, 10))
+		return server.JSON(w, http.StatusOK, out)
+	}
+}
+
+// HandleEntityUploadPatch godoc
+//
+//	@Summary		Upload Attachment Chunk
+//	@Description	Appends the request body to the upload. The Upload-Offset header must match the bytes received so far; if the connection drops, the bytes that arrived are kept. The chunk that completes the upload creates the attachment and sets attachmentId.
+//	@Tags			Entities Attachments
+//	@Accept			application/offset+octet-stream
+//	@Produce		json
+//	@Param			id				path		string	true	"Entity ID"
+//	@Param			upload_id		path		string	true	"Upload ID"
+//	@Param			Upload-Offset	header		int		true	"Offset of the chunk in the file"
+//	@Success		200				{object}	repo.AttachmentUploadOut
+//	@Failure		409				{object}	validate.ErrorResponse
+//	@Router			/v1/entities/{id}/uploads/{upload_id} [PATCH]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleEntityUploadPatch() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		spanCtx, span := startEntityCtrlSpan(r.Context(), "controller.V1.HandleEntityUploadPatch")
+		defer span.End()
+
+		ID, uploadID, err := ctrl.uploadRouteIDs(r)
+		if err != nil {
+			recordCtrlSpanError(span, err)
+			return err
+		}
+
+		offset, err := strconv.ParseInt(r.Header.Get(uploadOffsetHeader), 10, 64)
+		if err != nil || offset < 0 {
+			err = fmt.Errorf("%s header must be a non-negative number", uploadOffsetHeader)
+			recordCtrlSpanError(span, err)
+			return validate.NewRequestError(err, http.StatusBadRequest)
+		}
+
+		span.SetAttributes(
+			attribute.String("entity.id", ID.String()),
+			attribute.String("upload.id", uploadID.String()),
+			attribute.Int64("upload.offset", offset),
+		)
+
+		// A chunk over a slow link, and assembling the file after the last
+		// one, can both outlast the server timeouts.
+		allowSlowRequest(w, r)
+
+		auth := services.NewContext(spanCtx)
+		out, err := ctrl.repo.Attachments.WriteUploadChunk(auth, auth.GID, ID, uploadID, offset, r.Body)
+		if out.ID != uuid.Nil {
+			w.Header().Set(uploadOffsetHeader, strconv.FormatInt(out.Received, 10))
+		}
+		if err != nil {
+			recordCtrlSpanError(span, err)
+			log.Err(err).Msg("failed to write upload chunk")
+			return uploadError(err)
+		}
+
+		return server.JSON(w, http.StatusOK, out)
+	}
+}
+
+// HandleEntityUploadDelete godoc
+//
+//	@Summary	Delete Resumable Attachment Upload
+//	@Tags		Entities Attachments
+//	@Param		id			path	string	true	"Entity ID"
+//	@Param		upload_id	path	string	true	"Upload ID"
+//	@Success	204
+//	@Router		/v1/entities/{id}/uploads/{upload_id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityUploadDelete() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ID, uploadID, err := ctrl.uploadRouteIDs(r)
+		if err != nil {
+			return err
+		}
+
+		auth := services.NewContext(r.Context())
+		if err := ctrl.repo.Attachments.DeleteUpload(auth, auth.GID, ID, uploadID); err != nil {
+			return err
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+func (ctrl *V1Controller) uploadRouteIDs(r *http.Request) (uuid.UUID, uuid.UUID, error) {
+	ID, err := ctrl.routeID(r)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	uploadID, err := ctrl.routeUUID(r, "upload_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return ID, uploadID, nil
+}
@@ -1,15 +1,32 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
 )
 
+// entityFieldError turns rejected custom fields into a 400 with an error for
+// each field.
+func entityFieldError(err error) error {
+	var ferrs repo.EntityFieldErrors
+	if !errors.As(err, &ferrs) {
+		return err
+	}
+	errs := validate.NewFieldErrors()
+	for _, fe := range ferrs {
+		errs = errs.Append(fe.Field, fmt.Sprintf("%s %s", fe.Field, fe.Msg))
+	}
+	return errs
+}
+
 // HandleEntityTypeGetAll godoc
 //
 //	@Summary	Get All Entity Types
@@ -83,3 +100,93 @@ func (ctrl *V1Controller) HandleEntityTypeDelete() errchain.HandlerFunc {
 
 	return adapters.CommandID("id", fn, http.StatusNoContent)
 }
+
+// HandleEntityTypeFieldsGetAll godoc
+//
+//	@Summary	Get Entity Type Field Definitions
+//	@Tags		Entity Types
+//	@Produce	json
+//	@Param		id	path	string	true	"Entity Type ID"
+//	@Success	200	{array}	repo.EntityFieldDefinitionOut
+//	@Router		/v1/entity-types/{id}/fields [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityTypeFieldsGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.EntityFieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.EntityTypes.GetFieldDefinitions(r.Context(), auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleEntityTypeFieldCreate godoc
+//
+//	@Summary	Create Entity Type Field Definition
+//	@Tags		Entity Types
+//	@Produce	json
+//	@Param		id		path		string							true	"Entity Type ID"
+//	@Param		payload	body		repo.EntityFieldDefinitionCreate	true	"Field Definition Data"
+//	@Success	201		{object}	repo.EntityFieldDefinitionOut
+//	@Router		/v1/entity-types/{id}/fields [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityTypeFieldCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.EntityFieldDefinitionCreate) (repo.EntityFieldDefinitionOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.EntityTypes.CreateFieldDefinition(r.Context(), auth.GID, ID, body)
+		return out, entityFieldError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleEntityTypeFieldUpdate godoc
+//
+//	@Summary	Update Entity Type Field Definition
+//	@Tags		Entity Types
+//	@Produce	json
+//	@Param		id			path		string							true	"Entity Type ID"
+//	@Param		field_id	path		string							true	"Field Definition ID"
+//	@Param		payload		body		repo.EntityFieldDefinitionUpdate	true	"Field Definition Data"
+//	@Success	200			{object}	repo.EntityFieldDefinitionOut
+//	@Router		/v1/entity-types/{id}/fields/{field_id} [PUT]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityTypeFieldUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, body repo.EntityFieldDefinitionUpdate) (repo.EntityFieldDefinitionOut, error) {
+		fieldID, err := ctrl.routeUUID(r, "field_id")
+		if err != nil {
+			return repo.EntityFieldDefinitionOut{}, err
+		}
+
+		auth := services.NewContext(r.Context())
+		body.ID = fieldID
+		out, err := ctrl.repo.EntityTypes.UpdateFieldDefinition(r.Context(), auth.GID, ID, body)
+		return out, entityFieldError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleEntityTypeFieldDelete godoc
+//
+//	@Summary	Delete Entity Type Field Definition
+//	@Tags		Entity Types
+//	@Produce	json
+//	@Param		id			path	string	true	"Entity Type ID"
+//	@Param		field_id	path	string	true	"Field Definition ID"
+//	@Success	204
+//	@Router		/v1/entity-types/{id}/fields/{field_id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityTypeFieldDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		fieldID, err := ctrl.routeUUID(r, "field_id")
+		if err != nil {
+			return nil, err
+		}
+
+		auth := services.NewContext(r.Context())
+		err = ctrl.repo.EntityTypes.DeleteFieldDefinition(r.Context(), auth.GID, ID, fieldID)
+		return nil, err
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+type currencyField struct {
+	field, code string
+}
+
+// checkCurrencies returns field errors for the codes the registry doesn't
+// know. Empty codes mean the group's currency and are always fine.
+func (ctrl *V1Controller) checkCurrencies(fields ...currencyField) error {
+	var errs validate.FieldErrors
+	for _, f := range fields {
+		if f.code != "" && !ctrl.svc.Currencies.IsSupported(f.code) {
+			errs = errs.Append(f.field, "currency '"+f.code+"' is not supported")
+		}
+	}
+	if errs.Nil() {
+		return nil
+	}
+	return errs
+}
+
+func (ctrl *V1Controller) checkExchangeRate(rate repo.ExchangeRateCreate) error {
+	return ctrl.checkCurrencies(currencyField{"base", rate.Base}, currencyField{"quote", rate.Quote})
+}
+
+// HandleExchangeRatesGetAll godoc
+//
+//	@Summary	Get Exchange Rates
+//	@Tags		Group
+//	@Produce	json
+//	@Success	200	{array}	repo.ExchangeRateOut
+//	@Router		/v1/groups/exchange-rates [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleExchangeRatesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.ExchangeRateOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.ExchangeRates.GetAll(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleExchangeRateSet godoc
+//
+//	@Summary	Set Exchange Rate
+//	@Tags		Group
+//	@Produce	json
+//	@Param		payload	body		repo.ExchangeRateCreate	true	"Exchange Rate"
+//	@Success	201		{object}	repo.ExchangeRateOut
+//	@Router		/v1/groups/exchange-rates [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleExchangeRateSet() errchain.HandlerFunc {
+	fn := func(r *http.Request, body repo.ExchangeRateCreate) (repo.ExchangeRateOut, error) {
+		if err := ctrl.checkExchangeRate(body); err != nil {
+			return repo.ExchangeRateOut{}, err
+		}
+
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.ExchangeRates.Set(auth, auth.GID, body)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleExchangeRateDelete godoc
+//
+//	@Summary	Delete Exchange Rate
+//	@Tags		Group
+//	@Produce	json
+//	@Param		id	path	string	true	"Exchange Rate ID"
+//	@Success	204
+//	@Router		/v1/groups/exchange-rates/{id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleExchangeRateDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.repo.ExchangeRates.Delete(auth, auth.GID, id)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleExchangeRatesImport godoc
+//
+//	@Summary	Import Exchange Rates
+//	@Tags		Group
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Success	204
+//	@Param		file	formData	file	true	"JSON or CSV file with base, quote, rate and date"
+//	@Router		/v1/groups/exchange-rates/import [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleExchangeRatesImport() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		err := r.ParseMultipartForm(ctrl.maxParseMemory << 20)
+		if err != nil {
+			log.Err(err).Msg("failed to parse multipart form")
+			return multipartFormError(err)
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		defer func() { _ = file.Close() }()
+
+		rates, err := reporting.ReadExchangeRates(file)
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		for _, rate := range rates {
+			if err := validate.Check(rate); err != nil {
+				return err
+			}
+			if err := ctrl.checkExchangeRate(rate); err != nil {
+				return err
+			}
+		}
+
+		auth := services.NewContext(r.Context())
+		if _, err := ctrl.repo.ExchangeRates.Import(auth, auth.GID, rates); err != nil {
+			log.Err(err).Msg("failed to import exchange rates")
+			return err
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
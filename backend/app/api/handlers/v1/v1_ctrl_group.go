@@ -10,6 +10,7 @@ import (
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/samber/lo"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
@@ -19,13 +20,25 @@ type (
 	GroupInvitationCreate struct {
 		Uses      int       `json:"uses"      validate:"required,min=1,max=100"`
 		ExpiresAt time.Time `json:"expiresAt"`
+		// Role is given to members who join with the invitation. Defaults
+		// to editor.
+		Role usergroup.Role `json:"role" validate:"omitempty,oneof=viewer contributor editor"`
 	}
 
 	GroupInvitation struct {
-		ID        uuid.UUID `json:"id"`
-		Token     string    `json:"token"`
-		ExpiresAt time.Time `json:"expiresAt"`
-		Uses      int       `json:"uses"`
+		ID        uuid.UUID      `json:"id"`
+		Token     string         `json:"token"`
+		ExpiresAt time.Time      `json:"expiresAt"`
+		Uses      int            `json:"uses"`
+		Role      usergroup.Role `json:"role"`
+	}
+
+	GroupMemberRoleUpdate struct {
+		Role usergroup.Role `json:"role" validate:"required,oneof=viewer contributor editor owner"`
+	}
+
+	GroupMemberLocationsUpdate struct {
+		LocationIDs []uuid.UUID `json:"locationIds" validate:"max=100"`
 	}
 
 	GroupAcceptInvitationResponse struct {
@@ -55,6 +68,24 @@ func (ctrl *V1Controller) HandleGroupGet() errchain.HandlerFunc {
 	return adapters.Command(fn, http.StatusOK)
 }
 
+// HandleGroupStorageReport godoc
+//
+//	@Summary		Get Group Storage Report
+//	@Description	Compares the attachment files of the group in storage with the attachments referencing them. Orphaned files are removed by the garbage collector after a grace period; missing files have to be uploaded again.
+//	@Tags			Group
+//	@Produce		json
+//	@Success		200	{object}	repo.StorageReport
+//	@Router			/v1/groups/storage [Get]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleGroupStorageReport() errchain.HandlerFunc {
+	fn := func(r *http.Request) (repo.StorageReport, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Attachments.StorageReport(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
 // HandleGroupUpdate godoc
 //
 //	@Summary	Update Group
@@ -99,7 +130,7 @@ func (ctrl *V1Controller) HandleGroupInvitationsCreate() errchain.HandlerFunc {
 
 		auth := services.NewContext(r.Context())
 
-		invitation, token, err := ctrl.svc.Group.NewInvitation(auth, body.Uses, body.ExpiresAt)
+		invitation, token, err := ctrl.svc.Group.NewInvitation(auth, body.Uses, body.ExpiresAt, body.Role)
 		if err != nil {
 			return GroupInvitation{}, err
 		}
@@ -109,6 +140,7 @@ func (ctrl *V1Controller) HandleGroupInvitationsCreate() errchain.HandlerFunc {
 			Token:     token,
 			ExpiresAt: invitation.ExpiresAt,
 			Uses:      invitation.Uses,
+			Role:      invitation.Role,
 		}, nil
 	}
 
@@ -215,18 +247,56 @@ func (ctrl *V1Controller) HandleGroupInvitationsGetAll() errchain.HandlerFunc {
 //	@Summary	Get All Group Members
 //	@Tags		Group
 //	@Produce	json
-//	@Success	200	{object}	[]repo.UserSummary
+//	@Success	200	{object}	[]repo.GroupMember
 //	@Router		/v1/groups/members [Get]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleGroupMembersGetAll() errchain.HandlerFunc {
-	fn := func(r *http.Request) ([]repo.UserSummary, error) {
+	fn := func(r *http.Request) ([]repo.GroupMember, error) {
 		auth := services.NewContext(r.Context())
-		return ctrl.repo.Users.GetUsersByGroupID(auth, auth.GID)
+		return ctrl.repo.Groups.GetMembers(auth, auth.GID)
 	}
 
 	return adapters.Command(fn, http.StatusOK)
 }
 
+// HandleGroupMemberRoleUpdate godoc
+//
+//	@Summary	Change a Member's Role
+//	@Tags		Group
+//	@Produce	json
+//	@Param		user_id	path	string					true	"User ID"
+//	@Param		payload	body	GroupMemberRoleUpdate	true	"Role"
+//	@Success	204
+//	@Router		/v1/groups/members/{user_id} [Put]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleGroupMemberRoleUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, userID uuid.UUID, body GroupMemberRoleUpdate) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Group.SetMemberRole(auth, userID, body.Role)
+	}
+
+	return adapters.ActionID("user_id", fn, http.StatusNoContent)
+}
+
+// HandleGroupMemberLocationsUpdate godoc
+//
+//	@Summary	Limit a Member to Locations
+//	@Tags		Group
+//	@Produce	json
+//	@Param		user_id	path	string						true	"User ID"
+//	@Param		payload	body	GroupMemberLocationsUpdate	true	"Locations, empty for the whole collection"
+//	@Success	204
+//	@Router		/v1/groups/members/{user_id}/locations [Put]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleGroupMemberLocationsUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, userID uuid.UUID, body GroupMemberLocationsUpdate) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.svc.Group.SetMemberLocations(auth, userID, body.LocationIDs)
+	}
+
+	return adapters.ActionID("user_id", fn, http.StatusNoContent)
+}
+
 // HandleGroupMemberRemove godoc
 //
 //	@Summary	Remove User from Group
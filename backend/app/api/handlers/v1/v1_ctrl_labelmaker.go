@@ -1,12 +1,14 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/errchain"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
@@ -15,22 +17,71 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/pkgs/labelmaker"
 )
 
+// maxSavedSearchLabels caps how many labels one saved search can send to the
+// printer.
+const maxSavedSearchLabels = 500
+
+// maxSheetLabels caps how many labels one label sheet PDF can hold.
+const maxSheetLabels = 1000
+
+func labelParams(ctrl *V1Controller, title string, description string, url string) labelmaker.GenerateParameters {
+	return labelmaker.NewGenerateParams(int(ctrl.config.LabelMaker.Width), int(ctrl.config.LabelMaker.Height), int(ctrl.config.LabelMaker.Margin), int(ctrl.config.LabelMaker.Padding), ctrl.config.LabelMaker.FontSize, title, description, url, ctrl.config.LabelMaker.DynamicLength, ctrl.config.LabelMaker.AdditionalInformation)
+}
+
+func itemLabelDescription(item repo.EntityOut) string {
+	description := ""
+
+	if item.Parent != nil {
+		description += fmt.Sprintf("\nLocation: %s", item.Parent.Name)
+	}
+
+	return description
+}
+
+// labelFormat returns the label format of the format query parameter, and
+// the configured print format when it's absent.
+func labelFormat(ctrl *V1Controller, r *http.Request) (labelmaker.Format, error) {
+	raw := r.URL.Query().Get("format")
+	if raw == "" {
+		raw = ctrl.config.LabelMaker.PrintFormat
+	}
+	format, err := labelmaker.ParseFormat(raw)
+	if err != nil {
+		return "", validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return format, nil
+}
+
 func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request, title string, description string, url string) error {
-	params := labelmaker.NewGenerateParams(int(ctrl.config.LabelMaker.Width), int(ctrl.config.LabelMaker.Height), int(ctrl.config.LabelMaker.Margin), int(ctrl.config.LabelMaker.Padding), ctrl.config.LabelMaker.FontSize, title, description, url, ctrl.config.LabelMaker.DynamicLength, ctrl.config.LabelMaker.AdditionalInformation)
+	params := labelParams(ctrl, title, description, url)
 
 	print := queryBool(r.URL.Query().Get("print"))
 
 	if print {
-		err := labelmaker.PrintLabel(ctrl.config, &params)
+		format, err := labelFormat(ctrl, r)
+		if err != nil {
+			return err
+		}
+
+		err = labelmaker.PrintLabelAs(ctrl.config, &params, format)
 		if err != nil {
 			return err
 		}
 
 		_, err = w.Write([]byte("Printed!"))
 		return err
-	} else {
-		return labelmaker.GenerateLabel(w, &params, ctrl.config)
 	}
+
+	// Labels are shown as images unless a format is asked for.
+	format, err := labelmaker.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	if format != labelmaker.FormatPNG {
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", "attachment; filename=label."+format.Extension())
+	}
+	return labelmaker.RenderLabel(w, &params, ctrl.config, format)
 }
 
 // HandleGetLocationLabel godoc
@@ -40,6 +91,7 @@ func generateOrPrint(ctrl *V1Controller, w http.ResponseWriter, r *http.Request,
 //	@Produce	json
 //	@Param		id		path		string	true	"Location ID"
 //	@Param		print	query		bool	false	"Print this label, defaults to false"
+//	@Param		format	query		string	false	"Label format: png, zpl, escpos or brother-ql"
 //	@Success	200		{string}	string	"image/png"
 //	@Router		/v1/labelmaker/location/{id} [GET]
 //	@Security	Bearer
@@ -68,6 +120,7 @@ func (ctrl *V1Controller) HandleGetLocationLabel() errchain.HandlerFunc {
 //	@Produce	json
 //	@Param		id		path		string	true	"Item ID"
 //	@Param		print	query		bool	false	"Print this label, defaults to false"
+//	@Param		format	query		string	false	"Label format: png, zpl, escpos or brother-ql"
 //	@Success	200		{string}	string	"image/png"
 //	@Router		/v1/labelmaker/item/{id} [GET]
 //	@Security	Bearer
@@ -84,14 +137,8 @@ func (ctrl *V1Controller) HandleGetItemLabel() errchain.HandlerFunc {
 			return err
 		}
 
-		description := ""
-
-		if item.Parent != nil {
-			description += fmt.Sprintf("\nLocation: %s", item.Parent.Name)
-		}
-
 		hbURL := GetHBURL(r, &ctrl.config.Options, ctrl.url)
-		return generateOrPrint(ctrl, w, r, item.Name, description, fmt.Sprintf("%s/item/%s", hbURL, item.ID))
+		return generateOrPrint(ctrl, w, r, item.Name, itemLabelDescription(item), fmt.Sprintf("%s/item/%s", hbURL, item.ID))
 	}
 }
 
@@ -102,6 +149,7 @@ func (ctrl *V1Controller) HandleGetItemLabel() errchain.HandlerFunc {
 //	@Produce	json
 //	@Param		id		path		string	true	"Asset ID"
 //	@Param		print	query		bool	false	"Print this label, defaults to false"
+//	@Param		format	query		string	false	"Label format: png, zpl, escpos or brother-ql"
 //	@Success	200		{string}	string	"image/png"
 //	@Router		/v1/labelmaker/asset/{id} [GET]
 //	@Security	Bearer
@@ -134,3 +182,232 @@ func (ctrl *V1Controller) HandleGetAssetLabel() errchain.HandlerFunc {
 		return generateOrPrint(ctrl, w, r, item.Items[0].AssetID.String(), description, fmt.Sprintf("%s/a/%s", hbURL, item.Items[0].AssetID.String()))
 	}
 }
+
+// SavedSearchLabelsOut reports how many labels were sent to the printer.
+type SavedSearchLabelsOut struct {
+	Printed int `json:"printed"`
+}
+
+// HandlePrintSavedSearchLabels godoc
+//
+//	@Summary		Print Saved Search labels
+//	@Description	Prints an item label for every entity the saved search matches, up to 500.
+//	@Tags			Saved Searches
+//	@Produce		json
+//	@Param			id		path		string	true	"Saved Search ID"
+//	@Param			format	query		string	false	"Label format: png, zpl, escpos or brother-ql; defaults to the configured one"
+//	@Success		200		{object}	SavedSearchLabelsOut
+//	@Router			/v1/labelmaker/saved-search/{id} [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandlePrintSavedSearchLabels() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (SavedSearchLabelsOut, error) {
+		format, err := labelFormat(ctrl, r)
+		if err != nil {
+			return SavedSearchLabelsOut{}, err
+		}
+
+		auth := services.NewContext(r.Context())
+		search, err := ctrl.repo.SavedSearches.GetOne(auth, auth.GID, ID)
+		if err != nil {
+			return SavedSearchLabelsOut{}, err
+		}
+
+		items, err := ctrl.repo.Entities.GetAllMatching(auth, auth.GID, search.EntityQuery())
+		if err != nil {
+			return SavedSearchLabelsOut{}, entityQueryError(err)
+		}
+		if len(items) > maxSavedSearchLabels {
+			return SavedSearchLabelsOut{}, validate.NewRequestError(
+				fmt.Errorf("saved search matches %d entities, at most %d labels can be printed at once", len(items), maxSavedSearchLabels),
+				http.StatusBadRequest)
+		}
+
+		hbURL := GetHBURL(r, &ctrl.config.Options, ctrl.url)
+		out := SavedSearchLabelsOut{}
+		for _, item := range items {
+			params := labelParams(ctrl, item.Name, itemLabelDescription(item), fmt.Sprintf("%s/item/%s", hbURL, item.ID))
+			if err := labelmaker.PrintLabelAs(ctrl.config, &params, format); err != nil {
+				return out, err
+			}
+			out.Printed++
+		}
+		return out, nil
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// LabelSheetPreset is a label sheet template that can be chosen by ID.
+type LabelSheetPreset struct {
+	ID       string                   `json:"id"`
+	Template labelmaker.SheetTemplate `json:"template"`
+}
+
+// HandleGetLabelSheetPresets godoc
+//
+//	@Summary	Get Label Sheet Presets
+//	@Tags		Items
+//	@Produce	json
+//	@Success	200	{object}	[]LabelSheetPreset
+//	@Router		/v1/labelmaker/sheet/presets [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleGetLabelSheetPresets() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]LabelSheetPreset, error) {
+		out := make([]LabelSheetPreset, 0, len(labelmaker.SheetPresets))
+		for _, id := range labelmaker.SheetPresetIDs() {
+			out = append(out, LabelSheetPreset{ID: id, Template: labelmaker.SheetPresets[id]})
+		}
+		return out, nil
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// LabelSheetRequest selects the entities of a label sheet and the sheet they
+// are printed on. Exactly one of EntityIDs, Query and LocationID is set.
+type LabelSheetRequest struct {
+	EntityIDs  []uuid.UUID       `json:"entityIds"`
+	Query      *repo.EntityQuery `json:"query"`
+	LocationID uuid.UUID         `json:"locationId" extensions:"x-nullable"`
+
+	// Asset prints asset ID labels instead of name labels; entities without
+	// an asset ID are left out.
+	Asset bool `json:"asset"`
+
+	// Preset is the ID of a preset sheet, Template a custom one.
+	Preset   string                    `json:"preset"`
+	Template *labelmaker.SheetTemplate `json:"template"`
+	// Offset is the number of labels already used on the first sheet.
+	Offset int `json:"offset" validate:"min=0"`
+}
+
+func (req LabelSheetRequest) sheetTemplate() (labelmaker.SheetTemplate, error) {
+	switch {
+	case req.Template != nil && req.Preset != "":
+		return labelmaker.SheetTemplate{}, errors.New("set either a preset or a template, not both")
+	case req.Template != nil:
+		return *req.Template, req.Template.Validate()
+	case req.Preset != "":
+		t, ok := labelmaker.SheetPresets[req.Preset]
+		if !ok {
+			return labelmaker.SheetTemplate{}, fmt.Errorf("unknown label sheet preset %q", req.Preset)
+		}
+		return t, nil
+	default:
+		return labelmaker.SheetTemplate{}, errors.New("a preset or a template is required")
+	}
+}
+
+// HandleGetLabelSheet godoc
+//
+//	@Summary		Get Label Sheet
+//	@Description	Lays out the labels of a list of entities, the entities an entity query matches or the entities in a
+//	@Description	location subtree on sheets of label stock, as a PDF.
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		application/pdf
+//	@Param			payload	body		LabelSheetRequest	true	"Entities and sheet"
+//	@Success		200		{string}	string				"application/pdf"
+//	@Router			/v1/labelmaker/sheet [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleGetLabelSheet() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		req, err := adapters.DecodeBody[LabelSheetRequest](r)
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := req.sheetTemplate()
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		if req.Offset >= tmpl.PerSheet() {
+			return validate.NewRequestError(fmt.Errorf("offset must be less than the %d labels on a sheet", tmpl.PerSheet()), http.StatusBadRequest)
+		}
+
+		entities, err := ctrl.labelSheetEntities(r, req)
+		if err != nil {
+			return err
+		}
+		if len(entities) > maxSheetLabels {
+			return validate.NewRequestError(
+				fmt.Errorf("%d entities selected, at most %d labels fit in one label sheet PDF", len(entities), maxSheetLabels),
+				http.StatusBadRequest)
+		}
+
+		hbURL := GetHBURL(r, &ctrl.config.Options, ctrl.url)
+		labels := make([]labelmaker.SheetLabel, 0, len(entities))
+		for _, e := range entities {
+			switch {
+			case req.Asset:
+				if e.AssetID == 0 {
+					continue
+				}
+				labels = append(labels, labelmaker.SheetLabel{
+					Title:       e.AssetID.String(),
+					Description: e.Name + itemLabelDescription(e),
+					URL:         fmt.Sprintf("%s/a/%s", hbURL, e.AssetID.String()),
+				})
+			case e.EntityType != nil && e.EntityType.IsLocation:
+				labels = append(labels, labelmaker.SheetLabel{
+					Title:       e.Name,
+					Description: "Homebox Location",
+					URL:         fmt.Sprintf("%s/location/%s", hbURL, e.ID),
+				})
+			default:
+				labels = append(labels, labelmaker.SheetLabel{
+					Title:       e.Name,
+					Description: itemLabelDescription(e),
+					URL:         fmt.Sprintf("%s/item/%s", hbURL, e.ID),
+				})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", "attachment; filename=labels.pdf")
+		return labelmaker.GenerateSheet(w, tmpl, req.Offset, labels, ctrl.config)
+	}
+}
+
+// labelSheetEntities returns the entities req selects, in the order they are
+// printed.
+func (ctrl *V1Controller) labelSheetEntities(r *http.Request, req LabelSheetRequest) ([]repo.EntityOut, error) {
+	sources := 0
+	if len(req.EntityIDs) > 0 {
+		sources++
+	}
+	if req.Query != nil {
+		sources++
+	}
+	if req.LocationID != uuid.Nil {
+		sources++
+	}
+	if sources != 1 {
+		return nil, validate.NewRequestError(errors.New("set exactly one of entityIds, query and locationId"), http.StatusBadRequest)
+	}
+
+	auth := services.NewContext(r.Context())
+	switch {
+	case len(req.EntityIDs) > 0:
+		if len(req.EntityIDs) > maxSheetLabels {
+			return nil, validate.NewRequestError(
+				fmt.Errorf("at most %d labels fit in one label sheet PDF", maxSheetLabels), http.StatusBadRequest)
+		}
+		out := make([]repo.EntityOut, 0, len(req.EntityIDs))
+		for _, id := range req.EntityIDs {
+			e, err := ctrl.repo.Entities.GetOneByGroup(auth, auth.GID, id)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, e)
+		}
+		return out, nil
+	case req.Query != nil:
+		out, err := ctrl.repo.Entities.GetAllMatching(auth, auth.GID, *req.Query)
+		return out, entityQueryError(err)
+	default:
+		// The location itself and everything below it.
+		ctx := repo.WithEntityScope(auth, req.LocationID)
+		return ctrl.repo.Entities.GetAllMatching(ctx, auth.GID, repo.EntityQuery{})
+	}
+}
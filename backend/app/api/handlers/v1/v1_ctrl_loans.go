@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// loanError maps the loan state errors to client errors.
+func loanError(err error) error {
+	switch {
+	case errors.Is(err, repo.ErrOnLoan), errors.Is(err, repo.ErrNotOnLoan):
+		return validate.NewRequestError(err, http.StatusConflict)
+	case errors.Is(err, repo.ErrBorrowerRequired):
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
+// HandleEntityLoansGet godoc
+//
+//	@Summary	Get Loan History
+//	@Tags		Loans
+//	@Produce	json
+//	@Param		id	path	string	true	"Entity ID"
+//	@Success	200	{array}	repo.LoanOut
+//	@Router		/v1/entities/{id}/loans [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityLoansGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID) ([]repo.LoanOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Loans.GetByEntity(auth, auth.GID, id)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleEntityCheckout godoc
+//
+//	@Summary	Check Out Entity
+//	@Tags		Loans
+//	@Produce	json
+//	@Param		id		path		string				true	"Entity ID"
+//	@Param		payload	body		repo.LoanCheckout	true	"Borrower and due date"
+//	@Success	201		{object}	repo.LoanOut
+//	@Router		/v1/entities/{id}/loans/checkout [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityCheckout() errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID, body repo.LoanCheckout) (repo.LoanOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.Loans.Checkout(auth, auth.GID, id, body)
+		return out, loanError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleEntityCheckin godoc
+//
+//	@Summary	Check In Entity
+//	@Tags		Loans
+//	@Produce	json
+//	@Param		id		path		string				true	"Entity ID"
+//	@Param		payload	body		repo.LoanCheckin	true	"Return details"
+//	@Success	200		{object}	repo.LoanOut
+//	@Router		/v1/entities/{id}/loans/checkin [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityCheckin() errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID, body repo.LoanCheckin) (repo.LoanOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.Loans.Checkin(auth, auth.GID, id, body)
+		return out, loanError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
@@ -41,6 +41,10 @@ func (ctrl *V1Controller) HandleMaintenanceLogGet() errchain.HandlerFunc {
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleMaintenanceEntryCreate() errchain.HandlerFunc {
 	fn := func(r *http.Request, itemID uuid.UUID, body repo.MaintenanceEntryCreate) (repo.MaintenanceEntry, error) {
+		if err := ctrl.checkCurrencies(currencyField{"costCurrency", body.CostCurrency}); err != nil {
+			return repo.MaintenanceEntry{}, err
+		}
+
 		auth := services.NewContext(r.Context())
 		return ctrl.repo.MaintEntry.Create(auth, auth.GID, itemID, body)
 	}
@@ -40,6 +40,12 @@ func (ctrl *V1Controller) HandleMaintenanceGetAll() errchain.HandlerFunc {
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleMaintenanceEntryUpdate() errchain.HandlerFunc {
 	fn := func(r *http.Request, entryID uuid.UUID, body repo.MaintenanceEntryUpdate) (repo.MaintenanceEntry, error) {
+		if body.CostCurrency != nil {
+			if err := ctrl.checkCurrencies(currencyField{"costCurrency", *body.CostCurrency}); err != nil {
+				return repo.MaintenanceEntry{}, err
+			}
+		}
+
 		auth := services.NewContext(r.Context())
 		return ctrl.repo.MaintEntry.Update(auth, auth.GID, entryID, body)
 	}
@@ -124,6 +124,28 @@ func (ctrl *V1Controller) HandlerNotifierTest() errchain.HandlerFunc {
 	return adapters.Action(fn, http.StatusOK)
 }
 
+// HandleNotifierPreview godoc
+//
+//	@Summary		Preview Notifier Template
+//	@Description	Renders a subscription template against sample data for the event. An empty template renders the event's default message.
+//	@Tags			Notifiers
+//	@Produce		json
+//	@Param			payload	body		services.NotificationPreview	true	"Event and template"
+//	@Success		200		{object}	services.NotificationPreviewOut
+//	@Router			/v1/notifiers/preview [POST]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleNotifierPreview() errchain.HandlerFunc {
+	fn := func(r *http.Request, in services.NotificationPreview) (services.NotificationPreviewOut, error) {
+		out, err := ctrl.svc.Notifications.Preview(services.NewContext(r.Context()), in)
+		if err != nil {
+			return services.NotificationPreviewOut{}, validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		return out, nil
+	}
+
+	return adapters.Action(fn, http.StatusOK)
+}
+
 // validateNotifierURL validates a notifier URL against the configured block/allow lists
 func (ctrl *V1Controller) validateNotifierURL(url string) error {
 	return validate.ValidateNotifierURL(url, &ctrl.config.Notifier)
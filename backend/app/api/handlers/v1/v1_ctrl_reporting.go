@@ -15,7 +15,8 @@ import (
 //	@Summary	Export Bill of Materials
 //	@Tags		Reporting
 //	@Produce	json
-//	@Success	200	{string}	string	"text/csv"
+//	@Param		savedSearch	query		string	false	"only include entities matching this saved search"
+//	@Success	200			{string}	string	"text/csv"
 //	@Router		/v1/reporting/bill-of-materials [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleBillOfMaterialsExport() errchain.HandlerFunc {
@@ -26,7 +27,12 @@ func (ctrl *V1Controller) HandleBillOfMaterialsExport() errchain.HandlerFunc {
 			return validate.NewRequestError(errors.New("tenant required"), http.StatusBadRequest)
 		}
 
-		csv, err := ctrl.svc.Entities.ExportBillOfMaterialsCSV(r.Context(), tenant)
+		scope, err := ctrl.savedSearchScope(r.Context(), tenant, r)
+		if err != nil {
+			return err
+		}
+
+		csv, err := ctrl.svc.Entities.ExportBillOfMaterialsCSV(r.Context(), tenant, scope)
 		if err != nil {
 			return err
 		}
@@ -37,3 +43,39 @@ func (ctrl *V1Controller) HandleBillOfMaterialsExport() errchain.HandlerFunc {
 		return err
 	}
 }
+
+// HandleInsuranceReportExport godoc
+//
+//	@Summary	Export Insurance Report
+//	@Tags		Reporting
+//	@Produce	application/pdf
+//	@Param		locations	query		[]string	false	"only include entities in these locations"	collectionFormat(multi)
+//	@Param		tags		query		[]string	false	"only include entities with these tags"		collectionFormat(multi)
+//	@Param		insured		query		bool		false	"only include insured entities"
+//	@Success	200			{string}	string		"application/pdf"
+//	@Router		/v1/reporting/insurance [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleInsuranceReportExport() errchain.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		tenant := services.UseTenantCtx(r.Context())
+
+		if tenant == uuid.Nil {
+			return validate.NewRequestError(errors.New("tenant required"), http.StatusBadRequest)
+		}
+
+		params := r.URL.Query()
+		pdf, err := ctrl.svc.Entities.ExportInsuranceReportPDF(r.Context(), tenant, services.InsuranceReportOptions{
+			LocationIDs: queryUUIDList(params, "locations"),
+			TagIDs:      queryUUIDList(params, "tags"),
+			Insured:     queryBool(params.Get("insured")),
+		})
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", "attachment; filename=insurance-report.pdf")
+		_, err = w.Write(pdf)
+		return err
+	}
+}
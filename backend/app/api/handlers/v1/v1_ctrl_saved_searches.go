@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// entityQueryError turns a malformed entity query into a 400.
+func entityQueryError(err error) error {
+	var qerr *repo.EntityQueryError
+	if errors.As(err, &qerr) {
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
+// savedSearchScope resolves the optional savedSearch query parameter of an
+// export or report into the query it runs. It returns nil when the parameter
+// is absent.
+func (ctrl *V1Controller) savedSearchScope(ctx context.Context, gid uuid.UUID, r *http.Request) (*repo.EntityQuery, error) {
+	raw := r.URL.Query().Get("savedSearch")
+	if raw == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, validate.NewRequestError(errors.New("invalid savedSearch id"), http.StatusBadRequest)
+	}
+
+	search, err := ctrl.repo.SavedSearches.GetOne(ctx, gid, id)
+	if err != nil {
+		return nil, err
+	}
+
+	q := search.EntityQuery()
+	return &q, nil
+}
+
+// HandleSavedSearchesGetAll godoc
+//
+//	@Summary	Get Saved Searches
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Success	200	{object}	[]repo.SavedSearchOut
+//	@Router		/v1/saved-searches [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchesGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetByGroup(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleSavedSearchCreate godoc
+//
+//	@Summary	Create Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		payload	body		repo.SavedSearchCreate	true	"Saved Search Data"
+//	@Success	201		{object}	repo.SavedSearchOut
+//	@Router		/v1/saved-searches [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, in repo.SavedSearchCreate) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.SavedSearches.Create(auth, auth.GID, in)
+		return out, entityQueryError(err)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleSavedSearchGet godoc
+//
+//	@Summary	Get Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		id	path		string	true	"Saved Search ID"
+//	@Success	200	{object}	repo.SavedSearchOut
+//	@Router		/v1/saved-searches/{id} [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchGet() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.SavedSearches.GetOne(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchUpdate godoc
+//
+//	@Summary	Update Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		id		path		string					true	"Saved Search ID"
+//	@Param		payload	body		repo.SavedSearchUpdate	true	"Saved Search Data"
+//	@Success	200		{object}	repo.SavedSearchOut
+//	@Router		/v1/saved-searches/{id} [PUT]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, in repo.SavedSearchUpdate) (repo.SavedSearchOut, error) {
+		auth := services.NewContext(r.Context())
+		out, err := ctrl.repo.SavedSearches.Update(auth, auth.GID, ID, in)
+		return out, entityQueryError(err)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleSavedSearchDelete godoc
+//
+//	@Summary		Delete Saved Search
+//	@Description	Also removes notifier subscriptions scoped to the saved search.
+//	@Tags			Saved Searches
+//	@Param			id	path	string	true	"Saved Search ID"
+//	@Success		204
+//	@Router			/v1/saved-searches/{id} [DELETE]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleSavedSearchDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.repo.SavedSearches.Delete(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleSavedSearchEntities godoc
+//
+//	@Summary	Run Saved Search
+//	@Tags		Saved Searches
+//	@Produce	json
+//	@Param		id			path		string	true	"Saved Search ID"
+//	@Param		page		query		int		false	"page number"
+//	@Param		pageSize	query		int		false	"items per page"
+//	@Success	200			{object}	repo.PaginationResult[repo.EntitySummary]{}
+//	@Router		/v1/saved-searches/{id}/entities [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleSavedSearchEntities() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.PaginationResult[repo.EntitySummary], error) {
+		auth := services.NewContext(r.Context())
+		search, err := ctrl.repo.SavedSearches.GetOne(auth, auth.GID, ID)
+		if err != nil {
+			return repo.PaginationResult[repo.EntitySummary]{}, err
+		}
+
+		params := r.URL.Query()
+		q := search.EntityQuery()
+		q.Page = queryIntOrNegativeOne(params.Get("page"))
+		q.PageSize = queryIntOrNegativeOne(params.Get("pageSize"))
+
+		out, err := ctrl.repo.Entities.QueryByGroup(auth, auth.GID, q)
+		return out, entityQueryError(err)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -63,6 +64,29 @@ func (ctrl *V1Controller) HandleGroupStatistics() errchain.HandlerFunc {
 	return adapters.Command(fn, http.StatusOK)
 }
 
+// parseStatsRange reads the start and end query dates of a statistics
+// series, defaulting to the last month.
+func parseStatsRange(r *http.Request) (time.Time, time.Time, error) {
+	parseDate := func(datestr string, defaultDate time.Time) (time.Time, error) {
+		if datestr == "" {
+			return defaultDate, nil
+		}
+		return time.Parse("2006-01-02", datestr)
+	}
+
+	start, err := parseDate(r.URL.Query().Get("start"), time.Now().AddDate(0, -1, 0))
+	if err != nil {
+		return time.Time{}, time.Time{}, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+
+	end, err := parseDate(r.URL.Query().Get("end"), time.Now())
+	if err != nil {
+		return time.Time{}, time.Time{}, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+
+	return start, end, nil
+}
+
 // HandleGroupStatisticsPriceOverTime godoc
 //
 //	@Summary	Get Purchase Price Statistics
@@ -74,27 +98,46 @@ func (ctrl *V1Controller) HandleGroupStatistics() errchain.HandlerFunc {
 //	@Router		/v1/groups/statistics/purchase-price [GET]
 //	@Security	Bearer
 func (ctrl *V1Controller) HandleGroupStatisticsPriceOverTime() errchain.HandlerFunc {
-	parseDate := func(datestr string, defaultDate time.Time) (time.Time, error) {
-		if datestr == "" {
-			return defaultDate, nil
+	return func(w http.ResponseWriter, r *http.Request) error {
+		ctx := services.NewContext(r.Context())
+
+		startDate, endDate, err := parseStatsRange(r)
+		if err != nil {
+			return err
 		}
-		return time.Parse("2006-01-02", datestr)
+
+		stats, err := ctrl.repo.Groups.StatsPurchasePrice(ctx, ctx.GID, startDate, endDate)
+		if err != nil {
+			return validate.NewRequestError(err, http.StatusInternalServerError)
+		}
+
+		return server.JSON(w, http.StatusOK, stats)
 	}
+}
 
+// HandleGroupStatisticsValue godoc
+//
+//	@Summary	Get Current Value Statistics
+//	@Tags		Statistics
+//	@Produce	json
+//	@Success	200		{object}	repo.ValueOverTime
+//	@Param		start	query		string	false	"start date"
+//	@Param		end		query		string	false	"end date"
+//	@Router		/v1/groups/statistics/value [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleGroupStatisticsValue() errchain.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		ctx := services.NewContext(r.Context())
 
-		startDate, err := parseDate(r.URL.Query().Get("start"), time.Now().AddDate(0, -1, 0))
+		startDate, endDate, err := parseStatsRange(r)
 		if err != nil {
-			return validate.NewRequestError(err, http.StatusBadRequest)
+			return err
 		}
-
-		endDate, err := parseDate(r.URL.Query().Get("end"), time.Now())
-		if err != nil {
-			return validate.NewRequestError(err, http.StatusBadRequest)
+		if endDate.Before(startDate) {
+			return validate.NewRequestError(errors.New("end date is before start date"), http.StatusBadRequest)
 		}
 
-		stats, err := ctrl.repo.Groups.StatsPurchasePrice(ctx, ctx.GID, startDate, endDate)
+		stats, err := ctrl.repo.Groups.StatsValue(ctx, ctx.GID, startDate, endDate)
 		if err != nil {
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleEntityStockConsume godoc
+//
+//	@Summary	Consume Stock
+//	@Tags		Stock
+//	@Produce	json
+//	@Param		id		path		string				true	"Entity ID"
+//	@Param		payload	body		repo.StockChange	true	"Quantity consumed"
+//	@Success	201		{object}	repo.StockMovement
+//	@Router		/v1/entities/{id}/stock/consume [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityStockConsume() errchain.HandlerFunc {
+	return ctrl.handleStockChange(stockmovement.KindConsume)
+}
+
+// HandleEntityStockRestock godoc
+//
+//	@Summary	Restock
+//	@Tags		Stock
+//	@Produce	json
+//	@Param		id		path		string				true	"Entity ID"
+//	@Param		payload	body		repo.StockChange	true	"Quantity added"
+//	@Success	201		{object}	repo.StockMovement
+//	@Router		/v1/entities/{id}/stock/restock [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityStockRestock() errchain.HandlerFunc {
+	return ctrl.handleStockChange(stockmovement.KindRestock)
+}
+
+func (ctrl *V1Controller) handleStockChange(kind stockmovement.Kind) errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID, body repo.StockChange) (repo.StockMovement, error) {
+		auth := services.NewContext(r.Context())
+		movement, err := ctrl.repo.Entities.ChangeStock(auth, auth.GID, id, kind, body)
+		if errors.Is(err, repo.ErrInsufficientStock) {
+			return repo.StockMovement{}, validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		return movement, err
+	}
+
+	return adapters.ActionID("id", fn, http.StatusCreated)
+}
+
+// HandleEntityStockMovements godoc
+//
+//	@Summary	Get Stock Movements
+//	@Tags		Stock
+//	@Produce	json
+//	@Param		id	path	string	true	"Entity ID"
+//	@Success	200	{array}	repo.StockMovement
+//	@Router		/v1/entities/{id}/stock/movements [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleEntityStockMovements() errchain.HandlerFunc {
+	fn := func(r *http.Request, id uuid.UUID) ([]repo.StockMovement, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Entities.StockMovements(auth, auth.GID, id)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleLowStockReport godoc
+//
+//	@Summary	Low Stock Report
+//	@Tags		Reporting
+//	@Produce	json
+//	@Success	200	{array}	repo.LowStockEntry
+//	@Router		/v1/reporting/low-stock [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleLowStockReport() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.LowStockEntry, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Entities.LowStock(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
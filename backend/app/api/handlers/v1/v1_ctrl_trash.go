@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// HandleTrashGetAll godoc
+//
+//	@Summary	Get Trash
+//	@Tags		Trash
+//	@Produce	json
+//	@Success	200	{object}	[]repo.TrashEntry
+//	@Router		/v1/trash [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleTrashGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.TrashEntry, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Entities.GetTrash(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleTrashRestore godoc
+//
+//	@Summary	Restore Entity From Trash
+//	@Tags		Trash
+//	@Produce	json
+//	@Param		id	path		string	true	"Entity ID"
+//	@Success	200	{object}	repo.EntityOut
+//	@Router		/v1/trash/{id}/restore [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleTrashRestore() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (repo.EntityOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Entities.RestoreFromTrash(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
@@ -226,7 +226,7 @@ func (ctrl *V1Controller) HandleUserSelfSettingsUpdate() errchain.HandlerFunc {
 		}
 
 		ctx := services.NewContext(spanCtx)
-		ctrl.bus.Publish(eventbus.EventUserMutation, eventbus.GroupMutationEvent{GID: ctx.GID})
+		ctrl.bus.Publish(eventbus.EventUserMutation, eventbus.GroupMutationEvent{GID: ctx.GID, Action: eventbus.MutationUpdate, IDs: []uuid.UUID{actor.ID}, ActorID: actor.ID})
 
 		newSettings, err := ctrl.svc.User.GetSettings(spanCtx, actor.ID)
 		if err != nil {
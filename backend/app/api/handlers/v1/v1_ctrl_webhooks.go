@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hay-kot/httpkit/errchain"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/web/adapters"
+)
+
+// webhookDeliveryLogLimit is how many deliveries the delivery log returns.
+const webhookDeliveryLogLimit = 100
+
+// HandleWebhooksGetAll godoc
+//
+//	@Summary	Get Webhooks
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Success	200	{object}	[]repo.WebhookOut
+//	@Router		/v1/groups/webhooks [GET]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleWebhooksGetAll() errchain.HandlerFunc {
+	fn := func(r *http.Request) ([]repo.WebhookOut, error) {
+		auth := services.NewContext(r.Context())
+		return ctrl.repo.Webhooks.GetByGroup(auth, auth.GID)
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleWebhookCreate godoc
+//
+//	@Summary	Create Webhook
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		payload	body		repo.WebhookCreate	true	"Webhook Data"
+//	@Success	201		{object}	repo.WebhookOut
+//	@Router		/v1/groups/webhooks [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleWebhookCreate() errchain.HandlerFunc {
+	fn := func(r *http.Request, in repo.WebhookCreate) (repo.WebhookOut, error) {
+		return ctrl.svc.Webhooks.Create(services.NewContext(r.Context()), in)
+	}
+
+	return adapters.Action(fn, http.StatusCreated)
+}
+
+// HandleWebhookUpdate godoc
+//
+//	@Summary	Update Webhook
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id		path		string				true	"Webhook ID"
+//	@Param		payload	body		repo.WebhookUpdate	true	"Webhook Data"
+//	@Success	200		{object}	repo.WebhookOut
+//	@Router		/v1/groups/webhooks/{id} [PUT]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleWebhookUpdate() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID, in repo.WebhookUpdate) (repo.WebhookOut, error) {
+		return ctrl.svc.Webhooks.Update(services.NewContext(r.Context()), ID, in)
+	}
+
+	return adapters.ActionID("id", fn, http.StatusOK)
+}
+
+// HandleWebhookDelete godoc
+//
+//	@Summary	Delete Webhook
+//	@Tags		Webhooks
+//	@Param		id	path	string	true	"Webhook ID"
+//	@Success	204
+//	@Router		/v1/groups/webhooks/{id} [DELETE]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleWebhookDelete() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) (any, error) {
+		auth := services.NewContext(r.Context())
+		return nil, ctrl.repo.Webhooks.Delete(auth, auth.GID, ID)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusNoContent)
+}
+
+// HandleWebhookDeliveries godoc
+//
+//	@Summary		Get Webhook Deliveries
+//	@Description	Returns the 100 most recent deliveries of the webhook, newest first.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook ID"
+//	@Success		200	{object}	[]repo.WebhookDeliveryOut
+//	@Router			/v1/groups/webhooks/{id}/deliveries [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleWebhookDeliveries() errchain.HandlerFunc {
+	fn := func(r *http.Request, ID uuid.UUID) ([]repo.WebhookDeliveryOut, error) {
+		auth := services.NewContext(r.Context())
+		if _, err := ctrl.repo.Webhooks.GetOne(auth, auth.GID, ID); err != nil {
+			return nil, err
+		}
+		return ctrl.repo.Webhooks.GetDeliveries(auth, auth.GID, ID, webhookDeliveryLogLimit)
+	}
+
+	return adapters.CommandID("id", fn, http.StatusOK)
+}
+
+// HandleWebhookSecretGet godoc
+//
+//	@Summary		Get Webhook Signing Secret
+//	@Description	Returns the collection's webhook signing secret, generating it on first use.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Success		200	{object}	services.WebhookSecretOut
+//	@Router			/v1/groups/webhooks/secret [GET]
+//	@Security		Bearer
+func (ctrl *V1Controller) HandleWebhookSecretGet() errchain.HandlerFunc {
+	fn := func(r *http.Request) (services.WebhookSecretOut, error) {
+		return ctrl.svc.Webhooks.Secret(services.NewContext(r.Context()))
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
+
+// HandleWebhookSecretRotate godoc
+//
+//	@Summary	Rotate Webhook Signing Secret
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Success	200	{object}	services.WebhookSecretOut
+//	@Router		/v1/groups/webhooks/secret [POST]
+//	@Security	Bearer
+func (ctrl *V1Controller) HandleWebhookSecretRotate() errchain.HandlerFunc {
+	fn := func(r *http.Request) (services.WebhookSecretOut, error) {
+		return ctrl.svc.Webhooks.RotateSecret(services.NewContext(r.Context()))
+	}
+
+	return adapters.Command(fn, http.StatusOK)
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+)
+
+const (
+	// wsMaxSubscriptions caps the entity IDs plus subtree roots a single
+	// socket may subscribe to.
+	wsMaxSubscriptions = 500
+
+	wsAncestorTimeout = 5 * time.Second
+)
+
+// wsEventMsg is a server-to-client websocket frame.
+type wsEventMsg struct {
+	Event        string                  `json:"event"`
+	Action       eventbus.MutationAction `json:"action,omitempty"`
+	IDs          []uuid.UUID             `json:"ids,omitempty"`
+	ActorID      *uuid.UUID              `json:"actorId,omitempty"`
+	FromParentID *uuid.UUID              `json:"fromParentId,omitempty"`
+
+	// Set on subscription acknowledgements.
+	Subscriptions *wsSubscriptionsOut `json:"subscriptions,omitempty"`
+	Error         string              `json:"error,omitempty"`
+}
+
+type wsSubscriptionsOut struct {
+	IDs      []uuid.UUID `json:"ids"`
+	Subtrees []uuid.UUID `json:"subtrees"`
+}
+
+// wsClientMsg is a client-to-server websocket frame. "subscribe" adds the
+// given IDs and subtree roots, "unsubscribe" removes them, and "unsubscribe"
+// with neither clears every subscription.
+type wsClientMsg struct {
+	Type     string      `json:"type"`
+	IDs      []uuid.UUID `json:"ids"`
+	Subtrees []uuid.UUID `json:"subtrees"`
+}
+
+func newWSEventMsg(event string, evt eventbus.GroupMutationEvent) wsEventMsg {
+	msg := wsEventMsg{
+		Event:  event,
+		Action: evt.Action,
+		IDs:    evt.IDs,
+	}
+	if evt.ActorID != uuid.Nil {
+		msg.ActorID = &evt.ActorID
+	}
+	if evt.FromParentID != uuid.Nil {
+		msg.FromParentID = &evt.FromParentID
+	}
+	return msg
+}
+
+// wsSubscriptions is the per-socket entity filter. A socket without any
+// subscriptions receives every entity event in its group.
+type wsSubscriptions struct {
+	mu       sync.RWMutex
+	ids      map[uuid.UUID]struct{}
+	subtrees map[uuid.UUID]struct{}
+}
+
+func newWSSubscriptions() *wsSubscriptions {
+	return &wsSubscriptions{
+		ids:      map[uuid.UUID]struct{}{},
+		subtrees: map[uuid.UUID]struct{}{},
+	}
+}
+
+var errWSTooManySubscriptions = fmt.Errorf("at most %d subscriptions are allowed per connection", wsMaxSubscriptions)
+
+// apply updates the subscriptions from a client message.
+func (s *wsSubscriptions) apply(msg wsClientMsg) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch msg.Type {
+	case "subscribe":
+		added := 0
+		for _, list := range [][]uuid.UUID{msg.IDs, msg.Subtrees} {
+			for _, id := range list {
+				if id != uuid.Nil {
+					added++
+				}
+			}
+		}
+		if len(s.ids)+len(s.subtrees)+added > wsMaxSubscriptions {
+			return errWSTooManySubscriptions
+		}
+		for _, id := range msg.IDs {
+			if id != uuid.Nil {
+				s.ids[id] = struct{}{}
+			}
+		}
+		for _, id := range msg.Subtrees {
+			if id != uuid.Nil {
+				s.subtrees[id] = struct{}{}
+			}
+		}
+	case "unsubscribe":
+		if len(msg.IDs) == 0 && len(msg.Subtrees) == 0 {
+			clear(s.ids)
+			clear(s.subtrees)
+			return nil
+		}
+		for _, id := range msg.IDs {
+			delete(s.ids, id)
+		}
+		for _, id := range msg.Subtrees {
+			delete(s.subtrees, id)
+		}
+	default:
+		return fmt.Errorf("unknown message type %q", msg.Type)
+	}
+
+	return nil
+}
+
+func (s *wsSubscriptions) snapshot() *wsSubscriptionsOut {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := &wsSubscriptionsOut{
+		IDs:      make([]uuid.UUID, 0, len(s.ids)),
+		Subtrees: make([]uuid.UUID, 0, len(s.subtrees)),
+	}
+	for id := range s.ids {
+		out.IDs = append(out.IDs, id)
+	}
+	for id := range s.subtrees {
+		out.Subtrees = append(out.Subtrees, id)
+	}
+	return out
+}
+
+// matches reports whether an entity event should reach this socket. ids are
+// the touched entities (including the old parent of a move) and ancestors
+// lazily resolves them together with all of their ancestors; it is only
+// called when a subtree subscription needs it.
+func (s *wsSubscriptions) matches(ids []uuid.UUID, ancestors func() []uuid.UUID) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.ids) == 0 && len(s.subtrees) == 0 {
+		return true
+	}
+	// Bulk changes (e.g. a wipe) carry no IDs and may touch anything.
+	if len(ids) == 0 {
+		return true
+	}
+
+	for _, id := range ids {
+		if _, ok := s.ids[id]; ok {
+			return true
+		}
+	}
+
+	if len(s.subtrees) == 0 {
+		return false
+	}
+	for _, id := range ancestors() {
+		if _, ok := s.subtrees[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// handleWSClientMessage applies a subscription message and returns the
+// acknowledgement frame.
+func handleWSClientMessage(subs *wsSubscriptions, data []byte) []byte {
+	var msg wsClientMsg
+	ack := wsEventMsg{Event: "subscriptions"}
+
+	if err := json.Unmarshal(data, &msg); err != nil {
+		ack.Error = "invalid message"
+	} else if err := subs.apply(msg); err != nil {
+		ack.Error = err.Error()
+	}
+	ack.Subscriptions = subs.snapshot()
+
+	out, err := json.Marshal(ack)
+	if err != nil {
+		log.Err(err).Msg("failed to marshal websocket acknowledgement")
+		return nil
+	}
+	return out
+}
+
+// entityEventIDs returns the entities an event touches for filtering, with
+// the old parent of a move included so watchers of the source see it leave.
+func entityEventIDs(evt eventbus.GroupMutationEvent) []uuid.UUID {
+	if evt.FromParentID == uuid.Nil {
+		return evt.IDs
+	}
+	return append(append(make([]uuid.UUID, 0, len(evt.IDs)+1), evt.IDs...), evt.FromParentID)
+}
+
+// lazyAncestors resolves the ancestors of ids at most once per event.
+func (ctrl *V1Controller) lazyAncestors(gid uuid.UUID, ids []uuid.UUID) func() []uuid.UUID {
+	return sync.OnceValue(func() []uuid.UUID {
+		ctx, cancel := context.WithTimeout(context.Background(), wsAncestorTimeout)
+		defer cancel()
+
+		out, err := ctrl.repo.Entities.AncestorIDs(ctx, gid, ids...)
+		if err != nil {
+			log.Err(err).Msg("failed to resolve entity ancestors for websocket subscriptions")
+			return ids
+		}
+		return out
+	})
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSSubscriptions_Matches(t *testing.T) {
+	watched, root, other := uuid.New(), uuid.New(), uuid.New()
+
+	subs := newWSSubscriptions()
+	noAncestors := func() []uuid.UUID { t.Fatal("ancestors resolved unexpectedly"); return nil }
+
+	// No subscriptions: everything is delivered.
+	assert.True(t, subs.matches([]uuid.UUID{other}, noAncestors))
+
+	require.NoError(t, subs.apply(wsClientMsg{Type: "subscribe", IDs: []uuid.UUID{watched}}))
+	assert.True(t, subs.matches([]uuid.UUID{watched}, noAncestors))
+	assert.False(t, subs.matches([]uuid.UUID{other}, noAncestors))
+	assert.True(t, subs.matches(nil, noAncestors), "bulk events reach everyone")
+
+	require.NoError(t, subs.apply(wsClientMsg{Type: "subscribe", Subtrees: []uuid.UUID{root}}))
+	calls := 0
+	under := func() []uuid.UUID { calls++; return []uuid.UUID{other, root} }
+	assert.True(t, subs.matches([]uuid.UUID{other}, under))
+	assert.False(t, subs.matches([]uuid.UUID{other}, func() []uuid.UUID { return []uuid.UUID{other} }))
+	assert.Equal(t, 1, calls)
+
+	require.NoError(t, subs.apply(wsClientMsg{Type: "unsubscribe"}))
+	assert.True(t, subs.matches([]uuid.UUID{other}, noAncestors))
+}
+
+func TestWSSubscriptions_Limit(t *testing.T) {
+	subs := newWSSubscriptions()
+
+	ids := make([]uuid.UUID, wsMaxSubscriptions+1)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+
+	err := subs.apply(wsClientMsg{Type: "subscribe", IDs: ids})
+	require.ErrorIs(t, err, errWSTooManySubscriptions)
+	assert.Empty(t, subs.snapshot().IDs)
+
+	require.NoError(t, subs.apply(wsClientMsg{Type: "subscribe", IDs: ids[:wsMaxSubscriptions]}))
+}
+
+func TestHandleWSClientMessage(t *testing.T) {
+	subs := newWSSubscriptions()
+	id := uuid.New()
+
+	var ack wsEventMsg
+	require.NoError(t, json.Unmarshal(handleWSClientMessage(subs, []byte(`{"type":"subscribe","ids":["`+id.String()+`"]}`)), &ack))
+	assert.Equal(t, "subscriptions", ack.Event)
+	assert.Empty(t, ack.Error)
+	require.NotNil(t, ack.Subscriptions)
+	assert.Equal(t, []uuid.UUID{id}, ack.Subscriptions.IDs)
+
+	require.NoError(t, json.Unmarshal(handleWSClientMessage(subs, []byte(`{"type":"bogus"}`)), &ack))
+	assert.NotEmpty(t, ack.Error)
+}
@@ -193,6 +193,7 @@ func run(cfg *config.Config) error {
 	)
 
 	ensureAssetIDs(app)
+	syncSearchIndex(app)
 
 	// =========================================================================
 	// Start Server
@@ -399,3 +400,14 @@ func ensureAssetIDs(app *app) {
 		}
 	}
 }
+
+// syncSearchIndex indexes entities that have no search document yet, e.g.
+// everything that existed before the index was introduced.
+func syncSearchIndex(app *app) {
+	n, err := app.repos.Search.Sync(context.Background())
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to sync search index")
+	} else if n > 0 {
+		log.Info().Int("count", n).Msg("indexed entities for search")
+	}
+}
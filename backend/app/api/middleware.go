@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
@@ -12,9 +13,13 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
 	v1 "github.com/sysadminsmedia/homebox/backend/app/api/handlers/v1"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authroles"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
@@ -76,12 +81,14 @@ func (a *app) mwRoles(rm RoleMode, required ...string) errchain.Middleware {
 
 			token := maybeToken.(string)
 
-			// API keys grant the same access as the owning user. They live
-			// outside the auth_roles table, so we synthesize the "user" role
-			// here rather than querying the DB.
+			// API keys live outside the auth_roles table, so we synthesize
+			// their roles here rather than querying the DB. A key scoped to
+			// reading attachments only gets the "attachments" role, the same
+			// as an attachment token; every other key acts as the user and is
+			// narrowed further by mwAPIKeyScope.
 			var roles *set.Set[string]
-			if services.IsAPIKeyAuth(spanCtx) {
-				s := set.New("user")
+			if key := services.UseAPIKeyCtx(spanCtx); key != nil {
+				s := set.New(apiKeyRole(*key))
 				roles = &s
 				span.SetAttributes(attribute.Bool("roles.api_key", true))
 			} else {
@@ -123,6 +130,49 @@ func (a *app) mwRoles(rm RoleMode, required ...string) errchain.Middleware {
 	}
 }
 
+func apiKeyRole(key repo.APIKeyOut) string {
+	if len(key.Scopes) > 0 && lo.EveryBy(key.Scopes, func(s repo.APIKeyScope) bool {
+		return s == repo.APIKeyScopeAttachmentsRead
+	}) {
+		return authroles.RoleAttachments.String()
+	}
+	return authroles.RoleUser.String()
+}
+
+// mwAPIKeyScope rejects requests made with an API key whose scopes don't
+// cover res, with write access for anything but GET and HEAD. Session
+// requests and unrestricted keys pass through. It also turns attempts to
+// create or move entities outside a location-restricted key's subtree into a
+// 403.
+//
+// WARNING: This middleware _MUST_ be called after mwAuthToken
+func (a *app) mwAPIKeyScope(res repo.APIKeyResource) errchain.Middleware {
+	return func(next errchain.Handler) errchain.Handler {
+		return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			key := services.UseAPIKeyCtx(r.Context())
+			if key == nil {
+				return next.ServeHTTP(w, r)
+			}
+
+			spanCtx, span := mwTracer().Start(r.Context(), "middleware.mwAPIKeyScope",
+				trace.WithAttributes(
+					attribute.String("api_key.id", key.ID.String()),
+					attribute.String("api_key.resource", string(res)),
+				))
+			defer span.End()
+
+			write := r.Method != http.MethodGet && r.Method != http.MethodHead
+			if !key.Allows(res, write) {
+				span.SetAttributes(attribute.String("api_key.scope.outcome", "forbidden"))
+				return validate.NewRequestError(errors.New("API key is not allowed to make this request"), http.StatusForbidden)
+			}
+
+			span.SetAttributes(attribute.String("api_key.scope.outcome", "ok"))
+			return next.ServeHTTP(w, r.WithContext(spanCtx))
+		})
+	}
+}
+
 type KeyFunc func(r *http.Request) (string, error)
 
 func getBearer(r *http.Request) (string, error) {
@@ -241,6 +291,7 @@ func (a *app) mwAuthToken(next errchain.Handler) errchain.Handler {
 		}
 
 		isAPIKey := false
+		var key repo.APIKeyOut
 		if err != nil {
 			// Session-token lookup missed. API keys are only accepted via the
 			// Authorization header — never via cookies or query params, since
@@ -252,7 +303,7 @@ func (a *app) mwAuthToken(next errchain.Handler) errchain.Handler {
 			}
 
 			tokenHash := hasher.HashAPIKey(requestToken)
-			keyUsr, keyID, keyErr := a.repos.APIKeys.GetUserFromToken(r.Context(), tokenHash)
+			keyUsr, keyOut, keyErr := a.repos.APIKeys.GetUserFromToken(r.Context(), tokenHash)
 			if keyErr != nil {
 				if ent.IsNotFound(keyErr) {
 					span.SetAttributes(attribute.String("auth.outcome", "token_not_found"))
@@ -263,12 +314,13 @@ func (a *app) mwAuthToken(next errchain.Handler) errchain.Handler {
 				return keyErr
 			}
 			usr = keyUsr
+			key = keyOut
 			isAPIKey = true
 
 			// Best-effort last_used_at update; failure must not break the
 			// request, but we want it surfaced in logs.
-			if touchErr := a.repos.APIKeys.TouchLastUsed(r.Context(), keyID, time.Now()); touchErr != nil {
-				log.Warn().Err(touchErr).Str("api_key.id", keyID.String()).Msg("failed to update api key last_used_at")
+			if touchErr := a.repos.APIKeys.TouchLastUsed(r.Context(), key.ID, time.Now()); touchErr != nil {
+				log.Warn().Err(touchErr).Str("api_key.id", key.ID.String()).Msg("failed to update api key last_used_at")
 			}
 		}
 
@@ -282,16 +334,23 @@ func (a *app) mwAuthToken(next errchain.Handler) errchain.Handler {
 		)
 
 		ctxOut := services.SetUserCtx(r.Context(), &usr, requestToken)
+		actor := repo.AuditActor{UserID: usr.ID, UserName: usr.Name, Source: repo.AuditSourceWeb}
 		if isAPIKey {
-			ctxOut = services.SetAPIKeyAuth(ctxOut)
+			ctxOut = services.SetAPIKeyAuth(ctxOut, key)
+			actor.Source = repo.AuditSourceAPIKey
+			if key.LocationID != nil {
+				ctxOut = repo.WithEntityScope(ctxOut, *key.LocationID)
+			}
 		}
+		ctxOut = repo.WithAuditActor(ctxOut, actor)
 		r = r.WithContext(ctxOut)
 		return next.ServeHTTP(w, r)
 	})
 }
 
 // mwTenant is a middleware that will parse the X-Tenant header and validate the user has access
-// to the requested tenant. If no header is provided, the user's default group is used.
+// to the requested tenant. If no header is provided, the user's default group is used, or the
+// group an API key is restricted to.
 //
 // WARNING: This middleware _MUST_ be called after mwAuthToken
 func (a *app) mwTenant(next errchain.Handler) errchain.Handler {
@@ -307,8 +366,14 @@ func (a *app) mwTenant(next errchain.Handler) errchain.Handler {
 			return validate.NewRequestError(err, http.StatusInternalServerError)
 		}
 
+		// A key pinned to a collection defaults to it and can't switch away.
+		key := services.UseAPIKeyCtx(spanCtx)
 		tenantID := user.DefaultGroupID
 		tenantSource := "default"
+		if key != nil && key.GroupID != nil {
+			tenantID = *key.GroupID
+			tenantSource = "api_key"
+		}
 
 		tenantHeader := r.Header.Get("X-Tenant")
 		if tenantHeader == "" {
@@ -341,6 +406,9 @@ func (a *app) mwTenant(next errchain.Handler) errchain.Handler {
 				break
 			}
 		}
+		if key != nil && key.GroupID != nil && *key.GroupID != tenantID {
+			hasAccess = false
+		}
 
 		span.SetAttributes(
 			attribute.String("user.id", user.ID.String()),
@@ -355,8 +423,40 @@ func (a *app) mwTenant(next errchain.Handler) errchain.Handler {
 			return validate.NewRequestError(errors.New("user does not have access to the requested tenant"), http.StatusForbidden)
 		}
 
+		// Members limited to some locations see only those, on top of any
+		// limit the API key has.
+		locationIDs, err := a.repos.Groups.MemberLocations(spanCtx, tenantID, user.ID)
+		if err != nil {
+			recordMwSpanError(span, err)
+			span.SetAttributes(attribute.String("tenant.outcome", "lookup_error"))
+			return err
+		}
+		span.SetAttributes(attribute.Int("tenant.member.locations.count", len(locationIDs)))
+
+		ctx := services.SetTenantCtx(spanCtx, tenantID)
+		if len(locationIDs) > 0 {
+			ctx = repo.WithEntityScope(ctx, locationIDs...)
+		}
+
 		span.SetAttributes(attribute.String("tenant.outcome", "ok"))
-		r = r.WithContext(services.SetTenantCtx(spanCtx, tenantID))
+		err = next.ServeHTTP(w, r.WithContext(ctx))
+		if errors.Is(err, repo.ErrOutsideEntityScope) {
+			return validate.NewRequestError(err, http.StatusForbidden)
+		}
+		return err
+	})
+}
+
+// mwUnrestricted refuses requests limited to some locations, either by the
+// member's location grants or by the API key. Use it for anything that works
+// on the whole collection at once, such as backups and bulk actions.
+//
+// WARNING: This middleware _MUST_ be called after mwAuthToken and mwTenant.
+func (a *app) mwUnrestricted(next errchain.Handler) errchain.Handler {
+	return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		if repo.EntityScoped(r.Context()) {
+			return validate.NewRequestError(errors.New("this request needs access to the whole collection"), http.StatusForbidden)
+		}
 		return next.ServeHTTP(w, r)
 	})
 }
@@ -405,6 +505,66 @@ func (a *app) mwGroupOwner(next errchain.Handler) errchain.Handler {
 	})
 }
 
+// mwGroupRole limits what a member can do in the tenant collection by their
+// role. Every member may make GET and HEAD requests; other methods need at
+// least write, and DELETE at least editor, since contributors can add and
+// change inventory but not remove it. API keys act with their user's role.
+//
+// WARNING: This middleware _MUST_ be called after mwAuthToken and mwTenant.
+func (a *app) mwGroupRole(write usergroup.Role) errchain.Middleware {
+	return func(next errchain.Handler) errchain.Handler {
+		return errchain.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			spanCtx, span := mwTracer().Start(r.Context(), "middleware.mwGroupRole",
+				trace.WithAttributes(attribute.String("role.write", write.String())))
+			defer span.End()
+
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				span.SetAttributes(attribute.String("role.outcome", "read"))
+				return next.ServeHTTP(w, r.WithContext(spanCtx))
+			}
+
+			auth := services.NewContext(spanCtx)
+			if auth.User == nil {
+				err := errors.New("user context not found")
+				recordMwSpanError(span, err)
+				span.SetAttributes(attribute.String("role.outcome", "no_user_ctx"))
+				return validate.NewRequestError(err, http.StatusInternalServerError)
+			}
+
+			role, err := a.repos.Groups.MemberRole(spanCtx, auth.GID, auth.UID)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					span.SetAttributes(attribute.String("role.outcome", "not_member"))
+					return validate.NewRequestError(errors.New("user is not a member of this collection"), http.StatusForbidden)
+				}
+				recordMwSpanError(span, err)
+				span.SetAttributes(attribute.String("role.outcome", "lookup_error"))
+				return err
+			}
+
+			need := write
+			if r.Method == http.MethodDelete && !repo.GroupRoleAtLeast(need, usergroup.RoleEditor) {
+				need = usergroup.RoleEditor
+			}
+
+			span.SetAttributes(
+				attribute.String("user.id", auth.UID.String()),
+				attribute.String("tenant.id", auth.GID.String()),
+				attribute.String("role.actual", role.String()),
+				attribute.String("role.required", need.String()),
+			)
+
+			if !repo.GroupRoleAtLeast(role, need) {
+				span.SetAttributes(attribute.String("role.outcome", "forbidden"))
+				return validate.NewRequestError(fmt.Errorf("your role in this collection (%s) does not allow this", role), http.StatusForbidden)
+			}
+
+			span.SetAttributes(attribute.String("role.outcome", "ok"))
+			return next.ServeHTTP(w, r.WithContext(spanCtx))
+		})
+	}
+}
+
 // authRateLimiter tracks authentication attempts per client and applies a backoff when limits are exceeded.
 type authRateLimiter struct {
 	cfg         config.AuthRateLimit
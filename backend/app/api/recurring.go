@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hay-kot/httpkit/graceful"
 	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/utils"
 	"gocloud.dev/blob"
@@ -52,10 +53,44 @@ func registerRecurringTasks(app *app, cfg *config.Config, runner *graceful.Runne
 		}
 	}))
 
+	runner.AddPlugin(NewTask("purge-attachment-uploads", time.Hour, func(ctx context.Context) {
+		purged, err := app.repos.Attachments.PurgeExpiredUploads(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge expired attachment uploads")
+			return
+		}
+		if purged > 0 {
+			log.Info().Int("count", purged).Msg("purged expired attachment uploads")
+		}
+	}))
+
+	runner.AddPlugin(NewTask("collect-blobs", 24*time.Hour, func(ctx context.Context) {
+		res, err := app.repos.Attachments.CollectBlobs(ctx, time.Now().Add(-repo.BlobGracePeriod))
+		if err != nil {
+			log.Error().Err(err).Msg("failed to collect unreferenced attachment files")
+			return
+		}
+		if res.Deleted > 0 {
+			log.Info().Int("count", res.Deleted).Int64("bytes", res.Freed).Msg("removed unreferenced attachment files")
+		}
+	}))
+
 	runner.AddPlugin(NewTask("purge-stale-exports", 24*time.Hour, func(ctx context.Context) {
 		purgeStaleExports(ctx, app)
 	}))
 
+	runner.AddPlugin(NewTask("purge-trash", 24*time.Hour, func(ctx context.Context) {
+		cutoff := time.Now().AddDate(0, 0, -cfg.Options.TrashRetentionDays)
+		purged, err := app.repos.Entities.PurgeTrash(ctx, cutoff)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge trash")
+			return
+		}
+		if purged > 0 {
+			log.Info().Int("count", purged).Msg("purged entities from trash")
+		}
+	}))
+
 	runner.AddPlugin(NewTask("send-notifications", time.Hour, func(ctx context.Context) {
 		now := time.Now()
 		if now.Hour() == 8 {
@@ -64,6 +99,23 @@ func registerRecurringTasks(app *app, cfg *config.Config, runner *graceful.Runne
 			if err != nil {
 				log.Error().Err(err).Msg("failed to send notifiers")
 			}
+			err = app.services.BackgroundService.SendWarrantyNotifications(context.Background())
+			if err != nil {
+				log.Error().Err(err).Msg("failed to send warranty notifications")
+			}
+			err = app.services.BackgroundService.SendLoanReminders(context.Background())
+			if err != nil {
+				log.Error().Err(err).Msg("failed to send loan reminders")
+			}
+		}
+	}))
+
+	runner.AddFunc("webhook-deliveries", app.services.Webhooks.Run)
+
+	runner.AddPlugin(NewTask("purge-webhook-deliveries", 24*time.Hour, func(ctx context.Context) {
+		_, err := app.repos.Webhooks.PurgeDeliveries(ctx, time.Now().AddDate(0, 0, -30))
+		if err != nil {
+			log.Error().Err(err).Msg("failed to purge webhook deliveries")
 		}
 	}))
 
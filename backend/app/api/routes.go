@@ -19,6 +19,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/app/api/providers"
 	docs "github.com/sysadminsmedia/homebox/backend/app/api/static/docs"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authroles"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 )
 
@@ -85,6 +86,7 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		a.conf,
 		v1.WithMaxUploadSize(a.conf.Web.MaxUploadSize),
 		v1.WithMaxImportSize(a.conf.Web.MaxImportSize),
+		v1.WithMaxResumableUploadSize(a.conf.Web.MaxResumableUploadSize),
 		v1.WithMaxParseMemory(a.conf.Web.MaxParseMemory),
 		v1.WithRegistration(a.conf.Options.AllowRegistration),
 		v1.WithDemoStatus(a.conf.Demo), // Disable Password Change in Demo Mode
@@ -114,10 +116,64 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 			r.Get("/users/login/oidc/callback", chain.ToHandlerFunc(v1Ctrl.HandleOIDCCallback(), a.mwAuthRateLimit))
 		}
 
+		// Scoped API keys are checked against the resource a route belongs
+		// to, and members against their role in the collection (see
+		// mwGroupRole). accountMW covers the user's own account, which any
+		// member may change; userMW covers the rest of the collection, and
+		// inventory routes use entityMW or attachmentMW so that keys scoped
+		// to them can reach them.
+		accountMW := []errchain.Middleware{
+			a.mwAuthToken,
+			a.mwTenant,
+			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(""),
+		}
+
 		userMW := []errchain.Middleware{
 			a.mwAuthToken,
 			a.mwTenant,
 			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(""),
+			a.mwGroupRole(usergroup.RoleContributor),
+		}
+
+		entityMW := []errchain.Middleware{
+			a.mwAuthToken,
+			a.mwTenant,
+			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(repo.APIKeyResourceEntities),
+			a.mwGroupRole(usergroup.RoleContributor),
+		}
+
+		attachmentMW := []errchain.Middleware{
+			a.mwAuthToken,
+			a.mwTenant,
+			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(repo.APIKeyResourceAttachments),
+			a.mwGroupRole(usergroup.RoleContributor),
+		}
+
+		// editorMW is for bulk changes to the inventory, which contributors
+		// could otherwise use to delete things, and members limited to some
+		// locations to reach the rest of the collection.
+		editorMW := []errchain.Middleware{
+			a.mwAuthToken,
+			a.mwTenant,
+			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(""),
+			a.mwUnrestricted,
+			a.mwGroupRole(usergroup.RoleEditor),
+		}
+
+		// collectionMW is for routes that work on the whole collection at once,
+		// which members limited to some locations can't use.
+		collectionMW := []errchain.Middleware{
+			a.mwAuthToken,
+			a.mwTenant,
+			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(""),
+			a.mwUnrestricted,
+			a.mwGroupRole(usergroup.RoleContributor),
 		}
 
 		// ownerMW additionally requires role=owner on the tenant collection.
@@ -128,132 +184,188 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 			a.mwAuthToken,
 			a.mwTenant,
 			a.mwRoles(RoleModeOr, authroles.RoleUser.String()),
+			a.mwAPIKeyScope(""),
 			a.mwGroupOwner,
 		}
 
-		r.Get("/ws/events", chain.ToHandlerFunc(v1Ctrl.HandleCacheWS(), userMW...))
+		r.Get("/ws/events", chain.ToHandlerFunc(v1Ctrl.HandleCacheWS(), accountMW...))
 
 		// User management endpoints
-		r.Get("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelf(), userMW...))
-		r.Put("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfUpdate(), userMW...))
-		r.Delete("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfDelete(), userMW...))
-		r.Get("/users/self/settings", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfSettingsGet(), userMW...))
-		r.Put("/users/self/settings", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfSettingsUpdate(), userMW...))
-		r.Post("/users/logout", chain.ToHandlerFunc(v1Ctrl.HandleAuthLogout(), userMW...))
-		r.Post("/users/logout/all", chain.ToHandlerFunc(v1Ctrl.HandleAuthLogoutAll(), userMW...))
-		r.Get("/users/refresh", chain.ToHandlerFunc(v1Ctrl.HandleAuthRefresh(), userMW...))
-		r.Put("/users/self/change-password", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfChangePassword(), userMW...))
+		r.Get("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelf(), accountMW...))
+		r.Put("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfUpdate(), accountMW...))
+		r.Delete("/users/self", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfDelete(), accountMW...))
+		r.Get("/users/self/settings", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfSettingsGet(), accountMW...))
+		r.Put("/users/self/settings", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfSettingsUpdate(), accountMW...))
+		r.Post("/users/logout", chain.ToHandlerFunc(v1Ctrl.HandleAuthLogout(), accountMW...))
+		r.Post("/users/logout/all", chain.ToHandlerFunc(v1Ctrl.HandleAuthLogoutAll(), accountMW...))
+		r.Get("/users/refresh", chain.ToHandlerFunc(v1Ctrl.HandleAuthRefresh(), accountMW...))
+		r.Put("/users/self/change-password", chain.ToHandlerFunc(v1Ctrl.HandleUserSelfChangePassword(), accountMW...))
 
 		// User API keys (static tokens that authenticate as the owning user)
-		r.Get("/users/self/api-keys", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeysList(), userMW...))
-		r.Post("/users/self/api-keys", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeyCreate(), userMW...))
-		r.Delete("/users/self/api-keys/{id}", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeyDelete(), userMW...))
+		r.Get("/users/self/api-keys", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeysList(), accountMW...))
+		r.Post("/users/self/api-keys", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeyCreate(), accountMW...))
+		r.Delete("/users/self/api-keys/{id}", chain.ToHandlerFunc(v1Ctrl.HandleUserAPIKeyDelete(), accountMW...))
 
 		// Group management endpoints
-		r.Get("/groups/all", chain.ToHandlerFunc(v1Ctrl.HandleGroupsGetAll(), userMW...))
-		r.Post("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupCreate(), userMW...))
+		r.Get("/groups/all", chain.ToHandlerFunc(v1Ctrl.HandleGroupsGetAll(), accountMW...))
+		r.Post("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupCreate(), accountMW...))
 		r.Get("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupGet(), userMW...))
 		r.Put("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupUpdate(), ownerMW...))
 		r.Delete("/groups", chain.ToHandlerFunc(v1Ctrl.HandleGroupDelete(), ownerMW...))
+		r.Get("/groups/storage", chain.ToHandlerFunc(v1Ctrl.HandleGroupStorageReport(), ownerMW...))
 
 		r.Get("/groups/members", chain.ToHandlerFunc(v1Ctrl.HandleGroupMembersGetAll(), userMW...))
+		r.Put("/groups/members/{user_id}", chain.ToHandlerFunc(v1Ctrl.HandleGroupMemberRoleUpdate(), ownerMW...))
+		r.Put("/groups/members/{user_id}/locations", chain.ToHandlerFunc(v1Ctrl.HandleGroupMemberLocationsUpdate(), ownerMW...))
 		r.Delete("/groups/members/{user_id}", chain.ToHandlerFunc(v1Ctrl.HandleGroupMemberRemove(), ownerMW...))
 
 		r.Get("/groups/invitations", chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsGetAll(), userMW...))
 		r.Post("/groups/invitations", chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsCreate(), ownerMW...))
 		r.Delete("/groups/invitations/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsDelete(), ownerMW...))
-		r.Post("/groups/invitations/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsAccept(), userMW...))
+		r.Post("/groups/invitations/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGroupInvitationsAccept(), accountMW...))
+
+		// Webhooks administer where the collection's data is sent, so they
+		// are owner-only like the rest of collection administration.
+		r.Get("/groups/webhooks", chain.ToHandlerFunc(v1Ctrl.HandleWebhooksGetAll(), ownerMW...))
+		r.Post("/groups/webhooks", chain.ToHandlerFunc(v1Ctrl.HandleWebhookCreate(), ownerMW...))
+		r.Get("/groups/webhooks/secret", chain.ToHandlerFunc(v1Ctrl.HandleWebhookSecretGet(), ownerMW...))
+		r.Post("/groups/webhooks/secret", chain.ToHandlerFunc(v1Ctrl.HandleWebhookSecretRotate(), ownerMW...))
+		r.Put("/groups/webhooks/{id}", chain.ToHandlerFunc(v1Ctrl.HandleWebhookUpdate(), ownerMW...))
+		r.Delete("/groups/webhooks/{id}", chain.ToHandlerFunc(v1Ctrl.HandleWebhookDelete(), ownerMW...))
+		r.Get("/groups/webhooks/{id}/deliveries", chain.ToHandlerFunc(v1Ctrl.HandleWebhookDeliveries(), ownerMW...))
+
+		r.Get("/groups/exchange-rates", chain.ToHandlerFunc(v1Ctrl.HandleExchangeRatesGetAll(), userMW...))
+		r.Post("/groups/exchange-rates", chain.ToHandlerFunc(v1Ctrl.HandleExchangeRateSet(), editorMW...))
+		r.Post("/groups/exchange-rates/import", chain.ToHandlerFunc(v1Ctrl.HandleExchangeRatesImport(), editorMW...))
+		r.Delete("/groups/exchange-rates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleExchangeRateDelete(), editorMW...))
 
 		// Collection export/import (group-scoped)
-		r.Post("/group/exports", chain.ToHandlerFunc(v1Ctrl.HandleExportsCreate(), userMW...))
-		r.Get("/group/exports", chain.ToHandlerFunc(v1Ctrl.HandleExportsList(), userMW...))
-		r.Get("/group/exports/{id}", chain.ToHandlerFunc(v1Ctrl.HandleExportGet(), userMW...))
-		r.Get("/group/exports/{id}/download", chain.ToHandlerFunc(v1Ctrl.HandleExportDownload(), userMW...))
-		r.Delete("/group/exports/{id}", chain.ToHandlerFunc(v1Ctrl.HandleExportDelete(), userMW...))
-		r.Post("/group/import", chain.ToHandlerFunc(v1Ctrl.HandleCollectionImport(), userMW...))
+		r.Post("/group/exports", chain.ToHandlerFunc(v1Ctrl.HandleExportsCreate(), collectionMW...))
+		r.Get("/group/exports", chain.ToHandlerFunc(v1Ctrl.HandleExportsList(), collectionMW...))
+		r.Get("/group/exports/{id}", chain.ToHandlerFunc(v1Ctrl.HandleExportGet(), collectionMW...))
+		r.Get("/group/exports/{id}/download", chain.ToHandlerFunc(v1Ctrl.HandleExportDownload(), collectionMW...))
+		r.Delete("/group/exports/{id}", chain.ToHandlerFunc(v1Ctrl.HandleExportDelete(), collectionMW...))
+		r.Post("/group/import", chain.ToHandlerFunc(v1Ctrl.HandleCollectionImport(), ownerMW...))
 
 		r.Get("/groups/statistics", chain.ToHandlerFunc(v1Ctrl.HandleGroupStatistics(), userMW...))
 		r.Get("/groups/statistics/purchase-price", chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsPriceOverTime(), userMW...))
+		r.Get("/groups/statistics/value", chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsValue(), userMW...))
 		r.Get("/groups/statistics/locations", chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsLocations(), userMW...))
 		r.Get("/groups/statistics/tags", chain.ToHandlerFunc(v1Ctrl.HandleGroupStatisticsTags(), userMW...))
+		r.Get("/groups/history", chain.ToHandlerFunc(v1Ctrl.HandleGroupHistory(), userMW...))
 
 		// Action endpoints
-		r.Post("/actions/ensure-asset-ids", chain.ToHandlerFunc(v1Ctrl.HandleEnsureAssetID(), userMW...))
-		r.Post("/actions/zero-item-time-fields", chain.ToHandlerFunc(v1Ctrl.HandleItemDateZeroOut(), userMW...))
-		r.Post("/actions/ensure-import-refs", chain.ToHandlerFunc(v1Ctrl.HandleEnsureImportRefs(), userMW...))
-		r.Post("/actions/set-primary-photos", chain.ToHandlerFunc(v1Ctrl.HandleSetPrimaryPhotos(), userMW...))
-		r.Post("/actions/create-missing-thumbnails", chain.ToHandlerFunc(v1Ctrl.HandleCreateMissingThumbnails(), userMW...))
-		r.Post("/actions/wipe-inventory", chain.ToHandlerFunc(v1Ctrl.HandleWipeInventory(), userMW...))
+		r.Post("/actions/ensure-asset-ids", chain.ToHandlerFunc(v1Ctrl.HandleEnsureAssetID(), editorMW...))
+		r.Post("/actions/zero-item-time-fields", chain.ToHandlerFunc(v1Ctrl.HandleItemDateZeroOut(), editorMW...))
+		r.Post("/actions/ensure-import-refs", chain.ToHandlerFunc(v1Ctrl.HandleEnsureImportRefs(), editorMW...))
+		r.Post("/actions/set-primary-photos", chain.ToHandlerFunc(v1Ctrl.HandleSetPrimaryPhotos(), editorMW...))
+		r.Post("/actions/create-missing-thumbnails", chain.ToHandlerFunc(v1Ctrl.HandleCreateMissingThumbnails(), editorMW...))
+		r.Post("/actions/rebuild-search-index", chain.ToHandlerFunc(v1Ctrl.HandleRebuildSearchIndex(), editorMW...))
+		r.Post("/actions/wipe-inventory", chain.ToHandlerFunc(v1Ctrl.HandleWipeInventory(), ownerMW...))
 
 		// Tags endpoints
-		r.Get("/tags", chain.ToHandlerFunc(v1Ctrl.HandleTagsGetAll(), userMW...))
-		r.Post("/tags", chain.ToHandlerFunc(v1Ctrl.HandleTagsCreate(), userMW...))
-		r.Get("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagGet(), userMW...))
-		r.Put("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagUpdate(), userMW...))
-		r.Delete("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagDelete(), userMW...))
+		r.Get("/tags", chain.ToHandlerFunc(v1Ctrl.HandleTagsGetAll(), entityMW...))
+		r.Post("/tags", chain.ToHandlerFunc(v1Ctrl.HandleTagsCreate(), entityMW...))
+		r.Get("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagGet(), entityMW...))
+		r.Put("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagUpdate(), entityMW...))
+		r.Delete("/tags/{id}", chain.ToHandlerFunc(v1Ctrl.HandleTagDelete(), entityMW...))
 
 		// Entity Type endpoints
-		r.Get("/entity-types", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeGetAll(), userMW...))
-		r.Post("/entity-types", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeCreate(), userMW...))
-		r.Put("/entity-types/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeUpdate(), userMW...))
-		r.Delete("/entity-types/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeDelete(), userMW...))
+		r.Get("/entity-types", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeGetAll(), entityMW...))
+		r.Post("/entity-types", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeCreate(), entityMW...))
+		r.Put("/entity-types/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeUpdate(), entityMW...))
+		r.Delete("/entity-types/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeDelete(), entityMW...))
+		r.Get("/entity-types/{id}/fields", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeFieldsGetAll(), entityMW...))
+		r.Post("/entity-types/{id}/fields", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeFieldCreate(), entityMW...))
+		r.Put("/entity-types/{id}/fields/{field_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeFieldUpdate(), entityMW...))
+		r.Delete("/entity-types/{id}/fields/{field_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTypeFieldDelete(), entityMW...))
+
+		// Saved search endpoints
+		r.Get("/saved-searches", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchesGetAll(), entityMW...))
+		r.Post("/saved-searches", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchCreate(), entityMW...))
+		r.Get("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchGet(), entityMW...))
+		r.Put("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchUpdate(), entityMW...))
+		r.Delete("/saved-searches/{id}", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchDelete(), entityMW...))
+		r.Get("/saved-searches/{id}/entities", chain.ToHandlerFunc(v1Ctrl.HandleSavedSearchEntities(), entityMW...))
 
 		// Entity endpoints (primary)
-		r.Get("/entities", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesGetAll(), userMW...))
-		r.Post("/entities", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesCreate(), userMW...))
-		r.Post("/entities/import", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesImport(), userMW...))
-		r.Get("/entities/export", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesExport(), userMW...))
-		r.Get("/entities/fields", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldNames(), userMW...))
-		r.Get("/entities/fields/values", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldValues(), userMW...))
-		r.Get("/entities/tree", chain.ToHandlerFunc(v1Ctrl.HandleLocationTreeQuery(), userMW...))
-
-		r.Get("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityGet(), userMW...))
-		r.Get("/entities/{id}/path", chain.ToHandlerFunc(v1Ctrl.HandleEntityFullPath(), userMW...))
-		r.Put("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityUpdate(), userMW...))
-		r.Patch("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityPatch(), userMW...))
-		r.Delete("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityDelete(), userMW...))
-		r.Post("/entities/{id}/duplicate", chain.ToHandlerFunc(v1Ctrl.HandleEntityDuplicate(), userMW...))
+		r.Get("/entities", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesGetAll(), entityMW...))
+		r.Post("/entities", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesCreate(), entityMW...))
+		r.Post("/entities/import", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesImport(), entityMW...))
+		r.Get("/entities/export", chain.ToHandlerFunc(v1Ctrl.HandleEntitiesExport(), entityMW...))
+		r.Get("/entities/fields", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldNames(), entityMW...))
+		r.Get("/entities/fields/values", chain.ToHandlerFunc(v1Ctrl.HandleGetAllCustomFieldValues(), entityMW...))
+		r.Get("/entities/tree", chain.ToHandlerFunc(v1Ctrl.HandleLocationTreeQuery(), entityMW...))
+
+		r.Get("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityGet(), entityMW...))
+		r.Get("/entities/{id}/path", chain.ToHandlerFunc(v1Ctrl.HandleEntityFullPath(), entityMW...))
+		r.Get("/entities/{id}/history", chain.ToHandlerFunc(v1Ctrl.HandleEntityHistory(), entityMW...))
+		r.Put("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityUpdate(), entityMW...))
+		r.Patch("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityPatch(), entityMW...))
+		r.Delete("/entities/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityDelete(), entityMW...))
+		r.Post("/entities/{id}/duplicate", chain.ToHandlerFunc(v1Ctrl.HandleEntityDuplicate(), entityMW...))
 
 		// Entity attachment endpoints
-		r.Post("/entities/{id}/attachments", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentCreate(), userMW...))
-		r.Post("/entities/{id}/attachments/external", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentExternalCreate(), userMW...))
-		r.Put("/entities/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentUpdate(), userMW...))
-		r.Delete("/entities/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentDelete(), userMW...))
+		r.Post("/entities/{id}/attachments", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentCreate(), attachmentMW...))
+		r.Post("/entities/{id}/attachments/external", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentExternalCreate(), attachmentMW...))
+		r.Put("/entities/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentUpdate(), attachmentMW...))
+		r.Delete("/entities/{id}/attachments/{attachment_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentDelete(), attachmentMW...))
+		r.Post("/entities/{id}/attachments/{attachment_id}/apply-metadata", chain.ToHandlerFunc(v1Ctrl.HandleEntityAttachmentApplyMetadata(), entityMW...))
+		r.Post("/entities/{id}/uploads", chain.ToHandlerFunc(v1Ctrl.HandleEntityUploadCreate(), attachmentMW...))
+		r.Get("/entities/{id}/uploads/{upload_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityUploadGet(), attachmentMW...))
+		r.Patch("/entities/{id}/uploads/{upload_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityUploadPatch(), attachmentMW...))
+		r.Delete("/entities/{id}/uploads/{upload_id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityUploadDelete(), attachmentMW...))
 
 		// Entity maintenance endpoints
-		r.Get("/entities/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), userMW...))
-		r.Post("/entities/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryCreate(), userMW...))
+		r.Get("/entities/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceLogGet(), entityMW...))
+		r.Post("/entities/{id}/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryCreate(), entityMW...))
+
+		// Entity stock endpoints
+		r.Get("/entities/{id}/stock/movements", chain.ToHandlerFunc(v1Ctrl.HandleEntityStockMovements(), entityMW...))
+		r.Post("/entities/{id}/stock/consume", chain.ToHandlerFunc(v1Ctrl.HandleEntityStockConsume(), entityMW...))
+		r.Post("/entities/{id}/stock/restock", chain.ToHandlerFunc(v1Ctrl.HandleEntityStockRestock(), entityMW...))
+
+		// Entity loan endpoints
+		r.Get("/entities/{id}/loans", chain.ToHandlerFunc(v1Ctrl.HandleEntityLoansGet(), entityMW...))
+		r.Post("/entities/{id}/loans/checkout", chain.ToHandlerFunc(v1Ctrl.HandleEntityCheckout(), entityMW...))
+		r.Post("/entities/{id}/loans/checkin", chain.ToHandlerFunc(v1Ctrl.HandleEntityCheckin(), entityMW...))
+
+		r.Get("/assets/{id}", chain.ToHandlerFunc(v1Ctrl.HandleAssetGet(), entityMW...))
 
-		r.Get("/assets/{id}", chain.ToHandlerFunc(v1Ctrl.HandleAssetGet(), userMW...))
+		// Trash
+		r.Get("/trash", chain.ToHandlerFunc(v1Ctrl.HandleTrashGetAll(), entityMW...))
+		r.Post("/trash/{id}/restore", chain.ToHandlerFunc(v1Ctrl.HandleTrashRestore(), entityMW...))
 
 		// Entity Templates
-		r.Get("/templates", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesGetAll(), userMW...))
-		r.Post("/templates", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesCreate(), userMW...))
-		r.Get("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesGet(), userMW...))
-		r.Put("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesUpdate(), userMW...))
-		r.Delete("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesDelete(), userMW...))
-		r.Post("/templates/{id}/create-item", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesCreateItem(), userMW...))
+		r.Get("/templates", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesGetAll(), entityMW...))
+		r.Post("/templates", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesCreate(), entityMW...))
+		r.Get("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesGet(), entityMW...))
+		r.Put("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesUpdate(), entityMW...))
+		r.Delete("/templates/{id}", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesDelete(), entityMW...))
+		r.Post("/templates/{id}/create-item", chain.ToHandlerFunc(v1Ctrl.HandleEntityTemplatesCreateItem(), entityMW...))
 
 		// Maintenance
-		r.Get("/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceGetAll(), userMW...))
-		r.Put("/maintenance/{id}", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryUpdate(), userMW...))
-		r.Delete("/maintenance/{id}", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryDelete(), userMW...))
+		r.Get("/maintenance", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceGetAll(), entityMW...))
+		r.Put("/maintenance/{id}", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryUpdate(), entityMW...))
+		r.Delete("/maintenance/{id}", chain.ToHandlerFunc(v1Ctrl.HandleMaintenanceEntryDelete(), entityMW...))
 
 		// Notifiers
-		r.Get("/notifiers", chain.ToHandlerFunc(v1Ctrl.HandleGetUserNotifiers(), userMW...))
-		r.Post("/notifiers", chain.ToHandlerFunc(v1Ctrl.HandleCreateNotifier(), userMW...))
-		r.Put("/notifiers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleUpdateNotifier(), userMW...))
-		r.Delete("/notifiers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleDeleteNotifier(), userMW...))
-		r.Post("/notifiers/test", chain.ToHandlerFunc(v1Ctrl.HandlerNotifierTest(), append(userMW, a.notifierTestLimiter.middleware)...))
+		r.Get("/notifiers", chain.ToHandlerFunc(v1Ctrl.HandleGetUserNotifiers(), accountMW...))
+		r.Post("/notifiers", chain.ToHandlerFunc(v1Ctrl.HandleCreateNotifier(), accountMW...))
+		r.Put("/notifiers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleUpdateNotifier(), accountMW...))
+		r.Delete("/notifiers/{id}", chain.ToHandlerFunc(v1Ctrl.HandleDeleteNotifier(), accountMW...))
+		r.Post("/notifiers/test", chain.ToHandlerFunc(v1Ctrl.HandlerNotifierTest(), append(accountMW, a.notifierTestLimiter.middleware)...))
+		r.Post("/notifiers/preview", chain.ToHandlerFunc(v1Ctrl.HandleNotifierPreview(), append(accountMW, a.notifierTestLimiter.middleware)...))
 
 		// Asset-Like endpoints
 		assetMW := []errchain.Middleware{
 			a.mwAuthToken,
 			a.mwTenant,
 			a.mwRoles(RoleModeOr, authroles.RoleUser.String(), authroles.RoleAttachments.String()),
+			a.mwAPIKeyScope(repo.APIKeyResourceAttachments),
 		}
 
-		r.Get("/products/search-from-barcode", chain.ToHandlerFunc(v1Ctrl.HandleProductSearchFromBarcode(a.conf.Barcode), userMW...))
+		r.Get("/products/search-from-barcode", chain.ToHandlerFunc(v1Ctrl.HandleProductSearchFromBarcode(a.conf.Barcode), entityMW...))
 
 		r.Get("/qrcode", chain.ToHandlerFunc(v1Ctrl.HandleGenerateQRCode(), assetMW...))
 		r.Get(
@@ -262,20 +374,25 @@ func (a *app) mountRoutes(r *chi.Mux, chain *errchain.ErrChain, repos *repo.AllR
 		)
 
 		// Labelmaker
-		r.Get("/labelmaker/entity/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), userMW...))
-		r.Get("/labelmaker/location/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetLocationLabel(), userMW...))
-		r.Get("/labelmaker/item/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), userMW...))
-		r.Get("/labelmaker/asset/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetAssetLabel(), userMW...))
+		r.Get("/labelmaker/entity/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), entityMW...))
+		r.Get("/labelmaker/location/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetLocationLabel(), entityMW...))
+		r.Get("/labelmaker/item/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetItemLabel(), entityMW...))
+		r.Get("/labelmaker/asset/{id}", chain.ToHandlerFunc(v1Ctrl.HandleGetAssetLabel(), entityMW...))
+		r.Post("/labelmaker/saved-search/{id}", chain.ToHandlerFunc(v1Ctrl.HandlePrintSavedSearchLabels(), entityMW...))
+		r.Get("/labelmaker/sheet/presets", chain.ToHandlerFunc(v1Ctrl.HandleGetLabelSheetPresets(), userMW...))
+		r.Post("/labelmaker/sheet", chain.ToHandlerFunc(v1Ctrl.HandleGetLabelSheet(), entityMW...))
 
 		// Reporting Services
-		r.Get("/reporting/bill-of-materials", chain.ToHandlerFunc(v1Ctrl.HandleBillOfMaterialsExport(), userMW...))
+		r.Get("/reporting/bill-of-materials", chain.ToHandlerFunc(v1Ctrl.HandleBillOfMaterialsExport(), entityMW...))
+		r.Get("/reporting/insurance", chain.ToHandlerFunc(v1Ctrl.HandleInsuranceReportExport(), entityMW...))
+		r.Get("/reporting/low-stock", chain.ToHandlerFunc(v1Ctrl.HandleLowStockReport(), entityMW...))
 
 		// OpenTelemetry proxy endpoint for frontend telemetry (requires auth)
 		if a.otel != nil && a.otel.IsEnabled() && a.conf.Otel.ProxyEnabled {
 			r.Post("/telemetry", chain.ToHandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
 				a.otel.ProxyHandler().ServeHTTP(w, r)
 				return nil
-			}, userMW...))
+			}, accountMW...))
 		}
 
 		r.NotFound(http.NotFound)
@@ -93,6 +93,31 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/actions/rebuild-search-index": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Rebuilds the full-text search index for every entity in the collection",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Actions"
+                ],
+                "summary": "Rebuild Search Index",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ActionAmountResult"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/actions/set-primary-photos": {
             "post": {
                 "security": [
@@ -247,10 +272,16 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "search string",
+                        "description": "search string with optional filters, e.g. tag:garage AND purchasePrice\u003e100 AND NOT archived",
                         "name": "q",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "name (default), createdAt, updatedAt, assetId or relevance",
+                        "name": "orderBy",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "page number",
@@ -282,6 +313,18 @@ const docTemplate = `{
                         "description": "parent Ids",
                         "name": "parentIds",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only entities currently lent out",
+                        "name": "onLoan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only insured entities",
+                        "name": "insured",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -338,6 +381,14 @@ const docTemplate = `{
                     "Entities"
                 ],
                 "summary": "Export Entities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only export entities matching this saved search",
+                        "name": "savedSearch",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "text/csv",
@@ -856,20 +907,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/entities/{id}/duplicate": {
+        "/v1/entities/{id}/attachments/{attachment_id}/apply-metadata": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Copies the capture date of a photo to the purchase date of the entity, or its position to the Coordinates custom field.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Entities"
+                    "Entities Attachments"
                 ],
-                "summary": "Duplicate Entity",
+                "summary": "Apply Attachment Metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -879,18 +931,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Duplicate Options",
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Metadata to apply",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.DuplicateOptions"
+                            "$ref": "#/definitions/repo.AttachmentMetadataApply"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/repo.EntityOut"
                         }
@@ -898,8 +957,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/entities/{id}/maintenance": {
-            "get": {
+        "/v1/entities/{id}/duplicate": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -909,46 +968,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Maintenance"
+                    "Entities"
                 ],
-                "summary": "Get Maintenance Log",
+                "summary": "Duplicate Entity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Entity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "scheduled",
-                            "completed",
-                            "both"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "MaintenanceFilterStatusScheduled",
-                            "MaintenanceFilterStatusCompleted",
-                            "MaintenanceFilterStatusBoth"
-                        ],
-                        "name": "status",
-                        "in": "query"
+                        "description": "Duplicate Options",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.DuplicateOptions"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
-                            }
+                            "$ref": "#/definitions/repo.EntityOut"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/entities/{id}/history": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -958,38 +1010,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Item Maintenance"
+                    "Entities"
                 ],
-                "summary": "Create Maintenance Entry",
+                "summary": "Get Entity Change History",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Entity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Entry Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
-                        }
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "$ref": "#/definitions/repo.PaginationResult-repo_AuditEntryOut"
                         }
                     }
                 }
             }
         },
-        "/v1/entities/{id}/path": {
+        "/v1/entities/{id}/loans": {
             "get": {
                 "security": [
                     {
@@ -1000,9 +1055,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entities"
+                    "Loans"
                 ],
-                "summary": "Get the full path of an entity",
+                "summary": "Get Loan History",
                 "parameters": [
                     {
                         "type": "string",
@@ -1018,15 +1073,15 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.EntityPath"
+                                "$ref": "#/definitions/repo.LoanOut"
                             }
                         }
                     }
                 }
             }
         },
-        "/v1/entity-types": {
-            "get": {
+        "/v1/entities/{id}/loans/checkin": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1036,21 +1091,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Types"
+                    "Loans"
+                ],
+                "summary": "Check In Entity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Return details",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.LoanCheckin"
+                        }
+                    }
                 ],
-                "summary": "Get All Entity Types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.EntityTypeSummary"
-                            }
+                            "$ref": "#/definitions/repo.LoanOut"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/entities/{id}/loans/checkout": {
             "post": {
                 "security": [
                     {
@@ -1061,17 +1133,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Types"
+                    "Loans"
                 ],
-                "summary": "Create Entity Type",
+                "summary": "Check Out Entity",
                 "parameters": [
                     {
-                        "description": "Entity Type Data",
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Borrower and due date",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTypeCreate"
+                            "$ref": "#/definitions/repo.LoanCheckout"
                         }
                     }
                 ],
@@ -1079,14 +1158,14 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                            "$ref": "#/definitions/repo.LoanOut"
                         }
                     }
                 }
             }
         },
-        "/v1/entity-types/{id}": {
-            "put": {
+        "/v1/entities/{id}/maintenance": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -1096,37 +1175,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Types"
+                    "Item Maintenance"
                 ],
-                "summary": "Update Entity Type",
+                "summary": "Get Maintenance Log",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Entity Type ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Entity Type Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.EntityTypeUpdate"
-                        }
+                        "enum": [
+                            "scheduled",
+                            "completed",
+                            "both"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "MaintenanceFilterStatusScheduled",
+                            "MaintenanceFilterStatusCompleted",
+                            "MaintenanceFilterStatusBoth"
+                        ],
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
+                            }
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1136,74 +1224,116 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Types"
+                    "Item Maintenance"
                 ],
-                "summary": "Delete Entity Type",
+                "summary": "Create Maintenance Entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Entity Type ID",
+                        "description": "Item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Entry Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceEntryCreate"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                        }
                     }
                 }
             }
         },
-        "/v1/group/exports": {
+        "/v1/entities/{id}/path": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Returns export job rows for the caller's group, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entities"
+                ],
+                "summary": "Get the full path of an entity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List Collection Exports",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.Results-repo_ExportOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.EntityPath"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/entities/{id}/stock/consume": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a pending export row and enqueues the build job. Poll the listing endpoint or watch the WebSocket for completion.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Stock"
+                ],
+                "summary": "Consume Stock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quantity consumed",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.StockChange"
+                        }
+                    }
                 ],
-                "summary": "Start a Collection Export",
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.ExportOut"
+                            "$ref": "#/definitions/repo.StockMovement"
                         }
                     }
                 }
             }
         },
-        "/v1/group/exports/{id}": {
+        "/v1/entities/{id}/stock/movements": {
             "get": {
                 "security": [
                     {
@@ -1214,13 +1344,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Stock"
                 ],
-                "summary": "Get an Export",
+                "summary": "Get Stock Movements",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Export ID",
+                        "description": "Entity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1230,109 +1360,229 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ExportOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.StockMovement"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/entities/{id}/stock/restock": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes the export row and its blob artifact.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Group"
+                    "Stock"
                 ],
-                "summary": "Delete an Export",
+                "summary": "Restock",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Export ID",
+                        "description": "Entity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Quantity added",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.StockChange"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StockMovement"
+                        }
                     }
                 }
             }
         },
-        "/v1/group/exports/{id}/download": {
+        "/v1/entities/{id}/uploads": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Starts an upload of a file too large or a connection too flaky for a single request. Send the content in chunks with PATCH.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entities Attachments"
+                ],
+                "summary": "Create Resumable Attachment Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upload Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.AttachmentUploadCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.AttachmentUploadOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/entities/{id}/uploads/{upload_id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Returns the bytes received so far, the offset to resume the upload from.",
                 "produces": [
-                    "application/zip"
+                    "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entities Attachments"
                 ],
-                "summary": "Download an Export Artifact",
+                "summary": "Get Resumable Attachment Upload",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Export ID",
+                        "description": "Entity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/repo.AttachmentUploadOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/group/import": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Uploads a collection-export zip and enqueues the import job. The destination group must be empty. Returns the tracked import row so clients can poll for progress.",
+                "tags": [
+                    "Entities Attachments"
+                ],
+                "summary": "Delete Resumable Attachment Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Appends the request body to the upload. The Upload-Offset header must match the bytes received so far; if the connection drops, the bytes that arrived are kept. The chunk that completes the upload creates the attachment and sets attachmentId.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/offset+octet-stream"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entities Attachments"
                 ],
-                "summary": "Import a Collection Zip",
+                "summary": "Upload Attachment Chunk",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Export zip",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset of the chunk in the file",
+                        "name": "Upload-Offset",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ExportOut"
+                            "$ref": "#/definitions/repo.AttachmentUploadOut"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/validate.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/groups": {
+        "/v1/entity-types": {
             "get": {
                 "security": [
                     {
@@ -1343,19 +1593,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Get Group",
+                "summary": "Get All Entity Types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.EntityTypeSummary"
+                            }
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1365,30 +1618,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Update Group",
+                "summary": "Create Entity Type",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Entity Type Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.GroupUpdate"
+                            "$ref": "#/definitions/repo.EntityTypeCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/entity-types/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -1398,25 +1653,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Create Group",
+                "summary": "Update Entity Type",
                 "parameters": [
                     {
-                        "description": "Create group request",
+                        "type": "string",
+                        "description": "Entity Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entity Type Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateRequest"
+                            "$ref": "#/definitions/repo.EntityTypeUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.Group"
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
                         }
                     }
                 }
@@ -1431,9 +1693,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
+                ],
+                "summary": "Delete Entity Type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Delete Group",
                 "responses": {
                     "204": {
                         "description": "No Content"
@@ -1441,7 +1712,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/groups/all": {
+        "/v1/entity-types/{id}/fields": {
             "get": {
                 "security": [
                     {
@@ -1452,43 +1723,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
+                ],
+                "summary": "Get Entity Type Field Definitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get All Groups",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.Group"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/groups/invitations": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Group"
-                ],
-                "summary": "Get All Group Invitations",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.GroupInvitation"
+                                "$ref": "#/definitions/repo.EntityFieldDefinitionOut"
                             }
                         }
                     }
@@ -1504,33 +1757,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Create Group Invitation",
-                "operationId": "groupInvitationCreate",
+                "summary": "Create Entity Type Field Definition",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "type": "string",
+                        "description": "Entity Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Field Definition Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitationCreate"
+                            "$ref": "#/definitions/repo.EntityFieldDefinitionCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupInvitation"
+                            "$ref": "#/definitions/repo.EntityFieldDefinitionOut"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/invitations/{id}": {
-            "post": {
+        "/v1/entity-types/{id}/fields/{field_id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -1540,24 +1799,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Accept Group Invitation",
-                "operationId": "groupInvitationAccept",
+                "summary": "Update Entity Type Field Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Invitation Token",
+                        "description": "Entity Type ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field Definition ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Field Definition Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityFieldDefinitionUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.GroupAcceptInvitationResponse"
+                            "$ref": "#/definitions/repo.EntityFieldDefinitionOut"
                         }
                     }
                 }
@@ -1572,16 +1846,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Group"
+                    "Entity Types"
                 ],
-                "summary": "Delete Group Invitation",
+                "summary": "Delete Entity Type Field Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Invitation ID",
+                        "description": "Entity Type ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field Definition ID",
+                        "name": "field_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1591,64 +1872,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/groups/members": {
+        "/v1/group/exports": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Returns export job rows for the caller's group, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Group"
                 ],
-                "summary": "Get All Group Members",
+                "summary": "List Collection Exports",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.UserSummary"
-                            }
+                            "$ref": "#/definitions/v1.Results-repo_ExportOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/groups/members/{user_id}": {
-            "delete": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Creates a pending export row and enqueues the build job. Poll the listing endpoint or watch the WebSocket for completion.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Group"
                 ],
-                "summary": "Remove User from Group",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Start a Collection Export",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/repo.ExportOut"
+                        }
                     }
                 }
             }
         },
-        "/v1/groups/statistics": {
+        "/v1/group/exports/{id}": {
             "get": {
                 "security": [
                     {
@@ -1659,47 +1931,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Group"
+                ],
+                "summary": "Get an Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Group Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.GroupStatistics"
+                            "$ref": "#/definitions/repo.ExportOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/groups/statistics/locations": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes the export row and its blob artifact.",
                 "tags": [
-                    "Statistics"
+                    "Group"
+                ],
+                "summary": "Delete an Export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Location Statistics",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/groups/statistics/purchase-price": {
+        "/v1/group/exports/{id}/download": {
             "get": {
                 "security": [
                     {
@@ -1707,64 +1987,69 @@ const docTemplate = `{
                     }
                 ],
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Group"
                 ],
-                "summary": "Get Purchase Price Statistics",
+                "summary": "Download an Export Artifact",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "start date",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "end date",
-                        "name": "end",
-                        "in": "query"
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.ValueOverTime"
+                            "type": "file"
                         }
                     }
                 }
             }
         },
-        "/v1/groups/statistics/tags": {
-            "get": {
+        "/v1/group/import": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Uploads a collection-export zip and enqueues the import job. The destination group must be empty. Returns the tracked import row so clients can poll for progress.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Group"
+                ],
+                "summary": "Import a Collection Zip",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Export zip",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Tags Statistics",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.TotalsByOrganizer"
-                            }
+                            "$ref": "#/definitions/repo.ExportOut"
                         }
                     }
                 }
             }
         },
-        "/v1/labelmaker/asset/{id}": {
+        "/v1/groups": {
             "get": {
                 "security": [
                     {
@@ -1775,36 +2060,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Get Asset label",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
-                        "in": "query"
-                    }
+                    "Group"
                 ],
+                "summary": "Get Group",
                 "responses": {
                     "200": {
-                        "description": "image/png",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
-            }
-        },
-        "/v1/labelmaker/item/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -1814,36 +2082,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Get Item label",
+                "summary": "Update Group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
-                        "in": "query"
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.GroupUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "image/png",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
-            }
-        },
-        "/v1/labelmaker/location/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -1853,36 +2115,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Locations"
+                    "Group"
                 ],
-                "summary": "Get Location label",
+                "summary": "Create Group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Location ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Print this label, defaults to false",
-                        "name": "print",
-                        "in": "query"
+                        "description": "Create group request",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "image/png",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/repo.Group"
                         }
                     }
                 }
-            }
-        },
-        "/v1/maintenance": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
@@ -1892,41 +2148,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Query All Maintenance",
-                "parameters": [
-                    {
-                        "enum": [
-                            "scheduled",
-                            "completed",
-                            "both"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "MaintenanceFilterStatusScheduled",
-                            "MaintenanceFilterStatusCompleted",
-                            "MaintenanceFilterStatusBoth"
-                        ],
-                        "name": "status",
-                        "in": "query"
-                    }
+                    "Group"
                 ],
+                "summary": "Delete Group",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/maintenance/{id}": {
-            "put": {
+        "/v1/groups/all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -1936,66 +2169,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Update Maintenance Entry",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Maintenance ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Entry Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
-                        }
-                    }
+                    "Group"
                 ],
+                "summary": "Get All Groups",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.MaintenanceEntry"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.Group"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Maintenance"
-                ],
-                "summary": "Delete Maintenance Entry",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Maintenance ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
             }
         },
-        "/v1/notifiers": {
+        "/v1/groups/exchange-rates": {
             "get": {
                 "security": [
                     {
@@ -2006,16 +2196,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Group"
                 ],
-                "summary": "Get Notifiers",
+                "summary": "Get Exchange Rates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.NotifierOut"
+                                "$ref": "#/definitions/repo.ExchangeRateOut"
                             }
                         }
                     }
@@ -2031,50 +2221,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Group"
                 ],
-                "summary": "Create Notifier",
+                "summary": "Set Exchange Rate",
                 "parameters": [
                     {
-                        "description": "Notifier Data",
+                        "description": "Exchange Rate",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierCreate"
+                            "$ref": "#/definitions/repo.ExchangeRateCreate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
+                            "$ref": "#/definitions/repo.ExchangeRateOut"
                         }
                     }
                 }
             }
         },
-        "/v1/notifiers/test": {
+        "/v1/groups/exchange-rates/import": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifiers"
+                    "Group"
                 ],
-                "summary": "Test Notifier",
+                "summary": "Import Exchange Rates",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "URL",
-                        "name": "url",
-                        "in": "query",
+                        "type": "file",
+                        "description": "JSON or CSV file with base, quote, rate and date",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -2085,71 +2278,75 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/notifiers/{id}": {
-            "put": {
+        "/v1/groups/exchange-rates/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifiers"
+                    "Group"
                 ],
-                "summary": "Update Notifier",
+                "summary": "Delete Exchange Rate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notifier ID",
+                        "description": "Exchange Rate ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Notifier Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.NotifierUpdate"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.NotifierOut"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/groups/history": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifiers"
+                    "Group"
                 ],
-                "summary": "Delete a Notifier",
+                "summary": "Get Group Change History",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Notifier ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_AuditEntryOut"
+                        }
                     }
                 }
             }
         },
-        "/v1/products/search-from-barcode": {
+        "/v1/groups/invitations": {
             "get": {
                 "security": [
                     {
@@ -2160,32 +2357,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
-                ],
-                "summary": "Search EAN from Barcode",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "barcode to be searched",
-                        "name": "data",
-                        "in": "query"
-                    }
+                    "Group"
                 ],
+                "summary": "Get All Group Invitations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.BarcodeProduct"
+                                "$ref": "#/definitions/repo.GroupInvitation"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/v1/qrcode": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -2195,29 +2382,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Items"
+                    "Group"
                 ],
-                "summary": "Create QR Code",
+                "summary": "Create Group Invitation",
+                "operationId": "groupInvitationCreate",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "data to be encoded into qrcode",
-                        "name": "data",
-                        "in": "query"
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.GroupInvitationCreate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "image/jpeg",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.GroupInvitation"
                         }
                     }
                 }
             }
         },
-        "/v1/reporting/bill-of-materials": {
-            "get": {
+        "/v1/groups/invitations/{id}": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -2227,39 +2418,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reporting"
+                    "Group"
+                ],
+                "summary": "Accept Group Invitation",
+                "operationId": "groupInvitationAccept",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation Token",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Export Bill of Materials",
                 "responses": {
                     "200": {
-                        "description": "text/csv",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.GroupAcceptInvitationResponse"
                         }
                     }
                 }
-            }
-        },
-        "/v1/status": {
-            "get": {
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Base"
+                    "Group"
+                ],
+                "summary": "Delete Group Invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Application Info",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.APISummary"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/tags": {
+        "/v1/groups/members": {
             "get": {
                 "security": [
                     {
@@ -2270,22 +2480,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Group"
                 ],
-                "summary": "Get All Tags",
+                "summary": "Get All Group Members",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.TagSummary"
+                                "$ref": "#/definitions/repo.GroupMember"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/groups/members/{user_id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -2295,32 +2507,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Group"
                 ],
-                "summary": "Create Tag",
+                "summary": "Change a Member's Role",
                 "parameters": [
                     {
-                        "description": "Tag Data",
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.TagCreate"
+                            "$ref": "#/definitions/v1.GroupMemberRoleUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/repo.TagOut"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            }
-        },
-        "/v1/tags/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
@@ -2330,27 +2544,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Group"
                 ],
-                "summary": "Get Tag",
+                "summary": "Remove User from Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Tag ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.TagOut"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
+            }
+        },
+        "/v1/groups/members/{user_id}/locations": {
             "put": {
                 "security": [
                     {
@@ -2361,37 +2574,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "Group"
                 ],
-                "summary": "Update Tag",
+                "summary": "Limit a Member to Locations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Tag ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Tag Data",
+                        "description": "Locations, empty for the whole collection",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.TagUpdate"
+                            "$ref": "#/definitions/v1.GroupMemberLocationsUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repo.TagOut"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/groups/statistics": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -2401,26 +2613,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
-                ],
-                "summary": "Delete Tag",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Tag ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Statistics"
                 ],
+                "summary": "Get Group Statistics",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.GroupStatistics"
+                        }
                     }
                 }
             }
         },
-        "/v1/templates": {
+        "/v1/groups/statistics/locations": {
             "get": {
                 "security": [
                     {
@@ -2431,22 +2637,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
+                    "Statistics"
                 ],
-                "summary": "Get All Entity Templates",
+                "summary": "Get Location Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.EntityTemplateSummary"
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/groups/statistics/purchase-price": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -2456,31 +2664,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
+                    "Statistics"
                 ],
-                "summary": "Create Entity Template",
+                "summary": "Get Purchase Price Statistics",
                 "parameters": [
                     {
-                        "description": "Template Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.EntityTemplateCreate"
-                        }
+                        "type": "string",
+                        "description": "start date",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end date",
+                        "name": "end",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                            "$ref": "#/definitions/repo.ValueOverTime"
                         }
                     }
                 }
             }
         },
-        "/v1/templates/{id}": {
+        "/v1/groups/statistics/tags": {
             "get": {
                 "security": [
                     {
@@ -2491,28 +2702,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
-                ],
-                "summary": "Get Entity Template",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Template ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Statistics"
                 ],
+                "summary": "Get Tags Statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TotalsByOrganizer"
+                            }
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/groups/statistics/value": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -2522,66 +2729,84 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
+                    "Statistics"
                 ],
-                "summary": "Update Entity Template",
+                "summary": "Get Current Value Statistics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Template ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "start date",
+                        "name": "start",
+                        "in": "query"
                     },
                     {
-                        "description": "Template Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/repo.EntityTemplateUpdate"
-                        }
+                        "type": "string",
+                        "description": "end date",
+                        "name": "end",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                            "$ref": "#/definitions/repo.ValueOverTime"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/groups/storage": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Compares the attachment files of the group in storage with the attachments referencing them. Orphaned files are removed by the garbage collector after a grace period; missing files have to be uploaded again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
+                    "Group"
                 ],
-                "summary": "Delete Entity Template",
-                "parameters": [
+                "summary": "Get Group Storage Report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.StorageReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/groups/webhooks": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Template ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get Webhooks",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.WebhookOut"
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/v1/templates/{id}/create-item": {
+            },
             "post": {
                 "security": [
                     {
@@ -2592,24 +2817,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Entity Templates"
+                    "Webhooks"
                 ],
-                "summary": "Create Entity from Template",
+                "summary": "Create Webhook",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Template ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Entity Data",
+                        "description": "Webhook Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.EntityTemplateCreateItemRequest"
+                            "$ref": "#/definitions/repo.WebhookCreate"
                         }
                     }
                 ],
@@ -2617,257 +2835,201 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/repo.EntityOut"
+                            "$ref": "#/definitions/repo.WebhookOut"
                         }
                     }
                 }
             }
         },
-        "/v1/users/change-password": {
-            "put": {
+        "/v1/groups/webhooks/secret": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Returns the collection's webhook signing secret, generating it on first use.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "Webhooks"
                 ],
-                "summary": "Change Password",
-                "parameters": [
-                    {
-                        "description": "Password Payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get Webhook Signing Secret",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.ChangePassword"
+                            "$ref": "#/definitions/services.WebhookSecretOut"
                         }
                     }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
                 }
-            }
-        },
-        "/v1/users/forgot-password": {
+            },
             "post": {
-                "description": "Sends a password reset email if the address is associated with a local account.\nAlways returns 204 on success to avoid leaking whether the email is registered.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
-                ],
-                "summary": "Request Password Reset",
-                "parameters": [
-                    {
-                        "description": "Email",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ForgotPasswordRequest"
-                        }
-                    }
+                    "Webhooks"
                 ],
+                "summary": "Rotate Webhook Signing Secret",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "missing or invalid request body, or empty email field",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "403": {
-                        "description": "demo mode is enabled or local login is disabled",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "internal error while processing the request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/services.WebhookSecretOut"
                         }
                     }
                 }
             }
         },
-        "/v1/users/login": {
-            "post": {
-                "consumes": [
-                    "application/x-www-form-urlencoded",
-                    "application/json"
+        "/v1/groups/webhooks/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Webhooks"
                 ],
-                "summary": "User Login",
+                "summary": "Update Webhook",
                 "parameters": [
                     {
-                        "description": "Login Data",
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.LoginForm"
+                            "$ref": "#/definitions/repo.WebhookUpdate"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "auth provider",
-                        "name": "provider",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.TokenResponse"
+                            "$ref": "#/definitions/repo.WebhookOut"
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/login/oidc": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "OIDC Login Initiation",
-                "responses": {
-                    "302": {
-                        "description": "Found"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
                     }
-                }
-            }
-        },
-        "/v1/users/login/oidc/callback": {
-            "get": {
+                ],
                 "tags": [
-                    "Authentication"
+                    "Webhooks"
                 ],
-                "summary": "OIDC Callback Handler",
+                "summary": "Delete Webhook",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization code",
-                        "name": "code",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "State parameter",
-                        "name": "state",
-                        "in": "query",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "302": {
-                        "description": "Found"
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/v1/users/logout": {
-            "post": {
+        "/v1/groups/webhooks/{id}/deliveries": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Returns the 100 most recent deliveries of the webhook, newest first.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Authentication"
+                    "Webhooks"
                 ],
-                "summary": "User Logout",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/v1/users/logout/all": {
-            "post": {
-                "security": [
+                "summary": "Get Webhook Deliveries",
+                "parameters": [
                     {
-                        "Bearer": []
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "description": "Revokes every session token for the authenticated user across all\ndevices, including the current one. Use this to invalidate a session\ntoken that may have been leaked or stolen. API keys are stored\nseparately and are not affected; revoke them from the API keys page.",
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "Log Out Of All Sessions",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.WebhookDeliveryOut"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/users/refresh": {
+        "/v1/labelmaker/asset/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "User Token Refresh",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/v1/users/register": {
-            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Items"
                 ],
-                "summary": "Register New User",
+                "summary": "Get Asset label",
                 "parameters": [
                     {
-                        "description": "User Data",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/services.UserRegistration"
-                        }
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label format: png, zpl, escpos or brother-ql",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "403": {
-                        "description": "Local login is not enabled",
+                    "200": {
+                        "description": "image/png",
                         "schema": {
                             "type": "string"
                         }
@@ -2875,48 +3037,89 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/reset-password": {
-            "post": {
-                "description": "Consumes a single-use reset token and changes the user's password.\nOn success, all existing sessions for the user are revoked.",
-                "consumes": [
-                    "application/json"
+        "/v1/labelmaker/item/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Items"
                 ],
-                "summary": "Reset Password",
+                "summary": "Get Item label",
                 "parameters": [
                     {
-                        "description": "Token + new password",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ResetPasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label format: png, zpl, escpos or brother-ql",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "invalid request body, password shorter than the minimum length, or token that is invalid / expired / already used",
+                    "200": {
+                        "description": "image/png",
                         "schema": {
                             "type": "string"
                         }
+                    }
+                }
+            }
+        },
+        "/v1/labelmaker/location/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Locations"
+                ],
+                "summary": "Get Location label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
-                    "403": {
-                        "description": "demo mode is enabled or local login is disabled",
-                        "schema": {
-                            "type": "string"
-                        }
+                    {
+                        "type": "boolean",
+                        "description": "Print this label, defaults to false",
+                        "name": "print",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "internal error while processing the request",
+                    {
+                        "type": "string",
+                        "description": "Label format: png, zpl, escpos or brother-ql",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/png",
                         "schema": {
                             "type": "string"
                         }
@@ -2924,87 +3127,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/self": {
-            "get": {
+        "/v1/labelmaker/saved-search/{id}": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Prints an item label for every entity the saved search matches, up to 500.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Saved Searches"
+                ],
+                "summary": "Print Saved Search labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label format: png, zpl, escpos or brother-ql; defaults to the configured one",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get User Self",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserOut"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/v1.SavedSearchLabelsOut"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/labelmaker/sheet": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
+                "description": "Lays out the labels of a list of entities, the entities an entity query matches or the entities in a\nlocation subtree on sheets of label stock, as a PDF.",
+                "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/pdf"
+                ],
                 "tags": [
-                    "User"
+                    "Items"
                 ],
-                "summary": "Update Account",
+                "summary": "Get Label Sheet",
                 "parameters": [
                     {
-                        "description": "User Data",
+                        "description": "Entities and sheet",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.UserUpdate"
+                            "$ref": "#/definitions/v1.LabelSheetRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "application/pdf",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "$ref": "#/definitions/repo.UserUpdate"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/labelmaker/sheet/presets": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
@@ -3014,17 +3217,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Items"
                 ],
-                "summary": "Delete Account",
+                "summary": "Get Label Sheet Presets",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.LabelSheetPreset"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/v1/users/self/api-keys": {
+        "/v1/maintenance": {
             "get": {
                 "security": [
                     {
@@ -3035,22 +3244,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Maintenance"
+                ],
+                "summary": "Query All Maintenance",
+                "parameters": [
+                    {
+                        "enum": [
+                            "scheduled",
+                            "completed",
+                            "both"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "MaintenanceFilterStatusScheduled",
+                            "MaintenanceFilterStatusCompleted",
+                            "MaintenanceFilterStatusBoth"
+                        ],
+                        "name": "status",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List API Keys",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/repo.APIKeyOut"
+                                "$ref": "#/definitions/repo.MaintenanceEntryWithDetails"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/maintenance/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
@@ -3060,45 +3288,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Maintenance"
                 ],
-                "summary": "Create API Key",
+                "summary": "Update Maintenance Entry",
                 "parameters": [
                     {
-                        "description": "API Key Data",
+                        "type": "string",
+                        "description": "Maintenance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/repo.APIKeyCreate"
+                            "$ref": "#/definitions/repo.MaintenanceEntryUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/repo.APIKeyCreatedOut"
+                            "$ref": "#/definitions/repo.MaintenanceEntry"
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/self/api-keys/{id}": {
+            },
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "Maintenance"
                 ],
-                "summary": "Delete API Key",
+                "summary": "Delete Maintenance Entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "API Key ID",
+                        "description": "Maintenance ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3111,7 +3347,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/self/settings": {
+        "/v1/notifiers": {
             "get": {
                 "security": [
                     {
@@ -3122,32 +3358,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Notifiers"
                 ],
-                "summary": "Get user settings",
+                "summary": "Get Notifiers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "type": "object",
-                                            "additionalProperties": true
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.NotifierOut"
+                            }
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
@@ -3157,18 +3383,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Notifiers"
                 ],
-                "summary": "Update user settings",
+                "summary": "Create Notifier",
                 "parameters": [
                     {
-                        "description": "Settings Data",
+                        "description": "Notifier Data",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/repo.NotifierCreate"
                         }
                     }
                 ],
@@ -3176,246 +3401,3911 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/v1.Wrapped"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "item": {
-                                            "type": "object",
-                                            "additionalProperties": true
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/repo.NotifierOut"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "attachment.Type": {
-            "type": "string",
-            "enum": [
-                "attachment",
-                "photo",
-                "manual",
-                "warranty",
-                "attachment",
-                "receipt",
-                "thumbnail"
-            ],
-            "x-enum-varnames": [
-                "DefaultType",
-                "TypePhoto",
-                "TypeManual",
-                "TypeWarranty",
-                "TypeAttachment",
-                "TypeReceipt",
-                "TypeThumbnail"
-            ]
-        },
-        "authroles.Role": {
-            "type": "string",
-            "enum": [
-                "user",
-                "admin",
-                "user",
-                "attachments"
-            ],
-            "x-enum-varnames": [
-                "DefaultRole",
-                "RoleAdmin",
-                "RoleUser",
-                "RoleAttachments"
-            ]
-        },
-        "currencies.Currency": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "decimals": {
-                    "type": "integer"
-                },
-                "local": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "symbol": {
-                    "type": "string"
-                }
-            }
         },
-        "ent.APIKey": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the APIKeyQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.APIKeyEdges"
+        "/v1/notifiers/preview": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Renders a subscription template against sample data for the event. An empty template renders the event's default message.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Preview Notifier Template",
+                "parameters": [
+                    {
+                        "description": "Event and template",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.NotificationPreview"
                         }
-                    ]
-                },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
-                },
-                "last_used_at": {
-                    "description": "LastUsedAt holds the value of the \"last_used_at\" field.",
-                    "type": "string"
-                },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
-                },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
-                },
-                "user_id": {
-                    "description": "UserID holds the value of the \"user_id\" field.",
-                    "type": "string"
-                }
-            }
-        },
-        "ent.APIKeyEdges": {
-            "type": "object",
-            "properties": {
-                "user": {
-                    "description": "User holds the value of the user edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.User"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.NotificationPreviewOut"
                         }
-                    ]
+                    }
                 }
             }
         },
-        "ent.Attachment": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AttachmentQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AttachmentEdges"
-                        }
-                    ]
-                },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
-                },
-                "mime_type": {
-                    "description": "MimeType holds the value of the \"mime_type\" field.",
-                    "type": "string"
-                },
-                "path": {
-                    "description": "Path holds the value of the \"path\" field.",
-                    "type": "string"
-                },
-                "primary": {
-                    "description": "Primary holds the value of the \"primary\" field.",
-                    "type": "boolean"
-                },
-                "title": {
-                    "description": "Title holds the value of the \"title\" field.",
-                    "type": "string"
-                },
-                "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/attachment.Type"
-                        }
-                    ]
-                },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
+        "/v1/notifiers/test": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Test Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "URL",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
                 }
             }
         },
-        "ent.AttachmentEdges": {
+        "/v1/notifiers/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Update Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notifier Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.NotifierOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Notifiers"
+                ],
+                "summary": "Delete a Notifier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notifier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/products/search-from-barcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Search EAN from Barcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "barcode to be searched",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.BarcodeProduct"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Items"
+                ],
+                "summary": "Create QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "data to be encoded into qrcode",
+                        "name": "data",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image/jpeg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reporting/bill-of-materials": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reporting"
+                ],
+                "summary": "Export Bill of Materials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only include entities matching this saved search",
+                        "name": "savedSearch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/csv",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reporting/insurance": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Reporting"
+                ],
+                "summary": "Export Insurance Report",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "only include entities in these locations",
+                        "name": "locations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "only include entities with these tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only include insured entities",
+                        "name": "insured",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "application/pdf",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reporting/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reporting"
+                ],
+                "summary": "Low Stock Report",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.LowStockEntry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get Saved Searches",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.SavedSearchOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Create Saved Search",
+                "parameters": [
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Update Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saved Search Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.SavedSearchOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Also removes notifier subscriptions scoped to the saved search.",
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Delete Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/saved-searches/{id}/entities": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Run Saved Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved Search ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.PaginationResult-repo_EntitySummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/status": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Base"
+                ],
+                "summary": "Application Info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.APISummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tags": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get All Tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TagSummary"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Create Tag",
+                "parameters": [
+                    {
+                        "description": "Tag Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.TagCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.TagOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tags/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get Tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.TagOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Update Tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.TagUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.TagOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete Tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Get All Entity Templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.EntityTemplateSummary"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Create Entity Template",
+                "parameters": [
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityTemplateCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Get Entity Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Update Entity Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityTemplateUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityTemplateOut"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Delete Entity Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/templates/{id}/create-item": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entity Templates"
+                ],
+                "summary": "Create Entity from Template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entity Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.EntityTemplateCreateItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trash": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Get Trash",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.TrashEntry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trash/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trash"
+                ],
+                "summary": "Restore Entity From Trash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/repo.EntityOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/change-password": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Change Password",
+                "parameters": [
+                    {
+                        "description": "Password Payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ChangePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/forgot-password": {
+            "post": {
+                "description": "Sends a password reset email if the address is associated with a local account.\nAlways returns 204 on success to avoid leaking whether the email is registered.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Request Password Reset",
+                "parameters": [
+                    {
+                        "description": "Email",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "missing or invalid request body, or empty email field",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "demo mode is enabled or local login is disabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error while processing the request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login": {
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Login",
+                "parameters": [
+                    {
+                        "description": "Login Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginForm"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "auth provider",
+                        "name": "provider",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TokenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login/oidc": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "OIDC Login Initiation",
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/login/oidc/callback": {
+            "get": {
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "OIDC Callback Handler",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State parameter",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/v1/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/logout/all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revokes every session token for the authenticated user across all\ndevices, including the current one. Use this to invalidate a session\ntoken that may have been leaked or stolen. API keys are stored\nseparately and are not affected; revoke them from the API keys page.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Log Out Of All Sessions",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/refresh": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "handleAuthRefresh returns a handler that will issue a new token from an existing token.\nThis does not validate that the user still exists within the database.",
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "User Token Refresh",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/v1/users/register": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Register New User",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.UserRegistration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Local login is not enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/reset-password": {
+            "post": {
+                "description": "Consumes a single-use reset token and changes the user's password.\nOn success, all existing sessions for the user are revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Reset Password",
+                "parameters": [
+                    {
+                        "description": "Token + new password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "invalid request body, password shorter than the minimum length, or token that is invalid / expired / already used",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "demo mode is enabled or local login is disabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error while processing the request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get User Self",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserOut"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update Account",
+                "parameters": [
+                    {
+                        "description": "User Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "$ref": "#/definitions/repo.UserUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete Account",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "List API Keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/repo.APIKeyOut"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create API Key",
+                "parameters": [
+                    {
+                        "description": "API Key Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/repo.APIKeyCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/repo.APIKeyCreatedOut"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/self/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Delete API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API Key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/v1/users/self/settings": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get user settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update user settings",
+                "parameters": [
+                    {
+                        "description": "Settings Data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/v1.Wrapped"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "item": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "attachment.Type": {
+            "type": "string",
+            "enum": [
+                "attachment",
+                "photo",
+                "manual",
+                "warranty",
+                "attachment",
+                "receipt",
+                "thumbnail"
+            ],
+            "x-enum-varnames": [
+                "DefaultType",
+                "TypePhoto",
+                "TypeManual",
+                "TypeWarranty",
+                "TypeAttachment",
+                "TypeReceipt",
+                "TypeThumbnail"
+            ]
+        },
+        "attachmentupload.Type": {
+            "type": "string",
+            "enum": [
+                "attachment",
+                "photo",
+                "manual",
+                "warranty",
+                "attachment",
+                "receipt"
+            ],
+            "x-enum-varnames": [
+                "DefaultType",
+                "TypePhoto",
+                "TypeManual",
+                "TypeWarranty",
+                "TypeAttachment",
+                "TypeReceipt"
+            ]
+        },
+        "auditlog.Action": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "ActionCreate",
+                "ActionUpdate",
+                "ActionDelete"
+            ]
+        },
+        "auditlog.Source": {
+            "type": "string",
+            "enum": [
+                "system",
+                "web",
+                "api_key",
+                "import",
+                "system"
+            ],
+            "x-enum-varnames": [
+                "DefaultSource",
+                "SourceWeb",
+                "SourceAPIKey",
+                "SourceImport",
+                "SourceSystem"
+            ]
+        },
+        "authroles.Role": {
+            "type": "string",
+            "enum": [
+                "user",
+                "admin",
+                "user",
+                "attachments"
+            ],
+            "x-enum-varnames": [
+                "DefaultRole",
+                "RoleAdmin",
+                "RoleUser",
+                "RoleAttachments"
+            ]
+        },
+        "currencies.Currency": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "decimals": {
+                    "type": "integer"
+                },
+                "local": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "ent.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the APIKeyQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.APIKeyEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "description": "LastUsedAt holds the value of the \"last_used_at\" field.",
+                    "type": "string"
+                },
+                "location_id": {
+                    "description": "LocationID holds the value of the \"location_id\" field.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "Scopes holds the value of the \"scopes\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.APIKeyEdges": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Attachment": {
+            "type": "object",
+            "properties": {
+                "captured_at": {
+                    "description": "CapturedAt holds the value of the \"captured_at\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "document_author": {
+                    "description": "DocumentAuthor holds the value of the \"document_author\" field.",
+                    "type": "string"
+                },
+                "document_title": {
+                    "description": "DocumentTitle holds the value of the \"document_title\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AttachmentQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AttachmentEdges"
+                        }
+                    ]
+                },
+                "height": {
+                    "description": "Height holds the value of the \"height\" field.",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "latitude": {
+                    "description": "Latitude holds the value of the \"latitude\" field.",
+                    "type": "number"
+                },
+                "longitude": {
+                    "description": "Longitude holds the value of the \"longitude\" field.",
+                    "type": "number"
+                },
+                "mime_type": {
+                    "description": "MimeType holds the value of the \"mime_type\" field.",
+                    "type": "string"
+                },
+                "page_count": {
+                    "description": "PageCount holds the value of the \"page_count\" field.",
+                    "type": "integer"
+                },
+                "path": {
+                    "description": "Path holds the value of the \"path\" field.",
+                    "type": "string"
+                },
+                "primary": {
+                    "description": "Primary holds the value of the \"primary\" field.",
+                    "type": "boolean"
+                },
+                "title": {
+                    "description": "Title holds the value of the \"title\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/attachment.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "Width holds the value of the \"width\" field.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.AttachmentEdges": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "description": "Entity holds the value of the entity edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                },
+                "thumbnail": {
+                    "description": "Thumbnail holds the value of the thumbnail edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Attachment"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AttachmentUpload": {
+            "type": "object",
+            "properties": {
+                "attachment_id": {
+                    "description": "AttachmentID holds the value of the \"attachment_id\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AttachmentUploadQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AttachmentUploadEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "primary": {
+                    "description": "Primary holds the value of the \"primary\" field.",
+                    "type": "boolean"
+                },
+                "received": {
+                    "description": "Received holds the value of the \"received\" field.",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "Size holds the value of the \"size\" field.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title holds the value of the \"title\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/attachmentupload.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AttachmentUploadEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action holds the value of the \"action\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auditlog.Action"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuditLogQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuditLogEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "entity_name": {
+                    "description": "EntityName holds the value of the \"entity_name\" field.",
+                    "type": "string"
+                },
+                "field_name": {
+                    "description": "FieldName holds the value of the \"field_name\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "new_value": {
+                    "description": "NewValue holds the value of the \"new_value\" field.",
+                    "type": "string"
+                },
+                "old_value": {
+                    "description": "OldValue holds the value of the \"old_value\" field.",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source holds the value of the \"source\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auditlog.Source"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                },
+                "user_name": {
+                    "description": "UserName holds the value of the \"user_name\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AuditLogEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthRoles": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthRolesQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthRolesEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "Role holds the value of the \"role\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/authroles.Role"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthRolesEdges": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "Token holds the value of the token edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthTokens"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.AuthTokens": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthTokensQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthTokensEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Token holds the value of the \"token\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.AuthTokensEdges": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "description": "Roles holds the value of the roles edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.AuthRoles"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Blob": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the BlobQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.BlobEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "mime_type": {
+                    "description": "MimeType holds the value of the \"mime_type\" field.",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path holds the value of the \"path\" field.",
+                    "type": "string"
+                },
+                "ref_count": {
+                    "description": "RefCount holds the value of the \"ref_count\" field.",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "Size holds the value of the \"size\" field.",
+                    "type": "integer"
+                },
+                "unreferenced_at": {
+                    "description": "UnreferencedAt holds the value of the \"unreferenced_at\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.BlobEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Entity": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "Archived holds the value of the \"archived\" field.",
+                    "type": "boolean"
+                },
+                "asset_id": {
+                    "description": "AssetID holds the value of the \"asset_id\" field.",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt holds the value of the \"deleted_at\" field.",
+                    "type": "string"
+                },
+                "depreciation_method": {
+                    "description": "DepreciationMethod holds the value of the \"depreciation_method\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DepreciationMethod"
+                        }
+                    ]
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "import_ref": {
+                    "description": "ImportRef holds the value of the \"import_ref\" field.",
+                    "type": "string"
+                },
+                "insured": {
+                    "description": "Insured holds the value of the \"insured\" field.",
+                    "type": "boolean"
+                },
+                "lifetime_warranty": {
+                    "description": "LifetimeWarranty holds the value of the \"lifetime_warranty\" field.",
+                    "type": "boolean"
+                },
+                "manual_value": {
+                    "description": "ManualValue holds the value of the \"manual_value\" field.",
+                    "type": "number"
+                },
+                "manufacturer": {
+                    "description": "Manufacturer holds the value of the \"manufacturer\" field.",
+                    "type": "string"
+                },
+                "min_quantity": {
+                    "description": "MinQuantity holds the value of the \"min_quantity\" field.",
+                    "type": "number"
+                },
+                "model_number": {
+                    "description": "ModelNumber holds the value of the \"model_number\" field.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "purchase_currency": {
+                    "description": "PurchaseCurrency holds the value of the \"purchase_currency\" field.",
+                    "type": "string"
+                },
+                "purchase_date": {
+                    "description": "PurchaseDate holds the value of the \"purchase_date\" field.",
+                    "type": "string"
+                },
+                "purchase_from": {
+                    "description": "PurchaseFrom holds the value of the \"purchase_from\" field.",
+                    "type": "string"
+                },
+                "purchase_price": {
+                    "description": "PurchasePrice holds the value of the \"purchase_price\" field.",
+                    "type": "number"
+                },
+                "quantity": {
+                    "description": "Quantity holds the value of the \"quantity\" field.",
+                    "type": "number"
+                },
+                "reorder_point": {
+                    "description": "ReorderPoint holds the value of the \"reorder_point\" field.",
+                    "type": "number"
+                },
+                "salvage_value": {
+                    "description": "SalvageValue holds the value of the \"salvage_value\" field.",
+                    "type": "number"
+                },
+                "serial_number": {
+                    "description": "SerialNumber holds the value of the \"serial_number\" field.",
+                    "type": "string"
+                },
+                "sold_currency": {
+                    "description": "SoldCurrency holds the value of the \"sold_currency\" field.",
+                    "type": "string"
+                },
+                "sold_date": {
+                    "description": "SoldDate holds the value of the \"sold_date\" field.",
+                    "type": "string"
+                },
+                "sold_notes": {
+                    "description": "SoldNotes holds the value of the \"sold_notes\" field.",
+                    "type": "string"
+                },
+                "sold_price": {
+                    "description": "SoldPrice holds the value of the \"sold_price\" field.",
+                    "type": "number"
+                },
+                "sold_to": {
+                    "description": "SoldTo holds the value of the \"sold_to\" field.",
+                    "type": "string"
+                },
+                "sync_child_entity_locations": {
+                    "description": "SyncChildEntityLocations holds the value of the \"sync_child_entity_locations\" field.",
+                    "type": "boolean"
+                },
+                "trash_root_id": {
+                    "description": "TrashRootID holds the value of the \"trash_root_id\" field.",
+                    "type": "string"
+                },
+                "unit": {
+                    "description": "Unit holds the value of the \"unit\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "useful_life_years": {
+                    "description": "UsefulLifeYears holds the value of the \"useful_life_years\" field.",
+                    "type": "number"
+                },
+                "warranty_details": {
+                    "description": "WarrantyDetails holds the value of the \"warranty_details\" field.",
+                    "type": "string"
+                },
+                "warranty_expires": {
+                    "description": "WarrantyExpires holds the value of the \"warranty_expires\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.EntityEdges": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "description": "Attachments holds the value of the attachments edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Attachment"
+                    }
+                },
+                "children": {
+                    "description": "Children holds the value of the children edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Entity"
+                    }
+                },
+                "entity_type": {
+                    "description": "EntityType holds the value of the entity_type edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityType"
+                        }
+                    ]
+                },
+                "fields": {
+                    "description": "Fields holds the value of the fields edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.EntityField"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "loans": {
+                    "description": "Loans holds the value of the loans edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Loan"
+                    }
+                },
+                "maintenance_entries": {
+                    "description": "MaintenanceEntries holds the value of the maintenance_entries edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.MaintenanceEntry"
+                    }
+                },
+                "parent": {
+                    "description": "Parent holds the value of the parent edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                },
+                "stock_movements": {
+                    "description": "StockMovements holds the value of the stock_movements edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.StockMovement"
+                    }
+                },
+                "tag": {
+                    "description": "Tag holds the value of the tag edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Tag"
+                    }
+                },
+                "warranty_notifications": {
+                    "description": "WarrantyNotifications holds the value of the warranty_notifications edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.WarrantyNotification"
+                    }
+                }
+            }
+        },
+        "ent.EntityField": {
+            "type": "object",
+            "properties": {
+                "boolean_value": {
+                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "decimal_value": {
+                    "description": "DecimalValue holds the value of the \"decimal_value\" field.",
+                    "type": "number"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityFieldQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityFieldEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "number_value": {
+                    "description": "NumberValue holds the value of the \"number_value\" field.",
+                    "type": "integer"
+                },
+                "text_value": {
+                    "description": "TextValue holds the value of the \"text_value\" field.",
+                    "type": "string"
+                },
+                "time_value": {
+                    "description": "TimeValue holds the value of the \"time_value\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entityfield.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.EntityFieldDefinition": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "default_value": {
+                    "description": "DefaultValue holds the value of the \"default_value\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityFieldDefinitionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityFieldDefinitionEdges"
+                        }
+                    ]
+                },
+                "entity_type_id": {
+                    "description": "EntityTypeID holds the value of the \"entity_type_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "Options holds the value of the \"options\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "position": {
+                    "description": "Position holds the value of the \"position\" field.",
+                    "type": "integer"
+                },
+                "required": {
+                    "description": "Required holds the value of the \"required\" field.",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entityfielddefinition.Type"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.EntityFieldDefinitionEdges": {
+            "type": "object",
+            "properties": {
+                "entity_type": {
+                    "description": "EntityType holds the value of the entity_type edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityType"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.EntityFieldEdges": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "description": "Entity holds the value of the entity edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.EntityTemplate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "default_description": {
+                    "description": "Default description for items created from this template",
+                    "type": "string"
+                },
+                "default_insured": {
+                    "description": "DefaultInsured holds the value of the \"default_insured\" field.",
+                    "type": "boolean"
+                },
+                "default_lifetime_warranty": {
+                    "description": "DefaultLifetimeWarranty holds the value of the \"default_lifetime_warranty\" field.",
+                    "type": "boolean"
+                },
+                "default_manufacturer": {
+                    "description": "DefaultManufacturer holds the value of the \"default_manufacturer\" field.",
+                    "type": "string"
+                },
+                "default_model_number": {
+                    "description": "Default model number for items created from this template",
+                    "type": "string"
+                },
+                "default_name": {
+                    "description": "Default name template for items (can use placeholders)",
+                    "type": "string"
+                },
+                "default_quantity": {
+                    "description": "DefaultQuantity holds the value of the \"default_quantity\" field.",
+                    "type": "number"
+                },
+                "default_tag_ids": {
+                    "description": "Default tag IDs for items created from this template",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default_warranty_details": {
+                    "description": "DefaultWarrantyDetails holds the value of the \"default_warranty_details\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityTemplateQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityTemplateEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "include_purchase_fields": {
+                    "description": "Whether to include purchase fields in items created from this template",
+                    "type": "boolean"
+                },
+                "include_sold_fields": {
+                    "description": "Whether to include sold fields in items created from this template",
+                    "type": "boolean"
+                },
+                "include_warranty_fields": {
+                    "description": "Whether to include warranty fields in items created from this template",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.EntityTemplateEdges": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "description": "Fields holds the value of the fields edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.TemplateField"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "location": {
+                    "description": "Location holds the value of the location edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.EntityType": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "depreciation_method": {
+                    "description": "DepreciationMethod holds the value of the \"depreciation_method\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entitytype.DepreciationMethod"
+                        }
+                    ]
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityTypeQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityTypeEdges"
+                        }
+                    ]
+                },
+                "icon": {
+                    "description": "Icon holds the value of the \"icon\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_location": {
+                    "description": "IsLocation holds the value of the \"is_location\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "salvage_value": {
+                    "description": "SalvageValue holds the value of the \"salvage_value\" field.",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "useful_life_years": {
+                    "description": "UsefulLifeYears holds the value of the \"useful_life_years\" field.",
+                    "type": "number"
+                }
+            }
+        },
+        "ent.EntityTypeEdges": {
+            "type": "object",
+            "properties": {
+                "default_template": {
+                    "description": "DefaultTemplate holds the value of the default_template edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.EntityTemplate"
+                        }
+                    ]
+                },
+                "entities": {
+                    "description": "Entities holds the value of the entities edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Entity"
+                    }
+                },
+                "field_definitions": {
+                    "description": "FieldDefinitions holds the value of the field_definitions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.EntityFieldDefinition"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.ExchangeRate": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "description": "Base holds the value of the \"base\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date holds the value of the \"date\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ExchangeRateQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ExchangeRateEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "quote": {
+                    "description": "Quote holds the value of the \"quote\" field.",
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Rate holds the value of the \"rate\" field.",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ExchangeRateEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Export": {
+            "type": "object",
+            "properties": {
+                "artifact_path": {
+                    "description": "ArtifactPath holds the value of the \"artifact_path\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ExportQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ExportEdges"
+                        }
+                    ]
+                },
+                "error": {
+                    "description": "Error holds the value of the \"error\" field.",
+                    "type": "string"
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Kind holds the value of the \"kind\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/export.Kind"
+                        }
+                    ]
+                },
+                "progress": {
+                    "description": "Progress holds the value of the \"progress\" field.",
+                    "type": "integer"
+                },
+                "size_bytes": {
+                    "description": "SizeBytes holds the value of the \"size_bytes\" field.",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status holds the value of the \"status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/export.Status"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ExportEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Group": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency holds the value of the \"currency\" field.",
+                    "type": "string"
+                },
+                "currency_conversion": {
+                    "description": "CurrencyConversion holds the value of the \"currency_conversion\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/group.CurrencyConversion"
+                        }
+                    ]
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.GroupEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "warranty_notify_days": {
+                    "description": "WarrantyNotifyDays holds the value of the \"warranty_notify_days\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "ent.GroupEdges": {
+            "type": "object",
+            "properties": {
+                "attachment_uploads": {
+                    "description": "AttachmentUploads holds the value of the attachment_uploads edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.AttachmentUpload"
+                    }
+                },
+                "audit_logs": {
+                    "description": "AuditLogs holds the value of the audit_logs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.AuditLog"
+                    }
+                },
+                "blobs": {
+                    "description": "Blobs holds the value of the blobs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Blob"
+                    }
+                },
+                "entities": {
+                    "description": "Entities holds the value of the entities edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Entity"
+                    }
+                },
+                "entity_templates": {
+                    "description": "EntityTemplates holds the value of the entity_templates edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.EntityTemplate"
+                    }
+                },
+                "entity_types": {
+                    "description": "EntityTypes holds the value of the entity_types edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.EntityType"
+                    }
+                },
+                "exchange_rates": {
+                    "description": "ExchangeRates holds the value of the exchange_rates edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ExchangeRate"
+                    }
+                },
+                "exports": {
+                    "description": "Exports holds the value of the exports edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Export"
+                    }
+                },
+                "invitation_tokens": {
+                    "description": "InvitationTokens holds the value of the invitation_tokens edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.GroupInvitationToken"
+                    }
+                },
+                "notifiers": {
+                    "description": "Notifiers holds the value of the notifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Notifier"
+                    }
+                },
+                "saved_searches": {
+                    "description": "SavedSearches holds the value of the saved_searches edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.SavedSearch"
+                    }
+                },
+                "tags": {
+                    "description": "Tags holds the value of the tags edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Tag"
+                    }
+                },
+                "user_groups": {
+                    "description": "UserGroups holds the value of the user_groups edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.UserGroup"
+                    }
+                },
+                "users": {
+                    "description": "Users holds the value of the users edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.User"
+                    }
+                },
+                "webhooks": {
+                    "description": "Webhooks holds the value of the webhooks edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Webhook"
+                    }
+                }
+            }
+        },
+        "ent.GroupInvitationToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupInvitationTokenQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.GroupInvitationTokenEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Role given to members who join with the invitation",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/groupinvitationtoken.Role"
+                        }
+                    ]
+                },
+                "token": {
+                    "description": "Token holds the value of the \"token\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "uses": {
+                    "description": "Uses holds the value of the \"uses\" field.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.GroupInvitationTokenEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Loan": {
+            "type": "object",
+            "properties": {
+                "borrower_contact": {
+                    "description": "BorrowerContact holds the value of the \"borrower_contact\" field.",
+                    "type": "string"
+                },
+                "borrower_id": {
+                    "description": "BorrowerID holds the value of the \"borrower_id\" field.",
+                    "type": "string"
+                },
+                "borrower_name": {
+                    "description": "BorrowerName holds the value of the \"borrower_name\" field.",
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "description": "CheckedOutAt holds the value of the \"checked_out_at\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "DueAt holds the value of the \"due_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LoanQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LoanEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes holds the value of the \"notes\" field.",
+                    "type": "string"
+                },
+                "overdue_notified_at": {
+                    "description": "OverdueNotifiedAt holds the value of the \"overdue_notified_at\" field.",
+                    "type": "string"
+                },
+                "returned_at": {
+                    "description": "ReturnedAt holds the value of the \"returned_at\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LoanEdges": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "description": "Entity holds the value of the entity edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.MaintenanceEntry": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "Cost holds the value of the \"cost\" field.",
+                    "type": "number"
+                },
+                "cost_currency": {
+                    "description": "CostCurrency holds the value of the \"cost_currency\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date holds the value of the \"date\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the MaintenanceEntryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.MaintenanceEntryEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "recurrence_rule": {
+                    "description": "RecurrenceRule holds the value of the \"recurrence_rule\" field.",
+                    "type": "string"
+                },
+                "scheduled_date": {
+                    "description": "ScheduledDate holds the value of the \"scheduled_date\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.MaintenanceEntryEdges": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "description": "Entity holds the value of the entity edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Notifier": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the NotifierQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.NotifierEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "is_active": {
+                    "description": "IsActive holds the value of the \"is_active\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.NotifierEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "subscriptions": {
+                    "description": "Subscriptions holds the value of the subscriptions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.NotifierSubscription"
+                    }
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.NotifierSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the NotifierSubscriptionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.NotifierSubscriptionEdges"
+                        }
+                    ]
+                },
+                "event": {
+                    "description": "Event holds the value of the \"event\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/notifiersubscription.Event"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "notifier_id": {
+                    "description": "NotifierID holds the value of the \"notifier_id\" field.",
+                    "type": "string"
+                },
+                "saved_search_id": {
+                    "description": "SavedSearchID holds the value of the \"saved_search_id\" field.",
+                    "type": "string"
+                },
+                "template": {
+                    "description": "Template holds the value of the \"template\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.NotifierSubscriptionEdges": {
+            "type": "object",
+            "properties": {
+                "notifier": {
+                    "description": "Notifier holds the value of the notifier edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Notifier"
+                        }
+                    ]
+                },
+                "saved_search": {
+                    "description": "SavedSearch holds the value of the saved_search edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.SavedSearch"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.PasswordResetTokens": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the PasswordResetTokensQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.PasswordResetTokensEdges"
+                        }
+                    ]
+                },
+                "expires_at": {
+                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Token holds the value of the \"token\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "used_at": {
+                    "description": "UsedAt holds the value of the \"used_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.PasswordResetTokensEdges": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.SavedSearch": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Columns holds the value of the \"columns\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the SavedSearchQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.SavedSearchEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "include_archived": {
+                    "description": "IncludeArchived holds the value of the \"include_archived\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "negate_tags": {
+                    "description": "NegateTags holds the value of the \"negate_tags\" field.",
+                    "type": "boolean"
+                },
+                "only_with_photo": {
+                    "description": "OnlyWithPhoto holds the value of the \"only_with_photo\" field.",
+                    "type": "boolean"
+                },
+                "only_without_photo": {
+                    "description": "OnlyWithoutPhoto holds the value of the \"only_without_photo\" field.",
+                    "type": "boolean"
+                },
+                "order_by": {
+                    "description": "OrderBy holds the value of the \"order_by\" field.",
+                    "type": "string"
+                },
+                "parent_ids": {
+                    "description": "ParentIds holds the value of the \"parent_ids\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "query": {
+                    "description": "Query holds the value of the \"query\" field.",
+                    "type": "string"
+                },
+                "tag_ids": {
+                    "description": "TagIds holds the value of the \"tag_ids\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.SavedSearchEdges": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "notifier_subscriptions": {
+                    "description": "NotifierSubscriptions holds the value of the notifier_subscriptions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.NotifierSubscription"
+                    }
+                }
+            }
+        },
+        "ent.StockMovement": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "description": "Signed change to the quantity",
+                    "type": "number"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the StockMovementQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.StockMovementEdges"
+                        }
+                    ]
+                },
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Kind holds the value of the \"kind\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/stockmovement.Kind"
+                        }
+                    ]
+                },
+                "note": {
+                    "description": "Note holds the value of the \"note\" field.",
+                    "type": "string"
+                },
+                "quantity_after": {
+                    "description": "QuantityAfter holds the value of the \"quantity_after\" field.",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.StockMovementEdges": {
             "type": "object",
             "properties": {
                 "entity": {
                     "description": "Entity holds the value of the entity edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Entity"
+                            "$ref": "#/definitions/ent.Entity"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Tag": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color holds the value of the \"color\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TagQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TagEdges"
+                        }
+                    ]
+                },
+                "icon": {
+                    "description": "Icon holds the value of the \"icon\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.TagEdges": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "description": "Children holds the value of the children edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Tag"
+                    }
+                },
+                "entities": {
+                    "description": "Entities holds the value of the entities edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Entity"
+                    }
+                },
+                "group": {
+                    "description": "Group holds the value of the group edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "parent": {
+                    "description": "Parent holds the value of the parent edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Tag"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.TemplateField": {
+            "type": "object",
+            "properties": {
+                "boolean_value": {
+                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TemplateFieldQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TemplateFieldEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "number_value": {
+                    "description": "NumberValue holds the value of the \"number_value\" field.",
+                    "type": "integer"
+                },
+                "text_value": {
+                    "description": "TextValue holds the value of the \"text_value\" field.",
+                    "type": "string"
+                },
+                "time_value": {
+                    "description": "TimeValue holds the value of the \"time_value\" field.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type holds the value of the \"type\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/templatefield.Type"
                         }
                     ]
                 },
-                "thumbnail": {
-                    "description": "Thumbnail holds the value of the thumbnail edge.",
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.TemplateFieldEdges": {
+            "type": "object",
+            "properties": {
+                "entity_template": {
+                    "description": "EntityTemplate holds the value of the entity_template edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Attachment"
+                            "$ref": "#/definitions/ent.EntityTemplate"
                         }
                     ]
                 }
             }
         },
-        "ent.AuthRoles": {
+        "ent.User": {
             "type": "object",
             "properties": {
+                "activated_on": {
+                    "description": "ActivatedOn holds the value of the \"activated_on\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "default_group_id": {
+                    "description": "DefaultGroupID holds the value of the \"default_group_id\" field.",
+                    "type": "string"
+                },
                 "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthRolesQuery when eager-loading is set.",
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.AuthRolesEdges"
+                            "$ref": "#/definitions/ent.UserEdges"
                         }
                     ]
                 },
+                "email": {
+                    "description": "Email holds the value of the \"email\" field.",
+                    "type": "string"
+                },
                 "id": {
                     "description": "ID of the ent.",
-                    "type": "integer"
+                    "type": "string"
+                },
+                "is_superuser": {
+                    "description": "IsSuperuser holds the value of the \"is_superuser\" field.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "oidc_issuer": {
+                    "description": "OidcIssuer holds the value of the \"oidc_issuer\" field.",
+                    "type": "string"
+                },
+                "oidc_subject": {
+                    "description": "OidcSubject holds the value of the \"oidc_subject\" field.",
+                    "type": "string"
+                },
+                "settings": {
+                    "description": "Settings holds the value of the \"settings\" field.",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "superuser": {
+                    "description": "Superuser holds the value of the \"superuser\" field.",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.UserEdges": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "description": "APIKeys holds the value of the api_keys edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.APIKey"
+                    }
+                },
+                "auth_tokens": {
+                    "description": "AuthTokens holds the value of the auth_tokens edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.AuthTokens"
+                    }
+                },
+                "groups": {
+                    "description": "Groups holds the value of the groups edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Group"
+                    }
+                },
+                "notifiers": {
+                    "description": "Notifiers holds the value of the notifiers edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Notifier"
+                    }
+                },
+                "password_reset_tokens": {
+                    "description": "PasswordResetTokens holds the value of the password_reset_tokens edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.PasswordResetTokens"
+                    }
+                },
+                "user_groups": {
+                    "description": "UserGroups holds the value of the user_groups edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.UserGroup"
+                    }
+                }
+            }
+        },
+        "ent.UserGroup": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserGroupQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserGroupEdges"
+                        }
+                    ]
+                },
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
+                    "type": "string"
+                },
+                "location_ids": {
+                    "description": "Locations the member is limited to, along with everything below them. Empty means the whole collection.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "role": {
                     "description": "Role holds the value of the \"role\" field.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/authroles.Role"
+                            "$ref": "#/definitions/usergroup.Role"
                         }
                     ]
+                },
+                "user_id": {
+                    "description": "UserID holds the value of the \"user_id\" field.",
+                    "type": "string"
                 }
             }
         },
-        "ent.AuthRolesEdges": {
+        "ent.UserGroupEdges": {
             "type": "object",
             "properties": {
-                "token": {
-                    "description": "Token holds the value of the token edge.",
+                "group": {
+                    "description": "Group holds the value of the group edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.AuthTokens"
+                            "$ref": "#/definitions/ent.Group"
+                        }
+                    ]
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
                         }
                     ]
                 }
             }
         },
-        "ent.AuthTokens": {
+        "ent.WarrantyNotification": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -3423,27 +7313,28 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the AuthTokensQuery when eager-loading is set.",
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the WarrantyNotificationQuery when eager-loading is set.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.AuthTokensEdges"
+                            "$ref": "#/definitions/ent.WarrantyNotificationEdges"
                         }
                     ]
                 },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                "entity_id": {
+                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                    "type": "string"
+                },
+                "expires_on": {
+                    "description": "ExpiresOn holds the value of the \"expires_on\" field.",
                     "type": "string"
                 },
                 "id": {
                     "description": "ID of the ent.",
                     "type": "string"
                 },
-                "token": {
-                    "description": "Token holds the value of the \"token\" field.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "lead_days": {
+                    "description": "LeadDays holds the value of the \"lead_days\" field.",
+                    "type": "integer"
                 },
                 "updated_at": {
                     "description": "UpdatedAt holds the value of the \"updated_at\" field.",
@@ -3451,170 +7342,153 @@ const docTemplate = `{
                 }
             }
         },
-        "ent.AuthTokensEdges": {
+        "ent.WarrantyNotificationEdges": {
             "type": "object",
             "properties": {
-                "roles": {
-                    "description": "Roles holds the value of the roles edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.AuthRoles"
-                        }
-                    ]
-                },
-                "user": {
-                    "description": "User holds the value of the user edge.",
+                "entity": {
+                    "description": "Entity holds the value of the entity edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/ent.Entity"
                         }
                     ]
                 }
             }
         },
-        "ent.Entity": {
+        "ent.Webhook": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "description": "Archived holds the value of the \"archived\" field.",
-                    "type": "boolean"
-                },
-                "asset_id": {
-                    "description": "AssetID holds the value of the \"asset_id\" field.",
-                    "type": "integer"
-                },
                 "created_at": {
                     "description": "CreatedAt holds the value of the \"created_at\" field.",
                     "type": "string"
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
-                    "type": "string"
-                },
                 "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityQuery when eager-loading is set.",
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the WebhookQuery when eager-loading is set.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.EntityEdges"
+                            "$ref": "#/definitions/ent.WebhookEdges"
                         }
                     ]
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "events": {
+                    "description": "Events holds the value of the \"events\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "import_ref": {
-                    "description": "ImportRef holds the value of the \"import_ref\" field.",
+                "group_id": {
+                    "description": "GroupID holds the value of the \"group_id\" field.",
                     "type": "string"
                 },
-                "insured": {
-                    "description": "Insured holds the value of the \"insured\" field.",
-                    "type": "boolean"
-                },
-                "lifetime_warranty": {
-                    "description": "LifetimeWarranty holds the value of the \"lifetime_warranty\" field.",
-                    "type": "boolean"
-                },
-                "manufacturer": {
-                    "description": "Manufacturer holds the value of the \"manufacturer\" field.",
+                "id": {
+                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "model_number": {
-                    "description": "ModelNumber holds the value of the \"model_number\" field.",
-                    "type": "string"
+                "is_active": {
+                    "description": "IsActive holds the value of the \"is_active\" field.",
+                    "type": "boolean"
                 },
                 "name": {
                     "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "notes": {
-                    "description": "Notes holds the value of the \"notes\" field.",
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL holds the value of the \"url\" field.",
                     "type": "string"
+                }
+            }
+        },
+        "ent.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Attempts holds the value of the \"attempts\" field.",
+                    "type": "integer"
                 },
-                "purchase_date": {
-                    "description": "PurchaseDate holds the value of the \"purchase_date\" field.",
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
                     "type": "string"
                 },
-                "purchase_from": {
-                    "description": "PurchaseFrom holds the value of the \"purchase_from\" field.",
+                "delivered_at": {
+                    "description": "DeliveredAt holds the value of the \"delivered_at\" field.",
                     "type": "string"
                 },
-                "purchase_price": {
-                    "description": "PurchasePrice holds the value of the \"purchase_price\" field.",
-                    "type": "number"
-                },
-                "quantity": {
-                    "description": "Quantity holds the value of the \"quantity\" field.",
-                    "type": "number"
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the WebhookDeliveryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.WebhookDeliveryEdges"
+                        }
+                    ]
                 },
-                "serial_number": {
-                    "description": "SerialNumber holds the value of the \"serial_number\" field.",
+                "error": {
+                    "description": "Error holds the value of the \"error\" field.",
                     "type": "string"
                 },
-                "sold_date": {
-                    "description": "SoldDate holds the value of the \"sold_date\" field.",
+                "event": {
+                    "description": "Event holds the value of the \"event\" field.",
                     "type": "string"
                 },
-                "sold_notes": {
-                    "description": "SoldNotes holds the value of the \"sold_notes\" field.",
+                "id": {
+                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "sold_price": {
-                    "description": "SoldPrice holds the value of the \"sold_price\" field.",
-                    "type": "number"
+                "next_attempt_at": {
+                    "description": "NextAttemptAt holds the value of the \"next_attempt_at\" field.",
+                    "type": "string"
                 },
-                "sold_to": {
-                    "description": "SoldTo holds the value of the \"sold_to\" field.",
+                "payload": {
+                    "description": "Payload holds the value of the \"payload\" field.",
                     "type": "string"
                 },
-                "sync_child_entity_locations": {
-                    "description": "SyncChildEntityLocations holds the value of the \"sync_child_entity_locations\" field.",
-                    "type": "boolean"
+                "response_status": {
+                    "description": "ResponseStatus holds the value of the \"response_status\" field.",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status holds the value of the \"status\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/webhookdelivery.Status"
+                        }
+                    ]
                 },
                 "updated_at": {
                     "description": "UpdatedAt holds the value of the \"updated_at\" field.",
                     "type": "string"
                 },
-                "warranty_details": {
-                    "description": "WarrantyDetails holds the value of the \"warranty_details\" field.",
-                    "type": "string"
-                },
-                "warranty_expires": {
-                    "description": "WarrantyExpires holds the value of the \"warranty_expires\" field.",
+                "webhook_id": {
+                    "description": "WebhookID holds the value of the \"webhook_id\" field.",
                     "type": "string"
                 }
             }
         },
-        "ent.EntityEdges": {
+        "ent.WebhookDeliveryEdges": {
             "type": "object",
             "properties": {
-                "attachments": {
-                    "description": "Attachments holds the value of the attachments edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Attachment"
-                    }
-                },
-                "children": {
-                    "description": "Children holds the value of the children edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Entity"
-                    }
-                },
-                "entity_type": {
-                    "description": "EntityType holds the value of the entity_type edge.",
+                "webhook": {
+                    "description": "Webhook holds the value of the webhook edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.EntityType"
+                            "$ref": "#/definitions/ent.Webhook"
                         }
                     ]
-                },
-                "fields": {
-                    "description": "Fields holds the value of the fields edge.",
+                }
+            }
+        },
+        "ent.WebhookEdges": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "description": "Deliveries holds the value of the deliveries edge.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.EntityField"
+                        "$ref": "#/definitions/ent.WebhookDelivery"
                     }
                 },
                 "group": {
@@ -3624,1294 +7498,1691 @@ const docTemplate = `{
                             "$ref": "#/definitions/ent.Group"
                         }
                     ]
+                }
+            }
+        },
+        "entity.DepreciationMethod": {
+            "type": "string",
+            "enum": [
+                "none",
+                "straight_line",
+                "declining_balance",
+                "manual"
+            ],
+            "x-enum-varnames": [
+                "DepreciationMethodNone",
+                "DepreciationMethodStraightLine",
+                "DepreciationMethodDecliningBalance",
+                "DepreciationMethodManual"
+            ]
+        },
+        "entityfield.Type": {
+            "type": "string",
+            "enum": [
+                "text",
+                "number",
+                "boolean",
+                "time",
+                "decimal",
+                "date",
+                "url",
+                "email",
+                "select",
+                "multi_select",
+                "currency"
+            ],
+            "x-enum-varnames": [
+                "TypeText",
+                "TypeNumber",
+                "TypeBoolean",
+                "TypeTime",
+                "TypeDecimal",
+                "TypeDate",
+                "TypeURL",
+                "TypeEmail",
+                "TypeSelect",
+                "TypeMultiSelect",
+                "TypeCurrency"
+            ]
+        },
+        "entityfielddefinition.Type": {
+            "type": "string",
+            "enum": [
+                "text",
+                "number",
+                "decimal",
+                "boolean",
+                "date",
+                "url",
+                "email",
+                "select",
+                "multi_select",
+                "currency"
+            ],
+            "x-enum-varnames": [
+                "TypeText",
+                "TypeNumber",
+                "TypeDecimal",
+                "TypeBoolean",
+                "TypeDate",
+                "TypeURL",
+                "TypeEmail",
+                "TypeSelect",
+                "TypeMultiSelect",
+                "TypeCurrency"
+            ]
+        },
+        "entitytype.DepreciationMethod": {
+            "type": "string",
+            "enum": [
+                "none",
+                "straight_line",
+                "declining_balance",
+                "manual"
+            ],
+            "x-enum-varnames": [
+                "DepreciationMethodNone",
+                "DepreciationMethodStraightLine",
+                "DepreciationMethodDecliningBalance",
+                "DepreciationMethodManual"
+            ]
+        },
+        "export.Kind": {
+            "type": "string",
+            "enum": [
+                "export",
+                "export",
+                "import"
+            ],
+            "x-enum-varnames": [
+                "DefaultKind",
+                "KindExport",
+                "KindImport"
+            ]
+        },
+        "export.Status": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "pending",
+                "running",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusPending",
+                "StatusRunning",
+                "StatusCompleted",
+                "StatusFailed"
+            ]
+        },
+        "group.CurrencyConversion": {
+            "type": "string",
+            "enum": [
+                "purchase_date",
+                "purchase_date",
+                "today"
+            ],
+            "x-enum-varnames": [
+                "DefaultCurrencyConversion",
+                "CurrencyConversionPurchaseDate",
+                "CurrencyConversionToday"
+            ]
+        },
+        "groupinvitationtoken.Role": {
+            "type": "string",
+            "enum": [
+                "editor",
+                "viewer",
+                "contributor",
+                "editor"
+            ],
+            "x-enum-varnames": [
+                "DefaultRole",
+                "RoleViewer",
+                "RoleContributor",
+                "RoleEditor"
+            ]
+        },
+        "labelmaker.SheetTemplate": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
                 },
-                "maintenance_entries": {
-                    "description": "MaintenanceEntries holds the value of the maintenance_entries edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.MaintenanceEntry"
-                    }
+                "labelHeight": {
+                    "type": "number"
                 },
-                "parent": {
-                    "description": "Parent holds the value of the parent edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Entity"
-                        }
-                    ]
+                "labelWidth": {
+                    "type": "number"
                 },
-                "tag": {
-                    "description": "Tag holds the value of the tag edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Tag"
-                    }
+                "marginLeft": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "marginTop": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pageHeight": {
+                    "type": "number"
+                },
+                "pageWidth": {
+                    "type": "number"
+                },
+                "pitchX": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "pitchY": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "rows": {
+                    "type": "integer",
+                    "maximum": 40,
+                    "minimum": 1
                 }
             }
         },
-        "ent.EntityField": {
+        "notifiersubscription.Event": {
+            "type": "string",
+            "enum": [
+                "maintenance_due",
+                "warranty_expiring",
+                "export_completed",
+                "export_failed",
+                "import_finished",
+                "low_stock",
+                "member_joined",
+                "loan_overdue"
+            ],
+            "x-enum-varnames": [
+                "EventMaintenanceDue",
+                "EventWarrantyExpiring",
+                "EventExportCompleted",
+                "EventExportFailed",
+                "EventImportFinished",
+                "EventLowStock",
+                "EventMemberJoined",
+                "EventLoanOverdue"
+            ]
+        },
+        "repo.APIKeyCreate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "boolean_value": {
-                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
-                    "type": "boolean"
+                "expiresAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
+                "groupId": {
+                    "description": "GroupID pins the key to one of the user's collections.",
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
-                    "type": "string"
+                "locationId": {
+                    "description": "LocationID pins the key to a location and everything below it.\nIt implies the location's collection.",
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityFieldQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.EntityFieldEdges"
-                        }
-                    ]
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "scopes": {
+                    "description": "Scopes limits the key; leave empty for full access.",
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "$ref": "#/definitions/repo.APIKeyScope"
+                    }
+                }
+            }
+        },
+        "repo.APIKeyCreatedOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "groupId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
+                "lastUsedAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "number_value": {
-                    "description": "NumberValue holds the value of the \"number_value\" field.",
-                    "type": "integer"
+                "locationId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "text_value": {
-                    "description": "TextValue holds the value of the \"text_value\" field.",
+                "name": {
                     "type": "string"
                 },
-                "time_value": {
-                    "description": "TimeValue holds the value of the \"time_value\" field.",
-                    "type": "string"
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.APIKeyScope"
+                    }
                 },
-                "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entityfield.Type"
-                        }
-                    ]
+                "token": {
+                    "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "ent.EntityFieldEdges": {
-            "type": "object",
-            "properties": {
-                "entity": {
-                    "description": "Entity holds the value of the entity edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Entity"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.EntityTemplate": {
+        "repo.APIKeyOut": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "default_description": {
-                    "description": "Default description for items created from this template",
+                "createdAt": {
                     "type": "string"
                 },
-                "default_insured": {
-                    "description": "DefaultInsured holds the value of the \"default_insured\" field.",
-                    "type": "boolean"
+                "expiresAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "default_lifetime_warranty": {
-                    "description": "DefaultLifetimeWarranty holds the value of the \"default_lifetime_warranty\" field.",
-                    "type": "boolean"
+                "groupId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "default_manufacturer": {
-                    "description": "DefaultManufacturer holds the value of the \"default_manufacturer\" field.",
+                "id": {
                     "type": "string"
                 },
-                "default_model_number": {
-                    "description": "Default model number for items created from this template",
-                    "type": "string"
+                "lastUsedAt": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "default_name": {
-                    "description": "Default name template for items (can use placeholders)",
-                    "type": "string"
+                "locationId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "default_quantity": {
-                    "description": "DefaultQuantity holds the value of the \"default_quantity\" field.",
-                    "type": "number"
+                "name": {
+                    "type": "string"
                 },
-                "default_tag_ids": {
-                    "description": "Default tag IDs for items created from this template",
+                "scopes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/repo.APIKeyScope"
                     }
                 },
-                "default_warranty_details": {
-                    "description": "DefaultWarrantyDetails holds the value of the \"default_warranty_details\" field.",
+                "userId": {
                     "type": "string"
-                },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                }
+            }
+        },
+        "repo.APIKeyScope": {
+            "type": "string",
+            "enum": [
+                "read",
+                "entities:read",
+                "entities:write",
+                "attachments:read",
+                "attachments:write"
+            ],
+            "x-enum-varnames": [
+                "APIKeyScopeRead",
+                "APIKeyScopeEntitiesRead",
+                "APIKeyScopeEntitiesWrite",
+                "APIKeyScopeAttachmentsRead",
+                "APIKeyScopeAttachmentsWrite"
+            ]
+        },
+        "repo.AttachmentMetadata": {
+            "type": "object",
+            "properties": {
+                "author": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityTemplateQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.EntityTemplateEdges"
-                        }
-                    ]
+                "capturedAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "height": {
+                    "type": "integer"
                 },
-                "include_purchase_fields": {
-                    "description": "Whether to include purchase fields in items created from this template",
-                    "type": "boolean"
+                "latitude": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "include_sold_fields": {
-                    "description": "Whether to include sold fields in items created from this template",
-                    "type": "boolean"
+                "longitude": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "include_warranty_fields": {
-                    "description": "Whether to include warranty fields in items created from this template",
-                    "type": "boolean"
+                "pageCount": {
+                    "type": "integer"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "title": {
                     "type": "string"
                 },
-                "notes": {
-                    "description": "Notes holds the value of the \"notes\" field.",
-                    "type": "string"
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.AttachmentMetadataApply": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "description": "Coordinates sets the Coordinates custom field to where it was taken.",
+                    "type": "boolean"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
+                "purchaseDate": {
+                    "description": "PurchaseDate sets the purchase date to the day the photo was taken.",
+                    "type": "boolean"
                 }
             }
         },
-        "ent.EntityTemplateEdges": {
+        "repo.AttachmentUploadCreate": {
             "type": "object",
+            "required": [
+                "title"
+            ],
             "properties": {
-                "fields": {
-                    "description": "Fields holds the value of the fields edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.TemplateField"
-                    }
+                "primary": {
+                    "type": "boolean"
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "size": {
+                    "description": "Size is the length of the whole file in bytes.",
+                    "type": "integer"
                 },
-                "location": {
-                    "description": "Location holds the value of the location edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Entity"
-                        }
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "photo",
+                        "manual",
+                        "warranty",
+                        "attachment",
+                        "receipt"
                     ]
                 }
             }
         },
-        "ent.EntityType": {
+        "repo.AttachmentUploadOut": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
+                "attachmentId": {
+                    "description": "AttachmentID is set once the last chunk has arrived and the upload\nhas become an attachment.",
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                "createdAt": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the EntityTypeQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.EntityTypeEdges"
-                        }
-                    ]
+                "entityId": {
+                    "type": "string"
                 },
-                "icon": {
-                    "description": "Icon holds the value of the \"icon\" field.",
+                "expiresAt": {
                     "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "is_location": {
-                    "description": "IsLocation holds the value of the \"is_location\" field.",
+                "primary": {
                     "type": "boolean"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
-                },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
-                }
-            }
-        },
-        "ent.EntityTypeEdges": {
-            "type": "object",
-            "properties": {
-                "default_template": {
-                    "description": "DefaultTemplate holds the value of the default_template edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.EntityTemplate"
-                        }
-                    ]
+                "received": {
+                    "description": "Received is the number of bytes stored, the offset of the next chunk.",
+                    "type": "integer"
                 },
-                "entities": {
-                    "description": "Entities holds the value of the entities edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Entity"
-                    }
+                "size": {
+                    "type": "integer"
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "ent.Export": {
+        "repo.AuditEntryOut": {
             "type": "object",
             "properties": {
-                "artifact_path": {
-                    "description": "ArtifactPath holds the value of the \"artifact_path\" field.",
+                "action": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "createdAt": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ExportQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.ExportEdges"
-                        }
-                    ]
+                "entityId": {
+                    "type": "string"
                 },
-                "error": {
-                    "description": "Error holds the value of the \"error\" field.",
+                "entityName": {
                     "type": "string"
                 },
-                "group_id": {
-                    "description": "GroupID holds the value of the \"group_id\" field.",
+                "field": {
                     "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "kind": {
-                    "description": "Kind holds the value of the \"kind\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/export.Kind"
-                        }
-                    ]
+                "newValue": {
+                    "type": "string"
                 },
-                "progress": {
-                    "description": "Progress holds the value of the \"progress\" field.",
-                    "type": "integer"
+                "oldValue": {
+                    "type": "string"
                 },
-                "size_bytes": {
-                    "description": "SizeBytes holds the value of the \"size_bytes\" field.",
-                    "type": "integer"
+                "source": {
+                    "type": "string"
                 },
-                "status": {
-                    "description": "Status holds the value of the \"status\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/export.Status"
-                        }
-                    ]
+                "userId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "userName": {
                     "type": "string"
                 }
             }
         },
-        "ent.ExportEdges": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.Group": {
+        "repo.BarcodeProduct": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "barcode": {
                     "type": "string"
                 },
-                "currency": {
-                    "description": "Currency holds the value of the \"currency\" field.",
+                "imageBase64": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.GroupEdges"
-                        }
-                    ]
+                "imageURL": {
+                    "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "item": {
+                    "$ref": "#/definitions/repo.EntityCreate"
+                },
+                "manufacturer": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "modelNumber": {
+                    "description": "Identifications",
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "search_engine_name": {
                     "type": "string"
                 }
             }
         },
-        "ent.GroupEdges": {
+        "repo.DuplicateOptions": {
             "type": "object",
             "properties": {
-                "entities": {
-                    "description": "Entities holds the value of the entities edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Entity"
-                    }
+                "copyAttachments": {
+                    "type": "boolean"
                 },
-                "entity_templates": {
-                    "description": "EntityTemplates holds the value of the entity_templates edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.EntityTemplate"
-                    }
+                "copyCustomFields": {
+                    "type": "boolean"
                 },
-                "entity_types": {
-                    "description": "EntityTypes holds the value of the entity_types edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.EntityType"
-                    }
+                "copyMaintenance": {
+                    "type": "boolean"
                 },
-                "exports": {
-                    "description": "Exports holds the value of the exports edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Export"
-                    }
+                "copyPrefix": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.EntityCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "invitation_tokens": {
-                    "description": "InvitationTokens holds the value of the invitation_tokens edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.GroupInvitationToken"
-                    }
+                "entityTypeId": {
+                    "type": "string"
                 },
-                "notifiers": {
-                    "description": "Notifiers holds the value of the notifiers edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Notifier"
-                    }
+                "manufacturer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "tags": {
-                    "description": "Tags holds the value of the tags edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Tag"
-                    }
+                "modelNumber": {
+                    "description": "Identifications — optional at create time; populated e.g. by the\nbarcode product-search import flow (#1578).",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "user_groups": {
-                    "description": "UserGroups holds the value of the user_groups edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.UserGroup"
-                    }
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "users": {
-                    "description": "Users holds the value of the users edge.",
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "tagIds": {
+                    "description": "Edges",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.User"
+                        "type": "string"
                     }
                 }
             }
         },
-        "ent.GroupInvitationToken": {
+        "repo.EntityFieldData": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "booleanValue": {
+                    "type": "boolean"
+                },
+                "dateValue": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the GroupInvitationTokenQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.GroupInvitationTokenEdges"
-                        }
-                    ]
+                "decimalValue": {
+                    "description": "DecimalValue is the value of decimal fields and the amount of\ncurrency fields, whose TextValue is the currency code.",
+                    "type": "number"
                 },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                "id": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "name": {
                     "type": "string"
                 },
-                "token": {
-                    "description": "Token holds the value of the \"token\" field.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "numberValue": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "textValue": {
                     "type": "string"
                 },
-                "uses": {
-                    "description": "Uses holds the value of the \"uses\" field.",
-                    "type": "integer"
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "ent.GroupInvitationTokenEdges": {
+        "repo.EntityFieldDefinitionCreate": {
             "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
             "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
+                "defaultValue": {
+                    "description": "DefaultValue is prefilled into new entities, written like the value\nof a text field: \"12.5\", \"true\", \"2024-12-31\" or \"today\", \"A, B\" for\nmulti_select and \"0 EUR\" for currency.",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "options": {
+                    "description": "Options are the choices of select and multi_select fields.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "decimal",
+                        "boolean",
+                        "date",
+                        "url",
+                        "email",
+                        "select",
+                        "multi_select",
+                        "currency"
                     ]
                 }
             }
         },
-        "ent.MaintenanceEntry": {
+        "repo.EntityFieldDefinitionOut": {
             "type": "object",
             "properties": {
-                "cost": {
-                    "description": "Cost holds the value of the \"cost\" field.",
-                    "type": "number"
-                },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "createdAt": {
                     "type": "string"
                 },
-                "date": {
-                    "description": "Date holds the value of the \"date\" field.",
+                "defaultValue": {
                     "type": "string"
                 },
                 "description": {
-                    "description": "Description holds the value of the \"description\" field.",
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the MaintenanceEntryQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.MaintenanceEntryEdges"
-                        }
-                    ]
-                },
-                "entity_id": {
-                    "description": "EntityID holds the value of the \"entity_id\" field.",
+                "entityTypeId": {
                     "type": "string"
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
-                "scheduled_date": {
-                    "description": "ScheduledDate holds the value of the \"scheduled_date\" field.",
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "ent.MaintenanceEntryEdges": {
-            "type": "object",
-            "properties": {
-                "entity": {
-                    "description": "Entity holds the value of the entity edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Entity"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.Notifier": {
+        "repo.EntityFieldDefinitionUpdate": {
             "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
-                    "type": "string"
-                },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the NotifierQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.NotifierEdges"
-                        }
-                    ]
+                "defaultValue": {
+                    "type": "string",
+                    "maxLength": 500
                 },
-                "group_id": {
-                    "description": "GroupID holds the value of the \"group_id\" field.",
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
                 "id": {
-                    "description": "ID of the ent.",
                     "type": "string"
                 },
-                "is_active": {
-                    "description": "IsActive holds the value of the \"is_active\" field.",
-                    "type": "boolean"
-                },
                 "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "user_id": {
-                    "description": "UserID holds the value of the \"user_id\" field.",
-                    "type": "string"
+                "position": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "decimal",
+                        "boolean",
+                        "date",
+                        "url",
+                        "email",
+                        "select",
+                        "multi_select",
+                        "currency"
+                    ]
                 }
             }
         },
-        "ent.NotifierEdges": {
+        "repo.EntityListResult": {
             "type": "object",
             "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.EntitySummary"
+                    }
                 },
-                "user": {
-                    "description": "User holds the value of the user edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.User"
-                        }
-                    ]
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPrice": {
+                    "type": "number"
                 }
             }
         },
-        "ent.PasswordResetTokens": {
+        "repo.EntityOut": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "archived": {
+                    "type": "boolean"
+                },
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.ItemAttachment"
+                    }
+                },
+                "children": {
+                    "description": "Container-specific fields (for entities whose entity_type.is_location = true)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.EntitySummary"
+                    }
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the PasswordResetTokensQuery when eager-loading is set.",
+                "currentValue": {
+                    "type": "number"
+                },
+                "depreciationMethod": {
+                    "description": "Depreciation as set on the entity; CurrentValue is the per-unit\nvalue today, with unset settings taken from the entity type",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.PasswordResetTokensEdges"
+                            "$ref": "#/definitions/entity.DepreciationMethod"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "expires_at": {
-                    "description": "ExpiresAt holds the value of the \"expires_at\" field.",
+                "description": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "entityType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "token": {
-                    "description": "Token holds the value of the \"token\" field.",
+                "fields": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/repo.EntityFieldData"
                     }
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "id": {
                     "type": "string"
                 },
-                "used_at": {
-                    "description": "UsedAt holds the value of the \"used_at\" field.",
-                    "type": "string"
+                "imageId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "user_id": {
-                    "description": "UserID holds the value of the \"user_id\" field.",
-                    "type": "string"
-                }
-            }
-        },
-        "ent.PasswordResetTokensEdges": {
-            "type": "object",
-            "properties": {
-                "user": {
-                    "description": "User holds the value of the user edge.",
+                "insured": {
+                    "type": "boolean"
+                },
+                "itemCount": {
+                    "description": "Container-specific (populated when querying locations)",
+                    "type": "number"
+                },
+                "lifetimeWarranty": {
+                    "description": "Warranty",
+                    "type": "boolean"
+                },
+                "loans": {
+                    "description": "Loans, newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.LoanOut"
+                    }
+                },
+                "location": {
+                    "description": "Location is the nearest ancestor whose entity type is a location.\nWhen the direct parent is already a location it equals Parent; when\nthe entity is nested inside other items it is the location those\nitems ultimately live in. Nil for top-level entities.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/repo.EntitySummary"
                         }
-                    ]
-                }
-            }
-        },
-        "ent.Tag": {
-            "type": "object",
-            "properties": {
-                "color": {
-                    "description": "Color holds the value of the \"color\" field.",
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "lowStock": {
+                    "type": "boolean"
+                },
+                "manualValue": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "manufacturer": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "minQuantity": {
+                    "description": "Stock",
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "modelNumber": {
                     "type": "string"
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                "name": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TagQuery when eager-loading is set.",
+                "notes": {
+                    "description": "Extras",
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "Edges",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.TagEdges"
+                            "$ref": "#/definitions/repo.EntitySummary"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "icon": {
-                    "description": "Icon holds the value of the \"icon\" field.",
+                "purchaseCurrency": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "purchaseDate": {
+                    "description": "Purchase",
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "purchaseFrom": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
-                    "type": "string"
-                }
-            }
-        },
-        "ent.TagEdges": {
-            "type": "object",
-            "properties": {
-                "children": {
-                    "description": "Children holds the value of the children edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Tag"
-                    }
+                "purchasePrice": {
+                    "type": "number"
                 },
-                "entities": {
-                    "description": "Entities holds the value of the entities edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Entity"
-                    }
+                "quantity": {
+                    "type": "number"
                 },
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
+                "reorderPoint": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "parent": {
-                    "description": "Parent holds the value of the parent edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Tag"
-                        }
-                    ]
-                }
-            }
-        },
-        "ent.TemplateField": {
-            "type": "object",
-            "properties": {
-                "boolean_value": {
-                    "description": "BooleanValue holds the value of the \"boolean_value\" field.",
-                    "type": "boolean"
+                "salvageValue": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "serialNumber": {
                     "type": "string"
                 },
-                "description": {
-                    "description": "Description holds the value of the \"description\" field.",
+                "soldCurrency": {
                     "type": "string"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TemplateFieldQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.TemplateFieldEdges"
-                        }
-                    ]
+                "soldDate": {
+                    "description": "Sold",
+                    "type": "string"
                 },
-                "id": {
-                    "description": "ID of the ent.",
+                "soldNotes": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
+                "soldPrice": {
+                    "type": "number"
+                },
+                "soldTo": {
                     "type": "string"
                 },
-                "number_value": {
-                    "description": "NumberValue holds the value of the \"number_value\" field.",
-                    "type": "integer"
+                "syncChildEntityLocations": {
+                    "type": "boolean"
                 },
-                "text_value": {
-                    "description": "TextValue holds the value of the \"text_value\" field.",
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TagSummary"
+                    }
+                },
+                "thumbnailId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "totalPrice": {
+                    "type": "number"
+                },
+                "unit": {
                     "type": "string"
                 },
-                "time_value": {
-                    "description": "TimeValue holds the value of the \"time_value\" field.",
+                "updatedAt": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "Type holds the value of the \"type\" field.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/templatefield.Type"
-                        }
-                    ]
+                "usefulLifeYears": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "warrantyDetails": {
+                    "type": "string"
+                },
+                "warrantyExpires": {
                     "type": "string"
                 }
             }
         },
-        "ent.TemplateFieldEdges": {
+        "repo.EntityPatch": {
             "type": "object",
             "properties": {
-                "entity_template": {
-                    "description": "EntityTemplate holds the value of the entity_template edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.EntityTemplate"
-                        }
-                    ]
+                "entityTypeId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "id": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "quantity": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "tagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
-        "ent.User": {
+        "repo.EntityPath": {
             "type": "object",
             "properties": {
-                "activated_on": {
-                    "description": "ActivatedOn holds the value of the \"activated_on\" field.",
+                "id": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                "name": {
                     "type": "string"
                 },
-                "default_group_id": {
-                    "description": "DefaultGroupID holds the value of the \"default_group_id\" field.",
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/repo.EntityPathType"
+                }
+            }
+        },
+        "repo.EntityPathType": {
+            "type": "string",
+            "enum": [
+                "location",
+                "item"
+            ],
+            "x-enum-varnames": [
+                "EntityPathTypeLocation",
+                "EntityPathTypeItem"
+            ]
+        },
+        "repo.EntityQuery": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "integer"
                 },
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.UserEdges"
-                        }
-                    ]
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.FieldQuery"
+                    }
                 },
-                "email": {
-                    "description": "Email holds the value of the \"email\" field.",
-                    "type": "string"
+                "filterChildren": {
+                    "description": "when true, only return root entities (no parent)",
+                    "type": "boolean"
                 },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "string"
+                "includeArchived": {
+                    "type": "boolean"
                 },
-                "is_superuser": {
-                    "description": "IsSuperuser holds the value of the \"is_superuser\" field.",
+                "insured": {
+                    "description": "when true, only return insured entities",
                     "type": "boolean"
                 },
-                "name": {
-                    "description": "Name holds the value of the \"name\" field.",
-                    "type": "string"
+                "isLocation": {
+                    "description": "nil=all, true=locations only, false=items only",
+                    "type": "boolean"
                 },
-                "oidc_issuer": {
-                    "description": "OidcIssuer holds the value of the \"oidc_issuer\" field.",
-                    "type": "string"
+                "negateTags": {
+                    "type": "boolean"
                 },
-                "oidc_subject": {
-                    "description": "OidcSubject holds the value of the \"oidc_subject\" field.",
-                    "type": "string"
+                "onLoan": {
+                    "description": "when true, only return entities currently lent out",
+                    "type": "boolean"
                 },
-                "settings": {
-                    "description": "Settings holds the value of the \"settings\" field.",
-                    "type": "object",
-                    "additionalProperties": true
+                "onlyWithPhoto": {
+                    "type": "boolean"
                 },
-                "superuser": {
-                    "description": "Superuser holds the value of the \"superuser\" field.",
+                "onlyWithoutPhoto": {
                     "type": "boolean"
                 },
-                "updated_at": {
-                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                "orderBy": {
                     "type": "string"
-                }
-            }
-        },
-        "ent.UserEdges": {
-            "type": "object",
-            "properties": {
-                "api_keys": {
-                    "description": "APIKeys holds the value of the api_keys edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.APIKey"
-                    }
                 },
-                "auth_tokens": {
-                    "description": "AuthTokens holds the value of the auth_tokens edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.AuthTokens"
-                    }
+                "page": {
+                    "type": "integer"
                 },
-                "groups": {
-                    "description": "Groups holds the value of the groups edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.Group"
-                    }
+                "pageSize": {
+                    "type": "integer"
                 },
-                "notifiers": {
-                    "description": "Notifiers holds the value of the notifiers edge.",
+                "parentIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.Notifier"
+                        "type": "string"
                     }
                 },
-                "password_reset_tokens": {
-                    "description": "PasswordResetTokens holds the value of the password_reset_tokens edge.",
+                "parentItemIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.PasswordResetTokens"
+                        "type": "string"
                     }
                 },
-                "user_groups": {
-                    "description": "UserGroups holds the value of the user_groups edge.",
+                "search": {
+                    "type": "string"
+                },
+                "sortBy": {
+                    "type": "string"
+                },
+                "tagIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ent.UserGroup"
+                        "type": "string"
                     }
                 }
             }
         },
-        "ent.UserGroup": {
+        "repo.EntitySummary": {
             "type": "object",
             "properties": {
-                "edges": {
-                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserGroupQuery when eager-loading is set.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.UserGroupEdges"
-                        }
-                    ]
+                "archived": {
+                    "type": "boolean"
                 },
-                "group_id": {
-                    "description": "GroupID holds the value of the \"group_id\" field.",
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "role": {
-                    "description": "Role holds the value of the \"role\" field.",
+                "description": {
+                    "type": "string"
+                },
+                "entityType": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/usergroup.Role"
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "user_id": {
-                    "description": "UserID holds the value of the \"user_id\" field.",
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "ent.UserGroupEdges": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "description": "Group holds the value of the group edge.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/ent.Group"
-                        }
-                    ]
                 },
-                "user": {
-                    "description": "User holds the value of the user edge.",
+                "imageId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "itemCount": {
+                    "description": "Container-specific (populated when querying locations)",
+                    "type": "number"
+                },
+                "lowStock": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent": {
+                    "description": "Edges",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/repo.EntitySummary"
                         }
-                    ]
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "purchaseCurrency": {
+                    "type": "string"
+                },
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "soldDate": {
+                    "description": "Sale details",
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TagSummary"
+                    }
+                },
+                "thumbnailId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "entityfield.Type": {
-            "type": "string",
-            "enum": [
-                "text",
-                "number",
-                "boolean",
-                "time"
-            ],
-            "x-enum-varnames": [
-                "TypeText",
-                "TypeNumber",
-                "TypeBoolean",
-                "TypeTime"
-            ]
-        },
-        "export.Kind": {
-            "type": "string",
-            "enum": [
-                "export",
-                "export",
-                "import"
-            ],
-            "x-enum-varnames": [
-                "DefaultKind",
-                "KindExport",
-                "KindImport"
-            ]
-        },
-        "export.Status": {
-            "type": "string",
-            "enum": [
-                "pending",
-                "pending",
-                "running",
-                "completed",
-                "failed"
-            ],
-            "x-enum-varnames": [
-                "DefaultStatus",
-                "StatusPending",
-                "StatusRunning",
-                "StatusCompleted",
-                "StatusFailed"
-            ]
-        },
-        "repo.APIKeyCreate": {
+        "repo.EntityTemplateCreate": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "expiresAt": {
+                "defaultDescription": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
+                },
+                "defaultInsured": {
+                    "type": "boolean"
+                },
+                "defaultLifetimeWarranty": {
+                    "type": "boolean"
+                },
+                "defaultLocationId": {
+                    "description": "Default location and tags",
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "defaultManufacturer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultModelNumber": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
+                },
+                "defaultQuantity": {
+                    "description": "Default values for entities",
+                    "type": "number",
+                    "x-nullable": true
+                },
+                "defaultTagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-nullable": true
+                },
+                "defaultWarrantyDetails": {
                     "type": "string",
+                    "maxLength": 1000,
                     "x-nullable": true
                 },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "fields": {
+                    "description": "Custom fields",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateField"
+                    }
+                },
+                "includePurchaseFields": {
+                    "type": "boolean"
+                },
+                "includeSoldFields": {
+                    "type": "boolean"
+                },
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "repo.APIKeyCreatedOut": {
+        "repo.EntityTemplateOut": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "expiresAt": {
-                    "type": "string",
-                    "x-nullable": true
+                "defaultDescription": {
+                    "type": "string"
+                },
+                "defaultInsured": {
+                    "type": "boolean"
+                },
+                "defaultLifetimeWarranty": {
+                    "type": "boolean"
+                },
+                "defaultLocation": {
+                    "description": "Default location and tags",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.TemplateLocationSummary"
+                        }
+                    ]
+                },
+                "defaultManufacturer": {
+                    "type": "string"
+                },
+                "defaultModelNumber": {
+                    "type": "string"
+                },
+                "defaultName": {
+                    "type": "string"
+                },
+                "defaultQuantity": {
+                    "description": "Default values for entities",
+                    "type": "number"
+                },
+                "defaultTags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateTagSummary"
+                    }
+                },
+                "defaultWarrantyDetails": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Custom fields",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateField"
+                    }
                 },
                 "id": {
                     "type": "string"
                 },
-                "lastUsedAt": {
-                    "type": "string",
-                    "x-nullable": true
+                "includePurchaseFields": {
+                    "type": "boolean"
+                },
+                "includeSoldFields": {
+                    "type": "boolean"
+                },
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
+                    "type": "boolean"
                 },
                 "name": {
                     "type": "string"
                 },
-                "token": {
+                "notes": {
                     "type": "string"
                 },
-                "userId": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.APIKeyOut": {
+        "repo.EntityTemplateSummary": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "expiresAt": {
-                    "type": "string",
-                    "x-nullable": true
+                "description": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "lastUsedAt": {
-                    "type": "string",
-                    "x-nullable": true
-                },
                 "name": {
                     "type": "string"
                 },
-                "userId": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.BarcodeProduct": {
+        "repo.EntityTemplateUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "barcode": {
-                    "type": "string"
+                "defaultDescription": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
                 },
-                "imageBase64": {
-                    "type": "string"
+                "defaultInsured": {
+                    "type": "boolean"
                 },
-                "imageURL": {
-                    "type": "string"
+                "defaultLifetimeWarranty": {
+                    "type": "boolean"
                 },
-                "item": {
-                    "$ref": "#/definitions/repo.EntityCreate"
+                "defaultLocationId": {
+                    "description": "Default location and tags",
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "manufacturer": {
-                    "type": "string"
+                "defaultManufacturer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "modelNumber": {
-                    "description": "Identifications",
-                    "type": "string"
+                "defaultModelNumber": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "notes": {
-                    "description": "Extras",
-                    "type": "string"
+                "defaultName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-nullable": true
                 },
-                "search_engine_name": {
+                "defaultQuantity": {
+                    "description": "Default values for entities",
+                    "type": "number",
+                    "x-nullable": true
+                },
+                "defaultTagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-nullable": true
+                },
+                "defaultWarrantyDetails": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "x-nullable": true
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "fields": {
+                    "description": "Custom fields",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TemplateField"
+                    }
+                },
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "repo.DuplicateOptions": {
-            "type": "object",
-            "properties": {
-                "copyAttachments": {
+                },
+                "includePurchaseFields": {
                     "type": "boolean"
                 },
-                "copyCustomFields": {
+                "includeSoldFields": {
                     "type": "boolean"
                 },
-                "copyMaintenance": {
+                "includeWarrantyFields": {
+                    "description": "Metadata flags",
                     "type": "boolean"
                 },
-                "copyPrefix": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 1000
                 }
             }
         },
-        "repo.EntityCreate": {
+        "repo.EntityTypeCreate": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "defaultTemplateId": {
+                    "type": "string"
                 },
-                "entityTypeId": {
+                "depreciationMethod": {
+                    "description": "Depreciation defaults for entities of this type",
+                    "enum": [
+                        "none",
+                        "straight_line",
+                        "declining_balance",
+                        "manual"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entitytype.DepreciationMethod"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "icon": {
                     "type": "string"
                 },
-                "manufacturer": {
-                    "type": "string",
-                    "maxLength": 255,
+                "isLocation": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "salvageValue": {
+                    "type": "number",
+                    "minimum": 0,
                     "x-nullable": true,
                     "x-omitempty": true
                 },
-                "modelNumber": {
-                    "description": "Identifications — optional at create time; populated e.g. by the\nbarcode product-search import flow (#1578).",
-                    "type": "string",
-                    "maxLength": 255,
+                "usefulLifeYears": {
+                    "type": "number",
                     "x-nullable": true,
                     "x-omitempty": true
+                }
+            }
+        },
+        "repo.EntityTypeSummary": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "defaultTemplate": {
+                    "$ref": "#/definitions/repo.EntityTemplateSummary"
                 },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true
+                "defaultTemplateId": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "number"
+                "depreciationMethod": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entitytype.DepreciationMethod"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "tagIds": {
-                    "description": "Edges",
+                "description": {
+                    "type": "string"
+                },
+                "fieldDefinitions": {
+                    "description": "FieldDefinitions are the typed custom fields of entities of this type.",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/repo.EntityFieldDefinitionOut"
                     }
-                }
-            }
-        },
-        "repo.EntityFieldData": {
-            "type": "object",
-            "properties": {
-                "booleanValue": {
-                    "type": "boolean"
+                },
+                "icon": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "isLocation": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
-                "numberValue": {
-                    "type": "integer"
+                "salvageValue": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "textValue": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
+                "usefulLifeYears": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
-        "repo.EntityListResult": {
+        "repo.EntityTypeUpdate": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.EntitySummary"
-                    }
+                "defaultTemplateId": {
+                    "type": "string"
                 },
-                "page": {
-                    "type": "integer"
+                "depreciationMethod": {
+                    "description": "Depreciation defaults for entities of this type",
+                    "enum": [
+                        "none",
+                        "straight_line",
+                        "declining_balance",
+                        "manual"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entitytype.DepreciationMethod"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "pageSize": {
-                    "type": "integer"
+                "icon": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "totalPrice": {
-                    "type": "number"
+                "isLocation": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "salvageValue": {
+                    "type": "number",
+                    "minimum": 0,
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "usefulLifeYears": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
-        "repo.EntityOut": {
+        "repo.EntityUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
                 "archived": {
                     "type": "boolean"
                 },
                 "assetId": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "attachments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.ItemAttachment"
-                    }
-                },
-                "children": {
-                    "description": "Container-specific fields (for entities whose entity_type.is_location = true)",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.EntitySummary"
-                    }
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
                     "type": "string"
                 },
-                "entityType": {
+                "depreciationMethod": {
+                    "description": "Depreciation; unset settings fall back to the entity type",
+                    "enum": [
+                        "none",
+                        "straight_line",
+                        "declining_balance",
+                        "manual"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                            "$ref": "#/definitions/entity.DepreciationMethod"
                         }
                     ],
                     "x-nullable": true,
                     "x-omitempty": true
                 },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "entityTypeId": {
+                    "type": "string"
+                },
                 "fields": {
                     "type": "array",
                     "items": {
@@ -4921,71 +9192,85 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "imageId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
                 "insured": {
                     "type": "boolean"
                 },
-                "itemCount": {
-                    "description": "Container-specific (populated when querying locations)",
-                    "type": "number"
-                },
                 "lifetimeWarranty": {
                     "description": "Warranty",
                     "type": "boolean"
                 },
-                "location": {
-                    "description": "Location is the nearest ancestor whose entity type is a location.\nWhen the direct parent is already a location it equals Parent; when\nthe entity is nested inside other items it is the location those\nitems ultimately live in. Nil for top-level entities.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.EntitySummary"
-                        }
-                    ],
+                "manualValue": {
+                    "type": "number",
+                    "minimum": 0,
                     "x-nullable": true,
                     "x-omitempty": true
                 },
                 "manufacturer": {
                     "type": "string"
                 },
+                "minQuantity": {
+                    "description": "Stock",
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
                 "modelNumber": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
                 "notes": {
                     "description": "Extras",
                     "type": "string"
                 },
-                "parent": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.EntitySummary"
-                        }
-                    ],
+                "parentId": {
+                    "type": "string",
                     "x-nullable": true,
                     "x-omitempty": true
                 },
+                "purchaseCurrency": {
+                    "description": "Currencies are empty for prices in the group's currency",
+                    "type": "string",
+                    "maxLength": 16
+                },
                 "purchaseDate": {
                     "description": "Purchase",
                     "type": "string"
                 },
                 "purchaseFrom": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "purchasePrice": {
-                    "type": "number"
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
                 "quantity": {
                     "type": "number"
                 },
+                "reorderPoint": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "salvageValue": {
+                    "type": "number",
+                    "minimum": 0,
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
                 "serialNumber": {
+                    "description": "Identifications",
                     "type": "string"
                 },
+                "soldCurrency": {
+                    "type": "string",
+                    "maxLength": 16
+                },
                 "soldDate": {
                     "description": "Sold",
                     "type": "string"
@@ -4994,332 +9279,306 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "soldPrice": {
-                    "type": "number"
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
                 "soldTo": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "syncChildEntityLocations": {
                     "type": "boolean"
                 },
-                "tags": {
+                "tagIds": {
+                    "description": "Edges",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TagSummary"
+                        "type": "string"
                     }
                 },
-                "thumbnailId": {
+                "unit": {
                     "type": "string",
+                    "maxLength": 32
+                },
+                "usefulLifeYears": {
+                    "type": "number",
                     "x-nullable": true,
                     "x-omitempty": true
                 },
-                "totalPrice": {
+                "warrantyDetails": {
+                    "type": "string"
+                },
+                "warrantyExpires": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.ExchangeRateCreate": {
+            "type": "object",
+            "required": [
+                "base",
+                "quote",
+                "rate"
+            ],
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "date": {
+                    "type": "string"
+                },
+                "quote": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "rate": {
                     "type": "number"
+                }
+            }
+        },
+        "repo.ExchangeRateOut": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string"
                 },
-                "updatedAt": {
+                "date": {
                     "type": "string"
                 },
-                "warrantyDetails": {
+                "id": {
                     "type": "string"
                 },
-                "warrantyExpires": {
+                "quote": {
                     "type": "string"
+                },
+                "rate": {
+                    "type": "number"
                 }
             }
         },
-        "repo.EntityPatch": {
+        "repo.ExportOut": {
             "type": "object",
             "properties": {
-                "entityTypeId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "artifactPath": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "parentId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "kind": {
+                    "description": "Kind is \"export\" for server-produced backup artifacts, \"import\" for\nuser-uploaded restore zips. The lifecycle fields below behave the\nsame for both.",
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "number",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "progress": {
+                    "type": "integer"
                 },
-                "tagIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "sizeBytes": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "repo.EntityPath": {
+        "repo.FieldQuery": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/repo.EntityPathType"
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "repo.EntityPathType": {
-            "type": "string",
-            "enum": [
-                "location",
-                "item"
-            ],
-            "x-enum-varnames": [
-                "EntityPathTypeLocation",
-                "EntityPathTypeItem"
-            ]
-        },
-        "repo.EntitySummary": {
+        "repo.Group": {
             "type": "object",
             "properties": {
-                "archived": {
-                    "type": "boolean"
-                },
-                "assetId": {
-                    "type": "string",
-                    "example": "0"
-                },
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "currency": {
                     "type": "string"
                 },
-                "entityType": {
+                "currencyConversion": {
+                    "description": "CurrencyConversion is whether amounts in other currencies are\nconverted at the rate of their purchase date or at the latest rate.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                            "$ref": "#/definitions/group.CurrencyConversion"
                         }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
+                    ]
                 },
                 "id": {
                     "type": "string"
                 },
-                "imageId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "insured": {
-                    "type": "boolean"
-                },
-                "itemCount": {
-                    "description": "Container-specific (populated when querying locations)",
-                    "type": "number"
-                },
                 "name": {
                     "type": "string"
                 },
-                "parent": {
-                    "description": "Edges",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/repo.EntitySummary"
-                        }
-                    ],
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "purchasePrice": {
-                    "type": "number"
-                },
-                "quantity": {
-                    "type": "number"
-                },
-                "soldDate": {
-                    "description": "Sale details",
+                "updatedAt": {
                     "type": "string"
                 },
-                "tags": {
+                "warrantyNotifyDays": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TagSummary"
+                        "type": "integer"
                     }
-                },
-                "thumbnailId": {
-                    "type": "string",
-                    "x-nullable": true,
-                    "x-omitempty": true
-                },
-                "updatedAt": {
-                    "type": "string"
                 }
             }
         },
-        "repo.EntityTemplateCreate": {
+        "repo.GroupInvitation": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "defaultDescription": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
-                },
-                "defaultInsured": {
-                    "type": "boolean"
-                },
-                "defaultLifetimeWarranty": {
-                    "type": "boolean"
+                "expiresAt": {
+                    "type": "string"
                 },
-                "defaultLocationId": {
-                    "description": "Default location and tags",
-                    "type": "string",
-                    "x-nullable": true
+                "group": {
+                    "$ref": "#/definitions/repo.Group"
                 },
-                "defaultManufacturer": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                "id": {
+                    "type": "string"
                 },
-                "defaultModelNumber": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                "role": {
+                    "$ref": "#/definitions/usergroup.Role"
                 },
-                "defaultName": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                "uses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.GroupMember": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
                 },
-                "defaultQuantity": {
-                    "description": "Default values for entities",
-                    "type": "number",
-                    "x-nullable": true
+                "id": {
+                    "type": "string"
                 },
-                "defaultTagIds": {
+                "locationIds": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    },
-                    "x-nullable": true
+                    }
                 },
-                "defaultWarrantyDetails": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                "name": {
+                    "type": "string"
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "role": {
+                    "$ref": "#/definitions/usergroup.Role"
+                }
+            }
+        },
+        "repo.GroupStatistics": {
+            "type": "object",
+            "properties": {
+                "storageUsed": {
+                    "description": "StorageUsed is the size in bytes of the files attached in the\ngroup, counting files shared by several attachments once.",
+                    "type": "integer"
                 },
-                "fields": {
-                    "description": "Custom fields",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
-                    }
+                "totalCurrentValue": {
+                    "type": "number"
                 },
-                "includePurchaseFields": {
-                    "type": "boolean"
+                "totalItemPrice": {
+                    "type": "number"
                 },
-                "includeSoldFields": {
-                    "type": "boolean"
+                "totalItems": {
+                    "type": "integer"
                 },
-                "includeWarrantyFields": {
-                    "description": "Metadata flags",
-                    "type": "boolean"
+                "totalLocations": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "totalTags": {
+                    "type": "integer"
                 },
-                "notes": {
-                    "type": "string",
-                    "maxLength": 1000
+                "totalUsers": {
+                    "type": "integer"
+                },
+                "totalWithWarranty": {
+                    "type": "integer"
                 }
             }
         },
-        "repo.EntityTemplateOut": {
+        "repo.GroupUpdate": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "defaultDescription": {
+                "currency": {
                     "type": "string"
                 },
-                "defaultInsured": {
-                    "type": "boolean"
-                },
-                "defaultLifetimeWarranty": {
-                    "type": "boolean"
-                },
-                "defaultLocation": {
-                    "description": "Default location and tags",
+                "currencyConversion": {
+                    "description": "CurrencyConversion is left unchanged when empty.",
+                    "enum": [
+                        "purchase_date",
+                        "today"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/repo.TemplateLocationSummary"
+                            "$ref": "#/definitions/group.CurrencyConversion"
                         }
                     ]
                 },
-                "defaultManufacturer": {
-                    "type": "string"
-                },
-                "defaultModelNumber": {
-                    "type": "string"
-                },
-                "defaultName": {
+                "name": {
                     "type": "string"
                 },
-                "defaultQuantity": {
-                    "description": "Default values for entities",
-                    "type": "number"
-                },
-                "defaultTags": {
+                "warrantyNotifyDays": {
+                    "description": "WarrantyNotifyDays replaces the warranty reminder lead times when\nset; omit it to leave them unchanged.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.TemplateTagSummary"
-                    }
-                },
-                "defaultWarrantyDetails": {
+                        "type": "integer"
+                    },
+                    "x-nullable": true,
+                    "x-omitempty": true
+                }
+            }
+        },
+        "repo.ItemAttachment": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
                     "type": "string"
                 },
-                "fields": {
-                    "description": "Custom fields",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
-                    }
+                "metadata": {
+                    "description": "Metadata is read from the file when it is uploaded.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.AttachmentMetadata"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "id": {
+                "mimeType": {
                     "type": "string"
                 },
-                "includePurchaseFields": {
-                    "type": "boolean"
-                },
-                "includeSoldFields": {
-                    "type": "boolean"
+                "path": {
+                    "type": "string"
                 },
-                "includeWarrantyFields": {
-                    "description": "Metadata flags",
+                "primary": {
                     "type": "boolean"
                 },
-                "name": {
+                "thumbnail": {
+                    "$ref": "#/definitions/ent.Attachment"
+                },
+                "title": {
                     "type": "string"
                 },
-                "notes": {
+                "type": {
                     "type": "string"
                 },
                 "updatedAt": {
@@ -5327,157 +9586,188 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.EntityTemplateSummary": {
+        "repo.ItemAttachmentUpdate": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "primary": {
+                    "type": "boolean"
                 },
-                "name": {
+                "title": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "repo.EntityTemplateUpdate": {
+        "repo.LoanCheckin": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "defaultDescription": {
+                "notes": {
+                    "description": "Notes replaces the loan's notes when set.",
                     "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
-                },
-                "defaultInsured": {
-                    "type": "boolean"
-                },
-                "defaultLifetimeWarranty": {
-                    "type": "boolean"
-                },
-                "defaultLocationId": {
-                    "description": "Default location and tags",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "repo.LoanCheckout": {
+            "type": "object",
+            "properties": {
+                "borrowerContact": {
                     "type": "string",
-                    "x-nullable": true
+                    "maxLength": 255
                 },
-                "defaultManufacturer": {
+                "borrowerId": {
                     "type": "string",
-                    "maxLength": 255,
                     "x-nullable": true
                 },
-                "defaultModelNumber": {
+                "borrowerName": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "maxLength": 255
                 },
-                "defaultName": {
+                "dueDate": {
+                    "type": "string"
+                },
+                "notes": {
                     "type": "string",
-                    "maxLength": 255,
-                    "x-nullable": true
+                    "maxLength": 1000
+                }
+            }
+        },
+        "repo.LoanOut": {
+            "type": "object",
+            "properties": {
+                "borrowerContact": {
+                    "type": "string"
                 },
-                "defaultQuantity": {
-                    "description": "Default values for entities",
-                    "type": "number",
-                    "x-nullable": true
+                "borrowerId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "defaultTagIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "x-nullable": true
+                "borrowerName": {
+                    "type": "string"
                 },
-                "defaultWarrantyDetails": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "x-nullable": true
+                "checkedOutAt": {
+                    "type": "string"
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "dueDate": {
+                    "type": "string"
                 },
-                "fields": {
-                    "description": "Custom fields",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.TemplateField"
-                    }
+                "entityId": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "includePurchaseFields": {
-                    "type": "boolean"
-                },
-                "includeSoldFields": {
-                    "type": "boolean"
+                "notes": {
+                    "type": "string"
                 },
-                "includeWarrantyFields": {
-                    "description": "Metadata flags",
+                "overdue": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
-                "notes": {
+                "returnedAt": {
                     "type": "string",
-                    "maxLength": 1000
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
-        "repo.EntityTypeCreate": {
+        "repo.LowStockEntry": {
             "type": "object",
             "properties": {
-                "defaultTemplateId": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "assetId": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "icon": {
+                "description": {
                     "type": "string"
                 },
-                "isLocation": {
+                "entityType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imageId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "insured": {
+                    "type": "boolean"
+                },
+                "itemCount": {
+                    "description": "Container-specific (populated when querying locations)",
+                    "type": "number"
+                },
+                "lowStock": {
                     "type": "boolean"
                 },
+                "minQuantity": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
                 "name": {
                     "type": "string"
-                }
-            }
-        },
-        "repo.EntityTypeSummary": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
-                    "type": "string"
                 },
-                "defaultTemplate": {
-                    "$ref": "#/definitions/repo.EntityTemplateSummary"
+                "parent": {
+                    "description": "Edges",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.EntitySummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 },
-                "defaultTemplateId": {
+                "purchaseCurrency": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "purchasePrice": {
+                    "type": "number"
                 },
-                "icon": {
-                    "type": "string"
+                "quantity": {
+                    "type": "number"
                 },
-                "id": {
+                "reorderPoint": {
+                    "type": "number",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "soldDate": {
+                    "description": "Sale details",
                     "type": "string"
                 },
-                "isLocation": {
-                    "type": "boolean"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.TagSummary"
+                    }
                 },
-                "name": {
+                "threshold": {
+                    "description": "Threshold is the quantity at or below which the entity is low:\nthe reorder point when set, otherwise the minimum quantity.",
+                    "type": "number"
+                },
+                "thumbnailId": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "unit": {
                     "type": "string"
                 },
                 "updatedAt": {
@@ -5485,485 +9775,599 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.EntityTypeUpdate": {
+        "repo.MaintenanceEntry": {
             "type": "object",
             "properties": {
-                "defaultTemplateId": {
+                "completedDate": {
                     "type": "string"
                 },
-                "icon": {
+                "cost": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "costCurrency": {
                     "type": "string"
                 },
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "isLocation": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "recurrenceRule": {
+                    "type": "string"
+                },
+                "scheduledDate": {
+                    "type": "string"
                 }
             }
         },
-        "repo.EntityUpdate": {
+        "repo.MaintenanceEntryCreate": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "archived": {
-                    "type": "boolean"
-                },
-                "assetId": {
+                "completedDate": {
                     "type": "string"
                 },
-                "description": {
+                "cost": {
                     "type": "string",
-                    "maxLength": 1000
-                },
-                "entityTypeId": {
-                    "type": "string"
+                    "example": "0"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/repo.EntityFieldData"
-                    }
+                "costCurrency": {
+                    "description": "CostCurrency is empty for costs in the group's currency.",
+                    "type": "string",
+                    "maxLength": 16
                 },
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "insured": {
-                    "type": "boolean"
-                },
-                "lifetimeWarranty": {
-                    "description": "Warranty",
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "manufacturer": {
+                "recurrenceRule": {
+                    "description": "RecurrenceRule is an RRULE subset, e.g. \"FREQ=MONTHLY;INTERVAL=3\".\nSee ParseRecurrence.",
                     "type": "string"
                 },
-                "modelNumber": {
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryUpdate": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
                     "type": "string"
                 },
-                "name": {
+                "cost": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "example": "0"
                 },
-                "notes": {
-                    "description": "Extras",
+                "costCurrency": {
+                    "description": "CostCurrency replaces the currency of the cost when set; omit it to\nleave it unchanged.",
+                    "type": "string",
+                    "maxLength": 16,
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "description": {
                     "type": "string"
                 },
-                "parentId": {
+                "name": {
+                    "type": "string"
+                },
+                "recurrenceRule": {
+                    "description": "RecurrenceRule replaces the rule of the entry when set, and an empty\nrule ends the series; omit it to leave the rule unchanged.",
                     "type": "string",
                     "x-nullable": true,
                     "x-omitempty": true
                 },
-                "purchaseDate": {
-                    "description": "Purchase",
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceEntryWithDetails": {
+            "type": "object",
+            "properties": {
+                "completedDate": {
                     "type": "string"
                 },
-                "purchaseFrom": {
+                "cost": {
                     "type": "string",
-                    "maxLength": 255
+                    "example": "0"
                 },
-                "purchasePrice": {
-                    "type": "number",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "costCurrency": {
+                    "type": "string"
                 },
-                "quantity": {
-                    "type": "number"
+                "description": {
+                    "type": "string"
                 },
-                "serialNumber": {
-                    "description": "Identifications",
+                "id": {
                     "type": "string"
                 },
-                "soldDate": {
-                    "description": "Sold",
+                "itemID": {
                     "type": "string"
                 },
-                "soldNotes": {
+                "itemName": {
                     "type": "string"
                 },
-                "soldPrice": {
-                    "type": "number",
-                    "x-nullable": true,
-                    "x-omitempty": true
+                "name": {
+                    "type": "string"
                 },
-                "soldTo": {
-                    "type": "string",
-                    "maxLength": 255
+                "recurrenceRule": {
+                    "type": "string"
                 },
-                "syncChildEntityLocations": {
+                "scheduledDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.MaintenanceFilterStatus": {
+            "type": "string",
+            "enum": [
+                "scheduled",
+                "completed",
+                "both"
+            ],
+            "x-enum-varnames": [
+                "MaintenanceFilterStatusScheduled",
+                "MaintenanceFilterStatusCompleted",
+                "MaintenanceFilterStatusBoth"
+            ]
+        },
+        "repo.NotifierCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "url"
+            ],
+            "properties": {
+                "isActive": {
                     "type": "boolean"
                 },
-                "tagIds": {
-                    "description": "Edges",
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "subscriptions": {
+                    "description": "Subscriptions defaults to maintenance and warranty reminders when\nomitted.",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/repo.NotifierSubscription"
                     }
                 },
-                "warrantyDetails": {
-                    "type": "string"
-                },
-                "warrantyExpires": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "repo.ExportOut": {
+        "repo.NotifierEvent": {
+            "type": "string",
+            "enum": [
+                "maintenance_due",
+                "warranty_expiring",
+                "export_completed",
+                "export_failed",
+                "import_finished",
+                "low_stock",
+                "member_joined",
+                "loan_overdue"
+            ],
+            "x-enum-varnames": [
+                "NotifierEventMaintenanceDue",
+                "NotifierEventWarrantyExpiring",
+                "NotifierEventExportCompleted",
+                "NotifierEventExportFailed",
+                "NotifierEventImportFinished",
+                "NotifierEventLowStock",
+                "NotifierEventMemberJoined",
+                "NotifierEventLoanOverdue"
+            ]
+        },
+        "repo.NotifierOut": {
             "type": "object",
             "properties": {
-                "artifactPath": {
-                    "type": "string"
-                },
                 "createdAt": {
                     "type": "string"
                 },
-                "error": {
-                    "type": "string"
-                },
                 "groupId": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "kind": {
-                    "description": "Kind is \"export\" for server-produced backup artifacts, \"import\" for\nuser-uploaded restore zips. The lifecycle fields below behave the\nsame for both.",
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "progress": {
-                    "type": "integer"
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.NotifierSubscription"
+                    }
                 },
-                "sizeBytes": {
-                    "type": "integer"
+                "updatedAt": {
+                    "type": "string"
                 },
-                "status": {
+                "url": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "repo.Group": {
+        "repo.NotifierSubscription": {
             "type": "object",
+            "required": [
+                "event"
+            ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "event": {
+                    "$ref": "#/definitions/repo.NotifierEvent"
                 },
-                "name": {
-                    "type": "string"
+                "savedSearchId": {
+                    "type": "string",
+                    "x-nullable": true
                 },
-                "updatedAt": {
-                    "type": "string"
+                "template": {
+                    "type": "string",
+                    "maxLength": 4000
                 }
             }
         },
-        "repo.GroupInvitation": {
+        "repo.NotifierUpdate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "expiresAt": {
-                    "type": "string"
+                "isActive": {
+                    "type": "boolean"
                 },
-                "group": {
-                    "$ref": "#/definitions/repo.Group"
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "id": {
-                    "type": "string"
+                "subscriptions": {
+                    "description": "Subscriptions replaces the notifier's subscriptions when set; omit\nit to leave them unchanged.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.NotifierSubscription"
+                    },
+                    "x-nullable": true
                 },
-                "uses": {
-                    "type": "integer"
+                "url": {
+                    "type": "string",
+                    "x-nullable": true
                 }
             }
         },
-        "repo.GroupStatistics": {
+        "repo.PaginationResult-repo_AuditEntryOut": {
             "type": "object",
             "properties": {
-                "totalItemPrice": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.AuditEntryOut"
+                    }
                 },
-                "totalItems": {
+                "page": {
                     "type": "integer"
                 },
-                "totalLocations": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "totalTags": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "repo.PaginationResult-repo_EntitySummary": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.EntitySummary"
+                    }
+                },
+                "page": {
                     "type": "integer"
                 },
-                "totalUsers": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "totalWithWarranty": {
+                "total": {
                     "type": "integer"
                 }
             }
         },
-        "repo.GroupUpdate": {
+        "repo.SavedSearchCreate": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "currency": {
-                    "type": "string"
+                "columns": {
+                    "type": "array",
+                    "maxItems": 16,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "includeArchived": {
+                    "type": "boolean"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "negateTags": {
+                    "type": "boolean"
+                },
+                "onlyWithPhoto": {
+                    "type": "boolean"
+                },
+                "onlyWithoutPhoto": {
+                    "type": "boolean"
+                },
+                "orderBy": {
+                    "type": "string",
+                    "enum": [
+                        "name",
+                        "createdAt",
+                        "updatedAt",
+                        "assetId",
+                        "relevance"
+                    ]
+                },
+                "parentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "query": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "tagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "repo.ItemAttachment": {
+        "repo.SavedSearchOut": {
             "type": "object",
             "properties": {
+                "columns": {
+                    "type": "array",
+                    "maxItems": 16,
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "createdAt": {
                     "type": "string"
                 },
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "mimeType": {
+                "groupId": {
                     "type": "string"
                 },
-                "path": {
+                "id": {
                     "type": "string"
                 },
-                "primary": {
+                "includeArchived": {
                     "type": "boolean"
                 },
-                "thumbnail": {
-                    "$ref": "#/definitions/ent.Attachment"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "type": {
+                "name": {
                     "type": "string"
                 },
-                "updatedAt": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.ItemAttachmentUpdate": {
-            "type": "object",
-            "properties": {
-                "primary": {
+                "negateTags": {
                     "type": "boolean"
                 },
-                "title": {
-                    "type": "string"
+                "onlyWithPhoto": {
+                    "type": "boolean"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.MaintenanceEntry": {
-            "type": "object",
-            "properties": {
-                "completedDate": {
-                    "type": "string"
+                "onlyWithoutPhoto": {
+                    "type": "boolean"
                 },
-                "cost": {
+                "orderBy": {
                     "type": "string",
-                    "example": "0"
+                    "enum": [
+                        "name",
+                        "createdAt",
+                        "updatedAt",
+                        "assetId",
+                        "relevance"
+                    ]
                 },
-                "description": {
-                    "type": "string"
+                "parentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "query": {
+                    "type": "string",
+                    "maxLength": 2000
                 },
-                "name": {
-                    "type": "string"
+                "tagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "scheduledDate": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "repo.MaintenanceEntryCreate": {
+        "repo.SavedSearchUpdate": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "completedDate": {
-                    "type": "string"
+                "columns": {
+                    "type": "array",
+                    "maxItems": 16,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "cost": {
+                "description": {
                     "type": "string",
-                    "example": "0"
+                    "maxLength": 1000
                 },
-                "description": {
-                    "type": "string"
+                "includeArchived": {
+                    "type": "boolean"
                 },
                 "name": {
-                    "type": "string"
-                },
-                "scheduledDate": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.MaintenanceEntryUpdate": {
-            "type": "object",
-            "properties": {
-                "completedDate": {
-                    "type": "string"
-                },
-                "cost": {
                     "type": "string",
-                    "example": "0"
+                    "maxLength": 255,
+                    "minLength": 1
                 },
-                "description": {
-                    "type": "string"
+                "negateTags": {
+                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "onlyWithPhoto": {
+                    "type": "boolean"
                 },
-                "scheduledDate": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.MaintenanceEntryWithDetails": {
-            "type": "object",
-            "properties": {
-                "completedDate": {
-                    "type": "string"
+                "onlyWithoutPhoto": {
+                    "type": "boolean"
                 },
-                "cost": {
+                "orderBy": {
                     "type": "string",
-                    "example": "0"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "itemID": {
-                    "type": "string"
+                    "enum": [
+                        "name",
+                        "createdAt",
+                        "updatedAt",
+                        "assetId",
+                        "relevance"
+                    ]
                 },
-                "itemName": {
-                    "type": "string"
+                "parentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "query": {
+                    "type": "string",
+                    "maxLength": 2000
                 },
-                "scheduledDate": {
-                    "type": "string"
+                "tagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "repo.MaintenanceFilterStatus": {
-            "type": "string",
-            "enum": [
-                "scheduled",
-                "completed",
-                "both"
-            ],
-            "x-enum-varnames": [
-                "MaintenanceFilterStatusScheduled",
-                "MaintenanceFilterStatusCompleted",
-                "MaintenanceFilterStatusBoth"
-            ]
-        },
-        "repo.NotifierCreate": {
+        "repo.StockChange": {
             "type": "object",
             "required": [
-                "name",
-                "url"
+                "quantity"
             ],
             "properties": {
-                "isActive": {
-                    "type": "boolean"
-                },
-                "name": {
+                "note": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "maxLength": 1000
                 },
-                "url": {
-                    "type": "string"
+                "quantity": {
+                    "type": "number"
                 }
             }
         },
-        "repo.NotifierOut": {
+        "repo.StockMovement": {
             "type": "object",
             "properties": {
+                "change": {
+                    "type": "number"
+                },
                 "createdAt": {
                     "type": "string"
                 },
-                "groupId": {
+                "entityId": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "isActive": {
-                    "type": "boolean"
-                },
-                "name": {
-                    "type": "string"
+                "kind": {
+                    "$ref": "#/definitions/stockmovement.Kind"
                 },
-                "updatedAt": {
+                "note": {
                     "type": "string"
                 },
-                "url": {
-                    "type": "string"
+                "quantityAfter": {
+                    "type": "number"
                 },
                 "userId": {
-                    "type": "string"
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
-        "repo.NotifierUpdate": {
+        "repo.StorageReport": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "isActive": {
-                    "type": "boolean"
+                "files": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repo.StorageReportBlob"
+                    }
                 },
-                "url": {
-                    "type": "string",
-                    "x-nullable": true
-                }
-            }
-        },
-        "repo.PaginationResult-repo_EntitySummary": {
-            "type": "object",
-            "properties": {
-                "items": {
+                "orphaned": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/repo.EntitySummary"
+                        "$ref": "#/definitions/repo.StorageReportBlob"
                     }
                 },
-                "page": {
+                "reclaimable": {
                     "type": "integer"
                 },
-                "pageSize": {
+                "used": {
                     "type": "integer"
+                }
+            }
+        },
+        "repo.StorageReportBlob": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "type": "string"
                 },
-                "total": {
+                "references": {
+                    "description": "References is the number of attachments pointing at the file.",
+                    "type": "integer"
+                },
+                "size": {
                     "type": "integer"
                 }
             }
@@ -6144,22 +10548,58 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
-        "repo.TotalsByOrganizer": {
-            "type": "object",
-            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.TotalsByOrganizer": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "repo.TrashEntry": {
+            "type": "object",
+            "properties": {
+                "deletedAt": {
+                    "type": "string"
+                },
+                "descendants": {
+                    "description": "Descendants is the number of entities trashed together with this one.",
+                    "type": "integer"
+                },
+                "entityType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.EntityTypeSummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "total": {
-                    "type": "number"
+                "parent": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/repo.EntitySummary"
+                        }
+                    ],
+                    "x-nullable": true,
+                    "x-omitempty": true
                 }
             }
         },
@@ -6215,20 +10655,6 @@ const docTemplate = `{
                 }
             }
         },
-        "repo.UserSummary": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
         "repo.UserUpdate": {
             "type": "object",
             "properties": {
@@ -6277,6 +10703,138 @@ const docTemplate = `{
                 }
             }
         },
+        "repo.WebhookCreate": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2083
+                }
+            }
+        },
+        "repo.WebhookDeliveryOut": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nextAttemptAt": {
+                    "type": "string",
+                    "x-nullable": true,
+                    "x-omitempty": true
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "responseStatus": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.WebhookOut": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "repo.WebhookUpdate": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2083
+                }
+            }
+        },
         "services.Latest": {
             "type": "object",
             "properties": {
@@ -6288,6 +10846,29 @@ const docTemplate = `{
                 }
             }
         },
+        "services.NotificationPreview": {
+            "type": "object",
+            "required": [
+                "event"
+            ],
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/repo.NotifierEvent"
+                },
+                "template": {
+                    "type": "string",
+                    "maxLength": 4000
+                }
+            }
+        },
+        "services.NotificationPreviewOut": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
         "services.UserRegistration": {
             "type": "object",
             "properties": {
@@ -6305,6 +10886,27 @@ const docTemplate = `{
                 }
             }
         },
+        "services.WebhookSecretOut": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "stockmovement.Kind": {
+            "type": "string",
+            "enum": [
+                "consume",
+                "restock",
+                "adjust"
+            ],
+            "x-enum-varnames": [
+                "KindConsume",
+                "KindRestock",
+                "KindAdjust"
+            ]
+        },
         "templatefield.Type": {
             "type": "string",
             "enum": [
@@ -6323,13 +10925,17 @@ const docTemplate = `{
         "usergroup.Role": {
             "type": "string",
             "enum": [
-                "user",
-                "user",
+                "editor",
+                "viewer",
+                "contributor",
+                "editor",
                 "owner"
             ],
             "x-enum-varnames": [
                 "DefaultRole",
-                "RoleUser",
+                "RoleViewer",
+                "RoleContributor",
+                "RoleEditor",
                 "RoleOwner"
             ]
         },
@@ -6484,6 +11090,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "role": {
+                    "$ref": "#/definitions/usergroup.Role"
+                },
                 "token": {
                     "type": "string"
                 },
@@ -6501,6 +11110,19 @@ const docTemplate = `{
                 "expiresAt": {
                     "type": "string"
                 },
+                "role": {
+                    "description": "Role is given to members who join with the invitation. Defaults\nto editor.",
+                    "enum": [
+                        "viewer",
+                        "contributor",
+                        "editor"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/usergroup.Role"
+                        }
+                    ]
+                },
                 "uses": {
                     "type": "integer",
                     "maximum": 100,
@@ -6508,6 +11130,84 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.GroupMemberLocationsUpdate": {
+            "type": "object",
+            "properties": {
+                "locationIds": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.GroupMemberRoleUpdate": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "enum": [
+                        "viewer",
+                        "contributor",
+                        "editor",
+                        "owner"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/usergroup.Role"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.LabelSheetPreset": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "template": {
+                    "$ref": "#/definitions/labelmaker.SheetTemplate"
+                }
+            }
+        },
+        "v1.LabelSheetRequest": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "description": "Asset prints asset ID labels instead of name labels; entities without\nan asset ID are left out.",
+                    "type": "boolean"
+                },
+                "entityIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "locationId": {
+                    "type": "string",
+                    "x-nullable": true
+                },
+                "offset": {
+                    "description": "Offset is the number of labels already used on the first sheet.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "preset": {
+                    "description": "Preset is the ID of a preset sheet, Template a custom one.",
+                    "type": "string"
+                },
+                "query": {
+                    "$ref": "#/definitions/repo.EntityQuery"
+                },
+                "template": {
+                    "$ref": "#/definitions/labelmaker.SheetTemplate"
+                }
+            }
+        },
         "v1.LoginForm": {
             "type": "object",
             "properties": {
@@ -6569,6 +11269,14 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.SavedSearchLabelsOut": {
+            "type": "object",
+            "properties": {
+                "printed": {
+                    "type": "integer"
+                }
+            }
+        },
         "v1.TelemetryStatus": {
             "type": "object",
             "properties": {
@@ -6638,6 +11346,21 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "webhookdelivery.Status": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "DefaultStatus",
+                "StatusPending",
+                "StatusSucceeded",
+                "StatusFailed"
+            ]
         }
     },
     "securityDefinitions": {
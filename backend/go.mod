@@ -3,6 +3,7 @@ module github.com/sysadminsmedia/homebox/backend
 go 1.26.0
 
 require (
+	codeberg.org/go-pdf/fpdf v0.12.0
 	entgo.io/ent v0.14.6
 	github.com/XSAM/otelsql v0.43.0
 	github.com/ardanlabs/conf/v3 v3.13.0
@@ -65,6 +66,7 @@ require (
 	google.golang.org/grpc v1.83.1
 	google.golang.org/protobuf v1.36.12
 	modernc.org/sqlite v1.57.0
+	rsc.io/pdf v0.1.1
 )
 
 require (
@@ -16,6 +16,8 @@ type AllServices struct {
 	Entities          *EntityService
 	BackgroundService *BackgroundService
 	Exports           *ExportService
+	Notifications     *NotificationService
+	Webhooks          *WebhookService
 	Currencies        *currencies.CurrencyRegistry
 }
 
@@ -106,26 +108,37 @@ func New(repos *repo.AllRepos, opts ...OptionsFunc) *AllServices {
 		opt(options)
 	}
 
+	notifications := newNotificationService(repos, options.notifierConfig)
+
+	webhooks := newWebhookService(repos, options.notifierConfig)
+	if options.bus != nil {
+		notifications.subscribe(options.bus)
+		webhooks.subscribe(options.bus)
+	}
+
 	return &AllServices{
-		User:  &UserService{repos: repos, mailer: options.mailer},
-		Group: &GroupService{repos},
+		User:  &UserService{repos: repos, mailer: options.mailer, notifications: notifications},
+		Group: &GroupService{repos, notifications},
 		Entities: &EntityService{
 			repo:                 repos,
 			autoIncrementAssetID: options.autoIncrementAssetID,
 		},
 		BackgroundService: &BackgroundService{
-			repos:          repos,
-			latest:         Latest{},
-			notifierConfig: options.notifierConfig,
+			repos:         repos,
+			latest:        Latest{},
+			notifications: notifications,
 		},
 		Exports: &ExportService{
-			db:         options.db,
-			repos:      repos,
-			bus:        options.bus,
-			storage:    options.storage,
-			pubSubConn: options.pubSubConn,
-			dialect:    options.dialect,
+			db:            options.db,
+			repos:         repos,
+			bus:           options.bus,
+			storage:       options.storage,
+			pubSubConn:    options.pubSubConn,
+			dialect:       options.dialect,
+			notifications: notifications,
 		},
-		Currencies: currencies.NewCurrencyService(options.currencies),
+		Notifications: notifications,
+		Webhooks:      webhooks,
+		Currencies:    currencies.NewCurrencyService(options.currencies),
 	}
 }
@@ -93,14 +93,21 @@ func SetTenantCtx(ctx context.Context, tenantID uuid.UUID) context.Context {
 
 // SetAPIKeyAuth marks the request context as authenticated via a static API
 // key rather than a session token. Handlers that are session-specific (logout,
-// refresh) consult this flag via IsAPIKeyAuth.
-func SetAPIKeyAuth(ctx context.Context) context.Context {
-	return context.WithValue(ctx, ContextAPIKey, true)
+// refresh) consult this flag via IsAPIKeyAuth, and the key's restrictions are
+// available through UseAPIKeyCtx.
+func SetAPIKeyAuth(ctx context.Context, key repo.APIKeyOut) context.Context {
+	return context.WithValue(ctx, ContextAPIKey, &key)
 }
 
 // IsAPIKeyAuth reports whether the current request was authenticated via a
 // static API key.
 func IsAPIKeyAuth(ctx context.Context) bool {
-	v, _ := ctx.Value(ContextAPIKey).(bool)
+	return UseAPIKeyCtx(ctx) != nil
+}
+
+// UseAPIKeyCtx returns the API key the request was authenticated with, or
+// nil for session-authenticated requests.
+func UseAPIKeyCtx(ctx context.Context) *repo.APIKeyOut {
+	v, _ := ctx.Value(ContextAPIKey).(*repo.APIKeyOut)
 	return v
 }
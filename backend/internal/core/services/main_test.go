@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"testing"
@@ -12,6 +14,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/core/currencies"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/migrations"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	_ "github.com/sysadminsmedia/homebox/backend/pkgs/cgofreesqlite"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/faker"
@@ -53,6 +56,25 @@ func bootstrap() {
 	}
 }
 
+// createSearchIndex applies the entity_search migration, which
+// Schema.Create knows nothing about.
+func createSearchIndex(client *ent.Client) error {
+	files, err := migrations.Migrations(config.DriverSqlite3)
+	if err != nil {
+		return err
+	}
+	matches, err := fs.Glob(files, "sqlite3/*_entity_search.sql")
+	if err != nil || len(matches) != 1 {
+		return fmt.Errorf("entity_search migration not found: %v", err)
+	}
+	ddl, err := fs.ReadFile(files, matches[0])
+	if err != nil {
+		return err
+	}
+	_, err = client.Sql().Exec(string(ddl))
+	return err
+}
+
 func MainNoExit(m *testing.M) int {
 	// API key hashing is peppered and panics if the pepper was never configured
 	// (see hasher.HashAPIKey); the app sets it at startup, so tests must too.
@@ -72,6 +94,10 @@ func MainNoExit(m *testing.M) int {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	if err := createSearchIndex(client); err != nil {
+		log.Fatalf("failed creating search index: %v", err)
+	}
+
 	tClient = client
 	tRepos = repo.New(tClient, tbus, config.Storage{
 		PrefixPath: "/",
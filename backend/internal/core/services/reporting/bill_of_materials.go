@@ -19,13 +19,19 @@ type BillOfMaterialsEntry struct {
 	Quantity     float64    `csv:"Quantity"`
 	Price        float64    `csv:"Price"`
 	TotalPrice   float64    `csv:"Total Price"`
+	// The price as paid, when that was in another currency
+	OriginalPrice    float64 `csv:"Original Price"`
+	OriginalCurrency string  `csv:"Original Currency"`
 }
 
 // BillOfMaterialsCSV returns a byte slice of the Bill of Materials for a given GID in CSV format
-// See BillOfMaterialsEntry for the format of the output
-func BillOfMaterialsCSV(entities []repo.EntityOut) ([]byte, error) {
+// See BillOfMaterialsEntry for the format of the output. Prices are converted
+// with conv.
+func BillOfMaterialsCSV(entities []repo.EntityOut, conv *repo.CurrencyConverter) ([]byte, error) {
 	return gocsv.MarshalBytes(new(lo.Map(entities, func(entity repo.EntityOut, _ int) BillOfMaterialsEntry {
-		return BillOfMaterialsEntry{
+		price := conv.Convert(entity.PurchasePrice, entity.PurchaseCurrency, entity.PurchaseDate.Time())
+
+		row := BillOfMaterialsEntry{
 			PurchaseDate: entity.PurchaseDate,
 			Name:         entity.Name,
 			Description:  entity.Description,
@@ -33,8 +39,13 @@ func BillOfMaterialsCSV(entities []repo.EntityOut) ([]byte, error) {
 			SerialNumber: entity.SerialNumber,
 			ModelNumber:  entity.ModelNumber,
 			Quantity:     entity.Quantity,
-			Price:        entity.PurchasePrice,
-			TotalPrice:   entity.PurchasePrice * entity.Quantity,
+			Price:        price,
+			TotalPrice:   price * entity.Quantity,
+		}
+		if entity.PurchaseCurrency != "" && entity.PurchaseCurrency != conv.Currency {
+			row.OriginalPrice = entity.PurchasePrice
+			row.OriginalCurrency = entity.PurchaseCurrency
 		}
+		return row
 	})))
 }
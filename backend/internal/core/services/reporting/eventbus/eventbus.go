@@ -16,10 +16,34 @@ const (
 	EventUserMutation   Event = "user.mutation"
 	EventExportMutation Event = "export.mutation"
 	EventImportMutation Event = "import.mutation"
+	// EventEntityLowStock is published with a GroupMutationEvent when the
+	// quantity of the entities in IDs drops to their reorder threshold.
+	EventEntityLowStock Event = "entity.low_stock"
 )
 
+// MutationAction says what happened to the IDs in a GroupMutationEvent.
+type MutationAction string
+
+const (
+	MutationCreate MutationAction = "create"
+	MutationUpdate MutationAction = "update"
+	MutationDelete MutationAction = "delete"
+	// MutationMove is an update that changed the entity's parent.
+	MutationMove MutationAction = "move"
+)
+
+// GroupMutationEvent is published whenever data in a group changes. Action
+// and IDs are best-effort: bulk operations (imports, wipes) leave IDs empty,
+// which subscribers should treat as "anything in the group may have changed".
 type GroupMutationEvent struct {
-	GID uuid.UUID
+	GID    uuid.UUID
+	Action MutationAction
+	IDs    []uuid.UUID
+	// ActorID is the user that made the change; uuid.Nil for system changes
+	// such as scheduled tasks.
+	ActorID uuid.UUID
+	// FromParentID is the entity's previous parent for MutationMove.
+	FromParentID uuid.UUID
 }
 
 type eventData struct {
@@ -44,6 +68,7 @@ func New() *EventBus {
 			EventUserMutation:   {},
 			EventExportMutation: {},
 			EventImportMutation: {},
+			EventEntityLowStock: {},
 		},
 	}
 }
@@ -0,0 +1,96 @@
+package reporting
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+// ReadExchangeRates reads exchange rates from a JSON array of objects with
+// base, quote, rate and date keys, or from CSV with those column headers.
+// The date is optional in both.
+func ReadExchangeRates(r io.Reader) ([]repo.ExchangeRateCreate, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(1)
+	for err == nil && len(bytes.TrimSpace(head)) == 0 {
+		_, _ = br.ReadByte()
+		head, err = br.Peek(1)
+	}
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no exchange rates in file")
+		}
+		return nil, err
+	}
+
+	if head[0] == '[' {
+		var rates []repo.ExchangeRateCreate
+		if err := json.NewDecoder(br).Decode(&rates); err != nil {
+			return nil, err
+		}
+		return rates, nil
+	}
+
+	return readExchangeRatesCSV(br)
+}
+
+func readExchangeRatesCSV(r io.Reader) ([]repo.ExchangeRateCreate, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("no exchange rates in file")
+	}
+
+	cols := map[string]int{}
+	for i, h := range records[0] {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, required := range []string{"base", "quote", "rate"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("missing %q column", required)
+		}
+	}
+
+	get := func(record []string, col string) string {
+		i, ok := cols[col]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rates := make([]repo.ExchangeRateCreate, 0, len(records)-1)
+	for n, record := range records[1:] {
+		rate, err := strconv.ParseFloat(get(record, "rate"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid rate: %w", n+2, err)
+		}
+
+		var date types.Date
+		if s := get(record, "date"); s != "" {
+			date = types.DateFromString(s)
+			if date.Time().IsZero() {
+				return nil, fmt.Errorf("row %d: invalid date %q", n+2, s)
+			}
+		}
+
+		rates = append(rates, repo.ExchangeRateCreate{
+			Base:  get(record, "base"),
+			Quote: get(record, "quote"),
+			Rate:  rate,
+			Date:  date,
+		})
+	}
+	return rates, nil
+}
@@ -0,0 +1,36 @@
+package reporting
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadExchangeRates(t *testing.T) {
+	json := `
+	[{"base": "EUR", "quote": "USD", "rate": 1.1, "date": "2024-01-02"}, {"base": "GBP", "quote": "USD", "rate": 1.3}]`
+	rates, err := ReadExchangeRates(strings.NewReader(json))
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.Equal(t, "2024-01-02", rates[0].Date.Time().Format("2006-01-02"))
+	assert.True(t, rates[1].Date.Time().IsZero())
+
+	csv := "Quote,Base,Rate,Date\nUSD,EUR,1.1,2024-01-02\nUSD,GBP,1.3,\n"
+	rates, err = ReadExchangeRates(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.Equal(t, "USD", rates[0].Quote)
+	assert.InDelta(t, 1.3, rates[1].Rate, 0)
+	assert.True(t, rates[1].Date.Time().IsZero())
+
+	_, err = ReadExchangeRates(strings.NewReader("base,quote\nEUR,USD\n"))
+	require.Error(t, err)
+	_, err = ReadExchangeRates(strings.NewReader("base,quote,rate\nEUR,USD,lots\n"))
+	require.Error(t, err)
+	_, err = ReadExchangeRates(strings.NewReader("  \n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,363 @@
+package reporting
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/go-pdf/fpdf"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+)
+
+// InsuranceReport is the data of an insurance inventory report.
+type InsuranceReport struct {
+	Collection  string
+	GeneratedAt time.Time
+	// Filters describes the filters the entities were selected with, if any.
+	Filters []string
+
+	Stats    repo.GroupStatistics
+	Entities []repo.EntityOut
+	// Locations holds the location path of each entity, by entity ID.
+	// Entities without one are listed last.
+	Locations map[uuid.UUID]string
+	// Thumbnails holds the primary photo thumbnail of each entity that has
+	// one, by entity ID.
+	Thumbnails map[uuid.UUID]image.Image
+	Converter  *repo.CurrencyConverter
+}
+
+const (
+	reportMargin    = 15.0
+	reportRowHeight = 18.0
+	reportThumbSize = 16.0
+	noLocation      = "No location"
+)
+
+// reportColumns are the item table's columns; their widths fill the page
+// between the margins.
+var reportColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"", 18, "L"},
+	{"Item", 62, "L"},
+	{"Purchased", 22, "L"},
+	{"Qty", 12, "R"},
+	{"Unit Price", 22, "R"},
+	{"Value", 22, "R"},
+	{"Warranty", 22, "L"},
+}
+
+func reportWidth() float64 {
+	var w float64
+	for _, c := range reportColumns {
+		w += c.width
+	}
+	return w
+}
+
+type reportRow struct {
+	entity repo.EntityOut
+	price  float64 // per unit, converted
+	value  float64 // current value of all units, converted
+}
+
+type reportSection struct {
+	location string
+	rows     []reportRow
+	price    float64
+	value    float64
+}
+
+// InsuranceReportPDF renders report as an A4 PDF: a cover page summarising
+// the collection and the selected entities, followed by the entities grouped
+// by location with their photo, identifiers, purchase, value and warranty.
+// Amounts are in the collection's currency.
+func InsuranceReportPDF(report InsuranceReport) ([]byte, error) {
+	sections, price, value := reportSections(report)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(reportMargin, reportMargin, reportMargin)
+	pdf.SetAutoPageBreak(false, reportMargin)
+	pdf.AliasNbPages("")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	currency := report.Converter.Currency
+	_, pageHeight := pdf.GetPageSize()
+	bottom := pageHeight - reportMargin - 8
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-10)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(0, 5, tr(report.Collection+" - "+report.GeneratedAt.Format("2006-01-02")), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	// Cover
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 22)
+	pdf.CellFormat(0, 12, "Insurance Inventory", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 13)
+	pdf.CellFormat(0, 8, tr(report.Collection), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, "Generated "+report.GeneratedAt.Format("2006-01-02 15:04 MST"), "", 1, "L", false, 0, "")
+	for _, f := range report.Filters {
+		pdf.CellFormat(0, 6, tr(f), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	summary := func(title string, rows [][2]string) {
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(0, 8, title, "B", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		for _, r := range rows {
+			pdf.CellFormat(90, 7, r[0], "", 0, "L", false, 0, "")
+			pdf.CellFormat(0, 7, r[1], "", 1, "R", false, 0, "")
+		}
+		pdf.Ln(4)
+	}
+
+	items := 0
+	for _, s := range sections {
+		items += len(s.rows)
+	}
+	summary("This Report", [][2]string{
+		{"Items", fmt.Sprint(items)},
+		{"Locations", fmt.Sprint(len(sections))},
+		{"Total purchase price", formatMoney(price, currency)},
+		{"Total current value", formatMoney(value, currency)},
+	})
+	summary("Collection", [][2]string{
+		{"Items", fmt.Sprint(report.Stats.TotalItems)},
+		{"Locations", fmt.Sprint(report.Stats.TotalLocations)},
+		{"Tags", fmt.Sprint(report.Stats.TotalTags)},
+		{"Items under warranty", fmt.Sprint(report.Stats.TotalWithWarranty)},
+		{"Total purchase price", formatMoney(report.Stats.TotalItemPrice, currency)},
+		{"Total current value", formatMoney(report.Stats.TotalCurrentValue, currency)},
+	})
+
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, "Locations", "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, s := range sections {
+		if pdf.GetY()+7 > bottom {
+			pdf.AddPage()
+		}
+		pdf.CellFormat(90, 7, tr(s.location), "", 0, "L", false, 0, "")
+		pdf.CellFormat(30, 7, fmt.Sprintf("%d items", len(s.rows)), "", 0, "R", false, 0, "")
+		pdf.CellFormat(0, 7, formatMoney(s.value, currency), "", 1, "R", false, 0, "")
+	}
+
+	// Items
+	header := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.SetFillColor(235, 235, 235)
+		for _, c := range reportColumns {
+			pdf.CellFormat(c.width, 7, c.title, "", 0, c.align, true, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	for _, s := range sections {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(0, 10, tr(s.location), "", 1, "L", false, 0, "")
+		header()
+
+		for _, row := range s.rows {
+			if pdf.GetY()+reportRowHeight > bottom {
+				pdf.AddPage()
+				header()
+			}
+			reportEntityRow(pdf, tr, report, row)
+		}
+
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(reportColumns[0].width+reportColumns[1].width+reportColumns[2].width+reportColumns[3].width, 8, "Total", "T", 0, "L", false, 0, "")
+		pdf.CellFormat(reportColumns[4].width, 8, formatMoney(s.price, ""), "T", 0, "R", false, 0, "")
+		pdf.CellFormat(reportColumns[5].width, 8, formatMoney(s.value, ""), "T", 0, "R", false, 0, "")
+		pdf.CellFormat(reportColumns[6].width, 8, currency, "T", 1, "L", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func reportEntityRow(pdf *fpdf.Fpdf, tr func(string) string, report InsuranceReport, row reportRow) {
+	e := row.entity
+	x, y := pdf.GetXY()
+
+	if img, ok := report.Thumbnails[e.ID]; ok {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80}); err == nil {
+			name := e.ID.String()
+			opts := fpdf.ImageOptions{ImageType: "JPG"}
+			pdf.RegisterImageOptionsReader(name, opts, &buf)
+			if pdf.Ok() {
+				w, h := fitInto(img.Bounds().Dx(), img.Bounds().Dy(), reportThumbSize)
+				pdf.ImageOptions(name, x+1+(reportThumbSize-w)/2, y+1+(reportThumbSize-h)/2, w, h, false, opts, 0, "")
+			} else {
+				// A thumbnail that can't be embedded shouldn't lose the report.
+				pdf.ClearError()
+			}
+		}
+	}
+
+	// Name and identifiers stack in the item column.
+	col := x + reportColumns[0].width
+	pdf.SetXY(col, y+1)
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(reportColumns[1].width, 5, tr(truncate(e.Name, 40)), "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 7.5)
+	var ids []string
+	if e.SerialNumber != "" {
+		ids = append(ids, "S/N "+e.SerialNumber)
+	}
+	if e.ModelNumber != "" {
+		ids = append(ids, "Model "+e.ModelNumber)
+	}
+	pdf.CellFormat(reportColumns[1].width, 4, tr(truncate(strings.Join(ids, "  "), 55)), "", 2, "L", false, 0, "")
+	pdf.CellFormat(reportColumns[1].width, 4, tr(truncate(e.Manufacturer, 55)), "", 2, "L", false, 0, "")
+
+	pdf.SetXY(col+reportColumns[1].width, y)
+	pdf.SetFont("Helvetica", "", 8.5)
+	purchased := ""
+	if t := e.PurchaseDate.Time(); !t.IsZero() {
+		purchased = t.Format("2006-01-02")
+	}
+	cells := []string{
+		purchased,
+		formatQuantity(e.Quantity),
+		formatMoney(row.price, ""),
+		formatMoney(row.value, ""),
+		warrantyStatus(e, report.GeneratedAt),
+	}
+	for i, text := range cells {
+		c := reportColumns[i+2]
+		pdf.CellFormat(c.width, reportRowHeight, tr(text), "", 0, c.align, false, 0, "")
+	}
+
+	pdf.SetDrawColor(220, 220, 220)
+	pdf.Line(x, y+reportRowHeight, x+reportWidth(), y+reportRowHeight)
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetXY(x, y+reportRowHeight)
+}
+
+// reportSections groups the entities of report by location, sorted by
+// location and name, and totals them.
+func reportSections(report InsuranceReport) ([]reportSection, float64, float64) {
+	byLocation := map[string]*reportSection{}
+	var totalPrice, totalValue float64
+
+	for _, e := range report.Entities {
+		loc := report.Locations[e.ID]
+		if loc == "" {
+			loc = noLocation
+		}
+		s, ok := byLocation[loc]
+		if !ok {
+			s = &reportSection{location: loc}
+			byLocation[loc] = s
+		}
+
+		conv := report.Converter
+		price := conv.Convert(e.PurchasePrice, e.PurchaseCurrency, e.PurchaseDate.Time())
+		value := conv.Convert(e.CurrentValue, e.PurchaseCurrency, e.PurchaseDate.Time()) * e.Quantity
+
+		s.rows = append(s.rows, reportRow{entity: e, price: price, value: value})
+		s.price += price * e.Quantity
+		s.value += value
+		totalPrice += price * e.Quantity
+		totalValue += value
+	}
+
+	sections := make([]reportSection, 0, len(byLocation))
+	for _, s := range byLocation {
+		slices.SortFunc(s.rows, func(a, b reportRow) int {
+			return strings.Compare(strings.ToLower(a.entity.Name), strings.ToLower(b.entity.Name))
+		})
+		sections = append(sections, *s)
+	}
+	slices.SortFunc(sections, func(a, b reportSection) int {
+		switch {
+		case a.location == noLocation:
+			return 1
+		case b.location == noLocation:
+			return -1
+		}
+		return strings.Compare(strings.ToLower(a.location), strings.ToLower(b.location))
+	})
+
+	return sections, totalPrice, totalValue
+}
+
+func warrantyStatus(e repo.EntityOut, now time.Time) string {
+	expires := e.WarrantyExpires.Time()
+	switch {
+	case e.LifetimeWarranty:
+		return "Lifetime"
+	case expires.IsZero():
+		return "None"
+	case expires.Before(now):
+		return "Expired"
+	default:
+		return "Until " + expires.Format("2006-01-02")
+	}
+}
+
+// fitInto scales w by h to fit a square of size, keeping the aspect ratio.
+func fitInto(w, h int, size float64) (float64, float64) {
+	if w <= 0 || h <= 0 {
+		return size, size
+	}
+	if w >= h {
+		return size, size * float64(h) / float64(w)
+	}
+	return size * float64(w) / float64(h), size
+}
+
+// formatMoney formats amount with two decimals and thousands separators,
+// followed by currency when it's given.
+func formatMoney(amount float64, currency string) string {
+	cents := int64(math.Round(math.Abs(amount) * 100))
+	whole := fmt.Sprint(cents / 100)
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+
+	s := fmt.Sprintf("%s.%02d", whole, cents%100)
+	if amount < 0 && cents != 0 {
+		s = "-" + s
+	}
+	if currency != "" {
+		s += " " + currency
+	}
+	return s
+}
+
+func formatQuantity(q float64) string {
+	if q == math.Trunc(q) {
+		return fmt.Sprint(int64(q))
+	}
+	return fmt.Sprintf("%.2f", q)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "..."
+}
@@ -0,0 +1,59 @@
+package reporting
+
+import (
+	"bytes"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestInsuranceReportPDF(t *testing.T) {
+	drill := repo.EntityOut{EntitySummary: repo.EntitySummary{ID: uuid.New(), Name: "Drill", Quantity: 1, PurchasePrice: 120}, SerialNumber: "SN-1", CurrentValue: 100}
+	rake := repo.EntityOut{EntitySummary: repo.EntitySummary{ID: uuid.New(), Name: "Rake", Quantity: 2, PurchasePrice: 15}, CurrentValue: 15, LifetimeWarranty: true}
+	box := repo.EntityOut{EntitySummary: repo.EntitySummary{ID: uuid.New(), Name: "Box", Quantity: 1}}
+
+	report := InsuranceReport{
+		Collection:  "Home",
+		GeneratedAt: time.Now(),
+		Entities:    []repo.EntityOut{rake, box, drill},
+		Locations:   map[uuid.UUID]string{drill.ID: "Garage", rake.ID: "Garage"},
+		Thumbnails:  map[uuid.UUID]image.Image{drill.ID: image.NewRGBA(image.Rect(0, 0, 40, 20))},
+		Converter:   &repo.CurrencyConverter{Currency: "USD"},
+	}
+
+	sections, price, value := reportSections(report)
+	require.Len(t, sections, 2)
+	assert.Equal(t, "Garage", sections[0].location)
+	assert.Equal(t, "Drill", sections[0].rows[0].entity.Name)
+	assert.Equal(t, noLocation, sections[1].location)
+	assert.InDelta(t, 150, price, 0)
+	assert.InDelta(t, 130, value, 0)
+
+	// Enough rows to need more than one page for a location.
+	for range 40 {
+		report.Entities = append(report.Entities, drill)
+	}
+	pdf, err := InsuranceReportPDF(report)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+}
+
+func TestWarrantyStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Lifetime", warrantyStatus(repo.EntityOut{LifetimeWarranty: true}, now))
+	assert.Equal(t, "None", warrantyStatus(repo.EntityOut{}, now))
+	assert.Equal(t, "Expired", warrantyStatus(repo.EntityOut{WarrantyExpires: types.DateFromString("2025-01-01")}, now))
+	assert.Equal(t, "Until 2026-01-01", warrantyStatus(repo.EntityOut{WarrantyExpires: types.DateFromString("2026-01-01")}, now))
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "0.00", formatMoney(0, ""))
+	assert.Equal(t, "1,234,567.89 EUR", formatMoney(1234567.891, "EUR"))
+	assert.Equal(t, "-999.50", formatMoney(-999.5, ""))
+}
@@ -200,10 +200,16 @@ func (s *IOSheet) Read(data io.Reader) error {
 	return nil
 }
 
-// ReadItems writes the sheet to a writer.
+// ReadItems writes the sheet to a writer. Prices are converted into the
+// group's currency.
 func (s *IOSheet) ReadItems(ctx context.Context, entities []repo.EntityOut, gid uuid.UUID, repos *repo.AllRepos, hbURL string) error {
 	s.Rows = make([]ExportCSVRow, len(entities))
 
+	conv, err := repos.ExchangeRates.Converter(ctx, gid)
+	if err != nil {
+		return err
+	}
+
 	extraHeaders := map[string]struct{}{}
 	entitiesByID := lo.SliceToMap(entities, func(item repo.EntityOut) (uuid.UUID, repo.EntityOut) {
 		return item.ID, item
@@ -265,7 +271,7 @@ func (s *IOSheet) ReadItems(ctx context.Context, entities []repo.EntityOut, gid
 			Archived:        item.Archived,
 			URL:             url,
 
-			PurchasePrice: item.PurchasePrice,
+			PurchasePrice: conv.Convert(item.PurchasePrice, item.PurchaseCurrency, item.PurchaseDate.Time()),
 			PurchaseFrom:  item.PurchaseFrom,
 			PurchaseDate:  item.PurchaseDate,
 
@@ -279,7 +285,7 @@ func (s *IOSheet) ReadItems(ctx context.Context, entities []repo.EntityOut, gid
 
 			SoldTo:    item.SoldTo,
 			SoldDate:  item.SoldDate,
-			SoldPrice: item.SoldPrice,
+			SoldPrice: conv.Convert(item.SoldPrice, item.SoldCurrency, item.SoldDate.Time()),
 			SoldNotes: item.SoldNotes,
 
 			Notes:  item.Notes,
@@ -5,16 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/nicholas-fedor/shoutrrr"
 	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
-	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
-	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 )
 
 type Latest struct {
@@ -22,9 +18,9 @@ type Latest struct {
 	Date    string `json:"date"`
 }
 type BackgroundService struct {
-	repos          *repo.AllRepos
-	latest         Latest
-	notifierConfig *config.NotifierConf
+	repos         *repo.AllRepos
+	latest        Latest
+	notifications *NotificationService
 }
 
 func (svc *BackgroundService) SendNotifiersToday(ctx context.Context) error {
@@ -52,57 +48,126 @@ func (svc *BackgroundService) SendNotifiersToday(ctx context.Context) error {
 			continue
 		}
 
-		notifiers, err := svc.repos.Notifiers.GetActiveByGroup(ctx, group.ID)
+		_, sendErrs := svc.notifications.Notify(ctx, NotificationEvent{
+			Kind:    repo.NotifierEventMaintenanceDue,
+			GroupID: group.ID,
+			Group:   group.Name,
+			Date:    today,
+			Data:    entries,
+		})
+		if len(sendErrs) > 0 {
+			return sendErrs[0]
+		}
+	}
+
+	return nil
+}
+
+// SendWarrantyNotifications sends each group a digest of the entities whose
+// warranty expires within one of the group's configured lead times. Every
+// entity/threshold pair is only sent once; the reminders are recorded as
+// soon as at least one notifier accepted the digest.
+func (svc *BackgroundService) SendWarrantyNotifications(ctx context.Context) error {
+	groups, err := svc.repos.Groups.GetAllGroups(ctx, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	today := types.DateFromTime(time.Now())
+
+	var errs []error
+	for i := range groups {
+		group := groups[i]
+		if len(group.WarrantyNotifyDays) == 0 {
+			continue
+		}
+
+		reminders, err := svc.repos.WarrantyNotifications.GetDue(ctx, group.ID, group.WarrantyNotifyDays, today.Time())
 		if err != nil {
 			return err
 		}
 
-		if len(notifiers) == 0 {
+		if len(reminders) == 0 {
 			log.Debug().
 				Str("group_name", group.Name).
 				Str("group_id", group.ID.String()).
-				Msg("No active notifiers configured")
+				Msg("No warranties expiring soon")
 			continue
 		}
 
-		bldr := strings.Builder{}
+		sent, sendErrs := svc.notifications.Notify(ctx, NotificationEvent{
+			Kind:    repo.NotifierEventWarrantyExpiring,
+			GroupID: group.ID,
+			Group:   group.Name,
+			Date:    today,
+			Data:    reminders,
+		})
+		errs = append(errs, sendErrs...)
+		if sent == 0 {
+			continue
+		}
+
+		if err := svc.repos.WarrantyNotifications.MarkSent(ctx, reminders); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// SendLoanReminders sends each group a digest of the loans that are past
+// their due date. Each loan is only reminded about once; it is recorded as
+// soon as at least one notifier accepted the digest.
+func (svc *BackgroundService) SendLoanReminders(ctx context.Context) error {
+	groups, err := svc.repos.Groups.GetAllGroups(ctx, uuid.Nil)
+	if err != nil {
+		return err
+	}
 
-		bldr.WriteString("Homebox Maintenance for (")
-		bldr.WriteString(today.String())
-		bldr.WriteString("):\n")
+	today := types.DateFromTime(time.Now())
 
-		for i := range entries {
-			entry := entries[i]
-			bldr.WriteString(" - ")
-			bldr.WriteString(entry.Name)
-			bldr.WriteString("\n")
+	var errs []error
+	for i := range groups {
+		group := groups[i]
+
+		reminders, err := svc.repos.Loans.GetOverdue(ctx, group.ID, today.Time())
+		if err != nil {
+			return err
 		}
 
-		var sendErrs []error
-		for i := range notifiers {
-			// Validate notifier URL before sending
-			if err := validate.ValidateNotifierURL(notifiers[i].URL, svc.notifierConfig); err != nil {
-				log.Error().
-					Err(err).
-					Str("notifier_id", notifiers[i].ID.String()).
-					Str("notifier_name", notifiers[i].Name).
-					Msg("notifier URL failed validation, skipping")
-				sendErrs = append(sendErrs, fmt.Errorf("notifier %s failed validation: %w", notifiers[i].Name, err))
-				continue
-			}
-
-			err := shoutrrr.Send(notifiers[i].URL, bldr.String())
-
-			if err != nil {
-				sendErrs = append(sendErrs, err)
-			}
+		if len(reminders) == 0 {
+			log.Debug().
+				Str("group_name", group.Name).
+				Str("group_id", group.ID.String()).
+				Msg("No overdue loans")
+			continue
 		}
 
-		if len(sendErrs) > 0 {
-			return sendErrs[0]
+		sent, sendErrs := svc.notifications.Notify(ctx, NotificationEvent{
+			Kind:    repo.NotifierEventLoanOverdue,
+			GroupID: group.ID,
+			Group:   group.Name,
+			Date:    today,
+			Data:    reminders,
+		})
+		errs = append(errs, sendErrs...)
+		if sent == 0 {
+			continue
+		}
+
+		if err := svc.repos.Loans.MarkReminded(ctx, reminders); err != nil {
+			return err
 		}
 	}
 
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
 	return nil
 }
 
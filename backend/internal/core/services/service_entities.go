@@ -184,6 +184,9 @@ func (svc *EntityService) CsvImport(ctx context.Context, gid uuid.UUID, data io.
 		trace.WithAttributes(attribute.String("group.id", gid.String())))
 	defer span.End()
 
+	// Attribute every change made below to the importer in the audit log.
+	ctx = repo.WithAuditSource(ctx, repo.AuditSourceImport)
+
 	_, readSpan := entityServiceTracer().Start(ctx, "service.EntityService.CsvImport.readCsv")
 	sheet := reporting.IOSheet{}
 
@@ -453,6 +456,17 @@ func (svc *EntityService) CsvImport(ctx context.Context, gid uuid.UUID, data io.
 
 			Notes:  row.Notes,
 			Fields: fields,
+
+			// The CSV has no stock or depreciation columns; keep what the
+			// entity already has. Its prices are in the group's currency, so
+			// the price currencies are left empty.
+			MinQuantity:        entity.MinQuantity,
+			ReorderPoint:       entity.ReorderPoint,
+			Unit:               entity.Unit,
+			DepreciationMethod: entity.DepreciationMethod,
+			UsefulLifeYears:    entity.UsefulLifeYears,
+			SalvageValue:       entity.SalvageValue,
+			ManualValue:        entity.ManualValue,
 		}
 
 		_, err = svc.repo.Entities.UpdateByGroup(ctx, gid, updateEntity)
@@ -563,13 +577,13 @@ func (svc *EntityService) patchCSVParentRefs(ctx context.Context, gid uuid.UUID,
 	return nil
 }
 
-func (svc *EntityService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL string) ([][]string, error) {
+func (svc *EntityService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL string, scope *repo.EntityQuery) ([][]string, error) {
 	ctx, span := entityServiceTracer().Start(ctx, "service.EntityService.ExportCSV",
 		trace.WithAttributes(attribute.String("group.id", gid.String())))
 	defer span.End()
 
 	loadCtx, loadSpan := entityServiceTracer().Start(ctx, "service.EntityService.ExportCSV.load")
-	items, err := svc.repo.Entities.GetAll(loadCtx, gid)
+	items, err := svc.scopedEntities(loadCtx, gid, scope)
 	if err != nil {
 		recordServiceSpanError(loadSpan, err)
 		loadSpan.End()
@@ -603,13 +617,13 @@ func (svc *EntityService) ExportCSV(ctx context.Context, gid uuid.UUID, hbURL st
 	return rows, nil
 }
 
-func (svc *EntityService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid.UUID) ([]byte, error) {
+func (svc *EntityService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid.UUID, scope *repo.EntityQuery) ([]byte, error) {
 	ctx, span := entityServiceTracer().Start(ctx, "service.EntityService.ExportBillOfMaterialsCSV",
 		trace.WithAttributes(attribute.String("group.id", gid.String())))
 	defer span.End()
 
 	loadCtx, loadSpan := entityServiceTracer().Start(ctx, "service.EntityService.ExportBillOfMaterialsCSV.load")
-	items, err := svc.repo.Entities.GetAll(loadCtx, gid)
+	items, err := svc.scopedEntities(loadCtx, gid, scope)
 	if err != nil {
 		recordServiceSpanError(loadSpan, err)
 		loadSpan.End()
@@ -620,9 +634,15 @@ func (svc *EntityService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid
 	loadSpan.End()
 	span.SetAttributes(attribute.Int("entities.count", len(items)))
 
+	conv, err := svc.repo.ExchangeRates.Converter(ctx, gid)
+	if err != nil {
+		recordServiceSpanError(span, err)
+		return nil, err
+	}
+
 	_, encodeSpan := entityServiceTracer().Start(ctx, "service.EntityService.ExportBillOfMaterialsCSV.encode")
 	defer encodeSpan.End()
-	out, err := reporting.BillOfMaterialsCSV(items)
+	out, err := reporting.BillOfMaterialsCSV(items, conv)
 	if err != nil {
 		recordServiceSpanError(encodeSpan, err)
 		recordServiceSpanError(span, err)
@@ -631,3 +651,12 @@ func (svc *EntityService) ExportBillOfMaterialsCSV(ctx context.Context, gid uuid
 	encodeSpan.SetAttributes(attribute.Int("bytes.size", len(out)))
 	return out, nil
 }
+
+// scopedEntities returns the entities matching scope, or every entity in the
+// group when scope is nil.
+func (svc *EntityService) scopedEntities(ctx context.Context, gid uuid.UUID, scope *repo.EntityQuery) ([]repo.EntityOut, error) {
+	if scope == nil {
+		return svc.repo.Entities.GetAll(ctx, gid)
+	}
+	return svc.repo.Entities.GetAllMatching(ctx, gid, *scope)
+}
@@ -28,7 +28,9 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/tag"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/webhook"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/utils"
@@ -86,6 +88,10 @@ type tableSpec struct {
 	// time this row is inserted (self-references and forward-circular refs).
 	// They are nulled on insert and patched in a second pass.
 	deferCols map[string]string
+	// jsonFKCols are JSON arrays of IDs: { column → target table }. Each ID is
+	// remapped like an fkCols value; IDs the import didn't create are dropped
+	// since nothing enforces them.
+	jsonFKCols map[string]string
 }
 
 // exportTables defines the export/import schema. Order matters: imports run
@@ -107,6 +113,7 @@ type tableSpec struct {
 // We do not currently rewrite UUIDs nested inside JSON columns, so that
 // reference is lost on import. Templates and tags both still come across
 // individually; only the template→tag default association is dropped.
+// saved_searches lists its JSON ID columns in jsonFKCols instead.
 var exportTables = []tableSpec{
 	{
 		name:      "entity_types",
@@ -155,6 +162,18 @@ var exportTables = []tableSpec{
 		pkCol:  "id",
 		fkCols: map[string]string{"entity_id": entitiesTable},
 	},
+	{
+		name:   "stock_movements",
+		scope:  "entity_id IN (SELECT id FROM entities WHERE group_entities = ?)",
+		pkCol:  "id",
+		fkCols: map[string]string{"entity_id": entitiesTable},
+	},
+	{
+		name:   "loans",
+		scope:  "entity_id IN (SELECT id FROM entities WHERE group_entities = ?)",
+		pkCol:  "id",
+		fkCols: map[string]string{"entity_id": entitiesTable},
+	},
 	{
 		// Two-part scope: the regular attachments owned by an entity in this
 		// group, PLUS the thumbnail rows those attachments point at (which
@@ -175,6 +194,19 @@ var exportTables = []tableSpec{
 		scope:  "tag_id IN (SELECT id FROM tags WHERE group_tags = ?)",
 		fkCols: map[string]string{"tag_id": "tags", "entity_id": entitiesTable},
 	},
+	{
+		name:       "saved_searches",
+		scope:      "group_id = ?",
+		pkCol:      "id",
+		groupCols:  []string{"group_id"},
+		jsonFKCols: map[string]string{"tag_ids": "tags", "parent_ids": entitiesTable},
+	},
+	{
+		name:      "exchange_rates",
+		scope:     "group_id = ?",
+		pkCol:     "id",
+		groupCols: []string{"group_id"},
+	},
 	{
 		name:      "notifiers",
 		scope:     "group_id = ?",
@@ -182,6 +214,20 @@ var exportTables = []tableSpec{
 		groupCols: []string{"group_id"},
 		userCols:  []string{"user_id"},
 	},
+	{
+		name:   "notifier_subscriptions",
+		scope:  "notifier_id IN (SELECT id FROM notifiers WHERE group_id = ?)",
+		pkCol:  "id",
+		fkCols: map[string]string{"notifier_id": "notifiers", "saved_search_id": "saved_searches"},
+	},
+	{
+		// The delivery log is not exported, and neither is the signing
+		// secret: the destination group signs with its own.
+		name:      "webhooks",
+		scope:     "group_id = ?",
+		pkCol:     "id",
+		groupCols: []string{"group_id"},
+	},
 }
 
 // Manifest is the contents of manifest.json inside the export zip.
@@ -206,6 +252,8 @@ type ExportService struct {
 	pubSubConn string
 	dialect    string // "sqlite3" or "postgres"
 
+	notifications *NotificationService
+
 	// topics caches the publisher topic per topic name so it is opened once
 	// and reused for the lifetime of the process. Publishers must never call
 	// Shutdown on these: the default mem:// driver returns a shared singleton
@@ -231,7 +279,7 @@ func (s *ExportService) Enqueue(ctx context.Context, gid uuid.UUID) (repo.Export
 		return out, err
 	}
 
-	s.publishMutation(gid)
+	s.publishMutation(gid, eventbus.MutationCreate, out.ID)
 	return out, nil
 }
 
@@ -264,7 +312,7 @@ func (s *ExportService) EnqueueImport(ctx context.Context, gid uuid.UUID, userID
 // the lazily-created "Item"/"Location" entity_types from registration are
 // tolerated — the import wipes them before restoring. Any extra rows beyond
 // those seed baselines, or any presence in tables that aren't seeded
-// (entity_templates, notifiers), blocks the import so a one-click restore
+// (entity_templates, saved_searches, notifiers, webhooks), blocks the import so a one-click restore
 // can't silently destroy work.
 //
 // The seed-baseline counts are coarse: a user who deletes some default tags
@@ -325,6 +373,14 @@ func (s *ExportService) IsGroupReadyForImport(ctx context.Context, gid uuid.UUID
 		return false, nil
 	}
 
+	searches, err := s.db.SavedSearch.Query().Where(savedsearch.GroupID(gid)).Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	if searches > 0 {
+		return false, nil
+	}
+
 	notifiers, err := s.db.Notifier.Query().Where(notifier.HasGroupWith(group.ID(gid))).Count(ctx)
 	if err != nil {
 		return false, err
@@ -333,6 +389,14 @@ func (s *ExportService) IsGroupReadyForImport(ctx context.Context, gid uuid.UUID
 		return false, nil
 	}
 
+	webhooks, err := s.db.Webhook.Query().Where(webhook.GroupID(gid)).Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	if webhooks > 0 {
+		return false, nil
+	}
+
 	return true, nil
 }
 
@@ -357,20 +421,42 @@ func (s *ExportService) RunExport(ctx context.Context, exportID, gid uuid.UUID)
 		log.Err(err).Msg("export job: failed to mark running")
 		return
 	}
-	s.publishMutation(gid)
+	s.publishMutation(gid, eventbus.MutationUpdate, exportID)
 
 	artifactPath, sizeBytes, err := s.buildArtifact(ctx, exportID, gid)
 	if err != nil {
 		log.Err(err).Stringer("export_id", exportID).Msg("export job: failed")
 		_ = s.repos.Exports.SetFailed(ctx, gid, exportID, err.Error())
-		s.publishMutation(gid)
+		s.publishMutation(gid, eventbus.MutationUpdate, exportID)
+		s.notifyJobFinished(ctx, gid, exportID, repo.NotifierEventExportFailed)
 		return
 	}
 
 	if err := s.repos.Exports.SetCompleted(ctx, gid, exportID, artifactPath, sizeBytes); err != nil {
 		log.Err(err).Msg("export job: failed to mark completed")
 	}
-	s.publishMutation(gid)
+	s.publishMutation(gid, eventbus.MutationUpdate, exportID)
+	s.notifyJobFinished(ctx, gid, exportID, repo.NotifierEventExportCompleted)
+}
+
+// notifyJobFinished sends the finished export/import row to notifiers
+// subscribed to kind. The row is re-read so the payload carries the final
+// status and error message.
+func (s *ExportService) notifyJobFinished(ctx context.Context, gid, id uuid.UUID, kind repo.NotifierEvent) {
+	if s.notifications == nil {
+		return
+	}
+
+	row, err := s.repos.Exports.Get(ctx, gid, id)
+	if err != nil {
+		log.Warn().Err(err).Stringer("export_id", id).Msg("job notification: failed to load row")
+		return
+	}
+
+	evt := s.notifications.NewNotificationEvent(ctx, gid, kind, row)
+	if _, errs := s.notifications.Notify(ctx, evt); len(errs) > 0 {
+		log.Warn().Err(errors.Join(errs...)).Stringer("export_id", id).Str("event", string(kind)).Msg("job notification: delivery failed")
+	}
 }
 
 // buildArtifact does the actual zip generation: dump every group-scoped
@@ -623,9 +709,9 @@ func (s *ExportService) publishImportJob(ctx context.Context, gid, userID, impor
 	})
 }
 
-func (s *ExportService) publishMutation(gid uuid.UUID) {
+func (s *ExportService) publishMutation(gid uuid.UUID, action eventbus.MutationAction, exportID uuid.UUID) {
 	if s.bus != nil {
-		s.bus.Publish(eventbus.EventExportMutation, eventbus.GroupMutationEvent{GID: gid})
+		s.bus.Publish(eventbus.EventExportMutation, eventbus.GroupMutationEvent{GID: gid, Action: action, IDs: []uuid.UUID{exportID}})
 	}
 }
 
@@ -739,7 +825,8 @@ func (s *ExportService) RunImport(ctx context.Context, gid, userID, importID uui
 	if !strings.HasPrefix(uploadKey, prefix) {
 		log.Error().Str("upload_key", uploadKey).Stringer("gid", gid).Msg("import job: upload key outside group prefix, refusing")
 		_ = s.repos.Exports.SetFailed(ctx, gid, importID, "upload outside group prefix")
-		s.publishImportFinished(gid)
+		s.publishImportFinished(gid, importID)
+		s.notifyJobFinished(ctx, gid, importID, repo.NotifierEventImportFinished)
 		return
 	}
 
@@ -747,7 +834,7 @@ func (s *ExportService) RunImport(ctx context.Context, gid, userID, importID uui
 		log.Err(err).Stringer("import_id", importID).Msg("import job: failed to mark running")
 		return
 	}
-	s.publishImportFinished(gid)
+	s.publishImportFinished(gid, importID)
 
 	if err := s.runImport(ctx, gid, userID, importID, uploadKey); err != nil {
 		log.Err(err).Stringer("gid", gid).Msg("import job: failed")
@@ -766,7 +853,8 @@ func (s *ExportService) RunImport(ctx context.Context, gid, userID, importID uui
 		log.Warn().Err(err).Str("upload_key", uploadKey).Msg("import job: failed to clean staging upload")
 	}
 
-	s.publishImportFinished(gid)
+	s.publishImportFinished(gid, importID)
+	s.notifyJobFinished(ctx, gid, importID, repo.NotifierEventImportFinished)
 }
 
 func (s *ExportService) runImport(ctx context.Context, gid, userID, importID uuid.UUID, uploadKey string) error {
@@ -776,7 +864,7 @@ func (s *ExportService) runImport(ctx context.Context, gid, userID, importID uui
 		if err := s.repos.Exports.SetProgress(ctx, gid, importID, pct); err != nil {
 			log.Warn().Err(err).Stringer("import_id", importID).Int("pct", pct).Msg("import job: failed to update progress")
 		}
-		s.publishImportFinished(gid)
+		s.publishImportFinished(gid, importID)
 	}
 
 	// Precondition: no items (non-location entities) in this group. Default
@@ -895,10 +983,17 @@ func (s *ExportService) runImport(ctx context.Context, gid, userID, importID uui
 	}
 	setProgress(95)
 
+	// Rows were inserted with raw SQL, so the search index hooks never saw
+	// them. A failure leaves the import usable; the next startup sync or a
+	// manual rebuild fills the gaps.
+	if _, err := s.repos.Search.RebuildGroup(ctx, gid); err != nil {
+		log.Warn().Err(err).Stringer("gid", gid).Msg("import job: failed to rebuild search index")
+	}
+
 	// Notify the frontend that lots of things just appeared.
 	if s.bus != nil {
-		s.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: gid})
-		s.bus.Publish(eventbus.EventTagMutation, eventbus.GroupMutationEvent{GID: gid})
+		s.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: gid, Action: eventbus.MutationCreate})
+		s.bus.Publish(eventbus.EventTagMutation, eventbus.GroupMutationEvent{GID: gid, Action: eventbus.MutationCreate})
 	}
 	return nil
 }
@@ -985,9 +1080,9 @@ func (s *ExportService) deleteUpload(ctx context.Context, uploadKey string) erro
 	return bucket.Delete(ctx, s.repos.Attachments.GetFullPath(uploadKey))
 }
 
-func (s *ExportService) publishImportFinished(gid uuid.UUID) {
+func (s *ExportService) publishImportFinished(gid, importID uuid.UUID) {
 	if s.bus != nil {
-		s.bus.Publish(eventbus.EventImportMutation, eventbus.GroupMutationEvent{GID: gid})
+		s.bus.Publish(eventbus.EventImportMutation, eventbus.GroupMutationEvent{GID: gid, Action: eventbus.MutationUpdate, IDs: []uuid.UUID{importID}})
 	}
 }
 
@@ -1387,6 +1482,15 @@ func remapImportRow(
 			row[col] = remapFK(target, v)
 		}
 	}
+	for col, target := range spec.jsonFKCols {
+		if v, ok := row[col]; ok {
+			remapped, err := remapJSONIDs(target, v, remapFK)
+			if err != nil {
+				return "", fmt.Errorf("%s.%s: %w", spec.name, col, err)
+			}
+			row[col] = remapped
+		}
+	}
 	// Attachment paths are "{group_id}/documents/{hash}"; rewrite the source
 	// gid prefix to the destination so the row points at where we will
 	// actually upload the blob and so cascade-cleanup on group delete sweeps
@@ -1406,6 +1510,30 @@ func remapImportRow(
 	return newID, nil
 }
 
+// remapJSONIDs remaps a JSON array of IDs held in a text column. remapFK
+// hands back unknown IDs unchanged, and those are dropped.
+func remapJSONIDs(target string, v any, remapFK func(target string, v any) any) (any, error) {
+	str, ok := v.(string)
+	if !ok || str == "" {
+		return v, nil
+	}
+	var ids []string
+	if err := json.Unmarshal([]byte(str), &ids); err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if newID, ok := remapFK(target, id).(string); ok && newID != id {
+			out = append(out, newID)
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
 // rewriteAttachmentPath validates the attachment row's path column, swaps
 // the source gid prefix for the destination gid, and re-validates the
 // result. Mutates row in place.
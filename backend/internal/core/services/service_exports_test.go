@@ -70,6 +70,13 @@ func TestExportRoundTrip(t *testing.T) {
 	_, err = tClient.Entity.UpdateOneID(item.ID).AddTagIDs(tg.ID).Save(ctx)
 	require.NoError(t, err)
 
+	// Saved search whose JSON tag list must be remapped to the new tag ID.
+	_, err = tRepos.SavedSearches.Create(ctx, src.ID, repo.SavedSearchCreate{
+		Name:               "Tools",
+		SavedSearchFilters: repo.SavedSearchFilters{TagIDs: []uuid.UUID{tg.ID, uuid.New()}},
+	})
+	require.NoError(t, err)
+
 	// Real attachment + a fabricated thumbnail row pointing at it.
 	// This is the scenario that broke before: the thumbnail row has
 	// entity_attachments=NULL and is reachable only via the parent's
@@ -84,7 +91,7 @@ func TestExportRoundTrip(t *testing.T) {
 
 	srcGroup, err := tClient.Group.Get(ctx, src.ID)
 	require.NoError(t, err)
-	thumbUpload, err := tRepos.Attachments.UploadFile(ctx, srcGroup,
+	thumbUpload, err := tRepos.Attachments.UploadFile(ctx, tClient.Blob, srcGroup,
 		repo.ItemCreateAttachment{
 			Title:   "manual-thumb",
 			Content: bytes.NewReader([]byte("dummy thumbnail body")),
@@ -174,6 +181,11 @@ func TestExportRoundTrip(t *testing.T) {
 	require.Len(t, allTags, 1, "seeded tags should have been wiped")
 	assert.Equal(t, "tools", allTags[0].Name)
 
+	searches, err := tRepos.SavedSearches.GetByGroup(ctx, dst.ID)
+	require.NoError(t, err)
+	require.Len(t, searches, 1)
+	assert.Equal(t, []uuid.UUID{tags[0].ID}, searches[0].TagIDs, "saved search tag IDs must be remapped, unknown ones dropped")
+
 	// IDs are intentionally regenerated on import (so re-importing the same
 	// archive into a server that already has the data doesn't conflict on
 	// PK). Names + relationship structure are what matters.
@@ -248,7 +260,7 @@ func TestCSVExportImportPreservesItemParent(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	rows, err := tSvc.Entities.ExportCSV(ctx, src.ID, "https://homebox.example")
+	rows, err := tSvc.Entities.ExportCSV(ctx, src.ID, "https://homebox.example", nil)
 	require.NoError(t, err)
 
 	header := rows[0]
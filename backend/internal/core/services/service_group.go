@@ -2,21 +2,30 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
 )
 
+// maxWarrantyNotifyDays is the furthest ahead, in days, a warranty reminder
+// can be configured.
+const maxWarrantyNotifyDays = 365
+
 // ErrNotGroupOwner is returned when a member of a collection attempts an action
 // reserved for the collection's owner.
 var ErrNotGroupOwner = errors.New("only the owner of this collection can perform this action")
 
 type GroupService struct {
-	repos *repo.AllRepos
+	repos         *repo.AllRepos
+	notifications *NotificationService
 }
 
 // requireOwner asserts the acting user owns the collection identified by
@@ -49,6 +58,14 @@ func (svc *GroupService) UpdateGroup(ctx Context, data repo.GroupUpdate) (repo.G
 		return repo.Group{}, errors.New("currency cannot be empty")
 	}
 
+	if data.WarrantyNotifyDays != nil {
+		days, err := normalizeWarrantyNotifyDays(*data.WarrantyNotifyDays)
+		if err != nil {
+			return repo.Group{}, err
+		}
+		data.WarrantyNotifyDays = &days
+	}
+
 	return svc.repos.Groups.GroupUpdate(ctx.Context, ctx.GID, data)
 }
 
@@ -84,7 +101,9 @@ func (svc *GroupService) DeleteGroup(ctx Context) error {
 	return svc.repos.Groups.GroupDelete(ctx.Context, ctx.GID)
 }
 
-func (svc *GroupService) NewInvitation(ctx Context, uses int, expiresAt time.Time) (repo.GroupInvitation, string, error) {
+// NewInvitation mints an invitation that lets uses people join the collection
+// with role until expiresAt. An empty role means editor.
+func (svc *GroupService) NewInvitation(ctx Context, uses int, expiresAt time.Time, role usergroup.Role) (repo.GroupInvitation, string, error) {
 	if err := svc.requireOwner(ctx); err != nil {
 		return repo.GroupInvitation{}, "", err
 	}
@@ -95,6 +114,7 @@ func (svc *GroupService) NewInvitation(ctx Context, uses int, expiresAt time.Tim
 		Token:     token.Hash,
 		Uses:      uses,
 		ExpiresAt: expiresAt,
+		Role:      role,
 	})
 	if err != nil {
 		return repo.GroupInvitation{}, "", err
@@ -141,6 +161,34 @@ func (svc *GroupService) RemoveMember(ctx Context, userID uuid.UUID) error {
 	return nil
 }
 
+// SetMemberRole changes a member's role in the collection. Owners can promote
+// other members to owner, and step down as long as another owner remains.
+func (svc *GroupService) SetMemberRole(ctx Context, userID uuid.UUID, role usergroup.Role) error {
+	if err := svc.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	err := svc.repos.Groups.SetMemberRole(ctx.Context, ctx.GID, userID, role)
+	if errors.Is(err, repo.ErrLastOwner) {
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
+// SetMemberLocations limits a member of the current group to some locations
+// and everything below them. Only owners may do this.
+func (svc *GroupService) SetMemberLocations(ctx Context, userID uuid.UUID, locationIDs []uuid.UUID) error {
+	if err := svc.requireOwner(ctx); err != nil {
+		return err
+	}
+
+	err := svc.repos.Groups.SetMemberLocations(ctx.Context, ctx.GID, userID, locationIDs)
+	if errors.Is(err, repo.ErrOwnerUnrestricted) {
+		return validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return err
+}
+
 func (svc *GroupService) DeleteInvitation(ctx Context, id uuid.UUID) error {
 	if err := svc.requireOwner(ctx); err != nil {
 		return err
@@ -151,5 +199,40 @@ func (svc *GroupService) DeleteInvitation(ctx Context, id uuid.UUID) error {
 
 func (svc *GroupService) AcceptInvitation(ctx Context, token string) (repo.Group, error) {
 	hashedToken := hasher.HashToken(token)
-	return svc.repos.Groups.InvitationAccept(ctx.Context, hashedToken, ctx.UID)
+	group, err := svc.repos.Groups.InvitationAccept(ctx.Context, hashedToken, ctx.UID)
+	if err != nil {
+		return repo.Group{}, err
+	}
+
+	if ctx.User != nil {
+		svc.notifications.NotifyAsync(ctx, NotificationEvent{
+			Kind:    repo.NotifierEventMemberJoined,
+			GroupID: group.ID,
+			Group:   group.Name,
+			Date:    types.DateFromTime(time.Now()),
+			Data:    MemberJoined{UserID: ctx.User.ID, Name: ctx.User.Name, Email: ctx.User.Email},
+		})
+	}
+
+	return group, nil
+}
+
+// normalizeWarrantyNotifyDays validates warranty reminder lead times and
+// returns them de-duplicated, largest first.
+func normalizeWarrantyNotifyDays(days []int) ([]int, error) {
+	out := make([]int, 0, len(days))
+	for _, d := range days {
+		if d < 0 || d > maxWarrantyNotifyDays {
+			err := fmt.Errorf("warranty notification lead time must be between 0 and %d days", maxWarrantyNotifyDays)
+			return nil, validate.NewRequestError(err, http.StatusBadRequest)
+		}
+		if !slices.Contains(out, d) {
+			out = append(out, d)
+		}
+	}
+	if len(out) > 10 {
+		return nil, validate.NewRequestError(errors.New("at most 10 warranty notification lead times can be set"), http.StatusBadRequest)
+	}
+	slices.SortFunc(out, func(a, b int) int { return b - a })
+	return out, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
 )
@@ -107,7 +108,7 @@ func TestGroupService_UpdateGroup_OwnerAllowed(t *testing.T) {
 func TestGroupService_NewInvitation_MemberForbidden(t *testing.T) {
 	f := newOwnershipFixture(t)
 
-	_, token, err := tSvc.Group.NewInvitation(f.memberCtx, 1, time.Now().Add(time.Hour))
+	_, token, err := tSvc.Group.NewInvitation(f.memberCtx, 1, time.Now().Add(time.Hour), usergroup.RoleEditor)
 	assertForbidden(t, err)
 	assert.Empty(t, token, "no invitation token may be minted for a non-owner")
 
@@ -119,7 +120,7 @@ func TestGroupService_NewInvitation_MemberForbidden(t *testing.T) {
 func TestGroupService_NewInvitation_OwnerAllowed(t *testing.T) {
 	f := newOwnershipFixture(t)
 
-	invitation, token, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour))
+	invitation, token, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour), usergroup.RoleEditor)
 	require.NoError(t, err)
 	assert.NotEmpty(t, token)
 	assert.Equal(t, 1, invitation.Uses)
@@ -128,7 +129,7 @@ func TestGroupService_NewInvitation_OwnerAllowed(t *testing.T) {
 func TestGroupService_DeleteInvitation_MemberForbidden(t *testing.T) {
 	f := newOwnershipFixture(t)
 
-	invitation, _, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour))
+	invitation, _, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour), usergroup.RoleEditor)
 	require.NoError(t, err)
 
 	err = tSvc.Group.DeleteInvitation(f.memberCtx, invitation.ID)
@@ -144,7 +145,7 @@ func TestGroupService_DeleteInvitation_MemberForbidden(t *testing.T) {
 func TestGroupService_DeleteInvitation_OwnerAllowed(t *testing.T) {
 	f := newOwnershipFixture(t)
 
-	invitation, _, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour))
+	invitation, _, err := tSvc.Group.NewInvitation(f.ownerCtx, 1, time.Now().Add(time.Hour), usergroup.RoleEditor)
 	require.NoError(t, err)
 
 	require.NoError(t, tSvc.Group.DeleteInvitation(f.ownerCtx, invitation.ID))
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"image"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gocloud.dev/blob"
+
+	// Thumbnails are stored as WebP.
+	_ "github.com/gen2brain/webp"
+)
+
+// InsuranceReportOptions selects the items of an insurance report. Items are
+// never archived; locations and tags include everything below them.
+type InsuranceReportOptions struct {
+	LocationIDs []uuid.UUID
+	TagIDs      []uuid.UUID
+	Insured     bool
+}
+
+// ExportInsuranceReportPDF renders the insurance inventory report of the
+// items of gid selected by opts. See reporting.InsuranceReportPDF.
+func (svc *EntityService) ExportInsuranceReportPDF(ctx context.Context, gid uuid.UUID, opts InsuranceReportOptions) ([]byte, error) {
+	ctx, span := entityServiceTracer().Start(ctx, "service.EntityService.ExportInsuranceReportPDF",
+		trace.WithAttributes(
+			attribute.String("group.id", gid.String()),
+			attribute.Int("locations.count", len(opts.LocationIDs)),
+			attribute.Int("tags.count", len(opts.TagIDs)),
+			attribute.Bool("insured", opts.Insured),
+		))
+	defer span.End()
+
+	report, err := svc.insuranceReport(ctx, gid, opts)
+	if err != nil {
+		recordServiceSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("entities.count", len(report.Entities)))
+
+	out, err := reporting.InsuranceReportPDF(report)
+	if err != nil {
+		recordServiceSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("bytes.size", len(out)))
+	return out, nil
+}
+
+func (svc *EntityService) insuranceReport(ctx context.Context, gid uuid.UUID, opts InsuranceReportOptions) (reporting.InsuranceReport, error) {
+	group, err := svc.repo.Groups.GroupByID(ctx, gid)
+	if err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+
+	// The statistics and location names are looked up before scoping to the
+	// chosen subtrees: the cover summarises the whole collection, and the
+	// filter names the locations.
+	stats, err := svc.repo.Groups.StatsGroup(ctx, gid)
+	if err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+	all, err := svc.repo.Entities.GetAll(ctx, gid)
+	if err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+	byID := make(map[uuid.UUID]repo.EntityOut, len(all))
+	for _, e := range all {
+		byID[e.ID] = e
+	}
+
+	report := reporting.InsuranceReport{
+		Collection:  group.Name,
+		GeneratedAt: time.Now(),
+		Stats:       stats,
+		Locations:   map[uuid.UUID]string{},
+		Thumbnails:  map[uuid.UUID]image.Image{},
+	}
+
+	if len(opts.LocationIDs) > 0 {
+		ctx = repo.WithEntityScope(ctx, opts.LocationIDs...)
+		names := make([]string, 0, len(opts.LocationIDs))
+		for _, id := range opts.LocationIDs {
+			if e, ok := byID[id]; ok {
+				names = append(names, e.Name)
+			}
+		}
+		report.Filters = append(report.Filters, "Locations: "+strings.Join(names, ", "))
+	}
+	if len(opts.TagIDs) > 0 {
+		tags, err := svc.repo.Tags.GetAll(ctx, gid)
+		if err != nil {
+			return reporting.InsuranceReport{}, err
+		}
+		var names []string
+		for _, t := range tags {
+			if slices.Contains(opts.TagIDs, t.ID) {
+				names = append(names, t.Name)
+			}
+		}
+		report.Filters = append(report.Filters, "Tags: "+strings.Join(names, ", "))
+	}
+	if opts.Insured {
+		report.Filters = append(report.Filters, "Insured items only")
+	}
+
+	notLocation := false
+	report.Entities, err = svc.repo.Entities.GetAllMatching(ctx, gid, repo.EntityQuery{
+		IsLocation: &notLocation,
+		TagIDs:     opts.TagIDs,
+		Insured:    opts.Insured,
+	})
+	if err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+
+	ids := make([]uuid.UUID, len(report.Entities))
+	for i, e := range report.Entities {
+		ids[i] = e.ID
+		report.Locations[e.ID] = locationPath(byID, e)
+	}
+
+	report.Converter, err = svc.repo.ExchangeRates.Converter(ctx, gid)
+	if err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+
+	if err := svc.loadThumbnails(ctx, gid, ids, report.Thumbnails); err != nil {
+		return reporting.InsuranceReport{}, err
+	}
+	return report, nil
+}
+
+// locationPath returns the names of the locations e is in, outermost first,
+// joined by slashes.
+func locationPath(byID map[uuid.UUID]repo.EntityOut, e repo.EntityOut) string {
+	var path []string
+	seen := map[uuid.UUID]bool{}
+	for p := e.Parent; p != nil && !seen[p.ID]; {
+		seen[p.ID] = true
+		parent, ok := byID[p.ID]
+		if !ok {
+			break
+		}
+		if parent.EntityType != nil && parent.EntityType.IsLocation {
+			path = append(path, parent.Name)
+		}
+		p = parent.Parent
+	}
+	slices.Reverse(path)
+	return strings.Join(path, " / ")
+}
+
+// loadThumbnails decodes the primary photo thumbnails of ids into out.
+// Thumbnails that are missing or can't be decoded are skipped.
+func (svc *EntityService) loadThumbnails(ctx context.Context, gid uuid.UUID, ids []uuid.UUID, out map[uuid.UUID]image.Image) error {
+	paths, err := svc.repo.Attachments.PrimaryThumbnailPaths(ctx, gid, ids)
+	if err != nil || len(paths) == 0 {
+		return err
+	}
+
+	bucket, err := blob.OpenBucket(ctx, svc.repo.Attachments.GetConnString())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = bucket.Close() }()
+
+	for id, path := range paths {
+		r, err := bucket.NewReader(ctx, svc.repo.Attachments.GetFullPath(path), nil)
+		if err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("insurance report: thumbnail missing, skipping")
+			continue
+		}
+		img, _, err := image.Decode(r)
+		_ = r.Close()
+		if err != nil {
+			log.Warn().Err(err).Str("path", path).Msg("insurance report: failed to decode thumbnail, skipping")
+			continue
+		}
+		out[id] = img
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityService_InsuranceReport(t *testing.T) {
+	ctx := context.Background()
+
+	grp, err := tRepos.Groups.GroupCreate(ctx, "insurance-"+fk.Str(4), uuid.Nil)
+	require.NoError(t, err)
+
+	csv := "HB.import_ref,HB.location,HB.name,HB.quantity,HB.purchase_price,HB.insured,HB.serial_number\n" +
+		"ref-1,Home / Garage,Drill,1,120,true,SN-1\n" +
+		"ref-2,Home / Garage,Rake,2,15,false,\n" +
+		"ref-3,Office,Laptop,1,900,true,SN-3\n"
+	_, err = tSvc.Entities.CsvImport(ctx, grp.ID, strings.NewReader(csv))
+	require.NoError(t, err)
+
+	report, err := tSvc.Entities.insuranceReport(ctx, grp.ID, InsuranceReportOptions{})
+	require.NoError(t, err)
+	require.Len(t, report.Entities, 3)
+	assert.Empty(t, report.Filters)
+	assert.Equal(t, 3, report.Stats.TotalItems)
+
+	paths := map[string]string{}
+	for _, e := range report.Entities {
+		paths[e.Name] = report.Locations[e.ID]
+	}
+	assert.Equal(t, map[string]string{"Drill": "Home / Garage", "Rake": "Home / Garage", "Laptop": "Office"}, paths)
+
+	drill, err := tRepos.Entities.GetByRef(ctx, grp.ID, "ref-1")
+	require.NoError(t, err)
+	require.NotNil(t, drill.Location)
+
+	report, err = tSvc.Entities.insuranceReport(ctx, grp.ID, InsuranceReportOptions{LocationIDs: []uuid.UUID{drill.Location.ID}, Insured: true})
+	require.NoError(t, err)
+	require.Len(t, report.Entities, 1)
+	assert.Equal(t, "Drill", report.Entities[0].Name)
+	assert.Equal(t, []string{"Locations: Garage", "Insured items only"}, report.Filters)
+	// The cover still summarises the whole collection.
+	assert.Equal(t, 3, report.Stats.TotalItems)
+
+	pdf, err := tSvc.Entities.ExportInsuranceReportPDF(ctx, grp.ID, InsuranceReportOptions{})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+}
@@ -114,6 +114,32 @@ func (svc *EntityService) AttachmentAdd(ctx Context, entityID uuid.UUID, filenam
 	return out, err
 }
 
+// AttachmentUploadCreate starts a resumable upload of an attachment of the
+// entity. The chunks are written with the attachment repository.
+func (svc *EntityService) AttachmentUploadCreate(ctx Context, entityID uuid.UUID, data repo.AttachmentUploadCreate) (repo.AttachmentUploadOut, error) {
+	spanCtx, span := entityServiceTracer().Start(ctx.Context, "service.EntityService.AttachmentUploadCreate",
+		trace.WithAttributes(
+			attribute.String("group.id", ctx.GID.String()),
+			attribute.String("entity.id", entityID.String()),
+			attribute.String("attachment.filename", data.Title),
+			attribute.Int64("attachment.size", data.Size),
+		))
+	defer span.End()
+	ctx.Context = spanCtx
+
+	_, err := svc.repo.Entities.GetOneByGroup(ctx, ctx.GID, entityID)
+	if err != nil {
+		recordServiceSpanError(span, err)
+		return repo.AttachmentUploadOut{}, err
+	}
+
+	out, err := svc.repo.Attachments.CreateUpload(ctx, ctx.GID, entityID, data)
+	if err != nil {
+		recordServiceSpanError(span, err)
+	}
+	return out, err
+}
+
 func (svc *EntityService) AttachmentAddExternalLink(ctx Context, entityID uuid.UUID, sourceType, externalID, title string, attType attachment.Type) (repo.EntityOut, error) {
 	spanCtx, span := entityServiceTracer().Start(ctx.Context, "service.EntityService.AttachmentAddExternalLink",
 		trace.WithAttributes(
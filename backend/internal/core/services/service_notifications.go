@@ -0,0 +1,413 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nicholas-fedor/shoutrrr"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
+)
+
+// maxNotificationBytes caps a rendered message so a runaway template can't
+// build an arbitrarily large body.
+const maxNotificationBytes = 16 << 10
+
+var errNotificationTooLarge = fmt.Errorf("rendered notification exceeds %d bytes", maxNotificationBytes)
+
+// NotificationEvent is the value notifier templates are executed against.
+// Data holds the kind-specific payload:
+//
+//	maintenance_due    []repo.MaintenanceEntryWithDetails
+//	warranty_expiring  []repo.WarrantyReminder
+//	export_completed   repo.ExportOut
+//	export_failed      repo.ExportOut
+//	import_finished    repo.ExportOut
+//	low_stock          []LowStockItem
+//	member_joined      MemberJoined
+//	loan_overdue       []repo.LoanReminder
+type NotificationEvent struct {
+	Kind    repo.NotifierEvent `json:"kind"`
+	GroupID uuid.UUID          `json:"groupId"`
+	Group   string             `json:"group"`
+	Date    types.Date         `json:"date"`
+	Data    any                `json:"data"`
+}
+
+type (
+	// LowStockItem is the low_stock payload for a single entity.
+	LowStockItem struct {
+		EntityID  uuid.UUID `json:"entityId"`
+		Name      string    `json:"name"`
+		Quantity  float64   `json:"quantity"`
+		Threshold float64   `json:"threshold"`
+	}
+
+	// MemberJoined is the member_joined payload.
+	MemberJoined struct {
+		UserID uuid.UUID `json:"userId"`
+		Name   string    `json:"name"`
+		Email  string    `json:"email"`
+	}
+)
+
+// defaultNotificationTemplates is the message sent for each event kind when a
+// subscription has no template of its own.
+var defaultNotificationTemplates = map[repo.NotifierEvent]string{
+	repo.NotifierEventMaintenanceDue: "Homebox Maintenance for ({{.Date}}):\n" +
+		"{{range .Data}} - {{.Name}}\n{{end}}",
+	repo.NotifierEventWarrantyExpiring: "Homebox Warranty Expirations ({{.Date}}):\n" +
+		"{{range .Data}} - {{.Name}}{{if not .AssetID.Nil}} [{{.AssetID}}]{{end}} expires {{.WarrantyExpires}}" +
+		" ({{if eq .DaysLeft 0}}today{{else if eq .DaysLeft 1}}in 1 day{{else}}in {{.DaysLeft}} days{{end}})\n{{end}}",
+	repo.NotifierEventExportCompleted: "Homebox export of {{.Group}} completed ({{.Data.SizeBytes}} bytes).",
+	repo.NotifierEventExportFailed:    "Homebox export of {{.Group}} failed: {{.Data.Error}}",
+	repo.NotifierEventImportFinished:  "Homebox import into {{.Group}} {{.Data.Status}}{{if .Data.Error}}: {{.Data.Error}}{{end}}.",
+	repo.NotifierEventLowStock: "Homebox Low Stock ({{.Date}}):\n" +
+		"{{range .Data}} - {{.Name}}: {{.Quantity}} left (threshold {{.Threshold}})\n{{end}}",
+	repo.NotifierEventMemberJoined: "{{.Data.Name}} joined {{.Group}} on Homebox.",
+	repo.NotifierEventLoanOverdue: "Homebox Overdue Loans ({{.Date}}):\n" +
+		"{{range .Data}} - {{.Name}}{{if not .AssetID.Nil}} [{{.AssetID}}]{{end}} lent to {{.Borrower}}, due {{.DueDate}}\n{{end}}",
+}
+
+// NotificationService renders events through each subscribed notifier's
+// template and delivers them with shoutrrr.
+type NotificationService struct {
+	repos          *repo.AllRepos
+	notifierConfig *config.NotifierConf
+	// send is shoutrrr.Send outside of tests.
+	send func(url, message string) error
+}
+
+func newNotificationService(repos *repo.AllRepos, cfg *config.NotifierConf) *NotificationService {
+	return &NotificationService{
+		repos:          repos,
+		notifierConfig: cfg,
+		send: func(url, message string) error {
+			return shoutrrr.Send(url, message)
+		},
+	}
+}
+
+// Notify sends evt to every active notifier in the group that subscribes to
+// evt.Kind and reports how many deliveries succeeded. A notifier whose URL
+// fails validation or whose template fails to render is skipped and
+// reported in errs; it never stops delivery to the others.
+func (svc *NotificationService) Notify(ctx context.Context, evt NotificationEvent) (sent int, errs []error) {
+	notifiers, err := svc.repos.Notifiers.GetActiveByEvent(ctx, evt.GroupID, evt.Kind)
+	if err != nil {
+		return 0, []error{err}
+	}
+
+	if len(notifiers) == 0 {
+		log.Debug().
+			Str("group_id", evt.GroupID.String()).
+			Str("event", string(evt.Kind)).
+			Msg("No active notifiers subscribed to event")
+		return 0, nil
+	}
+
+	// Saved searches are run at most once per event.
+	scopes := map[uuid.UUID]set.Set[uuid.UUID]{}
+
+	for i := range notifiers {
+		n := notifiers[i]
+
+		// Validate notifier URL before sending
+		if err := validate.ValidateNotifierURL(n.URL, svc.notifierConfig); err != nil {
+			log.Error().
+				Err(err).
+				Str("notifier_id", n.ID.String()).
+				Str("notifier_name", n.Name).
+				Msg("notifier URL failed validation, skipping")
+			errs = append(errs, fmt.Errorf("notifier %s failed validation: %w", n.Name, err))
+			continue
+		}
+
+		var body string
+		scoped := evt
+		if len(n.Subscriptions) > 0 {
+			sub := n.Subscriptions[0]
+			body = sub.Template
+
+			if sub.SavedSearchID != nil {
+				var ok bool
+				scoped, ok, err = svc.scopeNotification(ctx, evt, *sub.SavedSearchID, scopes)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("notifier %s saved search: %w", n.Name, err))
+					continue
+				}
+				if !ok {
+					continue
+				}
+			}
+		}
+
+		msg, err := RenderNotification(body, scoped)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("notifier_id", n.ID.String()).
+				Str("event", string(evt.Kind)).
+				Msg("failed to render notifier template, skipping")
+			errs = append(errs, fmt.Errorf("notifier %s template: %w", n.Name, err))
+			continue
+		}
+
+		if err := svc.send(n.URL, msg); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, errs
+}
+
+// scopeNotification narrows the entity list in evt.Data to the entities
+// matched by the saved search searchID. ok is false when none are left.
+// Events that aren't about entities are returned unchanged.
+func (svc *NotificationService) scopeNotification(ctx context.Context, evt NotificationEvent, searchID uuid.UUID, scopes map[uuid.UUID]set.Set[uuid.UUID]) (scoped NotificationEvent, ok bool, err error) {
+	switch evt.Data.(type) {
+	case []repo.MaintenanceEntryWithDetails, []repo.WarrantyReminder, []LowStockItem, []repo.LoanReminder:
+	default:
+		return evt, true, nil
+	}
+
+	matched, cached := scopes[searchID]
+	if !cached {
+		search, err := svc.repos.SavedSearches.GetOne(ctx, evt.GroupID, searchID)
+		if err != nil {
+			return evt, false, err
+		}
+		ids, err := svc.repos.Entities.MatchingIDs(ctx, evt.GroupID, search.EntityQuery())
+		if err != nil {
+			return evt, false, err
+		}
+		matched = set.New(ids...)
+		scopes[searchID] = matched
+	}
+
+	switch data := evt.Data.(type) {
+	case []repo.MaintenanceEntryWithDetails:
+		kept := filterByEntity(data, matched, func(e repo.MaintenanceEntryWithDetails) uuid.UUID { return e.ItemID })
+		evt.Data = kept
+		return evt, len(kept) > 0, nil
+	case []repo.WarrantyReminder:
+		kept := filterByEntity(data, matched, func(r repo.WarrantyReminder) uuid.UUID { return r.EntityID })
+		evt.Data = kept
+		return evt, len(kept) > 0, nil
+	case []LowStockItem:
+		kept := filterByEntity(data, matched, func(l LowStockItem) uuid.UUID { return l.EntityID })
+		evt.Data = kept
+		return evt, len(kept) > 0, nil
+	case []repo.LoanReminder:
+		kept := filterByEntity(data, matched, func(l repo.LoanReminder) uuid.UUID { return l.EntityID })
+		evt.Data = kept
+		return evt, len(kept) > 0, nil
+	}
+	return evt, true, nil
+}
+
+func filterByEntity[T any](items []T, keep set.Set[uuid.UUID], id func(T) uuid.UUID) []T {
+	out := make([]T, 0, len(items))
+	for _, item := range items {
+		if keep.Contains(id(item)) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// NotifyAsync is Notify for request paths: delivery happens in the
+// background and failures are only logged.
+func (svc *NotificationService) NotifyAsync(ctx context.Context, evt NotificationEvent) {
+	if svc == nil {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		if _, errs := svc.Notify(ctx, evt); len(errs) > 0 {
+			log.Warn().
+				Err(errors.Join(errs...)).
+				Str("event", string(evt.Kind)).
+				Str("group_id", evt.GroupID.String()).
+				Msg("failed to deliver notification")
+		}
+	}()
+}
+
+// subscribe sends a low_stock notification whenever the repositories report
+// an entity dropping to its reorder threshold.
+func (svc *NotificationService) subscribe(bus *eventbus.EventBus) {
+	bus.Subscribe(eventbus.EventEntityLowStock, func(data any) {
+		evt, ok := data.(eventbus.GroupMutationEvent)
+		if !ok {
+			return
+		}
+		svc.notifyLowStock(context.Background(), evt.GID, evt.IDs)
+	})
+}
+
+func (svc *NotificationService) notifyLowStock(ctx context.Context, gid uuid.UUID, ids []uuid.UUID) {
+	items := make([]LowStockItem, 0, len(ids))
+	for _, id := range ids {
+		e, err := svc.repos.Entities.GetOneByGroup(ctx, gid, id)
+		if err != nil {
+			log.Warn().Err(err).Str("entity_id", id.String()).Msg("failed to load low stock entity")
+			continue
+		}
+		// Skip entities restocked again before the event got here.
+		threshold, ok := e.StockThreshold()
+		if !ok || !e.LowStock {
+			continue
+		}
+		items = append(items, LowStockItem{
+			EntityID:  e.ID,
+			Name:      e.Name,
+			Quantity:  e.Quantity,
+			Threshold: threshold,
+		})
+	}
+	if len(items) == 0 {
+		return
+	}
+	svc.NotifyAsync(ctx, svc.NewNotificationEvent(ctx, gid, repo.NotifierEventLowStock, items))
+}
+
+// NewNotificationEvent fills in the group name and today's date for an event
+// about gid.
+func (svc *NotificationService) NewNotificationEvent(ctx context.Context, gid uuid.UUID, kind repo.NotifierEvent, data any) NotificationEvent {
+	evt := NotificationEvent{
+		Kind:    kind,
+		GroupID: gid,
+		Date:    types.DateFromTime(time.Now()),
+		Data:    data,
+	}
+	if g, err := svc.repos.Groups.GroupByID(ctx, gid); err == nil {
+		evt.Group = g.Name
+	}
+	return evt
+}
+
+// RenderNotification executes body against evt. An empty body uses the
+// default message for evt.Kind.
+func RenderNotification(body string, evt NotificationEvent) (string, error) {
+	if body == "" {
+		body = defaultNotificationTemplates[evt.Kind]
+	}
+
+	tmpl, err := repo.ParseNotifierTemplate(body)
+	if err != nil {
+		return "", err
+	}
+
+	return executeNotificationTemplate(tmpl, evt)
+}
+
+func executeNotificationTemplate(tmpl *template.Template, evt NotificationEvent) (string, error) {
+	w := &cappedWriter{limit: maxNotificationBytes}
+	if err := tmpl.Execute(w, evt); err != nil {
+		if w.exceeded {
+			return "", errNotificationTooLarge
+		}
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// cappedWriter is a strings.Builder that refuses writes past limit.
+type cappedWriter struct {
+	strings.Builder
+	limit    int
+	exceeded bool
+}
+
+func (w *cappedWriter) Write(p []byte) (int, error) {
+	if w.Len()+len(p) > w.limit {
+		w.exceeded = true
+		return 0, errNotificationTooLarge
+	}
+	return w.Builder.Write(p)
+}
+
+// NotificationPreview is the request body of the preview endpoint.
+type NotificationPreview struct {
+	Event    repo.NotifierEvent `json:"event"              validate:"required"`
+	Template string             `json:"template,omitempty" validate:"max=4000"`
+}
+
+type NotificationPreviewOut struct {
+	Body string `json:"body"`
+}
+
+// Preview renders a template against a sample event of the requested kind so
+// users can check a template before saving it.
+func (svc *NotificationService) Preview(ctx Context, in NotificationPreview) (NotificationPreviewOut, error) {
+	if !in.Event.Valid() {
+		return NotificationPreviewOut{}, fmt.Errorf("unknown notifier event %q", in.Event)
+	}
+
+	evt := svc.NewNotificationEvent(ctx, ctx.GID, in.Event, sampleNotificationData(in.Event))
+
+	body, err := RenderNotification(in.Template, evt)
+	if err != nil {
+		return NotificationPreviewOut{}, err
+	}
+
+	return NotificationPreviewOut{Body: body}, nil
+}
+
+func sampleNotificationData(kind repo.NotifierEvent) any {
+	today := time.Now()
+	switch kind {
+	case repo.NotifierEventMaintenanceDue:
+		return []repo.MaintenanceEntryWithDetails{
+			{
+				MaintenanceEntry: repo.MaintenanceEntry{ID: uuid.New(), Name: "Replace furnace filter", ScheduledDate: types.DateFromTime(today)},
+				ItemName:         "Furnace",
+				ItemID:           uuid.New(),
+			},
+			{
+				MaintenanceEntry: repo.MaintenanceEntry{ID: uuid.New(), Name: "Oil change", ScheduledDate: types.DateFromTime(today), RecurrenceRule: "FREQ=MONTHLY;INTERVAL=6"},
+				ItemName:         "Car",
+				ItemID:           uuid.New(),
+			},
+		}
+	case repo.NotifierEventWarrantyExpiring:
+		return []repo.WarrantyReminder{
+			{EntityID: uuid.New(), Name: "Dishwasher", AssetID: 42, WarrantyExpires: types.DateFromTime(today.AddDate(0, 0, 7)), DaysLeft: 7, LeadDays: 7},
+			{EntityID: uuid.New(), Name: "Laptop", WarrantyExpires: types.DateFromTime(today.AddDate(0, 0, 28)), DaysLeft: 28, LeadDays: 30},
+		}
+	case repo.NotifierEventExportCompleted:
+		return repo.ExportOut{ID: uuid.New(), Kind: "export", Status: "completed", Progress: 100, SizeBytes: 1_482_113, CreatedAt: today, UpdatedAt: today}
+	case repo.NotifierEventExportFailed:
+		return repo.ExportOut{ID: uuid.New(), Kind: "export", Status: "failed", Error: "bucket unavailable", CreatedAt: today, UpdatedAt: today}
+	case repo.NotifierEventImportFinished:
+		return repo.ExportOut{ID: uuid.New(), Kind: "import", Status: "completed", Progress: 100, SizeBytes: 1_482_113, CreatedAt: today, UpdatedAt: today}
+	case repo.NotifierEventLowStock:
+		return []LowStockItem{
+			{EntityID: uuid.New(), Name: "AA Batteries", Quantity: 2, Threshold: 8},
+		}
+	case repo.NotifierEventMemberJoined:
+		return MemberJoined{UserID: uuid.New(), Name: "Alex", Email: "alex@example.com"}
+	case repo.NotifierEventLoanOverdue:
+		return []repo.LoanReminder{
+			{LoanID: uuid.New(), EntityID: uuid.New(), Name: "Cordless Drill", AssetID: 17, Borrower: "Sam", DueDate: types.DateFromTime(today.AddDate(0, 0, -3)), DaysOverdue: 3},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestRenderNotification_DefaultMaintenanceMatchesLegacyFormat(t *testing.T) {
+	evt := NotificationEvent{
+		Kind: repo.NotifierEventMaintenanceDue,
+		Date: types.DateFromString("2026-03-01"),
+		Data: []repo.MaintenanceEntry{{Name: "Oil change"}, {Name: "Filter"}},
+	}
+
+	got, err := RenderNotification("", evt)
+	require.NoError(t, err)
+	assert.Equal(t, "Homebox Maintenance for (2026-03-01):\n - Oil change\n - Filter\n", got)
+}
+
+func TestRenderNotification_DefaultsCoverEveryEvent(t *testing.T) {
+	for _, kind := range repo.NotifierEvents {
+		t.Run(string(kind), func(t *testing.T) {
+			evt := NotificationEvent{Kind: kind, Group: "Home", Date: types.DateFromString("2026-03-01"), Data: sampleNotificationData(kind)}
+			got, err := RenderNotification("", evt)
+			require.NoError(t, err)
+			assert.NotEmpty(t, strings.TrimSpace(got))
+		})
+	}
+}
+
+func TestRenderNotification_CustomTemplate(t *testing.T) {
+	evt := NotificationEvent{
+		Kind:  repo.NotifierEventExportFailed,
+		Group: "Home",
+		Data:  repo.ExportOut{Status: "failed", Error: "disk full"},
+	}
+
+	got, err := RenderNotification("⚠ {{.Group}}: {{.Data.Error}}", evt)
+	require.NoError(t, err)
+	assert.Equal(t, "⚠ Home: disk full", got)
+
+	_, err = RenderNotification("{{.Data.Nope}}", evt)
+	require.Error(t, err)
+
+	_, err = RenderNotification(`{{range .Data}}{{end}}{{printf "%099999d" 1}}`, NotificationEvent{Data: []int{}})
+	require.ErrorIs(t, err, errNotificationTooLarge)
+}
+
+func TestRenderNotification_BoundedLoops(t *testing.T) {
+	evt := NotificationEvent{
+		Kind: repo.NotifierEventLowStock,
+		Data: []LowStockItem{{Name: "Batteries", Quantity: 9_000_000_000}},
+	}
+
+	got, err := RenderNotification("{{range $i, $e := .Data}}{{$i}}:{{$e.Name}}{{end}}", evt)
+	require.NoError(t, err)
+	assert.Equal(t, "0:Batteries", got)
+
+	// Loops that write nothing would run until they end.
+	for _, body := range []string{
+		"{{range 9000000000000000000}}{{end}}",
+		"{{$n := 9000000000000000000}}{{range $n}}{{end}}",
+		"{{range .Data}}{{range .Quantity}}{{end}}{{end}}",
+		"{{range .Data}}{{range $.Data}}{{end}}{{end}}",
+		`{{define "loop"}}{{template "loop" .}}{{end}}{{template "loop" .}}`,
+	} {
+		_, err := RenderNotification(body, evt)
+		require.Error(t, err, body)
+	}
+}
+
+func TestNotificationService_NotifyOnlySubscribed(t *testing.T) {
+	ctx := context.Background()
+
+	maint, err := tRepos.Notifiers.Create(ctx, tGroup.ID, tUser.ID, repo.NotifierCreate{
+		Name:     "matrix",
+		IsActive: true,
+		URL:      "discord://token@maintenance",
+		Subscriptions: []repo.NotifierSubscription{
+			{Event: repo.NotifierEventMaintenanceDue, Template: "due: {{range .Data}}{{.Name}};{{end}}"},
+		},
+	})
+	require.NoError(t, err)
+
+	admin, err := tRepos.Notifiers.Create(ctx, tGroup.ID, tUser.ID, repo.NotifierCreate{
+		Name:          "discord",
+		IsActive:      true,
+		URL:           "discord://token@admin",
+		Subscriptions: []repo.NotifierSubscription{{Event: repo.NotifierEventExportFailed}},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = tRepos.Notifiers.Delete(ctx, tUser.ID, maint.ID)
+		_ = tRepos.Notifiers.Delete(ctx, tUser.ID, admin.ID)
+	})
+
+	got := map[string]string{}
+	svc := newNotificationService(tRepos, defaultNotifierConf())
+	svc.send = func(url, message string) error {
+		got[url] = message
+		return nil
+	}
+
+	sent, errs := svc.Notify(ctx, NotificationEvent{
+		Kind:    repo.NotifierEventMaintenanceDue,
+		GroupID: tGroup.ID,
+		Data:    []repo.MaintenanceEntry{{Name: "Oil change"}},
+	})
+	require.Empty(t, errs)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, map[string]string{"discord://token@maintenance": "due: Oil change;"}, got)
+}
+
+func TestNotifierCreate_DefaultSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	n, err := tRepos.Notifiers.Create(ctx, tGroup.ID, tUser.ID, repo.NotifierCreate{
+		Name:     "legacy",
+		IsActive: true,
+		URL:      "discord://token@legacy",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Notifiers.Delete(ctx, tUser.ID, n.ID) })
+
+	events := make([]repo.NotifierEvent, len(n.Subscriptions))
+	for i, s := range n.Subscriptions {
+		events[i] = s.Event
+	}
+	assert.Equal(t, []repo.NotifierEvent{repo.NotifierEventMaintenanceDue, repo.NotifierEventWarrantyExpiring}, events)
+
+	// An update without subscriptions leaves them alone; an empty list clears them.
+	n, err = tRepos.Notifiers.Update(ctx, tUser.ID, n.ID, repo.NotifierUpdate{Name: "legacy", IsActive: true})
+	require.NoError(t, err)
+	assert.Len(t, n.Subscriptions, 2)
+
+	n, err = tRepos.Notifiers.Update(ctx, tUser.ID, n.ID, repo.NotifierUpdate{Name: "legacy", IsActive: true, Subscriptions: []repo.NotifierSubscription{}})
+	require.NoError(t, err)
+	assert.Empty(t, n.Subscriptions)
+}
+
+func TestNotifierCreate_Validate(t *testing.T) {
+	nc := repo.NotifierCreate{Subscriptions: []repo.NotifierSubscription{{Event: "nope"}}}
+	require.Error(t, nc.Validate())
+
+	nc.Subscriptions = []repo.NotifierSubscription{{Event: repo.NotifierEventLowStock}, {Event: repo.NotifierEventLowStock}}
+	require.Error(t, nc.Validate())
+
+	nc.Subscriptions = []repo.NotifierSubscription{{Event: repo.NotifierEventLowStock, Template: "{{.Data"}}
+	require.Error(t, nc.Validate())
+
+	nc.Subscriptions = []repo.NotifierSubscription{{Event: repo.NotifierEventLowStock, Template: "{{.Data}}"}}
+	require.NoError(t, nc.Validate())
+}
+
+func TestNotificationService_Preview(t *testing.T) {
+	out, err := tSvc.Notifications.Preview(tCtx, NotificationPreview{Event: repo.NotifierEventMemberJoined})
+	require.NoError(t, err)
+	assert.Equal(t, "Alex joined test-group on Homebox.", out.Body)
+
+	_, err = tSvc.Notifications.Preview(tCtx, NotificationPreview{Event: "bogus"})
+	require.Error(t, err)
+}
+
+func TestNotificationService_SavedSearchScope(t *testing.T) {
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "scoped-notify", uuid.Nil)
+	require.NoError(t, err)
+	et, err := tRepos.EntityTypes.GetDefault(ctx, g.ID, false)
+	require.NoError(t, err)
+
+	batteries, err := tRepos.Entities.Create(ctx, g.ID, repo.EntityCreate{Name: "AA Batteries", EntityTypeID: et.ID})
+	require.NoError(t, err)
+	paper, err := tRepos.Entities.Create(ctx, g.ID, repo.EntityCreate{Name: "Printer Paper", EntityTypeID: et.ID})
+	require.NoError(t, err)
+
+	search, err := tRepos.SavedSearches.Create(ctx, g.ID, repo.SavedSearchCreate{
+		Name:               "Batteries",
+		SavedSearchFilters: repo.SavedSearchFilters{Query: "batteries"},
+	})
+	require.NoError(t, err)
+
+	_, err = tRepos.Notifiers.Create(ctx, g.ID, tUser.ID, repo.NotifierCreate{
+		Name:     "scoped",
+		IsActive: true,
+		URL:      "discord://token@scoped",
+		Subscriptions: []repo.NotifierSubscription{
+			{Event: repo.NotifierEventLowStock, Template: "{{range .Data}}{{.Name}};{{end}}", SavedSearchID: &search.ID},
+			{Event: repo.NotifierEventExportFailed, SavedSearchID: &search.ID},
+		},
+	})
+	require.NoError(t, err)
+	_, err = tRepos.Notifiers.Create(ctx, g.ID, tUser.ID, repo.NotifierCreate{
+		Name:          "everything",
+		IsActive:      true,
+		URL:           "discord://token@everything",
+		Subscriptions: []repo.NotifierSubscription{{Event: repo.NotifierEventLowStock, Template: "{{range .Data}}{{.Name}};{{end}}"}},
+	})
+	require.NoError(t, err)
+
+	got := map[string]string{}
+	svc := newNotificationService(tRepos, defaultNotifierConf())
+	svc.send = func(url, message string) error {
+		got[url] = message
+		return nil
+	}
+
+	sent, errs := svc.Notify(ctx, NotificationEvent{
+		Kind:    repo.NotifierEventLowStock,
+		GroupID: g.ID,
+		Data: []LowStockItem{
+			{EntityID: batteries.ID, Name: batteries.Name},
+			{EntityID: paper.ID, Name: paper.Name},
+		},
+	})
+	require.Empty(t, errs)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, map[string]string{
+		"discord://token@scoped":     "AA Batteries;",
+		"discord://token@everything": "AA Batteries;Printer Paper;",
+	}, got)
+
+	// Nothing left after scoping means nothing is sent.
+	clear(got)
+	sent, errs = svc.Notify(ctx, NotificationEvent{
+		Kind:    repo.NotifierEventLowStock,
+		GroupID: g.ID,
+		Data:    []LowStockItem{{EntityID: paper.ID, Name: paper.Name}},
+	})
+	require.Empty(t, errs)
+	assert.Equal(t, 1, sent)
+	assert.NotContains(t, got, "discord://token@scoped")
+
+	// Events that aren't about entities ignore the scope.
+	sent, errs = svc.Notify(ctx, NotificationEvent{
+		Kind:    repo.NotifierEventExportFailed,
+		GroupID: g.ID,
+		Data:    repo.ExportOut{Status: "failed"},
+	})
+	require.Empty(t, errs)
+	assert.Equal(t, 1, sent)
+}
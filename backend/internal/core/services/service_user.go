@@ -12,6 +12,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/authroles"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/mailer"
 	"go.opentelemetry.io/otel/attribute"
@@ -40,8 +41,9 @@ var (
 const PasswordMinLength = 6
 
 type UserService struct {
-	repos  *repo.AllRepos
-	mailer *mailer.Mailer
+	repos         *repo.AllRepos
+	mailer        *mailer.Mailer
+	notifications *NotificationService
 }
 
 type (
@@ -257,6 +259,14 @@ func (svc *UserService) RegisterUser(ctx context.Context, data UserRegistration,
 			return repo.UserOut{}, err
 		}
 		decSpan.End()
+
+		svc.notifications.NotifyAsync(ctx, NotificationEvent{
+			Kind:    repo.NotifierEventMemberJoined,
+			GroupID: group.ID,
+			Group:   group.Name,
+			Date:    types.DateFromTime(time.Now()),
+			Data:    MemberJoined{UserID: usr.ID, Name: usr.Name, Email: usr.Email},
+		})
 	}
 
 	return usr, nil
@@ -865,8 +875,9 @@ func (svc *UserService) CreateAPIKey(ctx context.Context, userID uuid.UUID, in r
 		attribute.String("api_key.expires_at", expiresAt.Format(time.RFC3339)),
 	)
 
+	in.ExpiresAt = expiresAt
 	token := hasher.GenerateAPIKeyCtx(ctx)
-	out, err := svc.repos.APIKeys.Create(ctx, userID, in.Name, token.Hash, expiresAt)
+	out, err := svc.repos.APIKeys.Create(ctx, userID, token.Hash, in)
 	if err != nil {
 		recordServiceSpanError(span, err)
 		return repo.APIKeyCreatedOut{}, err
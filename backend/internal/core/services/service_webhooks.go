@@ -0,0 +1,322 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/validate"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/hasher"
+)
+
+const (
+	// webhookSecretPrefix marks signing secrets so they are recognisable in
+	// receiver configuration.
+	webhookSecretPrefix = "whsec_"
+
+	webhookTimeout      = 10 * time.Second
+	webhookPollInterval = 15 * time.Second
+	webhookBatchSize    = 50
+
+	// Request headers sent with every delivery.
+	WebhookHeaderEvent     = "X-Homebox-Event"
+	WebhookHeaderDelivery  = "X-Homebox-Delivery"
+	WebhookHeaderTimestamp = "X-Homebox-Timestamp"
+	WebhookHeaderSignature = "X-Homebox-Signature"
+)
+
+// webhookRetryDelays is the wait before each retry. A delivery that still
+// fails after the last one is marked failed.
+var webhookRetryDelays = []time.Duration{
+	30 * time.Second,
+	2 * time.Minute,
+	10 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+}
+
+// WebhookPayload is the JSON body POSTed to webhook endpoints. ID is unique
+// per event; retries of the same event reuse it so receivers can
+// de-duplicate. ActorID is absent for system changes and FromParentID is only
+// set on moves.
+type WebhookPayload struct {
+	ID           uuid.UUID               `json:"id"`
+	Event        string                  `json:"event"`
+	Action       eventbus.MutationAction `json:"action,omitempty"`
+	GroupID      uuid.UUID               `json:"groupId"`
+	IDs          []uuid.UUID             `json:"ids"`
+	ActorID      *uuid.UUID              `json:"actorId,omitempty"`
+	FromParentID *uuid.UUID              `json:"fromParentId,omitempty"`
+	Timestamp    time.Time               `json:"timestamp"`
+}
+
+type WebhookSecretOut struct {
+	Secret string `json:"secret"`
+}
+
+// WebhookService turns event bus mutations into signed HTTP deliveries. Each
+// event is stored in the delivery log first and sent by Run, so a slow or
+// unreachable endpoint never holds up the event bus.
+type WebhookService struct {
+	repos          *repo.AllRepos
+	notifierConfig *config.NotifierConf
+	client         *http.Client
+	wake           chan struct{}
+}
+
+func newWebhookService(repos *repo.AllRepos, cfg *config.NotifierConf) *WebhookService {
+	return &WebhookService{
+		repos:          repos,
+		notifierConfig: cfg,
+		client: &http.Client{
+			Timeout:       webhookTimeout,
+			CheckRedirect: validate.NotifierRedirectGuard(cfg),
+		},
+		wake: make(chan struct{}, 1),
+	}
+}
+
+// subscribe registers the service on every event a webhook can receive.
+func (svc *WebhookService) subscribe(bus *eventbus.EventBus) {
+	for _, name := range repo.WebhookEvents {
+		bus.Subscribe(eventbus.Event(name), func(data any) {
+			evt, ok := data.(eventbus.GroupMutationEvent)
+			if !ok {
+				return
+			}
+			svc.enqueue(context.Background(), name, evt)
+		})
+	}
+}
+
+func (svc *WebhookService) enqueue(ctx context.Context, event string, evt eventbus.GroupMutationEvent) {
+	hooks, err := svc.repos.Webhooks.GetActiveByEvent(ctx, evt.GID, event)
+	if err != nil {
+		log.Err(err).Str("event", event).Msg("webhooks: failed to load webhooks")
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	ids := evt.IDs
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	payload := WebhookPayload{
+		ID:        uuid.New(),
+		Event:     event,
+		Action:    evt.Action,
+		GroupID:   evt.GID,
+		IDs:       ids,
+		Timestamp: time.Now().UTC(),
+	}
+	if evt.ActorID != uuid.Nil {
+		payload.ActorID = &evt.ActorID
+	}
+	if evt.FromParentID != uuid.Nil {
+		payload.FromParentID = &evt.FromParentID
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Err(err).Msg("webhooks: failed to marshal payload")
+		return
+	}
+
+	for _, h := range hooks {
+		if _, err := svc.repos.Webhooks.Enqueue(ctx, h.ID, event, body); err != nil {
+			log.Err(err).Str("webhook_id", h.ID.String()).Msg("webhooks: failed to enqueue delivery")
+		}
+	}
+
+	svc.signal()
+}
+
+func (svc *WebhookService) signal() {
+	select {
+	case svc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends due deliveries until ctx is cancelled. It wakes on new events
+// and otherwise polls for retries.
+func (svc *WebhookService) Run(ctx context.Context) error {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		svc.DeliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-svc.wake:
+		}
+	}
+}
+
+// DeliverDue attempts up to one batch of the deliveries that are currently
+// due. A full batch wakes Run again straight away for the rest.
+func (svc *WebhookService) DeliverDue(ctx context.Context) {
+	due, err := svc.repos.Webhooks.GetDue(ctx, time.Now(), webhookBatchSize)
+	if err != nil {
+		log.Err(err).Msg("webhooks: failed to load due deliveries")
+		return
+	}
+
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		svc.deliver(ctx, d)
+	}
+
+	if len(due) == webhookBatchSize {
+		svc.signal()
+	}
+}
+
+func (svc *WebhookService) deliver(ctx context.Context, d repo.PendingWebhookDelivery) {
+	status, err := svc.send(ctx, d)
+
+	attempt := repo.WebhookAttempt{ResponseStatus: status}
+	if err != nil {
+		attempt.Error = err.Error()
+		if d.Attempts < len(webhookRetryDelays) {
+			next := time.Now().Add(webhookRetryDelays[d.Attempts])
+			attempt.NextAttemptAt = &next
+		}
+		log.Warn().
+			Err(err).
+			Str("delivery_id", d.ID.String()).
+			Int("attempt", d.Attempts+1).
+			Msg("webhooks: delivery failed")
+	}
+
+	if err := svc.repos.Webhooks.RecordAttempt(ctx, d.ID, attempt); err != nil {
+		log.Err(err).Str("delivery_id", d.ID.String()).Msg("webhooks: failed to record attempt")
+	}
+}
+
+func (svc *WebhookService) send(ctx context.Context, d repo.PendingWebhookDelivery) (int, error) {
+	// Re-check on every attempt: DNS can change between creation and
+	// delivery.
+	if err := svc.validateURL(d.URL); err != nil {
+		return 0, err
+	}
+	if d.Secret == "" {
+		return 0, errors.New("group has no webhook signing secret")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader([]byte(d.Payload)))
+	if err != nil {
+		return 0, err
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Homebox-Webhooks")
+	req.Header.Set(WebhookHeaderEvent, d.Event)
+	req.Header.Set(WebhookHeaderDelivery, d.ID.String())
+	req.Header.Set(WebhookHeaderTimestamp, ts)
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(d.Secret, ts, []byte(d.Payload)))
+
+	resp, err := svc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the X-Homebox-Signature value for body: the hex
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the group's secret.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// validateURL applies the notifier SSRF policy to a webhook URL.
+func (svc *WebhookService) validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use http or https")
+	}
+	return validate.ValidateNotifierURL("generic+"+raw, svc.notifierConfig)
+}
+
+func (svc *WebhookService) Create(ctx Context, in repo.WebhookCreate) (repo.WebhookOut, error) {
+	if err := svc.validateURL(in.URL); err != nil {
+		return repo.WebhookOut{}, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	// Make sure receivers can be configured as soon as the first webhook
+	// exists.
+	if _, err := svc.Secret(ctx); err != nil {
+		return repo.WebhookOut{}, err
+	}
+	return svc.repos.Webhooks.Create(ctx, ctx.GID, in)
+}
+
+func (svc *WebhookService) Update(ctx Context, id uuid.UUID, in repo.WebhookUpdate) (repo.WebhookOut, error) {
+	if err := svc.validateURL(in.URL); err != nil {
+		return repo.WebhookOut{}, validate.NewRequestError(err, http.StatusBadRequest)
+	}
+	return svc.repos.Webhooks.Update(ctx, ctx.GID, id, in)
+}
+
+// Secret returns the group's signing secret, generating one on first use.
+func (svc *WebhookService) Secret(ctx Context) (WebhookSecretOut, error) {
+	secret, err := svc.repos.Webhooks.GetSecret(ctx, ctx.GID)
+	if err != nil {
+		return WebhookSecretOut{}, err
+	}
+	if secret != "" {
+		return WebhookSecretOut{Secret: secret}, nil
+	}
+	return svc.RotateSecret(ctx)
+}
+
+// RotateSecret replaces the group's signing secret. Pending retries are
+// signed with the new secret.
+func (svc *WebhookService) RotateSecret(ctx Context) (WebhookSecretOut, error) {
+	b, err := hasher.GenerateRandomBytes(32)
+	if err != nil {
+		return WebhookSecretOut{}, err
+	}
+	secret := webhookSecretPrefix + hex.EncodeToString(b)
+	if err := svc.repos.Webhooks.SetSecret(ctx, ctx.GID, secret); err != nil {
+		return WebhookSecretOut{}, err
+	}
+	return WebhookSecretOut{Secret: secret}, nil
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/repo"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+type webhookRecorder struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (rec *webhookRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.requests = append(rec.requests, r)
+	rec.bodies = append(rec.bodies, body)
+	w.WriteHeader(rec.status)
+}
+
+func newWebhookTestContext(t *testing.T) Context {
+	t.Helper()
+	g, err := tRepos.Groups.GroupCreate(context.Background(), "webhooks-"+fk.Str(6), uuid.Nil)
+	require.NoError(t, err)
+	return Context{Context: context.Background(), GID: g.ID, UID: tUser.ID}
+}
+
+func TestWebhookService_DeliversSignedPayload(t *testing.T) {
+	rec := &webhookRecorder{status: http.StatusNoContent}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	ctx := newWebhookTestContext(t)
+	svc := newWebhookService(tRepos, defaultNotifierConf())
+
+	hook, err := svc.Create(ctx, repo.WebhookCreate{
+		Name:     "erp",
+		URL:      srv.URL,
+		Events:   []string{string(eventbus.EventEntityMutation)},
+		IsActive: true,
+	})
+	require.NoError(t, err)
+
+	secret, err := svc.Secret(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, secret.Secret, webhookSecretPrefix)
+
+	entityID := uuid.New()
+	svc.enqueue(ctx, string(eventbus.EventEntityMutation), eventbus.GroupMutationEvent{
+		GID:    ctx.GID,
+		Action: eventbus.MutationUpdate,
+		IDs:    []uuid.UUID{entityID},
+	})
+	// Not subscribed, so nothing is queued for it.
+	svc.enqueue(ctx, string(eventbus.EventTagMutation), eventbus.GroupMutationEvent{GID: ctx.GID})
+
+	svc.DeliverDue(ctx)
+
+	require.Len(t, rec.requests, 1)
+	req, body := rec.requests[0], rec.bodies[0]
+	assert.Equal(t, string(eventbus.EventEntityMutation), req.Header.Get(WebhookHeaderEvent))
+	assert.Equal(t,
+		SignWebhookPayload(secret.Secret, req.Header.Get(WebhookHeaderTimestamp), body),
+		req.Header.Get(WebhookHeaderSignature))
+
+	var payload WebhookPayload
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, eventbus.MutationUpdate, payload.Action)
+	assert.Equal(t, ctx.GID, payload.GroupID)
+	assert.Equal(t, []uuid.UUID{entityID}, payload.IDs)
+
+	deliveries, err := tRepos.Webhooks.GetDeliveries(ctx, ctx.GID, hook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "succeeded", deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusNoContent, deliveries[0].ResponseStatus)
+	assert.NotNil(t, deliveries[0].DeliveredAt)
+}
+
+func TestWebhookService_RetriesThenFails(t *testing.T) {
+	rec := &webhookRecorder{status: http.StatusInternalServerError}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	ctx := newWebhookTestContext(t)
+	svc := newWebhookService(tRepos, defaultNotifierConf())
+
+	hook, err := svc.Create(ctx, repo.WebhookCreate{
+		Name:     "ha",
+		URL:      srv.URL,
+		Events:   []string{string(eventbus.EventEntityMutation)},
+		IsActive: true,
+	})
+	require.NoError(t, err)
+
+	svc.enqueue(ctx, string(eventbus.EventEntityMutation), eventbus.GroupMutationEvent{GID: ctx.GID})
+	svc.DeliverDue(ctx)
+
+	deliveries, err := tRepos.Webhooks.GetDeliveries(ctx, ctx.GID, hook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "pending", deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	require.NotNil(t, deliveries[0].NextAttemptAt)
+	assert.Contains(t, deliveries[0].Error, "500")
+
+	// Not due yet, so a second pass doesn't resend.
+	svc.DeliverDue(ctx)
+	assert.Len(t, rec.requests, 1)
+
+	// The attempt after the last retry delay gives up.
+	svc.deliver(ctx, repo.PendingWebhookDelivery{
+		WebhookDeliveryOut: repo.WebhookDeliveryOut{ID: deliveries[0].ID, Event: deliveries[0].Event, Payload: deliveries[0].Payload, Attempts: len(webhookRetryDelays)},
+		URL:                srv.URL,
+		Secret:             "s",
+	})
+
+	deliveries, err = tRepos.Webhooks.GetDeliveries(ctx, ctx.GID, hook.ID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "failed", deliveries[0].Status)
+	assert.Nil(t, deliveries[0].NextAttemptAt)
+}
+
+func TestWebhookService_ValidatesURL(t *testing.T) {
+	ctx := newWebhookTestContext(t)
+	svc := newWebhookService(tRepos, &config.NotifierConf{BlockLocalhost: true})
+
+	for _, u := range []string{"ftp://example.com/hook", "http://127.0.0.1:8080/hook"} {
+		_, err := svc.Create(ctx, repo.WebhookCreate{
+			Name:   "bad",
+			URL:    u,
+			Events: []string{string(eventbus.EventEntityMutation)},
+		})
+		require.Error(t, err, u)
+	}
+}
+
+func TestWebhookService_RotateSecret(t *testing.T) {
+	ctx := newWebhookTestContext(t)
+	svc := newWebhookService(tRepos, defaultNotifierConf())
+
+	first, err := svc.Secret(ctx)
+	require.NoError(t, err)
+
+	again, err := svc.Secret(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+
+	rotated, err := svc.RotateSecret(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, rotated)
+}
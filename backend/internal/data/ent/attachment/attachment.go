@@ -30,6 +30,22 @@ const (
 	FieldPath = "path"
 	// FieldMimeType holds the string denoting the mime_type field in the database.
 	FieldMimeType = "mime_type"
+	// FieldCapturedAt holds the string denoting the captured_at field in the database.
+	FieldCapturedAt = "captured_at"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldDocumentTitle holds the string denoting the document_title field in the database.
+	FieldDocumentTitle = "document_title"
+	// FieldDocumentAuthor holds the string denoting the document_author field in the database.
+	FieldDocumentAuthor = "document_author"
+	// FieldPageCount holds the string denoting the page_count field in the database.
+	FieldPageCount = "page_count"
 	// EdgeEntity holds the string denoting the entity edge name in mutations.
 	EdgeEntity = "entity"
 	// EdgeThumbnail holds the string denoting the thumbnail edge name in mutations.
@@ -59,6 +75,14 @@ var Columns = []string{
 	FieldTitle,
 	FieldPath,
 	FieldMimeType,
+	FieldCapturedAt,
+	FieldLatitude,
+	FieldLongitude,
+	FieldWidth,
+	FieldHeight,
+	FieldDocumentTitle,
+	FieldDocumentAuthor,
+	FieldPageCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "attachments"
@@ -98,6 +122,10 @@ var (
 	DefaultPath string
 	// DefaultMimeType holds the default value on creation for the "mime_type" field.
 	DefaultMimeType string
+	// DocumentTitleValidator is a validator for the "document_title" field. It is called by the builders before save.
+	DocumentTitleValidator func(string) error
+	// DocumentAuthorValidator is a validator for the "document_author" field. It is called by the builders before save.
+	DocumentAuthorValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -175,6 +203,46 @@ func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
 }
 
+// ByCapturedAt orders the results by the captured_at field.
+func ByCapturedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapturedAt, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByDocumentTitle orders the results by the document_title field.
+func ByDocumentTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentTitle, opts...).ToFunc()
+}
+
+// ByDocumentAuthor orders the results by the document_author field.
+func ByDocumentAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentAuthor, opts...).ToFunc()
+}
+
+// ByPageCount orders the results by the page_count field.
+func ByPageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageCount, opts...).ToFunc()
+}
+
 // ByEntityField orders the results by entity field.
 func ByEntityField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -86,6 +86,46 @@ func MimeType(v string) predicate.Attachment {
 	return predicate.Attachment(sql.FieldEQ(FieldMimeType, v))
 }
 
+// CapturedAt applies equality check predicate on the "captured_at" field. It's identical to CapturedAtEQ.
+func CapturedAt(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldCapturedAt, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldHeight, v))
+}
+
+// DocumentTitle applies equality check predicate on the "document_title" field. It's identical to DocumentTitleEQ.
+func DocumentTitle(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldDocumentTitle, v))
+}
+
+// DocumentAuthor applies equality check predicate on the "document_author" field. It's identical to DocumentAuthorEQ.
+func DocumentAuthor(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldDocumentAuthor, v))
+}
+
+// PageCount applies equality check predicate on the "page_count" field. It's identical to PageCountEQ.
+func PageCount(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldPageCount, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Attachment {
 	return predicate.Attachment(sql.FieldEQ(FieldCreatedAt, v))
@@ -391,6 +431,456 @@ func MimeTypeContainsFold(v string) predicate.Attachment {
 	return predicate.Attachment(sql.FieldContainsFold(FieldMimeType, v))
 }
 
+// CapturedAtEQ applies the EQ predicate on the "captured_at" field.
+func CapturedAtEQ(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldCapturedAt, v))
+}
+
+// CapturedAtNEQ applies the NEQ predicate on the "captured_at" field.
+func CapturedAtNEQ(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldCapturedAt, v))
+}
+
+// CapturedAtIn applies the In predicate on the "captured_at" field.
+func CapturedAtIn(vs ...time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldCapturedAt, vs...))
+}
+
+// CapturedAtNotIn applies the NotIn predicate on the "captured_at" field.
+func CapturedAtNotIn(vs ...time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldCapturedAt, vs...))
+}
+
+// CapturedAtGT applies the GT predicate on the "captured_at" field.
+func CapturedAtGT(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldCapturedAt, v))
+}
+
+// CapturedAtGTE applies the GTE predicate on the "captured_at" field.
+func CapturedAtGTE(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldCapturedAt, v))
+}
+
+// CapturedAtLT applies the LT predicate on the "captured_at" field.
+func CapturedAtLT(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldCapturedAt, v))
+}
+
+// CapturedAtLTE applies the LTE predicate on the "captured_at" field.
+func CapturedAtLTE(v time.Time) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldCapturedAt, v))
+}
+
+// CapturedAtIsNil applies the IsNil predicate on the "captured_at" field.
+func CapturedAtIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldCapturedAt))
+}
+
+// CapturedAtNotNil applies the NotNil predicate on the "captured_at" field.
+func CapturedAtNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldCapturedAt))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldLongitude))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldHeight))
+}
+
+// DocumentTitleEQ applies the EQ predicate on the "document_title" field.
+func DocumentTitleEQ(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldDocumentTitle, v))
+}
+
+// DocumentTitleNEQ applies the NEQ predicate on the "document_title" field.
+func DocumentTitleNEQ(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldDocumentTitle, v))
+}
+
+// DocumentTitleIn applies the In predicate on the "document_title" field.
+func DocumentTitleIn(vs ...string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldDocumentTitle, vs...))
+}
+
+// DocumentTitleNotIn applies the NotIn predicate on the "document_title" field.
+func DocumentTitleNotIn(vs ...string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldDocumentTitle, vs...))
+}
+
+// DocumentTitleGT applies the GT predicate on the "document_title" field.
+func DocumentTitleGT(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldDocumentTitle, v))
+}
+
+// DocumentTitleGTE applies the GTE predicate on the "document_title" field.
+func DocumentTitleGTE(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldDocumentTitle, v))
+}
+
+// DocumentTitleLT applies the LT predicate on the "document_title" field.
+func DocumentTitleLT(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldDocumentTitle, v))
+}
+
+// DocumentTitleLTE applies the LTE predicate on the "document_title" field.
+func DocumentTitleLTE(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldDocumentTitle, v))
+}
+
+// DocumentTitleContains applies the Contains predicate on the "document_title" field.
+func DocumentTitleContains(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldContains(FieldDocumentTitle, v))
+}
+
+// DocumentTitleHasPrefix applies the HasPrefix predicate on the "document_title" field.
+func DocumentTitleHasPrefix(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldHasPrefix(FieldDocumentTitle, v))
+}
+
+// DocumentTitleHasSuffix applies the HasSuffix predicate on the "document_title" field.
+func DocumentTitleHasSuffix(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldHasSuffix(FieldDocumentTitle, v))
+}
+
+// DocumentTitleIsNil applies the IsNil predicate on the "document_title" field.
+func DocumentTitleIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldDocumentTitle))
+}
+
+// DocumentTitleNotNil applies the NotNil predicate on the "document_title" field.
+func DocumentTitleNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldDocumentTitle))
+}
+
+// DocumentTitleEqualFold applies the EqualFold predicate on the "document_title" field.
+func DocumentTitleEqualFold(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEqualFold(FieldDocumentTitle, v))
+}
+
+// DocumentTitleContainsFold applies the ContainsFold predicate on the "document_title" field.
+func DocumentTitleContainsFold(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldContainsFold(FieldDocumentTitle, v))
+}
+
+// DocumentAuthorEQ applies the EQ predicate on the "document_author" field.
+func DocumentAuthorEQ(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorNEQ applies the NEQ predicate on the "document_author" field.
+func DocumentAuthorNEQ(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorIn applies the In predicate on the "document_author" field.
+func DocumentAuthorIn(vs ...string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldDocumentAuthor, vs...))
+}
+
+// DocumentAuthorNotIn applies the NotIn predicate on the "document_author" field.
+func DocumentAuthorNotIn(vs ...string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldDocumentAuthor, vs...))
+}
+
+// DocumentAuthorGT applies the GT predicate on the "document_author" field.
+func DocumentAuthorGT(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorGTE applies the GTE predicate on the "document_author" field.
+func DocumentAuthorGTE(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorLT applies the LT predicate on the "document_author" field.
+func DocumentAuthorLT(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorLTE applies the LTE predicate on the "document_author" field.
+func DocumentAuthorLTE(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorContains applies the Contains predicate on the "document_author" field.
+func DocumentAuthorContains(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldContains(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorHasPrefix applies the HasPrefix predicate on the "document_author" field.
+func DocumentAuthorHasPrefix(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldHasPrefix(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorHasSuffix applies the HasSuffix predicate on the "document_author" field.
+func DocumentAuthorHasSuffix(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldHasSuffix(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorIsNil applies the IsNil predicate on the "document_author" field.
+func DocumentAuthorIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldDocumentAuthor))
+}
+
+// DocumentAuthorNotNil applies the NotNil predicate on the "document_author" field.
+func DocumentAuthorNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldDocumentAuthor))
+}
+
+// DocumentAuthorEqualFold applies the EqualFold predicate on the "document_author" field.
+func DocumentAuthorEqualFold(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEqualFold(FieldDocumentAuthor, v))
+}
+
+// DocumentAuthorContainsFold applies the ContainsFold predicate on the "document_author" field.
+func DocumentAuthorContainsFold(v string) predicate.Attachment {
+	return predicate.Attachment(sql.FieldContainsFold(FieldDocumentAuthor, v))
+}
+
+// PageCountEQ applies the EQ predicate on the "page_count" field.
+func PageCountEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldEQ(FieldPageCount, v))
+}
+
+// PageCountNEQ applies the NEQ predicate on the "page_count" field.
+func PageCountNEQ(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNEQ(FieldPageCount, v))
+}
+
+// PageCountIn applies the In predicate on the "page_count" field.
+func PageCountIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldIn(FieldPageCount, vs...))
+}
+
+// PageCountNotIn applies the NotIn predicate on the "page_count" field.
+func PageCountNotIn(vs ...int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotIn(FieldPageCount, vs...))
+}
+
+// PageCountGT applies the GT predicate on the "page_count" field.
+func PageCountGT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGT(FieldPageCount, v))
+}
+
+// PageCountGTE applies the GTE predicate on the "page_count" field.
+func PageCountGTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldGTE(FieldPageCount, v))
+}
+
+// PageCountLT applies the LT predicate on the "page_count" field.
+func PageCountLT(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLT(FieldPageCount, v))
+}
+
+// PageCountLTE applies the LTE predicate on the "page_count" field.
+func PageCountLTE(v int) predicate.Attachment {
+	return predicate.Attachment(sql.FieldLTE(FieldPageCount, v))
+}
+
+// PageCountIsNil applies the IsNil predicate on the "page_count" field.
+func PageCountIsNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldIsNull(FieldPageCount))
+}
+
+// PageCountNotNil applies the NotNil predicate on the "page_count" field.
+func PageCountNotNil() predicate.Attachment {
+	return predicate.Attachment(sql.FieldNotNull(FieldPageCount))
+}
+
 // HasEntity applies the HasEdge predicate on the "entity" edge.
 func HasEntity() predicate.Attachment {
 	return predicate.Attachment(func(s *sql.Selector) {
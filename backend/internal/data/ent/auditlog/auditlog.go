@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the auditlog type in the database.
+	Label = "audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldEntityName holds the string denoting the entity_name field in the database.
+	FieldEntityName = "entity_name"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldUserName holds the string denoting the user_name field in the database.
+	FieldUserName = "user_name"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFieldName holds the string denoting the field_name field in the database.
+	FieldFieldName = "field_name"
+	// FieldOldValue holds the string denoting the old_value field in the database.
+	FieldOldValue = "old_value"
+	// FieldNewValue holds the string denoting the new_value field in the database.
+	FieldNewValue = "new_value"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
+	// Table holds the table name of the auditlog in the database.
+	Table = "audit_logs"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "audit_logs"
+	// GroupInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupInverseTable = "groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_id"
+)
+
+// Columns holds all SQL columns for auditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldGroupID,
+	FieldEntityID,
+	FieldEntityName,
+	FieldUserID,
+	FieldUserName,
+	FieldAction,
+	FieldSource,
+	FieldFieldName,
+	FieldOldValue,
+	FieldNewValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// EntityNameValidator is a validator for the "entity_name" field. It is called by the builders before save.
+	EntityNameValidator func(string) error
+	// UserNameValidator is a validator for the "user_name" field. It is called by the builders before save.
+	UserNameValidator func(string) error
+	// FieldNameValidator is a validator for the "field_name" field. It is called by the builders before save.
+	FieldNameValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionCreate, ActionUpdate, ActionDelete:
+		return nil
+	default:
+		return fmt.Errorf("auditlog: invalid enum value for action field: %q", a)
+	}
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceSystem is the default value of the Source enum.
+const DefaultSource = SourceSystem
+
+// Source values.
+const (
+	SourceWeb    Source = "web"
+	SourceAPIKey Source = "api_key"
+	SourceImport Source = "import"
+	SourceSystem Source = "system"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceWeb, SourceAPIKey, SourceImport, SourceSystem:
+		return nil
+	default:
+		return fmt.Errorf("auditlog: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AuditLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByEntityName orders the results by the entity_name field.
+func ByEntityName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityName, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByUserName orders the results by the user_name field.
+func ByUserName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserName, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFieldName orders the results by the field_name field.
+func ByFieldName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldName, opts...).ToFunc()
+}
+
+// ByOldValue orders the results by the old_value field.
+func ByOldValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldValue, opts...).ToFunc()
+}
+
+// ByNewValue orders the results by the new_value field.
+func ByNewValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewValue, opts...).ToFunc()
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+	)
+}
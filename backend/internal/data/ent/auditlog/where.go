@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldGroupID, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityName applies equality check predicate on the "entity_name" field. It's identical to EntityNameEQ.
+func EntityName(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityName, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserName, v))
+}
+
+// FieldName applies equality check predicate on the "field_name" field. It's identical to FieldNameEQ.
+func FieldName(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldFieldName, v))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOldValue, v))
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldNewValue, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityNameEQ applies the EQ predicate on the "entity_name" field.
+func EntityNameEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldEntityName, v))
+}
+
+// EntityNameNEQ applies the NEQ predicate on the "entity_name" field.
+func EntityNameNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldEntityName, v))
+}
+
+// EntityNameIn applies the In predicate on the "entity_name" field.
+func EntityNameIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldEntityName, vs...))
+}
+
+// EntityNameNotIn applies the NotIn predicate on the "entity_name" field.
+func EntityNameNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldEntityName, vs...))
+}
+
+// EntityNameGT applies the GT predicate on the "entity_name" field.
+func EntityNameGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldEntityName, v))
+}
+
+// EntityNameGTE applies the GTE predicate on the "entity_name" field.
+func EntityNameGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldEntityName, v))
+}
+
+// EntityNameLT applies the LT predicate on the "entity_name" field.
+func EntityNameLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldEntityName, v))
+}
+
+// EntityNameLTE applies the LTE predicate on the "entity_name" field.
+func EntityNameLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldEntityName, v))
+}
+
+// EntityNameContains applies the Contains predicate on the "entity_name" field.
+func EntityNameContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldEntityName, v))
+}
+
+// EntityNameHasPrefix applies the HasPrefix predicate on the "entity_name" field.
+func EntityNameHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldEntityName, v))
+}
+
+// EntityNameHasSuffix applies the HasSuffix predicate on the "entity_name" field.
+func EntityNameHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldEntityName, v))
+}
+
+// EntityNameIsNil applies the IsNil predicate on the "entity_name" field.
+func EntityNameIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldEntityName))
+}
+
+// EntityNameNotNil applies the NotNil predicate on the "entity_name" field.
+func EntityNameNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldEntityName))
+}
+
+// EntityNameEqualFold applies the EqualFold predicate on the "entity_name" field.
+func EntityNameEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldEntityName, v))
+}
+
+// EntityNameContainsFold applies the ContainsFold predicate on the "entity_name" field.
+func EntityNameContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldEntityName, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldUserID))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameIsNil applies the IsNil predicate on the "user_name" field.
+func UserNameIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldUserName))
+}
+
+// UserNameNotNil applies the NotNil predicate on the "user_name" field.
+func UserNameNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldUserName))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// FieldNameEQ applies the EQ predicate on the "field_name" field.
+func FieldNameEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldFieldName, v))
+}
+
+// FieldNameNEQ applies the NEQ predicate on the "field_name" field.
+func FieldNameNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldFieldName, v))
+}
+
+// FieldNameIn applies the In predicate on the "field_name" field.
+func FieldNameIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldFieldName, vs...))
+}
+
+// FieldNameNotIn applies the NotIn predicate on the "field_name" field.
+func FieldNameNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldFieldName, vs...))
+}
+
+// FieldNameGT applies the GT predicate on the "field_name" field.
+func FieldNameGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldFieldName, v))
+}
+
+// FieldNameGTE applies the GTE predicate on the "field_name" field.
+func FieldNameGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldFieldName, v))
+}
+
+// FieldNameLT applies the LT predicate on the "field_name" field.
+func FieldNameLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldFieldName, v))
+}
+
+// FieldNameLTE applies the LTE predicate on the "field_name" field.
+func FieldNameLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldFieldName, v))
+}
+
+// FieldNameContains applies the Contains predicate on the "field_name" field.
+func FieldNameContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldFieldName, v))
+}
+
+// FieldNameHasPrefix applies the HasPrefix predicate on the "field_name" field.
+func FieldNameHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldFieldName, v))
+}
+
+// FieldNameHasSuffix applies the HasSuffix predicate on the "field_name" field.
+func FieldNameHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldFieldName, v))
+}
+
+// FieldNameIsNil applies the IsNil predicate on the "field_name" field.
+func FieldNameIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldFieldName))
+}
+
+// FieldNameNotNil applies the NotNil predicate on the "field_name" field.
+func FieldNameNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldFieldName))
+}
+
+// FieldNameEqualFold applies the EqualFold predicate on the "field_name" field.
+func FieldNameEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldFieldName, v))
+}
+
+// FieldNameContainsFold applies the ContainsFold predicate on the "field_name" field.
+func FieldNameContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldFieldName, v))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldOldValue, v))
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldOldValue, v))
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldOldValue, vs...))
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldOldValue, vs...))
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldOldValue, v))
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldOldValue, v))
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldOldValue, v))
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldOldValue, v))
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldOldValue, v))
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldOldValue, v))
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldOldValue, v))
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldOldValue))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldOldValue, v))
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldOldValue, v))
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEQ(FieldNewValue, v))
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNEQ(FieldNewValue, v))
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIn(FieldNewValue, vs...))
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotIn(FieldNewValue, vs...))
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGT(FieldNewValue, v))
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldGTE(FieldNewValue, v))
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLT(FieldNewValue, v))
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldLTE(FieldNewValue, v))
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContains(FieldNewValue, v))
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasPrefix(FieldNewValue, v))
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldHasSuffix(FieldNewValue, v))
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldNotNull(FieldNewValue))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldEqualFold(FieldNewValue, v))
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(sql.FieldContainsFold(FieldNewValue, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldPath, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldMimeType, v))
+}
+
+// RefCount applies equality check predicate on the "ref_count" field. It's identical to RefCountEQ.
+func RefCount(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// UnreferencedAt applies equality check predicate on the "unreferenced_at" field. It's identical to UnreferencedAtEQ.
+func UnreferencedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUnreferencedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldPath, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldSize, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// RefCountEQ applies the EQ predicate on the "ref_count" field.
+func RefCountEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// RefCountNEQ applies the NEQ predicate on the "ref_count" field.
+func RefCountNEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldRefCount, v))
+}
+
+// RefCountIn applies the In predicate on the "ref_count" field.
+func RefCountIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldRefCount, vs...))
+}
+
+// RefCountNotIn applies the NotIn predicate on the "ref_count" field.
+func RefCountNotIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldRefCount, vs...))
+}
+
+// RefCountGT applies the GT predicate on the "ref_count" field.
+func RefCountGT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldRefCount, v))
+}
+
+// RefCountGTE applies the GTE predicate on the "ref_count" field.
+func RefCountGTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldRefCount, v))
+}
+
+// RefCountLT applies the LT predicate on the "ref_count" field.
+func RefCountLT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldRefCount, v))
+}
+
+// RefCountLTE applies the LTE predicate on the "ref_count" field.
+func RefCountLTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldRefCount, v))
+}
+
+// UnreferencedAtEQ applies the EQ predicate on the "unreferenced_at" field.
+func UnreferencedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtNEQ applies the NEQ predicate on the "unreferenced_at" field.
+func UnreferencedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtIn applies the In predicate on the "unreferenced_at" field.
+func UnreferencedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldUnreferencedAt, vs...))
+}
+
+// UnreferencedAtNotIn applies the NotIn predicate on the "unreferenced_at" field.
+func UnreferencedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldUnreferencedAt, vs...))
+}
+
+// UnreferencedAtGT applies the GT predicate on the "unreferenced_at" field.
+func UnreferencedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtGTE applies the GTE predicate on the "unreferenced_at" field.
+func UnreferencedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtLT applies the LT predicate on the "unreferenced_at" field.
+func UnreferencedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtLTE applies the LTE predicate on the "unreferenced_at" field.
+func UnreferencedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldUnreferencedAt, v))
+}
+
+// UnreferencedAtIsNil applies the IsNil predicate on the "unreferenced_at" field.
+func UnreferencedAtIsNil() predicate.Blob {
+	return predicate.Blob(sql.FieldIsNull(FieldUnreferencedAt))
+}
+
+// UnreferencedAtNotNil applies the NotNil predicate on the "unreferenced_at" field.
+func UnreferencedAtNotNil() predicate.Blob {
+	return predicate.Blob(sql.FieldNotNull(FieldUnreferencedAt))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.NotPredicates(p))
+}
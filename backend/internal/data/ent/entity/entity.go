@@ -3,6 +3,7 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,12 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDepreciationMethod holds the string denoting the depreciation_method field in the database.
+	FieldDepreciationMethod = "depreciation_method"
+	// FieldUsefulLifeYears holds the string denoting the useful_life_years field in the database.
+	FieldUsefulLifeYears = "useful_life_years"
+	// FieldSalvageValue holds the string denoting the salvage_value field in the database.
+	FieldSalvageValue = "salvage_value"
 	// FieldImportRef holds the string denoting the import_ref field in the database.
 	FieldImportRef = "import_ref"
 	// FieldNotes holds the string denoting the notes field in the database.
@@ -55,14 +62,30 @@ const (
 	FieldPurchaseFrom = "purchase_from"
 	// FieldPurchasePrice holds the string denoting the purchase_price field in the database.
 	FieldPurchasePrice = "purchase_price"
+	// FieldPurchaseCurrency holds the string denoting the purchase_currency field in the database.
+	FieldPurchaseCurrency = "purchase_currency"
 	// FieldSoldDate holds the string denoting the sold_date field in the database.
 	FieldSoldDate = "sold_date"
 	// FieldSoldTo holds the string denoting the sold_to field in the database.
 	FieldSoldTo = "sold_to"
 	// FieldSoldPrice holds the string denoting the sold_price field in the database.
 	FieldSoldPrice = "sold_price"
+	// FieldSoldCurrency holds the string denoting the sold_currency field in the database.
+	FieldSoldCurrency = "sold_currency"
 	// FieldSoldNotes holds the string denoting the sold_notes field in the database.
 	FieldSoldNotes = "sold_notes"
+	// FieldManualValue holds the string denoting the manual_value field in the database.
+	FieldManualValue = "manual_value"
+	// FieldMinQuantity holds the string denoting the min_quantity field in the database.
+	FieldMinQuantity = "min_quantity"
+	// FieldReorderPoint holds the string denoting the reorder_point field in the database.
+	FieldReorderPoint = "reorder_point"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTrashRootID holds the string denoting the trash_root_id field in the database.
+	FieldTrashRootID = "trash_root_id"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -77,6 +100,12 @@ const (
 	EdgeFields = "fields"
 	// EdgeMaintenanceEntries holds the string denoting the maintenance_entries edge name in mutations.
 	EdgeMaintenanceEntries = "maintenance_entries"
+	// EdgeStockMovements holds the string denoting the stock_movements edge name in mutations.
+	EdgeStockMovements = "stock_movements"
+	// EdgeLoans holds the string denoting the loans edge name in mutations.
+	EdgeLoans = "loans"
+	// EdgeWarrantyNotifications holds the string denoting the warranty_notifications edge name in mutations.
+	EdgeWarrantyNotifications = "warranty_notifications"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
 	// Table holds the table name of the entity in the database.
@@ -122,6 +151,27 @@ const (
 	MaintenanceEntriesInverseTable = "maintenance_entries"
 	// MaintenanceEntriesColumn is the table column denoting the maintenance_entries relation/edge.
 	MaintenanceEntriesColumn = "entity_id"
+	// StockMovementsTable is the table that holds the stock_movements relation/edge.
+	StockMovementsTable = "stock_movements"
+	// StockMovementsInverseTable is the table name for the StockMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "stockmovement" package.
+	StockMovementsInverseTable = "stock_movements"
+	// StockMovementsColumn is the table column denoting the stock_movements relation/edge.
+	StockMovementsColumn = "entity_id"
+	// LoansTable is the table that holds the loans relation/edge.
+	LoansTable = "loans"
+	// LoansInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoansInverseTable = "loans"
+	// LoansColumn is the table column denoting the loans relation/edge.
+	LoansColumn = "entity_id"
+	// WarrantyNotificationsTable is the table that holds the warranty_notifications relation/edge.
+	WarrantyNotificationsTable = "warranty_notifications"
+	// WarrantyNotificationsInverseTable is the table name for the WarrantyNotification entity.
+	// It exists in this package in order to avoid circular dependency with the "warrantynotification" package.
+	WarrantyNotificationsInverseTable = "warranty_notifications"
+	// WarrantyNotificationsColumn is the table column denoting the warranty_notifications relation/edge.
+	WarrantyNotificationsColumn = "entity_id"
 	// AttachmentsTable is the table that holds the attachments relation/edge.
 	AttachmentsTable = "attachments"
 	// AttachmentsInverseTable is the table name for the Attachment entity.
@@ -138,6 +188,9 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldDepreciationMethod,
+	FieldUsefulLifeYears,
+	FieldSalvageValue,
 	FieldImportRef,
 	FieldNotes,
 	FieldQuantity,
@@ -154,10 +207,18 @@ var Columns = []string{
 	FieldPurchaseDate,
 	FieldPurchaseFrom,
 	FieldPurchasePrice,
+	FieldPurchaseCurrency,
 	FieldSoldDate,
 	FieldSoldTo,
 	FieldSoldPrice,
+	FieldSoldCurrency,
 	FieldSoldNotes,
+	FieldManualValue,
+	FieldMinQuantity,
+	FieldReorderPoint,
+	FieldUnit,
+	FieldDeletedAt,
+	FieldTrashRootID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "entities"
@@ -226,14 +287,45 @@ var (
 	WarrantyDetailsValidator func(string) error
 	// DefaultPurchasePrice holds the default value on creation for the "purchase_price" field.
 	DefaultPurchasePrice float64
+	// PurchaseCurrencyValidator is a validator for the "purchase_currency" field. It is called by the builders before save.
+	PurchaseCurrencyValidator func(string) error
 	// DefaultSoldPrice holds the default value on creation for the "sold_price" field.
 	DefaultSoldPrice float64
+	// SoldCurrencyValidator is a validator for the "sold_currency" field. It is called by the builders before save.
+	SoldCurrencyValidator func(string) error
 	// SoldNotesValidator is a validator for the "sold_notes" field. It is called by the builders before save.
 	SoldNotesValidator func(string) error
+	// UnitValidator is a validator for the "unit" field. It is called by the builders before save.
+	UnitValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// DepreciationMethod defines the type for the "depreciation_method" enum field.
+type DepreciationMethod string
+
+// DepreciationMethod values.
+const (
+	DepreciationMethodNone             DepreciationMethod = "none"
+	DepreciationMethodStraightLine     DepreciationMethod = "straight_line"
+	DepreciationMethodDecliningBalance DepreciationMethod = "declining_balance"
+	DepreciationMethodManual           DepreciationMethod = "manual"
+)
+
+func (dm DepreciationMethod) String() string {
+	return string(dm)
+}
+
+// DepreciationMethodValidator is a validator for the "depreciation_method" field enum values. It is called by the builders before save.
+func DepreciationMethodValidator(dm DepreciationMethod) error {
+	switch dm {
+	case DepreciationMethodNone, DepreciationMethodStraightLine, DepreciationMethodDecliningBalance, DepreciationMethodManual:
+		return nil
+	default:
+		return fmt.Errorf("entity: invalid enum value for depreciation_method field: %q", dm)
+	}
+}
+
 // OrderOption defines the ordering options for the Entity queries.
 type OrderOption func(*sql.Selector)
 
@@ -262,6 +354,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDepreciationMethod orders the results by the depreciation_method field.
+func ByDepreciationMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepreciationMethod, opts...).ToFunc()
+}
+
+// ByUsefulLifeYears orders the results by the useful_life_years field.
+func ByUsefulLifeYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsefulLifeYears, opts...).ToFunc()
+}
+
+// BySalvageValue orders the results by the salvage_value field.
+func BySalvageValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalvageValue, opts...).ToFunc()
+}
+
 // ByImportRef orders the results by the import_ref field.
 func ByImportRef(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImportRef, opts...).ToFunc()
@@ -342,6 +449,11 @@ func ByPurchasePrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPurchasePrice, opts...).ToFunc()
 }
 
+// ByPurchaseCurrency orders the results by the purchase_currency field.
+func ByPurchaseCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchaseCurrency, opts...).ToFunc()
+}
+
 // BySoldDate orders the results by the sold_date field.
 func BySoldDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoldDate, opts...).ToFunc()
@@ -357,11 +469,46 @@ func BySoldPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoldPrice, opts...).ToFunc()
 }
 
+// BySoldCurrency orders the results by the sold_currency field.
+func BySoldCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoldCurrency, opts...).ToFunc()
+}
+
 // BySoldNotes orders the results by the sold_notes field.
 func BySoldNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoldNotes, opts...).ToFunc()
 }
 
+// ByManualValue orders the results by the manual_value field.
+func ByManualValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualValue, opts...).ToFunc()
+}
+
+// ByMinQuantity orders the results by the min_quantity field.
+func ByMinQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinQuantity, opts...).ToFunc()
+}
+
+// ByReorderPoint orders the results by the reorder_point field.
+func ByReorderPoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderPoint, opts...).ToFunc()
+}
+
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTrashRootID orders the results by the trash_root_id field.
+func ByTrashRootID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashRootID, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -439,6 +586,48 @@ func ByMaintenanceEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 	}
 }
 
+// ByStockMovementsCount orders the results by stock_movements count.
+func ByStockMovementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStockMovementsStep(), opts...)
+	}
+}
+
+// ByStockMovements orders the results by stock_movements terms.
+func ByStockMovements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStockMovementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoansCount orders the results by loans count.
+func ByLoansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoansStep(), opts...)
+	}
+}
+
+// ByLoans orders the results by loans terms.
+func ByLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWarrantyNotificationsCount orders the results by warranty_notifications count.
+func ByWarrantyNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWarrantyNotificationsStep(), opts...)
+	}
+}
+
+// ByWarrantyNotifications orders the results by warranty_notifications terms.
+func ByWarrantyNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarrantyNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAttachmentsCount orders the results by attachments count.
 func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -501,6 +690,27 @@ func newMaintenanceEntriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceEntriesTable, MaintenanceEntriesColumn),
 	)
 }
+func newStockMovementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StockMovementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+	)
+}
+func newLoansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+	)
+}
+func newWarrantyNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarrantyNotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WarrantyNotificationsTable, WarrantyNotificationsColumn),
+	)
+}
 func newAttachmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -76,6 +76,16 @@ func Description(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldDescription, v))
 }
 
+// UsefulLifeYears applies equality check predicate on the "useful_life_years" field. It's identical to UsefulLifeYearsEQ.
+func UsefulLifeYears(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldUsefulLifeYears, v))
+}
+
+// SalvageValue applies equality check predicate on the "salvage_value" field. It's identical to SalvageValueEQ.
+func SalvageValue(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSalvageValue, v))
+}
+
 // ImportRef applies equality check predicate on the "import_ref" field. It's identical to ImportRefEQ.
 func ImportRef(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldImportRef, v))
@@ -156,6 +166,11 @@ func PurchasePrice(v float64) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldPurchasePrice, v))
 }
 
+// PurchaseCurrency applies equality check predicate on the "purchase_currency" field. It's identical to PurchaseCurrencyEQ.
+func PurchaseCurrency(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldPurchaseCurrency, v))
+}
+
 // SoldDate applies equality check predicate on the "sold_date" field. It's identical to SoldDateEQ.
 func SoldDate(v time.Time) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoldDate, v))
@@ -171,11 +186,46 @@ func SoldPrice(v float64) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoldPrice, v))
 }
 
+// SoldCurrency applies equality check predicate on the "sold_currency" field. It's identical to SoldCurrencyEQ.
+func SoldCurrency(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSoldCurrency, v))
+}
+
 // SoldNotes applies equality check predicate on the "sold_notes" field. It's identical to SoldNotesEQ.
 func SoldNotes(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoldNotes, v))
 }
 
+// ManualValue applies equality check predicate on the "manual_value" field. It's identical to ManualValueEQ.
+func ManualValue(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldManualValue, v))
+}
+
+// MinQuantity applies equality check predicate on the "min_quantity" field. It's identical to MinQuantityEQ.
+func MinQuantity(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldMinQuantity, v))
+}
+
+// ReorderPoint applies equality check predicate on the "reorder_point" field. It's identical to ReorderPointEQ.
+func ReorderPoint(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldReorderPoint, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldUnit, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TrashRootID applies equality check predicate on the "trash_root_id" field. It's identical to TrashRootIDEQ.
+func TrashRootID(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldTrashRootID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldCreatedAt, v))
@@ -396,6 +446,136 @@ func DescriptionContainsFold(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DepreciationMethodEQ applies the EQ predicate on the "depreciation_method" field.
+func DepreciationMethodEQ(v DepreciationMethod) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodNEQ applies the NEQ predicate on the "depreciation_method" field.
+func DepreciationMethodNEQ(v DepreciationMethod) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodIn applies the In predicate on the "depreciation_method" field.
+func DepreciationMethodIn(vs ...DepreciationMethod) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationMethodNotIn applies the NotIn predicate on the "depreciation_method" field.
+func DepreciationMethodNotIn(vs ...DepreciationMethod) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationMethodIsNil applies the IsNil predicate on the "depreciation_method" field.
+func DepreciationMethodIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldDepreciationMethod))
+}
+
+// DepreciationMethodNotNil applies the NotNil predicate on the "depreciation_method" field.
+func DepreciationMethodNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldDepreciationMethod))
+}
+
+// UsefulLifeYearsEQ applies the EQ predicate on the "useful_life_years" field.
+func UsefulLifeYearsEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsNEQ applies the NEQ predicate on the "useful_life_years" field.
+func UsefulLifeYearsNEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsIn applies the In predicate on the "useful_life_years" field.
+func UsefulLifeYearsIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldUsefulLifeYears, vs...))
+}
+
+// UsefulLifeYearsNotIn applies the NotIn predicate on the "useful_life_years" field.
+func UsefulLifeYearsNotIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldUsefulLifeYears, vs...))
+}
+
+// UsefulLifeYearsGT applies the GT predicate on the "useful_life_years" field.
+func UsefulLifeYearsGT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsGTE applies the GTE predicate on the "useful_life_years" field.
+func UsefulLifeYearsGTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsLT applies the LT predicate on the "useful_life_years" field.
+func UsefulLifeYearsLT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsLTE applies the LTE predicate on the "useful_life_years" field.
+func UsefulLifeYearsLTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsIsNil applies the IsNil predicate on the "useful_life_years" field.
+func UsefulLifeYearsIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldUsefulLifeYears))
+}
+
+// UsefulLifeYearsNotNil applies the NotNil predicate on the "useful_life_years" field.
+func UsefulLifeYearsNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldUsefulLifeYears))
+}
+
+// SalvageValueEQ applies the EQ predicate on the "salvage_value" field.
+func SalvageValueEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSalvageValue, v))
+}
+
+// SalvageValueNEQ applies the NEQ predicate on the "salvage_value" field.
+func SalvageValueNEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldSalvageValue, v))
+}
+
+// SalvageValueIn applies the In predicate on the "salvage_value" field.
+func SalvageValueIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldSalvageValue, vs...))
+}
+
+// SalvageValueNotIn applies the NotIn predicate on the "salvage_value" field.
+func SalvageValueNotIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldSalvageValue, vs...))
+}
+
+// SalvageValueGT applies the GT predicate on the "salvage_value" field.
+func SalvageValueGT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldSalvageValue, v))
+}
+
+// SalvageValueGTE applies the GTE predicate on the "salvage_value" field.
+func SalvageValueGTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldSalvageValue, v))
+}
+
+// SalvageValueLT applies the LT predicate on the "salvage_value" field.
+func SalvageValueLT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldSalvageValue, v))
+}
+
+// SalvageValueLTE applies the LTE predicate on the "salvage_value" field.
+func SalvageValueLTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldSalvageValue, v))
+}
+
+// SalvageValueIsNil applies the IsNil predicate on the "salvage_value" field.
+func SalvageValueIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldSalvageValue))
+}
+
+// SalvageValueNotNil applies the NotNil predicate on the "salvage_value" field.
+func SalvageValueNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldSalvageValue))
+}
+
 // ImportRefEQ applies the EQ predicate on the "import_ref" field.
 func ImportRefEQ(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldImportRef, v))
@@ -1181,6 +1361,81 @@ func PurchasePriceLTE(v float64) predicate.Entity {
 	return predicate.Entity(sql.FieldLTE(FieldPurchasePrice, v))
 }
 
+// PurchaseCurrencyEQ applies the EQ predicate on the "purchase_currency" field.
+func PurchaseCurrencyEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyNEQ applies the NEQ predicate on the "purchase_currency" field.
+func PurchaseCurrencyNEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyIn applies the In predicate on the "purchase_currency" field.
+func PurchaseCurrencyIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldPurchaseCurrency, vs...))
+}
+
+// PurchaseCurrencyNotIn applies the NotIn predicate on the "purchase_currency" field.
+func PurchaseCurrencyNotIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldPurchaseCurrency, vs...))
+}
+
+// PurchaseCurrencyGT applies the GT predicate on the "purchase_currency" field.
+func PurchaseCurrencyGT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyGTE applies the GTE predicate on the "purchase_currency" field.
+func PurchaseCurrencyGTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyLT applies the LT predicate on the "purchase_currency" field.
+func PurchaseCurrencyLT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyLTE applies the LTE predicate on the "purchase_currency" field.
+func PurchaseCurrencyLTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyContains applies the Contains predicate on the "purchase_currency" field.
+func PurchaseCurrencyContains(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContains(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyHasPrefix applies the HasPrefix predicate on the "purchase_currency" field.
+func PurchaseCurrencyHasPrefix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasPrefix(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyHasSuffix applies the HasSuffix predicate on the "purchase_currency" field.
+func PurchaseCurrencyHasSuffix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasSuffix(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyIsNil applies the IsNil predicate on the "purchase_currency" field.
+func PurchaseCurrencyIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldPurchaseCurrency))
+}
+
+// PurchaseCurrencyNotNil applies the NotNil predicate on the "purchase_currency" field.
+func PurchaseCurrencyNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldPurchaseCurrency))
+}
+
+// PurchaseCurrencyEqualFold applies the EqualFold predicate on the "purchase_currency" field.
+func PurchaseCurrencyEqualFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEqualFold(FieldPurchaseCurrency, v))
+}
+
+// PurchaseCurrencyContainsFold applies the ContainsFold predicate on the "purchase_currency" field.
+func PurchaseCurrencyContainsFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContainsFold(FieldPurchaseCurrency, v))
+}
+
 // SoldDateEQ applies the EQ predicate on the "sold_date" field.
 func SoldDateEQ(v time.Time) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoldDate, v))
@@ -1346,6 +1601,81 @@ func SoldPriceLTE(v float64) predicate.Entity {
 	return predicate.Entity(sql.FieldLTE(FieldSoldPrice, v))
 }
 
+// SoldCurrencyEQ applies the EQ predicate on the "sold_currency" field.
+func SoldCurrencyEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyNEQ applies the NEQ predicate on the "sold_currency" field.
+func SoldCurrencyNEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyIn applies the In predicate on the "sold_currency" field.
+func SoldCurrencyIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldSoldCurrency, vs...))
+}
+
+// SoldCurrencyNotIn applies the NotIn predicate on the "sold_currency" field.
+func SoldCurrencyNotIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldSoldCurrency, vs...))
+}
+
+// SoldCurrencyGT applies the GT predicate on the "sold_currency" field.
+func SoldCurrencyGT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyGTE applies the GTE predicate on the "sold_currency" field.
+func SoldCurrencyGTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyLT applies the LT predicate on the "sold_currency" field.
+func SoldCurrencyLT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyLTE applies the LTE predicate on the "sold_currency" field.
+func SoldCurrencyLTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyContains applies the Contains predicate on the "sold_currency" field.
+func SoldCurrencyContains(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContains(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyHasPrefix applies the HasPrefix predicate on the "sold_currency" field.
+func SoldCurrencyHasPrefix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasPrefix(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyHasSuffix applies the HasSuffix predicate on the "sold_currency" field.
+func SoldCurrencyHasSuffix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasSuffix(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyIsNil applies the IsNil predicate on the "sold_currency" field.
+func SoldCurrencyIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldSoldCurrency))
+}
+
+// SoldCurrencyNotNil applies the NotNil predicate on the "sold_currency" field.
+func SoldCurrencyNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldSoldCurrency))
+}
+
+// SoldCurrencyEqualFold applies the EqualFold predicate on the "sold_currency" field.
+func SoldCurrencyEqualFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEqualFold(FieldSoldCurrency, v))
+}
+
+// SoldCurrencyContainsFold applies the ContainsFold predicate on the "sold_currency" field.
+func SoldCurrencyContainsFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContainsFold(FieldSoldCurrency, v))
+}
+
 // SoldNotesEQ applies the EQ predicate on the "sold_notes" field.
 func SoldNotesEQ(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoldNotes, v))
@@ -1421,6 +1751,331 @@ func SoldNotesContainsFold(v string) predicate.Entity {
 	return predicate.Entity(sql.FieldContainsFold(FieldSoldNotes, v))
 }
 
+// ManualValueEQ applies the EQ predicate on the "manual_value" field.
+func ManualValueEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldManualValue, v))
+}
+
+// ManualValueNEQ applies the NEQ predicate on the "manual_value" field.
+func ManualValueNEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldManualValue, v))
+}
+
+// ManualValueIn applies the In predicate on the "manual_value" field.
+func ManualValueIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldManualValue, vs...))
+}
+
+// ManualValueNotIn applies the NotIn predicate on the "manual_value" field.
+func ManualValueNotIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldManualValue, vs...))
+}
+
+// ManualValueGT applies the GT predicate on the "manual_value" field.
+func ManualValueGT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldManualValue, v))
+}
+
+// ManualValueGTE applies the GTE predicate on the "manual_value" field.
+func ManualValueGTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldManualValue, v))
+}
+
+// ManualValueLT applies the LT predicate on the "manual_value" field.
+func ManualValueLT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldManualValue, v))
+}
+
+// ManualValueLTE applies the LTE predicate on the "manual_value" field.
+func ManualValueLTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldManualValue, v))
+}
+
+// ManualValueIsNil applies the IsNil predicate on the "manual_value" field.
+func ManualValueIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldManualValue))
+}
+
+// ManualValueNotNil applies the NotNil predicate on the "manual_value" field.
+func ManualValueNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldManualValue))
+}
+
+// MinQuantityEQ applies the EQ predicate on the "min_quantity" field.
+func MinQuantityEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldMinQuantity, v))
+}
+
+// MinQuantityNEQ applies the NEQ predicate on the "min_quantity" field.
+func MinQuantityNEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldMinQuantity, v))
+}
+
+// MinQuantityIn applies the In predicate on the "min_quantity" field.
+func MinQuantityIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldMinQuantity, vs...))
+}
+
+// MinQuantityNotIn applies the NotIn predicate on the "min_quantity" field.
+func MinQuantityNotIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldMinQuantity, vs...))
+}
+
+// MinQuantityGT applies the GT predicate on the "min_quantity" field.
+func MinQuantityGT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldMinQuantity, v))
+}
+
+// MinQuantityGTE applies the GTE predicate on the "min_quantity" field.
+func MinQuantityGTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldMinQuantity, v))
+}
+
+// MinQuantityLT applies the LT predicate on the "min_quantity" field.
+func MinQuantityLT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldMinQuantity, v))
+}
+
+// MinQuantityLTE applies the LTE predicate on the "min_quantity" field.
+func MinQuantityLTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldMinQuantity, v))
+}
+
+// MinQuantityIsNil applies the IsNil predicate on the "min_quantity" field.
+func MinQuantityIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldMinQuantity))
+}
+
+// MinQuantityNotNil applies the NotNil predicate on the "min_quantity" field.
+func MinQuantityNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldMinQuantity))
+}
+
+// ReorderPointEQ applies the EQ predicate on the "reorder_point" field.
+func ReorderPointEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointNEQ applies the NEQ predicate on the "reorder_point" field.
+func ReorderPointNEQ(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointIn applies the In predicate on the "reorder_point" field.
+func ReorderPointIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointNotIn applies the NotIn predicate on the "reorder_point" field.
+func ReorderPointNotIn(vs ...float64) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointGT applies the GT predicate on the "reorder_point" field.
+func ReorderPointGT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldReorderPoint, v))
+}
+
+// ReorderPointGTE applies the GTE predicate on the "reorder_point" field.
+func ReorderPointGTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldReorderPoint, v))
+}
+
+// ReorderPointLT applies the LT predicate on the "reorder_point" field.
+func ReorderPointLT(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldReorderPoint, v))
+}
+
+// ReorderPointLTE applies the LTE predicate on the "reorder_point" field.
+func ReorderPointLTE(v float64) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldReorderPoint, v))
+}
+
+// ReorderPointIsNil applies the IsNil predicate on the "reorder_point" field.
+func ReorderPointIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldReorderPoint))
+}
+
+// ReorderPointNotNil applies the NotNil predicate on the "reorder_point" field.
+func ReorderPointNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldReorderPoint))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldUnit, v))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldUnit, v))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldUnit, v))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldUnit, v))
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContains(FieldUnit, v))
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasPrefix(FieldUnit, v))
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldHasSuffix(FieldUnit, v))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldUnit))
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldEqualFold(FieldUnit, v))
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.Entity {
+	return predicate.Entity(sql.FieldContainsFold(FieldUnit, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TrashRootIDEQ applies the EQ predicate on the "trash_root_id" field.
+func TrashRootIDEQ(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldTrashRootID, v))
+}
+
+// TrashRootIDNEQ applies the NEQ predicate on the "trash_root_id" field.
+func TrashRootIDNEQ(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldTrashRootID, v))
+}
+
+// TrashRootIDIn applies the In predicate on the "trash_root_id" field.
+func TrashRootIDIn(vs ...uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldTrashRootID, vs...))
+}
+
+// TrashRootIDNotIn applies the NotIn predicate on the "trash_root_id" field.
+func TrashRootIDNotIn(vs ...uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldTrashRootID, vs...))
+}
+
+// TrashRootIDGT applies the GT predicate on the "trash_root_id" field.
+func TrashRootIDGT(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldTrashRootID, v))
+}
+
+// TrashRootIDGTE applies the GTE predicate on the "trash_root_id" field.
+func TrashRootIDGTE(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldTrashRootID, v))
+}
+
+// TrashRootIDLT applies the LT predicate on the "trash_root_id" field.
+func TrashRootIDLT(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldTrashRootID, v))
+}
+
+// TrashRootIDLTE applies the LTE predicate on the "trash_root_id" field.
+func TrashRootIDLTE(v uuid.UUID) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldTrashRootID, v))
+}
+
+// TrashRootIDIsNil applies the IsNil predicate on the "trash_root_id" field.
+func TrashRootIDIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldTrashRootID))
+}
+
+// TrashRootIDNotNil applies the NotNil predicate on the "trash_root_id" field.
+func TrashRootIDNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldTrashRootID))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.Entity {
 	return predicate.Entity(func(s *sql.Selector) {
@@ -1582,6 +2237,75 @@ func HasMaintenanceEntriesWith(preds ...predicate.MaintenanceEntry) predicate.En
 	})
 }
 
+// HasStockMovements applies the HasEdge predicate on the "stock_movements" edge.
+func HasStockMovements() predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StockMovementsTable, StockMovementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStockMovementsWith applies the HasEdge predicate on the "stock_movements" edge with a given conditions (other predicates).
+func HasStockMovementsWith(preds ...predicate.StockMovement) predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := newStockMovementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoans applies the HasEdge predicate on the "loans" edge.
+func HasLoans() predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoansWith applies the HasEdge predicate on the "loans" edge with a given conditions (other predicates).
+func HasLoansWith(preds ...predicate.Loan) predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := newLoansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWarrantyNotifications applies the HasEdge predicate on the "warranty_notifications" edge.
+func HasWarrantyNotifications() predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarrantyNotificationsTable, WarrantyNotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarrantyNotificationsWith applies the HasEdge predicate on the "warranty_notifications" edge with a given conditions (other predicates).
+func HasWarrantyNotificationsWith(preds ...predicate.WarrantyNotification) predicate.Entity {
+	return predicate.Entity(func(s *sql.Selector) {
+		step := newWarrantyNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAttachments applies the HasEdge predicate on the "attachments" edge.
 func HasAttachments() predicate.Entity {
 	return predicate.Entity(func(s *sql.Selector) {
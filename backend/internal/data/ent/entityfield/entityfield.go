@@ -30,6 +30,8 @@ const (
 	FieldTextValue = "text_value"
 	// FieldNumberValue holds the string denoting the number_value field in the database.
 	FieldNumberValue = "number_value"
+	// FieldDecimalValue holds the string denoting the decimal_value field in the database.
+	FieldDecimalValue = "decimal_value"
 	// FieldBooleanValue holds the string denoting the boolean_value field in the database.
 	FieldBooleanValue = "boolean_value"
 	// FieldTimeValue holds the string denoting the time_value field in the database.
@@ -57,6 +59,7 @@ var Columns = []string{
 	FieldType,
 	FieldTextValue,
 	FieldNumberValue,
+	FieldDecimalValue,
 	FieldBooleanValue,
 	FieldTimeValue,
 }
@@ -108,10 +111,17 @@ type Type string
 
 // Type values.
 const (
-	TypeText    Type = "text"
-	TypeNumber  Type = "number"
-	TypeBoolean Type = "boolean"
-	TypeTime    Type = "time"
+	TypeText        Type = "text"
+	TypeNumber      Type = "number"
+	TypeBoolean     Type = "boolean"
+	TypeTime        Type = "time"
+	TypeDecimal     Type = "decimal"
+	TypeDate        Type = "date"
+	TypeURL         Type = "url"
+	TypeEmail       Type = "email"
+	TypeSelect      Type = "select"
+	TypeMultiSelect Type = "multi_select"
+	TypeCurrency    Type = "currency"
 )
 
 func (_type Type) String() string {
@@ -121,7 +131,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeText, TypeNumber, TypeBoolean, TypeTime:
+	case TypeText, TypeNumber, TypeBoolean, TypeTime, TypeDecimal, TypeDate, TypeURL, TypeEmail, TypeSelect, TypeMultiSelect, TypeCurrency:
 		return nil
 	default:
 		return fmt.Errorf("entityfield: invalid enum value for type field: %q", _type)
@@ -171,6 +181,11 @@ func ByNumberValue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNumberValue, opts...).ToFunc()
 }
 
+// ByDecimalValue orders the results by the decimal_value field.
+func ByDecimalValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecimalValue, opts...).ToFunc()
+}
+
 // ByBooleanValue orders the results by the boolean_value field.
 func ByBooleanValue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBooleanValue, opts...).ToFunc()
@@ -86,6 +86,11 @@ func NumberValue(v int) predicate.EntityField {
 	return predicate.EntityField(sql.FieldEQ(FieldNumberValue, v))
 }
 
+// DecimalValue applies equality check predicate on the "decimal_value" field. It's identical to DecimalValueEQ.
+func DecimalValue(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldEQ(FieldDecimalValue, v))
+}
+
 // BooleanValue applies equality check predicate on the "boolean_value" field. It's identical to BooleanValueEQ.
 func BooleanValue(v bool) predicate.EntityField {
 	return predicate.EntityField(sql.FieldEQ(FieldBooleanValue, v))
@@ -461,6 +466,56 @@ func NumberValueNotNil() predicate.EntityField {
 	return predicate.EntityField(sql.FieldNotNull(FieldNumberValue))
 }
 
+// DecimalValueEQ applies the EQ predicate on the "decimal_value" field.
+func DecimalValueEQ(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldEQ(FieldDecimalValue, v))
+}
+
+// DecimalValueNEQ applies the NEQ predicate on the "decimal_value" field.
+func DecimalValueNEQ(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldNEQ(FieldDecimalValue, v))
+}
+
+// DecimalValueIn applies the In predicate on the "decimal_value" field.
+func DecimalValueIn(vs ...float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldIn(FieldDecimalValue, vs...))
+}
+
+// DecimalValueNotIn applies the NotIn predicate on the "decimal_value" field.
+func DecimalValueNotIn(vs ...float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldNotIn(FieldDecimalValue, vs...))
+}
+
+// DecimalValueGT applies the GT predicate on the "decimal_value" field.
+func DecimalValueGT(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldGT(FieldDecimalValue, v))
+}
+
+// DecimalValueGTE applies the GTE predicate on the "decimal_value" field.
+func DecimalValueGTE(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldGTE(FieldDecimalValue, v))
+}
+
+// DecimalValueLT applies the LT predicate on the "decimal_value" field.
+func DecimalValueLT(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldLT(FieldDecimalValue, v))
+}
+
+// DecimalValueLTE applies the LTE predicate on the "decimal_value" field.
+func DecimalValueLTE(v float64) predicate.EntityField {
+	return predicate.EntityField(sql.FieldLTE(FieldDecimalValue, v))
+}
+
+// DecimalValueIsNil applies the IsNil predicate on the "decimal_value" field.
+func DecimalValueIsNil() predicate.EntityField {
+	return predicate.EntityField(sql.FieldIsNull(FieldDecimalValue))
+}
+
+// DecimalValueNotNil applies the NotNil predicate on the "decimal_value" field.
+func DecimalValueNotNil() predicate.EntityField {
+	return predicate.EntityField(sql.FieldNotNull(FieldDecimalValue))
+}
+
 // BooleanValueEQ applies the EQ predicate on the "boolean_value" field.
 func BooleanValueEQ(v bool) predicate.EntityField {
 	return predicate.EntityField(sql.FieldEQ(FieldBooleanValue, v))
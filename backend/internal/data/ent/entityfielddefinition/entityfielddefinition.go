@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entityfielddefinition
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the entityfielddefinition type in the database.
+	Label = "entity_field_definition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldEntityTypeID holds the string denoting the entity_type_id field in the database.
+	FieldEntityTypeID = "entity_type_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldDefaultValue holds the string denoting the default_value field in the database.
+	FieldDefaultValue = "default_value"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeEntityType holds the string denoting the entity_type edge name in mutations.
+	EdgeEntityType = "entity_type"
+	// Table holds the table name of the entityfielddefinition in the database.
+	Table = "entity_field_definitions"
+	// EntityTypeTable is the table that holds the entity_type relation/edge.
+	EntityTypeTable = "entity_field_definitions"
+	// EntityTypeInverseTable is the table name for the EntityType entity.
+	// It exists in this package in order to avoid circular dependency with the "entitytype" package.
+	EntityTypeInverseTable = "entity_types"
+	// EntityTypeColumn is the table column denoting the entity_type relation/edge.
+	EntityTypeColumn = "entity_type_id"
+)
+
+// Columns holds all SQL columns for entityfielddefinition fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldDescription,
+	FieldEntityTypeID,
+	FieldType,
+	FieldOptions,
+	FieldRequired,
+	FieldDefaultValue,
+	FieldPosition,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// DefaultRequired holds the default value on creation for the "required" field.
+	DefaultRequired bool
+	// DefaultValueValidator is a validator for the "default_value" field. It is called by the builders before save.
+	DefaultValueValidator func(string) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeText        Type = "text"
+	TypeNumber      Type = "number"
+	TypeDecimal     Type = "decimal"
+	TypeBoolean     Type = "boolean"
+	TypeDate        Type = "date"
+	TypeURL         Type = "url"
+	TypeEmail       Type = "email"
+	TypeSelect      Type = "select"
+	TypeMultiSelect Type = "multi_select"
+	TypeCurrency    Type = "currency"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeText, TypeNumber, TypeDecimal, TypeBoolean, TypeDate, TypeURL, TypeEmail, TypeSelect, TypeMultiSelect, TypeCurrency:
+		return nil
+	default:
+		return fmt.Errorf("entityfielddefinition: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the EntityFieldDefinition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByEntityTypeID orders the results by the entity_type_id field.
+func ByEntityTypeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityTypeID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByRequired orders the results by the required field.
+func ByRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequired, opts...).ToFunc()
+}
+
+// ByDefaultValue orders the results by the default_value field.
+func ByDefaultValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultValue, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByEntityTypeField orders the results by entity_type field.
+func ByEntityTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntityTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEntityTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntityTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EntityTypeTable, EntityTypeColumn),
+	)
+}
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entityfielddefinition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// EntityTypeID applies equality check predicate on the "entity_type_id" field. It's identical to EntityTypeIDEQ.
+func EntityTypeID(v uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldEntityTypeID, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// DefaultValue applies equality check predicate on the "default_value" field. It's identical to DefaultValueEQ.
+func DefaultValue(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EntityTypeIDEQ applies the EQ predicate on the "entity_type_id" field.
+func EntityTypeIDEQ(v uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldEntityTypeID, v))
+}
+
+// EntityTypeIDNEQ applies the NEQ predicate on the "entity_type_id" field.
+func EntityTypeIDNEQ(v uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldEntityTypeID, v))
+}
+
+// EntityTypeIDIn applies the In predicate on the "entity_type_id" field.
+func EntityTypeIDIn(vs ...uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldEntityTypeID, vs...))
+}
+
+// EntityTypeIDNotIn applies the NotIn predicate on the "entity_type_id" field.
+func EntityTypeIDNotIn(vs ...uuid.UUID) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldEntityTypeID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldType, vs...))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotNull(FieldOptions))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldRequired, v))
+}
+
+// DefaultValueEQ applies the EQ predicate on the "default_value" field.
+func DefaultValueEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueNEQ applies the NEQ predicate on the "default_value" field.
+func DefaultValueNEQ(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueIn applies the In predicate on the "default_value" field.
+func DefaultValueIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueNotIn applies the NotIn predicate on the "default_value" field.
+func DefaultValueNotIn(vs ...string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueGT applies the GT predicate on the "default_value" field.
+func DefaultValueGT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldDefaultValue, v))
+}
+
+// DefaultValueGTE applies the GTE predicate on the "default_value" field.
+func DefaultValueGTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldDefaultValue, v))
+}
+
+// DefaultValueLT applies the LT predicate on the "default_value" field.
+func DefaultValueLT(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldDefaultValue, v))
+}
+
+// DefaultValueLTE applies the LTE predicate on the "default_value" field.
+func DefaultValueLTE(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldDefaultValue, v))
+}
+
+// DefaultValueContains applies the Contains predicate on the "default_value" field.
+func DefaultValueContains(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContains(FieldDefaultValue, v))
+}
+
+// DefaultValueHasPrefix applies the HasPrefix predicate on the "default_value" field.
+func DefaultValueHasPrefix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasPrefix(FieldDefaultValue, v))
+}
+
+// DefaultValueHasSuffix applies the HasSuffix predicate on the "default_value" field.
+func DefaultValueHasSuffix(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldHasSuffix(FieldDefaultValue, v))
+}
+
+// DefaultValueIsNil applies the IsNil predicate on the "default_value" field.
+func DefaultValueIsNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIsNull(FieldDefaultValue))
+}
+
+// DefaultValueNotNil applies the NotNil predicate on the "default_value" field.
+func DefaultValueNotNil() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotNull(FieldDefaultValue))
+}
+
+// DefaultValueEqualFold applies the EqualFold predicate on the "default_value" field.
+func DefaultValueEqualFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEqualFold(FieldDefaultValue, v))
+}
+
+// DefaultValueContainsFold applies the ContainsFold predicate on the "default_value" field.
+func DefaultValueContainsFold(v string) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldContainsFold(FieldDefaultValue, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasEntityType applies the HasEdge predicate on the "entity_type" edge.
+func HasEntityType() predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntityTypeTable, EntityTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntityTypeWith applies the HasEdge predicate on the "entity_type" edge with a given conditions (other predicates).
+func HasEntityTypeWith(preds ...predicate.EntityType) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(func(s *sql.Selector) {
+		step := newEntityTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EntityFieldDefinition) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EntityFieldDefinition) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EntityFieldDefinition) predicate.EntityFieldDefinition {
+	return predicate.EntityFieldDefinition(sql.NotPredicates(p))
+}
@@ -3,6 +3,7 @@
 package entitytype
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,12 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDepreciationMethod holds the string denoting the depreciation_method field in the database.
+	FieldDepreciationMethod = "depreciation_method"
+	// FieldUsefulLifeYears holds the string denoting the useful_life_years field in the database.
+	FieldUsefulLifeYears = "useful_life_years"
+	// FieldSalvageValue holds the string denoting the salvage_value field in the database.
+	FieldSalvageValue = "salvage_value"
 	// FieldIsLocation holds the string denoting the is_location field in the database.
 	FieldIsLocation = "is_location"
 	// FieldIcon holds the string denoting the icon field in the database.
@@ -33,6 +40,8 @@ const (
 	EdgeEntities = "entities"
 	// EdgeDefaultTemplate holds the string denoting the default_template edge name in mutations.
 	EdgeDefaultTemplate = "default_template"
+	// EdgeFieldDefinitions holds the string denoting the field_definitions edge name in mutations.
+	EdgeFieldDefinitions = "field_definitions"
 	// Table holds the table name of the entitytype in the database.
 	Table = "entity_types"
 	// GroupTable is the table that holds the group relation/edge.
@@ -56,6 +65,13 @@ const (
 	DefaultTemplateInverseTable = "entity_templates"
 	// DefaultTemplateColumn is the table column denoting the default_template relation/edge.
 	DefaultTemplateColumn = "entity_type_default_template"
+	// FieldDefinitionsTable is the table that holds the field_definitions relation/edge.
+	FieldDefinitionsTable = "entity_field_definitions"
+	// FieldDefinitionsInverseTable is the table name for the EntityFieldDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "entityfielddefinition" package.
+	FieldDefinitionsInverseTable = "entity_field_definitions"
+	// FieldDefinitionsColumn is the table column denoting the field_definitions relation/edge.
+	FieldDefinitionsColumn = "entity_type_id"
 )
 
 // Columns holds all SQL columns for entitytype fields.
@@ -65,6 +81,9 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldDescription,
+	FieldDepreciationMethod,
+	FieldUsefulLifeYears,
+	FieldSalvageValue,
 	FieldIsLocation,
 	FieldIcon,
 }
@@ -110,6 +129,31 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// DepreciationMethod defines the type for the "depreciation_method" enum field.
+type DepreciationMethod string
+
+// DepreciationMethod values.
+const (
+	DepreciationMethodNone             DepreciationMethod = "none"
+	DepreciationMethodStraightLine     DepreciationMethod = "straight_line"
+	DepreciationMethodDecliningBalance DepreciationMethod = "declining_balance"
+	DepreciationMethodManual           DepreciationMethod = "manual"
+)
+
+func (dm DepreciationMethod) String() string {
+	return string(dm)
+}
+
+// DepreciationMethodValidator is a validator for the "depreciation_method" field enum values. It is called by the builders before save.
+func DepreciationMethodValidator(dm DepreciationMethod) error {
+	switch dm {
+	case DepreciationMethodNone, DepreciationMethodStraightLine, DepreciationMethodDecliningBalance, DepreciationMethodManual:
+		return nil
+	default:
+		return fmt.Errorf("entitytype: invalid enum value for depreciation_method field: %q", dm)
+	}
+}
+
 // OrderOption defines the ordering options for the EntityType queries.
 type OrderOption func(*sql.Selector)
 
@@ -138,6 +182,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByDepreciationMethod orders the results by the depreciation_method field.
+func ByDepreciationMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepreciationMethod, opts...).ToFunc()
+}
+
+// ByUsefulLifeYears orders the results by the useful_life_years field.
+func ByUsefulLifeYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsefulLifeYears, opts...).ToFunc()
+}
+
+// BySalvageValue orders the results by the salvage_value field.
+func BySalvageValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalvageValue, opts...).ToFunc()
+}
+
 // ByIsLocation orders the results by the is_location field.
 func ByIsLocation(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsLocation, opts...).ToFunc()
@@ -175,6 +234,20 @@ func ByDefaultTemplateField(field string, opts ...sql.OrderTermOption) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newDefaultTemplateStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFieldDefinitionsCount orders the results by field_definitions count.
+func ByFieldDefinitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFieldDefinitionsStep(), opts...)
+	}
+}
+
+// ByFieldDefinitions orders the results by field_definitions terms.
+func ByFieldDefinitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFieldDefinitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -196,3 +269,10 @@ func newDefaultTemplateStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, DefaultTemplateTable, DefaultTemplateColumn),
 	)
 }
+func newFieldDefinitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FieldDefinitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FieldDefinitionsTable, FieldDefinitionsColumn),
+	)
+}
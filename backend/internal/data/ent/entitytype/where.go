@@ -76,6 +76,16 @@ func Description(v string) predicate.EntityType {
 	return predicate.EntityType(sql.FieldEQ(FieldDescription, v))
 }
 
+// UsefulLifeYears applies equality check predicate on the "useful_life_years" field. It's identical to UsefulLifeYearsEQ.
+func UsefulLifeYears(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldEQ(FieldUsefulLifeYears, v))
+}
+
+// SalvageValue applies equality check predicate on the "salvage_value" field. It's identical to SalvageValueEQ.
+func SalvageValue(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldEQ(FieldSalvageValue, v))
+}
+
 // IsLocation applies equality check predicate on the "is_location" field. It's identical to IsLocationEQ.
 func IsLocation(v bool) predicate.EntityType {
 	return predicate.EntityType(sql.FieldEQ(FieldIsLocation, v))
@@ -306,6 +316,136 @@ func DescriptionContainsFold(v string) predicate.EntityType {
 	return predicate.EntityType(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DepreciationMethodEQ applies the EQ predicate on the "depreciation_method" field.
+func DepreciationMethodEQ(v DepreciationMethod) predicate.EntityType {
+	return predicate.EntityType(sql.FieldEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodNEQ applies the NEQ predicate on the "depreciation_method" field.
+func DepreciationMethodNEQ(v DepreciationMethod) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNEQ(FieldDepreciationMethod, v))
+}
+
+// DepreciationMethodIn applies the In predicate on the "depreciation_method" field.
+func DepreciationMethodIn(vs ...DepreciationMethod) predicate.EntityType {
+	return predicate.EntityType(sql.FieldIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationMethodNotIn applies the NotIn predicate on the "depreciation_method" field.
+func DepreciationMethodNotIn(vs ...DepreciationMethod) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotIn(FieldDepreciationMethod, vs...))
+}
+
+// DepreciationMethodIsNil applies the IsNil predicate on the "depreciation_method" field.
+func DepreciationMethodIsNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldIsNull(FieldDepreciationMethod))
+}
+
+// DepreciationMethodNotNil applies the NotNil predicate on the "depreciation_method" field.
+func DepreciationMethodNotNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotNull(FieldDepreciationMethod))
+}
+
+// UsefulLifeYearsEQ applies the EQ predicate on the "useful_life_years" field.
+func UsefulLifeYearsEQ(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldEQ(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsNEQ applies the NEQ predicate on the "useful_life_years" field.
+func UsefulLifeYearsNEQ(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNEQ(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsIn applies the In predicate on the "useful_life_years" field.
+func UsefulLifeYearsIn(vs ...float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldIn(FieldUsefulLifeYears, vs...))
+}
+
+// UsefulLifeYearsNotIn applies the NotIn predicate on the "useful_life_years" field.
+func UsefulLifeYearsNotIn(vs ...float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotIn(FieldUsefulLifeYears, vs...))
+}
+
+// UsefulLifeYearsGT applies the GT predicate on the "useful_life_years" field.
+func UsefulLifeYearsGT(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldGT(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsGTE applies the GTE predicate on the "useful_life_years" field.
+func UsefulLifeYearsGTE(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldGTE(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsLT applies the LT predicate on the "useful_life_years" field.
+func UsefulLifeYearsLT(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldLT(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsLTE applies the LTE predicate on the "useful_life_years" field.
+func UsefulLifeYearsLTE(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldLTE(FieldUsefulLifeYears, v))
+}
+
+// UsefulLifeYearsIsNil applies the IsNil predicate on the "useful_life_years" field.
+func UsefulLifeYearsIsNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldIsNull(FieldUsefulLifeYears))
+}
+
+// UsefulLifeYearsNotNil applies the NotNil predicate on the "useful_life_years" field.
+func UsefulLifeYearsNotNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotNull(FieldUsefulLifeYears))
+}
+
+// SalvageValueEQ applies the EQ predicate on the "salvage_value" field.
+func SalvageValueEQ(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldEQ(FieldSalvageValue, v))
+}
+
+// SalvageValueNEQ applies the NEQ predicate on the "salvage_value" field.
+func SalvageValueNEQ(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNEQ(FieldSalvageValue, v))
+}
+
+// SalvageValueIn applies the In predicate on the "salvage_value" field.
+func SalvageValueIn(vs ...float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldIn(FieldSalvageValue, vs...))
+}
+
+// SalvageValueNotIn applies the NotIn predicate on the "salvage_value" field.
+func SalvageValueNotIn(vs ...float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotIn(FieldSalvageValue, vs...))
+}
+
+// SalvageValueGT applies the GT predicate on the "salvage_value" field.
+func SalvageValueGT(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldGT(FieldSalvageValue, v))
+}
+
+// SalvageValueGTE applies the GTE predicate on the "salvage_value" field.
+func SalvageValueGTE(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldGTE(FieldSalvageValue, v))
+}
+
+// SalvageValueLT applies the LT predicate on the "salvage_value" field.
+func SalvageValueLT(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldLT(FieldSalvageValue, v))
+}
+
+// SalvageValueLTE applies the LTE predicate on the "salvage_value" field.
+func SalvageValueLTE(v float64) predicate.EntityType {
+	return predicate.EntityType(sql.FieldLTE(FieldSalvageValue, v))
+}
+
+// SalvageValueIsNil applies the IsNil predicate on the "salvage_value" field.
+func SalvageValueIsNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldIsNull(FieldSalvageValue))
+}
+
+// SalvageValueNotNil applies the NotNil predicate on the "salvage_value" field.
+func SalvageValueNotNil() predicate.EntityType {
+	return predicate.EntityType(sql.FieldNotNull(FieldSalvageValue))
+}
+
 // IsLocationEQ applies the EQ predicate on the "is_location" field.
 func IsLocationEQ(v bool) predicate.EntityType {
 	return predicate.EntityType(sql.FieldEQ(FieldIsLocation, v))
@@ -460,6 +600,29 @@ func HasDefaultTemplateWith(preds ...predicate.EntityTemplate) predicate.EntityT
 	})
 }
 
+// HasFieldDefinitions applies the HasEdge predicate on the "field_definitions" edge.
+func HasFieldDefinitions() predicate.EntityType {
+	return predicate.EntityType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FieldDefinitionsTable, FieldDefinitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFieldDefinitionsWith applies the HasEdge predicate on the "field_definitions" edge with a given conditions (other predicates).
+func HasFieldDefinitionsWith(preds ...predicate.EntityFieldDefinition) predicate.EntityType {
+	return predicate.EntityType(func(s *sql.Selector) {
+		step := newFieldDefinitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.EntityType) predicate.EntityType {
 	return predicate.EntityType(sql.AndPredicates(predicates...))
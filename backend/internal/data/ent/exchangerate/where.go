@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Base applies equality check predicate on the "base" field. It's identical to BaseEQ.
+func Base(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldBase, v))
+}
+
+// Quote applies equality check predicate on the "quote" field. It's identical to QuoteEQ.
+func Quote(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldQuote, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// BaseEQ applies the EQ predicate on the "base" field.
+func BaseEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldBase, v))
+}
+
+// BaseNEQ applies the NEQ predicate on the "base" field.
+func BaseNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldBase, v))
+}
+
+// BaseIn applies the In predicate on the "base" field.
+func BaseIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldBase, vs...))
+}
+
+// BaseNotIn applies the NotIn predicate on the "base" field.
+func BaseNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldBase, vs...))
+}
+
+// BaseGT applies the GT predicate on the "base" field.
+func BaseGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldBase, v))
+}
+
+// BaseGTE applies the GTE predicate on the "base" field.
+func BaseGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldBase, v))
+}
+
+// BaseLT applies the LT predicate on the "base" field.
+func BaseLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldBase, v))
+}
+
+// BaseLTE applies the LTE predicate on the "base" field.
+func BaseLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldBase, v))
+}
+
+// BaseContains applies the Contains predicate on the "base" field.
+func BaseContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldBase, v))
+}
+
+// BaseHasPrefix applies the HasPrefix predicate on the "base" field.
+func BaseHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldBase, v))
+}
+
+// BaseHasSuffix applies the HasSuffix predicate on the "base" field.
+func BaseHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldBase, v))
+}
+
+// BaseEqualFold applies the EqualFold predicate on the "base" field.
+func BaseEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldBase, v))
+}
+
+// BaseContainsFold applies the ContainsFold predicate on the "base" field.
+func BaseContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldBase, v))
+}
+
+// QuoteEQ applies the EQ predicate on the "quote" field.
+func QuoteEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldQuote, v))
+}
+
+// QuoteNEQ applies the NEQ predicate on the "quote" field.
+func QuoteNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldQuote, v))
+}
+
+// QuoteIn applies the In predicate on the "quote" field.
+func QuoteIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldQuote, vs...))
+}
+
+// QuoteNotIn applies the NotIn predicate on the "quote" field.
+func QuoteNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldQuote, vs...))
+}
+
+// QuoteGT applies the GT predicate on the "quote" field.
+func QuoteGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldQuote, v))
+}
+
+// QuoteGTE applies the GTE predicate on the "quote" field.
+func QuoteGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldQuote, v))
+}
+
+// QuoteLT applies the LT predicate on the "quote" field.
+func QuoteLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldQuote, v))
+}
+
+// QuoteLTE applies the LTE predicate on the "quote" field.
+func QuoteLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldQuote, v))
+}
+
+// QuoteContains applies the Contains predicate on the "quote" field.
+func QuoteContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldQuote, v))
+}
+
+// QuoteHasPrefix applies the HasPrefix predicate on the "quote" field.
+func QuoteHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldQuote, v))
+}
+
+// QuoteHasSuffix applies the HasSuffix predicate on the "quote" field.
+func QuoteHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldQuote, v))
+}
+
+// QuoteEqualFold applies the EqualFold predicate on the "quote" field.
+func QuoteEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldQuote, v))
+}
+
+// QuoteContainsFold applies the ContainsFold predicate on the "quote" field.
+func QuoteContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldQuote, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldRate, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldDate, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.NotPredicates(p))
+}
@@ -3,6 +3,7 @@
 package group
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +24,12 @@ const (
 	FieldName = "name"
 	// FieldCurrency holds the string denoting the currency field in the database.
 	FieldCurrency = "currency"
+	// FieldCurrencyConversion holds the string denoting the currency_conversion field in the database.
+	FieldCurrencyConversion = "currency_conversion"
+	// FieldWarrantyNotifyDays holds the string denoting the warranty_notify_days field in the database.
+	FieldWarrantyNotifyDays = "warranty_notify_days"
+	// FieldWebhookSecret holds the string denoting the webhook_secret field in the database.
+	FieldWebhookSecret = "webhook_secret"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeEntityTypes holds the string denoting the entity_types edge name in mutations.
@@ -39,6 +46,18 @@ const (
 	EdgeEntityTemplates = "entity_templates"
 	// EdgeExports holds the string denoting the exports edge name in mutations.
 	EdgeExports = "exports"
+	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
+	EdgeAuditLogs = "audit_logs"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeExchangeRates holds the string denoting the exchange_rates edge name in mutations.
+	EdgeExchangeRates = "exchange_rates"
+	// EdgeAttachmentUploads holds the string denoting the attachment_uploads edge name in mutations.
+	EdgeAttachmentUploads = "attachment_uploads"
+	// EdgeBlobs holds the string denoting the blobs edge name in mutations.
+	EdgeBlobs = "blobs"
 	// EdgeUserGroups holds the string denoting the user_groups edge name in mutations.
 	EdgeUserGroups = "user_groups"
 	// Table holds the table name of the group in the database.
@@ -97,6 +116,48 @@ const (
 	ExportsInverseTable = "exports"
 	// ExportsColumn is the table column denoting the exports relation/edge.
 	ExportsColumn = "group_id"
+	// AuditLogsTable is the table that holds the audit_logs relation/edge.
+	AuditLogsTable = "audit_logs"
+	// AuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogsInverseTable = "audit_logs"
+	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
+	AuditLogsColumn = "group_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "group_id"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "group_id"
+	// ExchangeRatesTable is the table that holds the exchange_rates relation/edge.
+	ExchangeRatesTable = "exchange_rates"
+	// ExchangeRatesInverseTable is the table name for the ExchangeRate entity.
+	// It exists in this package in order to avoid circular dependency with the "exchangerate" package.
+	ExchangeRatesInverseTable = "exchange_rates"
+	// ExchangeRatesColumn is the table column denoting the exchange_rates relation/edge.
+	ExchangeRatesColumn = "group_id"
+	// AttachmentUploadsTable is the table that holds the attachment_uploads relation/edge.
+	AttachmentUploadsTable = "attachment_uploads"
+	// AttachmentUploadsInverseTable is the table name for the AttachmentUpload entity.
+	// It exists in this package in order to avoid circular dependency with the "attachmentupload" package.
+	AttachmentUploadsInverseTable = "attachment_uploads"
+	// AttachmentUploadsColumn is the table column denoting the attachment_uploads relation/edge.
+	AttachmentUploadsColumn = "group_id"
+	// BlobsTable is the table that holds the blobs relation/edge.
+	BlobsTable = "blobs"
+	// BlobsInverseTable is the table name for the Blob entity.
+	// It exists in this package in order to avoid circular dependency with the "blob" package.
+	BlobsInverseTable = "blobs"
+	// BlobsColumn is the table column denoting the blobs relation/edge.
+	BlobsColumn = "group_id"
 	// UserGroupsTable is the table that holds the user_groups relation/edge.
 	UserGroupsTable = "user_groups"
 	// UserGroupsInverseTable is the table name for the UserGroup entity.
@@ -113,6 +174,9 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldName,
 	FieldCurrency,
+	FieldCurrencyConversion,
+	FieldWarrantyNotifyDays,
+	FieldWebhookSecret,
 }
 
 var (
@@ -142,10 +206,38 @@ var (
 	NameValidator func(string) error
 	// DefaultCurrency holds the default value on creation for the "currency" field.
 	DefaultCurrency string
+	// WebhookSecretValidator is a validator for the "webhook_secret" field. It is called by the builders before save.
+	WebhookSecretValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// CurrencyConversion defines the type for the "currency_conversion" enum field.
+type CurrencyConversion string
+
+// CurrencyConversionPurchaseDate is the default value of the CurrencyConversion enum.
+const DefaultCurrencyConversion = CurrencyConversionPurchaseDate
+
+// CurrencyConversion values.
+const (
+	CurrencyConversionPurchaseDate CurrencyConversion = "purchase_date"
+	CurrencyConversionToday        CurrencyConversion = "today"
+)
+
+func (cc CurrencyConversion) String() string {
+	return string(cc)
+}
+
+// CurrencyConversionValidator is a validator for the "currency_conversion" field enum values. It is called by the builders before save.
+func CurrencyConversionValidator(cc CurrencyConversion) error {
+	switch cc {
+	case CurrencyConversionPurchaseDate, CurrencyConversionToday:
+		return nil
+	default:
+		return fmt.Errorf("group: invalid enum value for currency_conversion field: %q", cc)
+	}
+}
+
 // OrderOption defines the ordering options for the Group queries.
 type OrderOption func(*sql.Selector)
 
@@ -174,6 +266,16 @@ func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
+// ByCurrencyConversion orders the results by the currency_conversion field.
+func ByCurrencyConversion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrencyConversion, opts...).ToFunc()
+}
+
+// ByWebhookSecret orders the results by the webhook_secret field.
+func ByWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookSecret, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -286,6 +388,90 @@ func ByExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAuditLogsCount orders the results by audit_logs count.
+func ByAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditLogsStep(), opts...)
+	}
+}
+
+// ByAuditLogs orders the results by audit_logs terms.
+func ByAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExchangeRatesCount orders the results by exchange_rates count.
+func ByExchangeRatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExchangeRatesStep(), opts...)
+	}
+}
+
+// ByExchangeRates orders the results by exchange_rates terms.
+func ByExchangeRates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExchangeRatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentUploadsCount orders the results by attachment_uploads count.
+func ByAttachmentUploadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentUploadsStep(), opts...)
+	}
+}
+
+// ByAttachmentUploads orders the results by attachment_uploads terms.
+func ByAttachmentUploads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentUploadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlobsCount orders the results by blobs count.
+func ByBlobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlobsStep(), opts...)
+	}
+}
+
+// ByBlobs orders the results by blobs terms.
+func ByBlobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUserGroupsCount orders the results by user_groups count.
 func ByUserGroupsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -355,6 +541,48 @@ func newExportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
 	)
 }
+func newAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newExchangeRatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExchangeRatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExchangeRatesTable, ExchangeRatesColumn),
+	)
+}
+func newAttachmentUploadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentUploadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentUploadsTable, AttachmentUploadsColumn),
+	)
+}
+func newBlobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlobsTable, BlobsColumn),
+	)
+}
 func newUserGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -76,6 +76,11 @@ func Currency(v string) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCurrency, v))
 }
 
+// WebhookSecret applies equality check predicate on the "webhook_secret" field. It's identical to WebhookSecretEQ.
+func WebhookSecret(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -286,6 +291,111 @@ func CurrencyContainsFold(v string) predicate.Group {
 	return predicate.Group(sql.FieldContainsFold(FieldCurrency, v))
 }
 
+// CurrencyConversionEQ applies the EQ predicate on the "currency_conversion" field.
+func CurrencyConversionEQ(v CurrencyConversion) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldCurrencyConversion, v))
+}
+
+// CurrencyConversionNEQ applies the NEQ predicate on the "currency_conversion" field.
+func CurrencyConversionNEQ(v CurrencyConversion) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldCurrencyConversion, v))
+}
+
+// CurrencyConversionIn applies the In predicate on the "currency_conversion" field.
+func CurrencyConversionIn(vs ...CurrencyConversion) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldCurrencyConversion, vs...))
+}
+
+// CurrencyConversionNotIn applies the NotIn predicate on the "currency_conversion" field.
+func CurrencyConversionNotIn(vs ...CurrencyConversion) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldCurrencyConversion, vs...))
+}
+
+// WarrantyNotifyDaysIsNil applies the IsNil predicate on the "warranty_notify_days" field.
+func WarrantyNotifyDaysIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldWarrantyNotifyDays))
+}
+
+// WarrantyNotifyDaysNotNil applies the NotNil predicate on the "warranty_notify_days" field.
+func WarrantyNotifyDaysNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldWarrantyNotifyDays))
+}
+
+// WebhookSecretEQ applies the EQ predicate on the "webhook_secret" field.
+func WebhookSecretEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretNEQ applies the NEQ predicate on the "webhook_secret" field.
+func WebhookSecretNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIn applies the In predicate on the "webhook_secret" field.
+func WebhookSecretIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretNotIn applies the NotIn predicate on the "webhook_secret" field.
+func WebhookSecretNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldWebhookSecret, vs...))
+}
+
+// WebhookSecretGT applies the GT predicate on the "webhook_secret" field.
+func WebhookSecretGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretGTE applies the GTE predicate on the "webhook_secret" field.
+func WebhookSecretGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLT applies the LT predicate on the "webhook_secret" field.
+func WebhookSecretLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldWebhookSecret, v))
+}
+
+// WebhookSecretLTE applies the LTE predicate on the "webhook_secret" field.
+func WebhookSecretLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContains applies the Contains predicate on the "webhook_secret" field.
+func WebhookSecretContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasPrefix applies the HasPrefix predicate on the "webhook_secret" field.
+func WebhookSecretHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretHasSuffix applies the HasSuffix predicate on the "webhook_secret" field.
+func WebhookSecretHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldWebhookSecret, v))
+}
+
+// WebhookSecretIsNil applies the IsNil predicate on the "webhook_secret" field.
+func WebhookSecretIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldWebhookSecret))
+}
+
+// WebhookSecretNotNil applies the NotNil predicate on the "webhook_secret" field.
+func WebhookSecretNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldWebhookSecret))
+}
+
+// WebhookSecretEqualFold applies the EqualFold predicate on the "webhook_secret" field.
+func WebhookSecretEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldWebhookSecret, v))
+}
+
+// WebhookSecretContainsFold applies the ContainsFold predicate on the "webhook_secret" field.
+func WebhookSecretContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldWebhookSecret, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -470,6 +580,144 @@ func HasExportsWith(preds ...predicate.Export) predicate.Group {
 	})
 }
 
+// HasAuditLogs applies the HasEdge predicate on the "audit_logs" edge.
+func HasAuditLogs() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditLogsWith applies the HasEdge predicate on the "audit_logs" edge with a given conditions (other predicates).
+func HasAuditLogsWith(preds ...predicate.AuditLog) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newAuditLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExchangeRates applies the HasEdge predicate on the "exchange_rates" edge.
+func HasExchangeRates() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExchangeRatesTable, ExchangeRatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExchangeRatesWith applies the HasEdge predicate on the "exchange_rates" edge with a given conditions (other predicates).
+func HasExchangeRatesWith(preds ...predicate.ExchangeRate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newExchangeRatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachmentUploads applies the HasEdge predicate on the "attachment_uploads" edge.
+func HasAttachmentUploads() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentUploadsTable, AttachmentUploadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentUploadsWith applies the HasEdge predicate on the "attachment_uploads" edge with a given conditions (other predicates).
+func HasAttachmentUploadsWith(preds ...predicate.AttachmentUpload) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newAttachmentUploadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlobs applies the HasEdge predicate on the "blobs" edge.
+func HasBlobs() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlobsTable, BlobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobsWith applies the HasEdge predicate on the "blobs" edge with a given conditions (other predicates).
+func HasBlobsWith(preds ...predicate.Blob) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		step := newBlobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUserGroups applies the HasEdge predicate on the "user_groups" edge.
 func HasUserGroups() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -3,6 +3,7 @@
 package groupinvitationtoken
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +26,8 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldUses holds the string denoting the uses field in the database.
 	FieldUses = "uses"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// Table holds the table name of the groupinvitationtoken in the database.
@@ -46,6 +49,7 @@ var Columns = []string{
 	FieldToken,
 	FieldExpiresAt,
 	FieldUses,
+	FieldRole,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "group_invitation_tokens"
@@ -84,6 +88,33 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleEditor is the default value of the Role enum.
+const DefaultRole = RoleEditor
+
+// Role values.
+const (
+	RoleViewer      Role = "viewer"
+	RoleContributor Role = "contributor"
+	RoleEditor      Role = "editor"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleViewer, RoleContributor, RoleEditor:
+		return nil
+	default:
+		return fmt.Errorf("groupinvitationtoken: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the GroupInvitationToken queries.
 type OrderOption func(*sql.Selector)
 
@@ -112,6 +143,11 @@ func ByUses(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUses, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -281,6 +281,26 @@ func UsesLTE(v int) predicate.GroupInvitationToken {
 	return predicate.GroupInvitationToken(sql.FieldLTE(FieldUses, v))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.GroupInvitationToken {
+	return predicate.GroupInvitationToken(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.GroupInvitationToken {
+	return predicate.GroupInvitationToken(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.GroupInvitationToken {
+	return predicate.GroupInvitationToken(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.GroupInvitationToken {
+	return predicate.GroupInvitationToken(sql.FieldNotIn(FieldRole, vs...))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.GroupInvitationToken {
 	return predicate.GroupInvitationToken(func(s *sql.Selector) {
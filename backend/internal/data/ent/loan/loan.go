@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the loan type in the database.
+	Label = "loan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldBorrowerID holds the string denoting the borrower_id field in the database.
+	FieldBorrowerID = "borrower_id"
+	// FieldBorrowerName holds the string denoting the borrower_name field in the database.
+	FieldBorrowerName = "borrower_name"
+	// FieldBorrowerContact holds the string denoting the borrower_contact field in the database.
+	FieldBorrowerContact = "borrower_contact"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldCheckedOutAt holds the string denoting the checked_out_at field in the database.
+	FieldCheckedOutAt = "checked_out_at"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldReturnedAt holds the string denoting the returned_at field in the database.
+	FieldReturnedAt = "returned_at"
+	// FieldOverdueNotifiedAt holds the string denoting the overdue_notified_at field in the database.
+	FieldOverdueNotifiedAt = "overdue_notified_at"
+	// EdgeEntity holds the string denoting the entity edge name in mutations.
+	EdgeEntity = "entity"
+	// Table holds the table name of the loan in the database.
+	Table = "loans"
+	// EntityTable is the table that holds the entity relation/edge.
+	EntityTable = "loans"
+	// EntityInverseTable is the table name for the Entity entity.
+	// It exists in this package in order to avoid circular dependency with the "entity" package.
+	EntityInverseTable = "entities"
+	// EntityColumn is the table column denoting the entity relation/edge.
+	EntityColumn = "entity_id"
+)
+
+// Columns holds all SQL columns for loan fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldEntityID,
+	FieldBorrowerID,
+	FieldBorrowerName,
+	FieldBorrowerContact,
+	FieldNotes,
+	FieldCheckedOutAt,
+	FieldDueAt,
+	FieldReturnedAt,
+	FieldOverdueNotifiedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// BorrowerNameValidator is a validator for the "borrower_name" field. It is called by the builders before save.
+	BorrowerNameValidator func(string) error
+	// BorrowerContactValidator is a validator for the "borrower_contact" field. It is called by the builders before save.
+	BorrowerContactValidator func(string) error
+	// NotesValidator is a validator for the "notes" field. It is called by the builders before save.
+	NotesValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Loan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByBorrowerID orders the results by the borrower_id field.
+func ByBorrowerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBorrowerID, opts...).ToFunc()
+}
+
+// ByBorrowerName orders the results by the borrower_name field.
+func ByBorrowerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBorrowerName, opts...).ToFunc()
+}
+
+// ByBorrowerContact orders the results by the borrower_contact field.
+func ByBorrowerContact(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBorrowerContact, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByCheckedOutAt orders the results by the checked_out_at field.
+func ByCheckedOutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedOutAt, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByReturnedAt orders the results by the returned_at field.
+func ByReturnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnedAt, opts...).ToFunc()
+}
+
+// ByOverdueNotifiedAt orders the results by the overdue_notified_at field.
+func ByOverdueNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverdueNotifiedAt, opts...).ToFunc()
+}
+
+// ByEntityField orders the results by entity field.
+func ByEntityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntityStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEntityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EntityTable, EntityColumn),
+	)
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldEntityID, v))
+}
+
+// BorrowerID applies equality check predicate on the "borrower_id" field. It's identical to BorrowerIDEQ.
+func BorrowerID(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerID, v))
+}
+
+// BorrowerName applies equality check predicate on the "borrower_name" field. It's identical to BorrowerNameEQ.
+func BorrowerName(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerName, v))
+}
+
+// BorrowerContact applies equality check predicate on the "borrower_contact" field. It's identical to BorrowerContactEQ.
+func BorrowerContact(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerContact, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNotes, v))
+}
+
+// CheckedOutAt applies equality check predicate on the "checked_out_at" field. It's identical to CheckedOutAtEQ.
+func CheckedOutAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCheckedOutAt, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldDueAt, v))
+}
+
+// ReturnedAt applies equality check predicate on the "returned_at" field. It's identical to ReturnedAtEQ.
+func ReturnedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// OverdueNotifiedAt applies equality check predicate on the "overdue_notified_at" field. It's identical to OverdueNotifiedAtEQ.
+func OverdueNotifiedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldOverdueNotifiedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// BorrowerIDEQ applies the EQ predicate on the "borrower_id" field.
+func BorrowerIDEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerID, v))
+}
+
+// BorrowerIDNEQ applies the NEQ predicate on the "borrower_id" field.
+func BorrowerIDNEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldBorrowerID, v))
+}
+
+// BorrowerIDIn applies the In predicate on the "borrower_id" field.
+func BorrowerIDIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldBorrowerID, vs...))
+}
+
+// BorrowerIDNotIn applies the NotIn predicate on the "borrower_id" field.
+func BorrowerIDNotIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldBorrowerID, vs...))
+}
+
+// BorrowerIDGT applies the GT predicate on the "borrower_id" field.
+func BorrowerIDGT(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldBorrowerID, v))
+}
+
+// BorrowerIDGTE applies the GTE predicate on the "borrower_id" field.
+func BorrowerIDGTE(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldBorrowerID, v))
+}
+
+// BorrowerIDLT applies the LT predicate on the "borrower_id" field.
+func BorrowerIDLT(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldBorrowerID, v))
+}
+
+// BorrowerIDLTE applies the LTE predicate on the "borrower_id" field.
+func BorrowerIDLTE(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldBorrowerID, v))
+}
+
+// BorrowerIDIsNil applies the IsNil predicate on the "borrower_id" field.
+func BorrowerIDIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldBorrowerID))
+}
+
+// BorrowerIDNotNil applies the NotNil predicate on the "borrower_id" field.
+func BorrowerIDNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldBorrowerID))
+}
+
+// BorrowerNameEQ applies the EQ predicate on the "borrower_name" field.
+func BorrowerNameEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerName, v))
+}
+
+// BorrowerNameNEQ applies the NEQ predicate on the "borrower_name" field.
+func BorrowerNameNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldBorrowerName, v))
+}
+
+// BorrowerNameIn applies the In predicate on the "borrower_name" field.
+func BorrowerNameIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldBorrowerName, vs...))
+}
+
+// BorrowerNameNotIn applies the NotIn predicate on the "borrower_name" field.
+func BorrowerNameNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldBorrowerName, vs...))
+}
+
+// BorrowerNameGT applies the GT predicate on the "borrower_name" field.
+func BorrowerNameGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldBorrowerName, v))
+}
+
+// BorrowerNameGTE applies the GTE predicate on the "borrower_name" field.
+func BorrowerNameGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldBorrowerName, v))
+}
+
+// BorrowerNameLT applies the LT predicate on the "borrower_name" field.
+func BorrowerNameLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldBorrowerName, v))
+}
+
+// BorrowerNameLTE applies the LTE predicate on the "borrower_name" field.
+func BorrowerNameLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldBorrowerName, v))
+}
+
+// BorrowerNameContains applies the Contains predicate on the "borrower_name" field.
+func BorrowerNameContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldBorrowerName, v))
+}
+
+// BorrowerNameHasPrefix applies the HasPrefix predicate on the "borrower_name" field.
+func BorrowerNameHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldBorrowerName, v))
+}
+
+// BorrowerNameHasSuffix applies the HasSuffix predicate on the "borrower_name" field.
+func BorrowerNameHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldBorrowerName, v))
+}
+
+// BorrowerNameEqualFold applies the EqualFold predicate on the "borrower_name" field.
+func BorrowerNameEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldBorrowerName, v))
+}
+
+// BorrowerNameContainsFold applies the ContainsFold predicate on the "borrower_name" field.
+func BorrowerNameContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldBorrowerName, v))
+}
+
+// BorrowerContactEQ applies the EQ predicate on the "borrower_contact" field.
+func BorrowerContactEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerContact, v))
+}
+
+// BorrowerContactNEQ applies the NEQ predicate on the "borrower_contact" field.
+func BorrowerContactNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldBorrowerContact, v))
+}
+
+// BorrowerContactIn applies the In predicate on the "borrower_contact" field.
+func BorrowerContactIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldBorrowerContact, vs...))
+}
+
+// BorrowerContactNotIn applies the NotIn predicate on the "borrower_contact" field.
+func BorrowerContactNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldBorrowerContact, vs...))
+}
+
+// BorrowerContactGT applies the GT predicate on the "borrower_contact" field.
+func BorrowerContactGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldBorrowerContact, v))
+}
+
+// BorrowerContactGTE applies the GTE predicate on the "borrower_contact" field.
+func BorrowerContactGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldBorrowerContact, v))
+}
+
+// BorrowerContactLT applies the LT predicate on the "borrower_contact" field.
+func BorrowerContactLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldBorrowerContact, v))
+}
+
+// BorrowerContactLTE applies the LTE predicate on the "borrower_contact" field.
+func BorrowerContactLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldBorrowerContact, v))
+}
+
+// BorrowerContactContains applies the Contains predicate on the "borrower_contact" field.
+func BorrowerContactContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldBorrowerContact, v))
+}
+
+// BorrowerContactHasPrefix applies the HasPrefix predicate on the "borrower_contact" field.
+func BorrowerContactHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldBorrowerContact, v))
+}
+
+// BorrowerContactHasSuffix applies the HasSuffix predicate on the "borrower_contact" field.
+func BorrowerContactHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldBorrowerContact, v))
+}
+
+// BorrowerContactIsNil applies the IsNil predicate on the "borrower_contact" field.
+func BorrowerContactIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldBorrowerContact))
+}
+
+// BorrowerContactNotNil applies the NotNil predicate on the "borrower_contact" field.
+func BorrowerContactNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldBorrowerContact))
+}
+
+// BorrowerContactEqualFold applies the EqualFold predicate on the "borrower_contact" field.
+func BorrowerContactEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldBorrowerContact, v))
+}
+
+// BorrowerContactContainsFold applies the ContainsFold predicate on the "borrower_contact" field.
+func BorrowerContactContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldBorrowerContact, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CheckedOutAtEQ applies the EQ predicate on the "checked_out_at" field.
+func CheckedOutAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtNEQ applies the NEQ predicate on the "checked_out_at" field.
+func CheckedOutAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtIn applies the In predicate on the "checked_out_at" field.
+func CheckedOutAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldCheckedOutAt, vs...))
+}
+
+// CheckedOutAtNotIn applies the NotIn predicate on the "checked_out_at" field.
+func CheckedOutAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldCheckedOutAt, vs...))
+}
+
+// CheckedOutAtGT applies the GT predicate on the "checked_out_at" field.
+func CheckedOutAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtGTE applies the GTE predicate on the "checked_out_at" field.
+func CheckedOutAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtLT applies the LT predicate on the "checked_out_at" field.
+func CheckedOutAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldCheckedOutAt, v))
+}
+
+// CheckedOutAtLTE applies the LTE predicate on the "checked_out_at" field.
+func CheckedOutAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldCheckedOutAt, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldDueAt))
+}
+
+// ReturnedAtEQ applies the EQ predicate on the "returned_at" field.
+func ReturnedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtNEQ applies the NEQ predicate on the "returned_at" field.
+func ReturnedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtIn applies the In predicate on the "returned_at" field.
+func ReturnedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtNotIn applies the NotIn predicate on the "returned_at" field.
+func ReturnedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtGT applies the GT predicate on the "returned_at" field.
+func ReturnedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldReturnedAt, v))
+}
+
+// ReturnedAtGTE applies the GTE predicate on the "returned_at" field.
+func ReturnedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtLT applies the LT predicate on the "returned_at" field.
+func ReturnedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldReturnedAt, v))
+}
+
+// ReturnedAtLTE applies the LTE predicate on the "returned_at" field.
+func ReturnedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtIsNil applies the IsNil predicate on the "returned_at" field.
+func ReturnedAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldReturnedAt))
+}
+
+// ReturnedAtNotNil applies the NotNil predicate on the "returned_at" field.
+func ReturnedAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldReturnedAt))
+}
+
+// OverdueNotifiedAtEQ applies the EQ predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtNEQ applies the NEQ predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtIn applies the In predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldOverdueNotifiedAt, vs...))
+}
+
+// OverdueNotifiedAtNotIn applies the NotIn predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldOverdueNotifiedAt, vs...))
+}
+
+// OverdueNotifiedAtGT applies the GT predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtGTE applies the GTE predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtLT applies the LT predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtLTE applies the LTE predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldOverdueNotifiedAt, v))
+}
+
+// OverdueNotifiedAtIsNil applies the IsNil predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldOverdueNotifiedAt))
+}
+
+// OverdueNotifiedAtNotNil applies the NotNil predicate on the "overdue_notified_at" field.
+func OverdueNotifiedAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldOverdueNotifiedAt))
+}
+
+// HasEntity applies the HasEdge predicate on the "entity" edge.
+func HasEntity() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntityTable, EntityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntityWith applies the HasEdge predicate on the "entity" edge with a given conditions (other predicates).
+func HasEntityWith(preds ...predicate.Entity) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newEntityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.NotPredicates(p))
+}
@@ -31,6 +31,10 @@ const (
 	FieldDescription = "description"
 	// FieldCost holds the string denoting the cost field in the database.
 	FieldCost = "cost"
+	// FieldCostCurrency holds the string denoting the cost_currency field in the database.
+	FieldCostCurrency = "cost_currency"
+	// FieldRecurrenceRule holds the string denoting the recurrence_rule field in the database.
+	FieldRecurrenceRule = "recurrence_rule"
 	// EdgeEntity holds the string denoting the entity edge name in mutations.
 	EdgeEntity = "entity"
 	// Table holds the table name of the maintenanceentry in the database.
@@ -55,6 +59,8 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldCost,
+	FieldCostCurrency,
+	FieldRecurrenceRule,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +86,10 @@ var (
 	DescriptionValidator func(string) error
 	// DefaultCost holds the default value on creation for the "cost" field.
 	DefaultCost float64
+	// CostCurrencyValidator is a validator for the "cost_currency" field. It is called by the builders before save.
+	CostCurrencyValidator func(string) error
+	// RecurrenceRuleValidator is a validator for the "recurrence_rule" field. It is called by the builders before save.
+	RecurrenceRuleValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -132,6 +142,16 @@ func ByCost(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCost, opts...).ToFunc()
 }
 
+// ByCostCurrency orders the results by the cost_currency field.
+func ByCostCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostCurrency, opts...).ToFunc()
+}
+
+// ByRecurrenceRule orders the results by the recurrence_rule field.
+func ByRecurrenceRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrenceRule, opts...).ToFunc()
+}
+
 // ByEntityField orders the results by entity field.
 func ByEntityField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -96,6 +96,16 @@ func Cost(v float64) predicate.MaintenanceEntry {
 	return predicate.MaintenanceEntry(sql.FieldEQ(FieldCost, v))
 }
 
+// CostCurrency applies equality check predicate on the "cost_currency" field. It's identical to CostCurrencyEQ.
+func CostCurrency(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEQ(FieldCostCurrency, v))
+}
+
+// RecurrenceRule applies equality check predicate on the "recurrence_rule" field. It's identical to RecurrenceRuleEQ.
+func RecurrenceRule(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.MaintenanceEntry {
 	return predicate.MaintenanceEntry(sql.FieldEQ(FieldCreatedAt, v))
@@ -476,6 +486,156 @@ func CostLTE(v float64) predicate.MaintenanceEntry {
 	return predicate.MaintenanceEntry(sql.FieldLTE(FieldCost, v))
 }
 
+// CostCurrencyEQ applies the EQ predicate on the "cost_currency" field.
+func CostCurrencyEQ(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEQ(FieldCostCurrency, v))
+}
+
+// CostCurrencyNEQ applies the NEQ predicate on the "cost_currency" field.
+func CostCurrencyNEQ(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNEQ(FieldCostCurrency, v))
+}
+
+// CostCurrencyIn applies the In predicate on the "cost_currency" field.
+func CostCurrencyIn(vs ...string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldIn(FieldCostCurrency, vs...))
+}
+
+// CostCurrencyNotIn applies the NotIn predicate on the "cost_currency" field.
+func CostCurrencyNotIn(vs ...string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNotIn(FieldCostCurrency, vs...))
+}
+
+// CostCurrencyGT applies the GT predicate on the "cost_currency" field.
+func CostCurrencyGT(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldGT(FieldCostCurrency, v))
+}
+
+// CostCurrencyGTE applies the GTE predicate on the "cost_currency" field.
+func CostCurrencyGTE(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldGTE(FieldCostCurrency, v))
+}
+
+// CostCurrencyLT applies the LT predicate on the "cost_currency" field.
+func CostCurrencyLT(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldLT(FieldCostCurrency, v))
+}
+
+// CostCurrencyLTE applies the LTE predicate on the "cost_currency" field.
+func CostCurrencyLTE(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldLTE(FieldCostCurrency, v))
+}
+
+// CostCurrencyContains applies the Contains predicate on the "cost_currency" field.
+func CostCurrencyContains(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldContains(FieldCostCurrency, v))
+}
+
+// CostCurrencyHasPrefix applies the HasPrefix predicate on the "cost_currency" field.
+func CostCurrencyHasPrefix(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldHasPrefix(FieldCostCurrency, v))
+}
+
+// CostCurrencyHasSuffix applies the HasSuffix predicate on the "cost_currency" field.
+func CostCurrencyHasSuffix(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldHasSuffix(FieldCostCurrency, v))
+}
+
+// CostCurrencyIsNil applies the IsNil predicate on the "cost_currency" field.
+func CostCurrencyIsNil() predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldIsNull(FieldCostCurrency))
+}
+
+// CostCurrencyNotNil applies the NotNil predicate on the "cost_currency" field.
+func CostCurrencyNotNil() predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNotNull(FieldCostCurrency))
+}
+
+// CostCurrencyEqualFold applies the EqualFold predicate on the "cost_currency" field.
+func CostCurrencyEqualFold(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEqualFold(FieldCostCurrency, v))
+}
+
+// CostCurrencyContainsFold applies the ContainsFold predicate on the "cost_currency" field.
+func CostCurrencyContainsFold(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldContainsFold(FieldCostCurrency, v))
+}
+
+// RecurrenceRuleEQ applies the EQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleEQ(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleNEQ applies the NEQ predicate on the "recurrence_rule" field.
+func RecurrenceRuleNEQ(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNEQ(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIn applies the In predicate on the "recurrence_rule" field.
+func RecurrenceRuleIn(vs ...string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleNotIn applies the NotIn predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotIn(vs ...string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNotIn(FieldRecurrenceRule, vs...))
+}
+
+// RecurrenceRuleGT applies the GT predicate on the "recurrence_rule" field.
+func RecurrenceRuleGT(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldGT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleGTE applies the GTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleGTE(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldGTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLT applies the LT predicate on the "recurrence_rule" field.
+func RecurrenceRuleLT(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldLT(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleLTE applies the LTE predicate on the "recurrence_rule" field.
+func RecurrenceRuleLTE(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldLTE(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContains applies the Contains predicate on the "recurrence_rule" field.
+func RecurrenceRuleContains(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldContains(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasPrefix applies the HasPrefix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasPrefix(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldHasPrefix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleHasSuffix applies the HasSuffix predicate on the "recurrence_rule" field.
+func RecurrenceRuleHasSuffix(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldHasSuffix(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleIsNil applies the IsNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleIsNil() predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldIsNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleNotNil applies the NotNil predicate on the "recurrence_rule" field.
+func RecurrenceRuleNotNil() predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldNotNull(FieldRecurrenceRule))
+}
+
+// RecurrenceRuleEqualFold applies the EqualFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleEqualFold(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldEqualFold(FieldRecurrenceRule, v))
+}
+
+// RecurrenceRuleContainsFold applies the ContainsFold predicate on the "recurrence_rule" field.
+func RecurrenceRuleContainsFold(v string) predicate.MaintenanceEntry {
+	return predicate.MaintenanceEntry(sql.FieldContainsFold(FieldRecurrenceRule, v))
+}
+
 // HasEntity applies the HasEdge predicate on the "entity" edge.
 func HasEntity() predicate.MaintenanceEntry {
 	return predicate.MaintenanceEntry(func(s *sql.Selector) {
@@ -18,6 +18,9 @@ var (
 		{Name: "token", Type: field.TypeBytes, Unique: true},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "location_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// APIKeysTable holds the schema information for the "api_keys" table.
@@ -28,7 +31,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[7]},
+				Columns:    []*schema.Column{APIKeysColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -42,7 +45,7 @@ var (
 			{
 				Name:    "apikey_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[7]},
+				Columns: []*schema.Column{APIKeysColumns[10]},
 			},
 		},
 	}
@@ -56,6 +59,14 @@ var (
 		{Name: "title", Type: field.TypeString, Default: ""},
 		{Name: "path", Type: field.TypeString, Default: ""},
 		{Name: "mime_type", Type: field.TypeString, Default: "application/octet-stream"},
+		{Name: "captured_at", Type: field.TypeTime, Nullable: true},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "document_title", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "document_author", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "page_count", Type: field.TypeInt, Nullable: true},
 		{Name: "attachment_thumbnail", Type: field.TypeUUID, Unique: true, Nullable: true},
 		{Name: "entity_attachments", Type: field.TypeUUID, Nullable: true},
 	}
@@ -67,18 +78,101 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "attachments_attachments_thumbnail",
-				Columns:    []*schema.Column{AttachmentsColumns[8]},
+				Columns:    []*schema.Column{AttachmentsColumns[16]},
 				RefColumns: []*schema.Column{AttachmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "attachments_entities_attachments",
-				Columns:    []*schema.Column{AttachmentsColumns[9]},
+				Columns:    []*schema.Column{AttachmentsColumns[17]},
 				RefColumns: []*schema.Column{EntitiesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// AttachmentUploadsColumns holds the columns for the "attachment_uploads" table.
+	AttachmentUploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"photo", "manual", "warranty", "attachment", "receipt"}, Default: "attachment"},
+		{Name: "primary", Type: field.TypeBool, Default: false},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "received", Type: field.TypeInt64, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "attachment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// AttachmentUploadsTable holds the schema information for the "attachment_uploads" table.
+	AttachmentUploadsTable = &schema.Table{
+		Name:       "attachment_uploads",
+		Columns:    AttachmentUploadsColumns,
+		PrimaryKey: []*schema.Column{AttachmentUploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachment_uploads_groups_attachment_uploads",
+				Columns:    []*schema.Column{AttachmentUploadsColumns[11]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachmentupload_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentUploadsColumns[9]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "entity_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete"}},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"web", "api_key", "import", "system"}, Default: "system"},
+		{Name: "field_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "old_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "new_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_groups_audit_logs",
+				Columns:    []*schema.Column{AuditLogsColumns[12]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[12]},
+			},
+			{
+				Name:    "auditlog_group_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[12], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3]},
+			},
+		},
+	}
 	// AuthRolesColumns holds the columns for the "auth_roles" table.
 	AuthRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -129,6 +223,39 @@ var (
 			},
 		},
 	}
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "path", Type: field.TypeString, Unique: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "mime_type", Type: field.TypeString, Default: "application/octet-stream"},
+		{Name: "ref_count", Type: field.TypeInt, Default: 0},
+		{Name: "unreferenced_at", Type: field.TypeTime, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blobs_groups_blobs",
+				Columns:    []*schema.Column{BlobsColumns[8]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blob_ref_count_unreferenced_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlobsColumns[6], BlobsColumns[7]},
+			},
+		},
+	}
 	// EntitiesColumns holds the columns for the "entities" table.
 	EntitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -136,6 +263,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "depreciation_method", Type: field.TypeEnum, Nullable: true, Enums: []string{"none", "straight_line", "declining_balance", "manual"}},
+		{Name: "useful_life_years", Type: field.TypeFloat64, Nullable: true},
+		{Name: "salvage_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "import_ref", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
 		{Name: "quantity", Type: field.TypeFloat64, Default: 1},
@@ -152,10 +282,18 @@ var (
 		{Name: "purchase_date", Type: field.TypeTime, Nullable: true},
 		{Name: "purchase_from", Type: field.TypeString, Nullable: true},
 		{Name: "purchase_price", Type: field.TypeFloat64, Default: 0},
+		{Name: "purchase_currency", Type: field.TypeString, Nullable: true, Size: 16},
 		{Name: "sold_date", Type: field.TypeTime, Nullable: true},
 		{Name: "sold_to", Type: field.TypeString, Nullable: true},
 		{Name: "sold_price", Type: field.TypeFloat64, Default: 0},
+		{Name: "sold_currency", Type: field.TypeString, Nullable: true, Size: 16},
 		{Name: "sold_notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "manual_value", Type: field.TypeFloat64, Nullable: true},
+		{Name: "min_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "reorder_point", Type: field.TypeFloat64, Nullable: true},
+		{Name: "unit", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trash_root_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "entity_children", Type: field.TypeUUID, Nullable: true},
 		{Name: "entity_type_entities", Type: field.TypeUUID},
 		{Name: "group_entities", Type: field.TypeUUID},
@@ -168,19 +306,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entities_entities_children",
-				Columns:    []*schema.Column{EntitiesColumns[25]},
+				Columns:    []*schema.Column{EntitiesColumns[36]},
 				RefColumns: []*schema.Column{EntitiesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_entity_types_entities",
-				Columns:    []*schema.Column{EntitiesColumns[26]},
+				Columns:    []*schema.Column{EntitiesColumns[37]},
 				RefColumns: []*schema.Column{EntityTypesColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "entities_groups_entities",
-				Columns:    []*schema.Column{EntitiesColumns[27]},
+				Columns:    []*schema.Column{EntitiesColumns[38]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -194,27 +332,37 @@ var (
 			{
 				Name:    "entity_manufacturer",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[14]},
+				Columns: []*schema.Column{EntitiesColumns[17]},
 			},
 			{
 				Name:    "entity_model_number",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[13]},
+				Columns: []*schema.Column{EntitiesColumns[16]},
 			},
 			{
 				Name:    "entity_serial_number",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[12]},
+				Columns: []*schema.Column{EntitiesColumns[15]},
 			},
 			{
 				Name:    "entity_archived",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[9]},
+				Columns: []*schema.Column{EntitiesColumns[12]},
 			},
 			{
 				Name:    "entity_asset_id",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[10]},
+				Columns: []*schema.Column{EntitiesColumns[13]},
+			},
+			{
+				Name:    "entity_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{EntitiesColumns[34]},
+			},
+			{
+				Name:    "entity_trash_root_id",
+				Unique:  false,
+				Columns: []*schema.Column{EntitiesColumns[35]},
 			},
 		},
 	}
@@ -225,9 +373,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "boolean", "time"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "boolean", "time", "decimal", "date", "url", "email", "select", "multi_select", "currency"}},
 		{Name: "text_value", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "number_value", Type: field.TypeInt, Nullable: true},
+		{Name: "decimal_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "boolean_value", Type: field.TypeBool, Default: false},
 		{Name: "time_value", Type: field.TypeTime},
 		{Name: "entity_fields", Type: field.TypeUUID, Nullable: true},
@@ -240,12 +389,47 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entity_fields_entities_fields",
-				Columns:    []*schema.Column{EntityFieldsColumns[10]},
+				Columns:    []*schema.Column{EntityFieldsColumns[11]},
 				RefColumns: []*schema.Column{EntitiesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// EntityFieldDefinitionsColumns holds the columns for the "entity_field_definitions" table.
+	EntityFieldDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "decimal", "boolean", "date", "url", "email", "select", "multi_select", "currency"}},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "default_value", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "entity_type_id", Type: field.TypeUUID},
+	}
+	// EntityFieldDefinitionsTable holds the schema information for the "entity_field_definitions" table.
+	EntityFieldDefinitionsTable = &schema.Table{
+		Name:       "entity_field_definitions",
+		Columns:    EntityFieldDefinitionsColumns,
+		PrimaryKey: []*schema.Column{EntityFieldDefinitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "entity_field_definitions_entity_types_field_definitions",
+				Columns:    []*schema.Column{EntityFieldDefinitionsColumns[10]},
+				RefColumns: []*schema.Column{EntityTypesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entityfielddefinition_entity_type_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{EntityFieldDefinitionsColumns[10], EntityFieldDefinitionsColumns[3]},
+			},
+		},
+	}
 	// EntityTemplatesColumns holds the columns for the "entity_templates" table.
 	EntityTemplatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -303,6 +487,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "depreciation_method", Type: field.TypeEnum, Nullable: true, Enums: []string{"none", "straight_line", "declining_balance", "manual"}},
+		{Name: "useful_life_years", Type: field.TypeFloat64, Nullable: true},
+		{Name: "salvage_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "is_location", Type: field.TypeBool, Default: false},
 		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "entity_type_default_template", Type: field.TypeUUID, Nullable: true},
@@ -316,17 +503,49 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entity_types_entity_templates_default_template",
-				Columns:    []*schema.Column{EntityTypesColumns[7]},
+				Columns:    []*schema.Column{EntityTypesColumns[10]},
 				RefColumns: []*schema.Column{EntityTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entity_types_groups_entity_types",
-				Columns:    []*schema.Column{EntityTypesColumns[8]},
+				Columns:    []*schema.Column{EntityTypesColumns[11]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "base", Type: field.TypeString, Size: 16},
+		{Name: "quote", Type: field.TypeString, Size: 16},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exchange_rates_groups_exchange_rates",
+				Columns:    []*schema.Column{ExchangeRatesColumns[7]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_group_id_base_quote_date",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[7], ExchangeRatesColumns[3], ExchangeRatesColumns[4], ExchangeRatesColumns[6]},
+			},
+		},
 	}
 	// ExportsColumns holds the columns for the "exports" table.
 	ExportsColumns = []*schema.Column{
@@ -374,6 +593,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "currency", Type: field.TypeString, Default: "usd"},
+		{Name: "currency_conversion", Type: field.TypeEnum, Enums: []string{"purchase_date", "today"}, Default: "purchase_date"},
+		{Name: "warranty_notify_days", Type: field.TypeJSON, Nullable: true},
+		{Name: "webhook_secret", Type: field.TypeString, Nullable: true, Size: 255},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -389,6 +611,7 @@ var (
 		{Name: "token", Type: field.TypeBytes, Unique: true},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "contributor", "editor"}, Default: "editor"},
 		{Name: "group_invitation_tokens", Type: field.TypeUUID, Nullable: true},
 	}
 	// GroupInvitationTokensTable holds the schema information for the "group_invitation_tokens" table.
@@ -399,12 +622,61 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "group_invitation_tokens_groups_invitation_tokens",
-				Columns:    []*schema.Column{GroupInvitationTokensColumns[6]},
+				Columns:    []*schema.Column{GroupInvitationTokensColumns[7]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// LoansColumns holds the columns for the "loans" table.
+	LoansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "borrower_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "borrower_name", Type: field.TypeString, Size: 255},
+		{Name: "borrower_contact", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "checked_out_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "returned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "overdue_notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "entity_id", Type: field.TypeUUID},
+	}
+	// LoansTable holds the schema information for the "loans" table.
+	LoansTable = &schema.Table{
+		Name:       "loans",
+		Columns:    LoansColumns,
+		PrimaryKey: []*schema.Column{LoansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loans_entities_loans",
+				Columns:    []*schema.Column{LoansColumns[11]},
+				RefColumns: []*schema.Column{EntitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loan_entity_id_checked_out_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoansColumns[11], LoansColumns[7]},
+			},
+			{
+				Name:    "loan_entity_id",
+				Unique:  true,
+				Columns: []*schema.Column{LoansColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "returned_at IS NULL",
+				},
+			},
+			{
+				Name:    "loan_returned_at_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoansColumns[9], LoansColumns[8]},
+			},
+		},
+	}
 	// MaintenanceEntriesColumns holds the columns for the "maintenance_entries" table.
 	MaintenanceEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -415,6 +687,8 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2500},
 		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "cost_currency", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "recurrence_rule", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "entity_id", Type: field.TypeUUID},
 	}
 	// MaintenanceEntriesTable holds the schema information for the "maintenance_entries" table.
@@ -425,7 +699,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "maintenance_entries_entities_maintenance_entries",
-				Columns:    []*schema.Column{MaintenanceEntriesColumns[8]},
+				Columns:    []*schema.Column{MaintenanceEntriesColumns[10]},
 				RefColumns: []*schema.Column{EntitiesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -484,6 +758,48 @@ var (
 			},
 		},
 	}
+	// NotifierSubscriptionsColumns holds the columns for the "notifier_subscriptions" table.
+	NotifierSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"maintenance_due", "warranty_expiring", "export_completed", "export_failed", "import_finished", "low_stock", "member_joined", "loan_overdue"}},
+		{Name: "template", Type: field.TypeString, Nullable: true, Size: 4000},
+		{Name: "notifier_id", Type: field.TypeUUID},
+		{Name: "saved_search_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// NotifierSubscriptionsTable holds the schema information for the "notifier_subscriptions" table.
+	NotifierSubscriptionsTable = &schema.Table{
+		Name:       "notifier_subscriptions",
+		Columns:    NotifierSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{NotifierSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifier_subscriptions_notifiers_subscriptions",
+				Columns:    []*schema.Column{NotifierSubscriptionsColumns[5]},
+				RefColumns: []*schema.Column{NotifiersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifier_subscriptions_saved_searches_notifier_subscriptions",
+				Columns:    []*schema.Column{NotifierSubscriptionsColumns[6]},
+				RefColumns: []*schema.Column{SavedSearchesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notifiersubscription_notifier_id_event",
+				Unique:  true,
+				Columns: []*schema.Column{NotifierSubscriptionsColumns[5], NotifierSubscriptionsColumns[3]},
+			},
+			{
+				Name:    "notifiersubscription_event",
+				Unique:  false,
+				Columns: []*schema.Column{NotifierSubscriptionsColumns[3]},
+			},
+		},
+	}
 	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
 	PasswordResetTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -515,6 +831,78 @@ var (
 			},
 		},
 	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "query", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "order_by", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "tag_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "parent_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "negate_tags", Type: field.TypeBool, Default: false},
+		{Name: "only_with_photo", Type: field.TypeBool, Default: false},
+		{Name: "only_without_photo", Type: field.TypeBool, Default: false},
+		{Name: "include_archived", Type: field.TypeBool, Default: false},
+		{Name: "columns", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_groups_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[14]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedsearch_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchesColumns[14]},
+			},
+		},
+	}
+	// StockMovementsColumns holds the columns for the "stock_movements" table.
+	StockMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"consume", "restock", "adjust"}},
+		{Name: "change", Type: field.TypeFloat64},
+		{Name: "quantity_after", Type: field.TypeFloat64},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "entity_id", Type: field.TypeUUID},
+	}
+	// StockMovementsTable holds the schema information for the "stock_movements" table.
+	StockMovementsTable = &schema.Table{
+		Name:       "stock_movements",
+		Columns:    StockMovementsColumns,
+		PrimaryKey: []*schema.Column{StockMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movements_entities_stock_movements",
+				Columns:    []*schema.Column{StockMovementsColumns[8]},
+				RefColumns: []*schema.Column{EntitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementsColumns[8], StockMovementsColumns[1]},
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -606,7 +994,8 @@ var (
 	}
 	// UserGroupsColumns holds the columns for the "user_groups" table.
 	UserGroupsColumns = []*schema.Column{
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "owner"}, Default: "user"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"viewer", "contributor", "editor", "owner"}, Default: "editor"},
+		{Name: "location_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 		{Name: "group_id", Type: field.TypeUUID},
 	}
@@ -614,21 +1003,129 @@ var (
 	UserGroupsTable = &schema.Table{
 		Name:       "user_groups",
 		Columns:    UserGroupsColumns,
-		PrimaryKey: []*schema.Column{UserGroupsColumns[1], UserGroupsColumns[2]},
+		PrimaryKey: []*schema.Column{UserGroupsColumns[2], UserGroupsColumns[3]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_groups_users_user",
-				Columns:    []*schema.Column{UserGroupsColumns[1]},
+				Columns:    []*schema.Column{UserGroupsColumns[2]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_groups_groups_group",
-				Columns:    []*schema.Column{UserGroupsColumns[2]},
+				Columns:    []*schema.Column{UserGroupsColumns[3]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WarrantyNotificationsColumns holds the columns for the "warranty_notifications" table.
+	WarrantyNotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "lead_days", Type: field.TypeInt},
+		{Name: "expires_on", Type: field.TypeTime},
+		{Name: "entity_id", Type: field.TypeUUID},
+	}
+	// WarrantyNotificationsTable holds the schema information for the "warranty_notifications" table.
+	WarrantyNotificationsTable = &schema.Table{
+		Name:       "warranty_notifications",
+		Columns:    WarrantyNotificationsColumns,
+		PrimaryKey: []*schema.Column{WarrantyNotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "warranty_notifications_entities_warranty_notifications",
+				Columns:    []*schema.Column{WarrantyNotificationsColumns[5]},
+				RefColumns: []*schema.Column{EntitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warrantynotification_entity_id_lead_days_expires_on",
+				Unique:  true,
+				Columns: []*schema.Column{WarrantyNotificationsColumns[5], WarrantyNotificationsColumns[3], WarrantyNotificationsColumns[4]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "url", Type: field.TypeString, Size: 2083},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "group_id", Type: field.TypeUUID},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_groups_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[7]},
+			},
+			{
+				Name:    "webhook_group_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[7], WebhooksColumns[6]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeString, Size: 64},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+		},
 	}
 	// TagEntitiesColumns holds the columns for the "tag_entities" table.
 	TagEntitiesColumns = []*schema.Column{
@@ -659,22 +1156,34 @@ var (
 	Tables = []*schema.Table{
 		APIKeysTable,
 		AttachmentsTable,
+		AttachmentUploadsTable,
+		AuditLogsTable,
 		AuthRolesTable,
 		AuthTokensTable,
+		BlobsTable,
 		EntitiesTable,
 		EntityFieldsTable,
+		EntityFieldDefinitionsTable,
 		EntityTemplatesTable,
 		EntityTypesTable,
+		ExchangeRatesTable,
 		ExportsTable,
 		GroupsTable,
 		GroupInvitationTokensTable,
+		LoansTable,
 		MaintenanceEntriesTable,
 		NotifiersTable,
+		NotifierSubscriptionsTable,
 		PasswordResetTokensTable,
+		SavedSearchesTable,
+		StockMovementsTable,
 		TagsTable,
 		TemplateFieldsTable,
 		UsersTable,
 		UserGroupsTable,
+		WarrantyNotificationsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		TagEntitiesTable,
 	}
 )
@@ -683,22 +1192,32 @@ func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	AttachmentsTable.ForeignKeys[0].RefTable = AttachmentsTable
 	AttachmentsTable.ForeignKeys[1].RefTable = EntitiesTable
+	AttachmentUploadsTable.ForeignKeys[0].RefTable = GroupsTable
+	AuditLogsTable.ForeignKeys[0].RefTable = GroupsTable
 	AuthRolesTable.ForeignKeys[0].RefTable = AuthTokensTable
 	AuthTokensTable.ForeignKeys[0].RefTable = UsersTable
+	BlobsTable.ForeignKeys[0].RefTable = GroupsTable
 	EntitiesTable.ForeignKeys[0].RefTable = EntitiesTable
 	EntitiesTable.ForeignKeys[1].RefTable = EntityTypesTable
 	EntitiesTable.ForeignKeys[2].RefTable = GroupsTable
 	EntityFieldsTable.ForeignKeys[0].RefTable = EntitiesTable
+	EntityFieldDefinitionsTable.ForeignKeys[0].RefTable = EntityTypesTable
 	EntityTemplatesTable.ForeignKeys[0].RefTable = EntitiesTable
 	EntityTemplatesTable.ForeignKeys[1].RefTable = GroupsTable
 	EntityTypesTable.ForeignKeys[0].RefTable = EntityTemplatesTable
 	EntityTypesTable.ForeignKeys[1].RefTable = GroupsTable
+	ExchangeRatesTable.ForeignKeys[0].RefTable = GroupsTable
 	ExportsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupInvitationTokensTable.ForeignKeys[0].RefTable = GroupsTable
+	LoansTable.ForeignKeys[0].RefTable = EntitiesTable
 	MaintenanceEntriesTable.ForeignKeys[0].RefTable = EntitiesTable
 	NotifiersTable.ForeignKeys[0].RefTable = GroupsTable
 	NotifiersTable.ForeignKeys[1].RefTable = UsersTable
+	NotifierSubscriptionsTable.ForeignKeys[0].RefTable = NotifiersTable
+	NotifierSubscriptionsTable.ForeignKeys[1].RefTable = SavedSearchesTable
 	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = GroupsTable
+	StockMovementsTable.ForeignKeys[0].RefTable = EntitiesTable
 	TagsTable.ForeignKeys[0].RefTable = GroupsTable
 	TagsTable.ForeignKeys[1].RefTable = TagsTable
 	TemplateFieldsTable.ForeignKeys[0].RefTable = EntityTemplatesTable
@@ -707,6 +1226,9 @@ func init() {
 	UserGroupsTable.Annotation = &entsql.Annotation{
 		Table: "user_groups",
 	}
+	WarrantyNotificationsTable.ForeignKeys[0].RefTable = EntitiesTable
+	WebhooksTable.ForeignKeys[0].RefTable = GroupsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	TagEntitiesTable.ForeignKeys[0].RefTable = TagsTable
 	TagEntitiesTable.ForeignKeys[1].RefTable = EntitiesTable
 }
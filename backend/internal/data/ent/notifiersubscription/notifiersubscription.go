@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notifiersubscription
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the notifiersubscription type in the database.
+	Label = "notifier_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNotifierID holds the string denoting the notifier_id field in the database.
+	FieldNotifierID = "notifier_id"
+	// FieldEvent holds the string denoting the event field in the database.
+	FieldEvent = "event"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
+	// FieldSavedSearchID holds the string denoting the saved_search_id field in the database.
+	FieldSavedSearchID = "saved_search_id"
+	// EdgeNotifier holds the string denoting the notifier edge name in mutations.
+	EdgeNotifier = "notifier"
+	// EdgeSavedSearch holds the string denoting the saved_search edge name in mutations.
+	EdgeSavedSearch = "saved_search"
+	// Table holds the table name of the notifiersubscription in the database.
+	Table = "notifier_subscriptions"
+	// NotifierTable is the table that holds the notifier relation/edge.
+	NotifierTable = "notifier_subscriptions"
+	// NotifierInverseTable is the table name for the Notifier entity.
+	// It exists in this package in order to avoid circular dependency with the "notifier" package.
+	NotifierInverseTable = "notifiers"
+	// NotifierColumn is the table column denoting the notifier relation/edge.
+	NotifierColumn = "notifier_id"
+	// SavedSearchTable is the table that holds the saved_search relation/edge.
+	SavedSearchTable = "notifier_subscriptions"
+	// SavedSearchInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchInverseTable = "saved_searches"
+	// SavedSearchColumn is the table column denoting the saved_search relation/edge.
+	SavedSearchColumn = "saved_search_id"
+)
+
+// Columns holds all SQL columns for notifiersubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNotifierID,
+	FieldEvent,
+	FieldTemplate,
+	FieldSavedSearchID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TemplateValidator is a validator for the "template" field. It is called by the builders before save.
+	TemplateValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Event defines the type for the "event" enum field.
+type Event string
+
+// Event values.
+const (
+	EventMaintenanceDue   Event = "maintenance_due"
+	EventWarrantyExpiring Event = "warranty_expiring"
+	EventExportCompleted  Event = "export_completed"
+	EventExportFailed     Event = "export_failed"
+	EventImportFinished   Event = "import_finished"
+	EventLowStock         Event = "low_stock"
+	EventMemberJoined     Event = "member_joined"
+	EventLoanOverdue      Event = "loan_overdue"
+)
+
+func (e Event) String() string {
+	return string(e)
+}
+
+// EventValidator is a validator for the "event" field enum values. It is called by the builders before save.
+func EventValidator(e Event) error {
+	switch e {
+	case EventMaintenanceDue, EventWarrantyExpiring, EventExportCompleted, EventExportFailed, EventImportFinished, EventLowStock, EventMemberJoined, EventLoanOverdue:
+		return nil
+	default:
+		return fmt.Errorf("notifiersubscription: invalid enum value for event field: %q", e)
+	}
+}
+
+// OrderOption defines the ordering options for the NotifierSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNotifierID orders the results by the notifier_id field.
+func ByNotifierID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifierID, opts...).ToFunc()
+}
+
+// ByEvent orders the results by the event field.
+func ByEvent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvent, opts...).ToFunc()
+}
+
+// ByTemplate orders the results by the template field.
+func ByTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplate, opts...).ToFunc()
+}
+
+// BySavedSearchID orders the results by the saved_search_id field.
+func BySavedSearchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSavedSearchID, opts...).ToFunc()
+}
+
+// ByNotifierField orders the results by notifier field.
+func ByNotifierField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotifierStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySavedSearchField orders the results by saved_search field.
+func BySavedSearchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newNotifierStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotifierInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NotifierTable, NotifierColumn),
+	)
+}
+func newSavedSearchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+	)
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notifiersubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NotifierID applies equality check predicate on the "notifier_id" field. It's identical to NotifierIDEQ.
+func NotifierID(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldTemplate, v))
+}
+
+// SavedSearchID applies equality check predicate on the "saved_search_id" field. It's identical to SavedSearchIDEQ.
+func SavedSearchID(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NotifierIDEQ applies the EQ predicate on the "notifier_id" field.
+func NotifierIDEQ(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldNotifierID, v))
+}
+
+// NotifierIDNEQ applies the NEQ predicate on the "notifier_id" field.
+func NotifierIDNEQ(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldNotifierID, v))
+}
+
+// NotifierIDIn applies the In predicate on the "notifier_id" field.
+func NotifierIDIn(vs ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldNotifierID, vs...))
+}
+
+// NotifierIDNotIn applies the NotIn predicate on the "notifier_id" field.
+func NotifierIDNotIn(vs ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldNotifierID, vs...))
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v Event) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldEvent, v))
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v Event) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldEvent, v))
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...Event) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldEvent, vs...))
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...Event) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldEvent, vs...))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateIsNil applies the IsNil predicate on the "template" field.
+func TemplateIsNil() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIsNull(FieldTemplate))
+}
+
+// TemplateNotNil applies the NotNil predicate on the "template" field.
+func TemplateNotNil() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotNull(FieldTemplate))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// SavedSearchIDEQ applies the EQ predicate on the "saved_search_id" field.
+func SavedSearchIDEQ(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDNEQ applies the NEQ predicate on the "saved_search_id" field.
+func SavedSearchIDNEQ(v uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNEQ(FieldSavedSearchID, v))
+}
+
+// SavedSearchIDIn applies the In predicate on the "saved_search_id" field.
+func SavedSearchIDIn(vs ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDNotIn applies the NotIn predicate on the "saved_search_id" field.
+func SavedSearchIDNotIn(vs ...uuid.UUID) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotIn(FieldSavedSearchID, vs...))
+}
+
+// SavedSearchIDIsNil applies the IsNil predicate on the "saved_search_id" field.
+func SavedSearchIDIsNil() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldIsNull(FieldSavedSearchID))
+}
+
+// SavedSearchIDNotNil applies the NotNil predicate on the "saved_search_id" field.
+func SavedSearchIDNotNil() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.FieldNotNull(FieldSavedSearchID))
+}
+
+// HasNotifier applies the HasEdge predicate on the "notifier" edge.
+func HasNotifier() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NotifierTable, NotifierColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotifierWith applies the HasEdge predicate on the "notifier" edge with a given conditions (other predicates).
+func HasNotifierWith(preds ...predicate.Notifier) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(func(s *sql.Selector) {
+		step := newNotifierStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearch applies the HasEdge predicate on the "saved_search" edge.
+func HasSavedSearch() predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SavedSearchTable, SavedSearchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchWith applies the HasEdge predicate on the "saved_search" edge with a given conditions (other predicates).
+func HasSavedSearchWith(preds ...predicate.SavedSearch) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(func(s *sql.Selector) {
+		step := newSavedSearchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotifierSubscription) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotifierSubscription) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotifierSubscription) predicate.NotifierSubscription {
+	return predicate.NotifierSubscription(sql.NotPredicates(p))
+}
@@ -12,24 +12,39 @@ type APIKey func(*sql.Selector)
 // Attachment is the predicate function for attachment builders.
 type Attachment func(*sql.Selector)
 
+// AttachmentUpload is the predicate function for attachmentupload builders.
+type AttachmentUpload func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // AuthRoles is the predicate function for authroles builders.
 type AuthRoles func(*sql.Selector)
 
 // AuthTokens is the predicate function for authtokens builders.
 type AuthTokens func(*sql.Selector)
 
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
 // Entity is the predicate function for entity builders.
 type Entity func(*sql.Selector)
 
 // EntityField is the predicate function for entityfield builders.
 type EntityField func(*sql.Selector)
 
+// EntityFieldDefinition is the predicate function for entityfielddefinition builders.
+type EntityFieldDefinition func(*sql.Selector)
+
 // EntityTemplate is the predicate function for entitytemplate builders.
 type EntityTemplate func(*sql.Selector)
 
 // EntityType is the predicate function for entitytype builders.
 type EntityType func(*sql.Selector)
 
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
 // Export is the predicate function for export builders.
 type Export func(*sql.Selector)
 
@@ -39,15 +54,27 @@ type Group func(*sql.Selector)
 // GroupInvitationToken is the predicate function for groupinvitationtoken builders.
 type GroupInvitationToken func(*sql.Selector)
 
+// Loan is the predicate function for loan builders.
+type Loan func(*sql.Selector)
+
 // MaintenanceEntry is the predicate function for maintenanceentry builders.
 type MaintenanceEntry func(*sql.Selector)
 
 // Notifier is the predicate function for notifier builders.
 type Notifier func(*sql.Selector)
 
+// NotifierSubscription is the predicate function for notifiersubscription builders.
+type NotifierSubscription func(*sql.Selector)
+
 // PasswordResetTokens is the predicate function for passwordresettokens builders.
 type PasswordResetTokens func(*sql.Selector)
 
+// SavedSearch is the predicate function for savedsearch builders.
+type SavedSearch func(*sql.Selector)
+
+// StockMovement is the predicate function for stockmovement builders.
+type StockMovement func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
@@ -59,3 +86,12 @@ type User func(*sql.Selector)
 
 // UserGroup is the predicate function for usergroup builders.
 type UserGroup func(*sql.Selector)
+
+// WarrantyNotification is the predicate function for warrantynotification builders.
+type WarrantyNotification func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
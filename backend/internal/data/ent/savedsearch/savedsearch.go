@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedsearch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the savedsearch type in the database.
+	Label = "saved_search"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
+	// FieldOrderBy holds the string denoting the order_by field in the database.
+	FieldOrderBy = "order_by"
+	// FieldTagIds holds the string denoting the tag_ids field in the database.
+	FieldTagIds = "tag_ids"
+	// FieldParentIds holds the string denoting the parent_ids field in the database.
+	FieldParentIds = "parent_ids"
+	// FieldNegateTags holds the string denoting the negate_tags field in the database.
+	FieldNegateTags = "negate_tags"
+	// FieldOnlyWithPhoto holds the string denoting the only_with_photo field in the database.
+	FieldOnlyWithPhoto = "only_with_photo"
+	// FieldOnlyWithoutPhoto holds the string denoting the only_without_photo field in the database.
+	FieldOnlyWithoutPhoto = "only_without_photo"
+	// FieldIncludeArchived holds the string denoting the include_archived field in the database.
+	FieldIncludeArchived = "include_archived"
+	// FieldColumns holds the string denoting the columns field in the database.
+	FieldColumns = "columns"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
+	// EdgeNotifierSubscriptions holds the string denoting the notifier_subscriptions edge name in mutations.
+	EdgeNotifierSubscriptions = "notifier_subscriptions"
+	// Table holds the table name of the savedsearch in the database.
+	Table = "saved_searches"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "saved_searches"
+	// GroupInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupInverseTable = "groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_id"
+	// NotifierSubscriptionsTable is the table that holds the notifier_subscriptions relation/edge.
+	NotifierSubscriptionsTable = "notifier_subscriptions"
+	// NotifierSubscriptionsInverseTable is the table name for the NotifierSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "notifiersubscription" package.
+	NotifierSubscriptionsInverseTable = "notifier_subscriptions"
+	// NotifierSubscriptionsColumn is the table column denoting the notifier_subscriptions relation/edge.
+	NotifierSubscriptionsColumn = "saved_search_id"
+)
+
+// Columns holds all SQL columns for savedsearch fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldDescription,
+	FieldGroupID,
+	FieldQuery,
+	FieldOrderBy,
+	FieldTagIds,
+	FieldParentIds,
+	FieldNegateTags,
+	FieldOnlyWithPhoto,
+	FieldOnlyWithoutPhoto,
+	FieldIncludeArchived,
+	FieldColumns,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// QueryValidator is a validator for the "query" field. It is called by the builders before save.
+	QueryValidator func(string) error
+	// OrderByValidator is a validator for the "order_by" field. It is called by the builders before save.
+	OrderByValidator func(string) error
+	// DefaultNegateTags holds the default value on creation for the "negate_tags" field.
+	DefaultNegateTags bool
+	// DefaultOnlyWithPhoto holds the default value on creation for the "only_with_photo" field.
+	DefaultOnlyWithPhoto bool
+	// DefaultOnlyWithoutPhoto holds the default value on creation for the "only_without_photo" field.
+	DefaultOnlyWithoutPhoto bool
+	// DefaultIncludeArchived holds the default value on creation for the "include_archived" field.
+	DefaultIncludeArchived bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SavedSearch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
+// ByOrderBy orders the results by the order_by field.
+func ByOrderBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderBy, opts...).ToFunc()
+}
+
+// ByNegateTags orders the results by the negate_tags field.
+func ByNegateTags(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNegateTags, opts...).ToFunc()
+}
+
+// ByOnlyWithPhoto orders the results by the only_with_photo field.
+func ByOnlyWithPhoto(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnlyWithPhoto, opts...).ToFunc()
+}
+
+// ByOnlyWithoutPhoto orders the results by the only_without_photo field.
+func ByOnlyWithoutPhoto(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnlyWithoutPhoto, opts...).ToFunc()
+}
+
+// ByIncludeArchived orders the results by the include_archived field.
+func ByIncludeArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncludeArchived, opts...).ToFunc()
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByNotifierSubscriptionsCount orders the results by notifier_subscriptions count.
+func ByNotifierSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotifierSubscriptionsStep(), opts...)
+	}
+}
+
+// ByNotifierSubscriptions orders the results by notifier_subscriptions terms.
+func ByNotifierSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotifierSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+	)
+}
+func newNotifierSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotifierSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotifierSubscriptionsTable, NotifierSubscriptionsColumn),
+	)
+}
@@ -0,0 +1,633 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedsearch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldDescription, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldGroupID, v))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldQuery, v))
+}
+
+// OrderBy applies equality check predicate on the "order_by" field. It's identical to OrderByEQ.
+func OrderBy(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOrderBy, v))
+}
+
+// NegateTags applies equality check predicate on the "negate_tags" field. It's identical to NegateTagsEQ.
+func NegateTags(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldNegateTags, v))
+}
+
+// OnlyWithPhoto applies equality check predicate on the "only_with_photo" field. It's identical to OnlyWithPhotoEQ.
+func OnlyWithPhoto(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOnlyWithPhoto, v))
+}
+
+// OnlyWithoutPhoto applies equality check predicate on the "only_without_photo" field. It's identical to OnlyWithoutPhotoEQ.
+func OnlyWithoutPhoto(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOnlyWithoutPhoto, v))
+}
+
+// IncludeArchived applies equality check predicate on the "include_archived" field. It's identical to IncludeArchivedEQ.
+func IncludeArchived(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldIncludeArchived, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryIsNil applies the IsNil predicate on the "query" field.
+func QueryIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldQuery))
+}
+
+// QueryNotNil applies the NotNil predicate on the "query" field.
+func QueryNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldQuery))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// OrderByEQ applies the EQ predicate on the "order_by" field.
+func OrderByEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOrderBy, v))
+}
+
+// OrderByNEQ applies the NEQ predicate on the "order_by" field.
+func OrderByNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldOrderBy, v))
+}
+
+// OrderByIn applies the In predicate on the "order_by" field.
+func OrderByIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldOrderBy, vs...))
+}
+
+// OrderByNotIn applies the NotIn predicate on the "order_by" field.
+func OrderByNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldOrderBy, vs...))
+}
+
+// OrderByGT applies the GT predicate on the "order_by" field.
+func OrderByGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldOrderBy, v))
+}
+
+// OrderByGTE applies the GTE predicate on the "order_by" field.
+func OrderByGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldOrderBy, v))
+}
+
+// OrderByLT applies the LT predicate on the "order_by" field.
+func OrderByLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldOrderBy, v))
+}
+
+// OrderByLTE applies the LTE predicate on the "order_by" field.
+func OrderByLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldOrderBy, v))
+}
+
+// OrderByContains applies the Contains predicate on the "order_by" field.
+func OrderByContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldOrderBy, v))
+}
+
+// OrderByHasPrefix applies the HasPrefix predicate on the "order_by" field.
+func OrderByHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldOrderBy, v))
+}
+
+// OrderByHasSuffix applies the HasSuffix predicate on the "order_by" field.
+func OrderByHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldOrderBy, v))
+}
+
+// OrderByIsNil applies the IsNil predicate on the "order_by" field.
+func OrderByIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldOrderBy))
+}
+
+// OrderByNotNil applies the NotNil predicate on the "order_by" field.
+func OrderByNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldOrderBy))
+}
+
+// OrderByEqualFold applies the EqualFold predicate on the "order_by" field.
+func OrderByEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldOrderBy, v))
+}
+
+// OrderByContainsFold applies the ContainsFold predicate on the "order_by" field.
+func OrderByContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldOrderBy, v))
+}
+
+// TagIdsIsNil applies the IsNil predicate on the "tag_ids" field.
+func TagIdsIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldTagIds))
+}
+
+// TagIdsNotNil applies the NotNil predicate on the "tag_ids" field.
+func TagIdsNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldTagIds))
+}
+
+// ParentIdsIsNil applies the IsNil predicate on the "parent_ids" field.
+func ParentIdsIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldParentIds))
+}
+
+// ParentIdsNotNil applies the NotNil predicate on the "parent_ids" field.
+func ParentIdsNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldParentIds))
+}
+
+// NegateTagsEQ applies the EQ predicate on the "negate_tags" field.
+func NegateTagsEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldNegateTags, v))
+}
+
+// NegateTagsNEQ applies the NEQ predicate on the "negate_tags" field.
+func NegateTagsNEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldNegateTags, v))
+}
+
+// OnlyWithPhotoEQ applies the EQ predicate on the "only_with_photo" field.
+func OnlyWithPhotoEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOnlyWithPhoto, v))
+}
+
+// OnlyWithPhotoNEQ applies the NEQ predicate on the "only_with_photo" field.
+func OnlyWithPhotoNEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldOnlyWithPhoto, v))
+}
+
+// OnlyWithoutPhotoEQ applies the EQ predicate on the "only_without_photo" field.
+func OnlyWithoutPhotoEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldOnlyWithoutPhoto, v))
+}
+
+// OnlyWithoutPhotoNEQ applies the NEQ predicate on the "only_without_photo" field.
+func OnlyWithoutPhotoNEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldOnlyWithoutPhoto, v))
+}
+
+// IncludeArchivedEQ applies the EQ predicate on the "include_archived" field.
+func IncludeArchivedEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldIncludeArchived, v))
+}
+
+// IncludeArchivedNEQ applies the NEQ predicate on the "include_archived" field.
+func IncludeArchivedNEQ(v bool) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldIncludeArchived, v))
+}
+
+// ColumnsIsNil applies the IsNil predicate on the "columns" field.
+func ColumnsIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldColumns))
+}
+
+// ColumnsNotNil applies the NotNil predicate on the "columns" field.
+func ColumnsNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldColumns))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Group) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifierSubscriptions applies the HasEdge predicate on the "notifier_subscriptions" edge.
+func HasNotifierSubscriptions() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotifierSubscriptionsTable, NotifierSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotifierSubscriptionsWith applies the HasEdge predicate on the "notifier_subscriptions" edge with a given conditions (other predicates).
+func HasNotifierSubscriptionsWith(preds ...predicate.NotifierSubscription) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newNotifierSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.NotPredicates(p))
+}
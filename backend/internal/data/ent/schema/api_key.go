@@ -4,11 +4,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
 )
 
 // APIKey holds the schema definition for static, user-issued API keys that
-// authenticate as the owning user.
+// authenticate as the owning user. A key can be narrowed with scopes and
+// pinned to a single group or location subtree; an unrestricted key has the
+// same access as the user.
 type APIKey struct {
 	ent.Schema
 }
@@ -37,6 +40,14 @@ func (APIKey) Fields() []ent.Field {
 		field.Time("last_used_at").
 			Optional().
 			Nillable(),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.UUID("group_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("location_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -26,6 +26,30 @@ func (Attachment) Fields() []ent.Field {
 		field.String("title").Default(""),
 		field.String("path").Default(""),
 		field.String("mime_type").Default("application/octet-stream"),
+
+		// ------------------------------------
+		// Metadata extracted from the file when it is uploaded.
+		field.Time("captured_at").
+			Optional().
+			Nillable(),
+		field.Float("latitude").
+			Optional().
+			Nillable(),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
+		field.Int("width").
+			Optional(),
+		field.Int("height").
+			Optional(),
+		field.String("document_title").
+			MaxLen(255).
+			Optional(),
+		field.String("document_author").
+			MaxLen(255).
+			Optional(),
+		field.Int("page_count").
+			Optional(),
 	}
 }
 
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// AttachmentUpload is a resumable upload of an attachment. The content is
+// stored in the bucket in chunks until all of it has arrived, then it becomes
+// an attachment of the entity.
+type AttachmentUpload struct {
+	ent.Schema
+}
+
+func (AttachmentUpload) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "attachment_uploads",
+			field: "group_id",
+		},
+	}
+}
+
+func (AttachmentUpload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("entity_id", uuid.UUID{}),
+		field.String("title").
+			MaxLen(255).
+			NotEmpty(),
+		field.Enum("type").
+			Values("photo", "manual", "warranty", "attachment", "receipt").
+			Default("attachment"),
+		field.Bool("primary").
+			Default(false),
+		field.Int64("size").
+			NonNegative(),
+		// Received is the number of bytes stored so far, the offset the next
+		// chunk starts at.
+		field.Int64("received").
+			NonNegative().
+			Default(0),
+		field.Time("expires_at"),
+		// AttachmentID is set in the transaction that turns the completed
+		// upload into an attachment.
+		field.UUID("attachment_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+func (AttachmentUpload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
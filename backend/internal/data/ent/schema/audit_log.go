@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity. Each row
+// records a single field change (or a create/delete) made to an entity,
+// along with who made it and through which channel.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "audit_logs",
+			field: "group_id",
+		},
+	}
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// entity_id and user_id are intentionally plain columns rather than
+		// edges: history has to outlive the entity (delete is itself an
+		// audited action) and the user that made the change.
+		field.UUID("entity_id", uuid.UUID{}),
+		field.String("entity_name").
+			MaxLen(255).
+			Optional(),
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("user_name").
+			MaxLen(255).
+			Optional(),
+		field.Enum("action").
+			Values("create", "update", "delete"),
+		field.Enum("source").
+			Values("web", "api_key", "import", "system").
+			Default("system"),
+		// field_name is the changed attribute, empty for create/delete rows.
+		// Custom fields are recorded as "field:<name>".
+		field.String("field_name").
+			MaxLen(255).
+			Optional(),
+		field.Text("old_value").
+			Optional(),
+		field.Text("new_value").
+			Optional(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id"),
+		index.Fields("group_id", "created_at"),
+		index.Fields("entity_id"),
+	}
+}
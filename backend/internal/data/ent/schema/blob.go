@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// Blob is a file in the content store. Attachments with the same content
+// share one blob; it counts the attachments that reference it and is removed
+// from the bucket by the garbage collector once none do.
+type Blob struct {
+	ent.Schema
+}
+
+func (Blob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "blobs",
+			field: "group_id",
+		},
+	}
+}
+
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		// Path is the key of the file in the bucket, relative to the storage
+		// prefix, as stored in the path of the attachments.
+		field.String("path").
+			NotEmpty().
+			Unique(),
+		field.Int64("size").
+			NonNegative().
+			Default(0),
+		field.String("mime_type").
+			Default("application/octet-stream"),
+		field.Int("ref_count").
+			NonNegative().
+			Default(0),
+		// UnreferencedAt is when the last reference went away; the garbage
+		// collector waits a grace period after it before removing the file.
+		field.Time("unreferenced_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Blob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ref_count", "unreferenced_at"),
+	}
+}
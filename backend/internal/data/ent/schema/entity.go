@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
 )
 
@@ -18,6 +19,7 @@ func (Entity) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
+		mixins.DepreciationMixin{},
 		GroupMixin{ref: "entities"},
 	}
 }
@@ -30,6 +32,8 @@ func (Entity) Indexes() []ent.Index {
 		index.Fields("serial_number"),
 		index.Fields("archived"),
 		index.Fields("asset_id"),
+		index.Fields("deleted_at"),
+		index.Fields("trash_root_id"),
 	}
 }
 
@@ -83,6 +87,10 @@ func (Entity) Fields() []ent.Field {
 			Optional(),
 		field.Float("purchase_price").
 			Default(0),
+		// Currency codes are empty for amounts in the group's currency.
+		field.String("purchase_currency").
+			MaxLen(16).
+			Optional(),
 
 		// ------------------------------------
 		// Sold Details
@@ -92,9 +100,52 @@ func (Entity) Fields() []ent.Field {
 			Optional(),
 		field.Float("sold_price").
 			Default(0),
+		field.String("sold_currency").
+			MaxLen(16).
+			Optional(),
 		field.String("sold_notes").
 			MaxLen(1000).
 			Optional(),
+
+		// ------------------------------------
+		// Depreciation
+		//
+		// manual_value is the current value of an entity depreciated with the
+		// manual method. The method itself comes from DepreciationMixin.
+		field.Float("manual_value").
+			Optional().
+			Nillable(),
+
+		// ------------------------------------
+		// Stock
+		//
+		// Consumables keep min_quantity on hand and are reordered once the
+		// quantity falls to reorder_point, or to min_quantity when no reorder
+		// point is set. Entities with neither don't track stock.
+		field.Float("min_quantity").
+			Optional().
+			Nillable(),
+		field.Float("reorder_point").
+			Optional().
+			Nillable(),
+		field.String("unit").
+			MaxLen(32).
+			Optional(),
+
+		// ------------------------------------
+		// Trash
+		//
+		// deleted_at marks a soft-deleted entity; the repository hides these
+		// rows from every query until they are restored or purged.
+		// trash_root_id is the entity the user actually deleted, shared by
+		// every descendant trashed with it so the whole subtree can be
+		// restored (or purged) as one unit.
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		field.UUID("trash_root_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -119,6 +170,9 @@ func (Entity) Edges() []ent.Edge {
 			Required(),
 		owned("fields", EntityField.Type),
 		owned("maintenance_entries", MaintenanceEntry.Type),
+		owned("stock_movements", StockMovement.Type),
+		owned("loans", Loan.Type),
+		owned("warranty_notifications", WarrantyNotification.Type),
 		owned("attachments", Attachment.Type),
 	}
 }
@@ -25,12 +25,15 @@ func (EntityField) Mixin() []ent.Mixin {
 func (EntityField) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").
-			Values("text", "number", "boolean", "time"),
+			Values("text", "number", "boolean", "time", "decimal", "date", "url", "email", "select", "multi_select", "currency"),
 		field.String("text_value").
 			MaxLen(500).
 			Optional(),
 		field.Int("number_value").
 			Optional(),
+		// Decimal fields, and the amount of currency fields.
+		field.Float("decimal_value").
+			Optional(),
 		field.Bool("boolean_value").
 			Default(false),
 		field.Time("time_value").
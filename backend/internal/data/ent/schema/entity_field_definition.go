@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// EntityFieldDefinition describes a custom field every entity of an entity
+// type has. Entity fields with the definition's name take its type and are
+// checked against it.
+type EntityFieldDefinition struct {
+	ent.Schema
+}
+
+func (EntityFieldDefinition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+	}
+}
+
+func (EntityFieldDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type_id", "name").
+			Unique(),
+	}
+}
+
+// Fields of the EntityFieldDefinition.
+func (EntityFieldDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("entity_type_id", uuid.UUID{}),
+		field.Enum("type").
+			Values("text", "number", "decimal", "boolean", "date", "url", "email", "select", "multi_select", "currency"),
+		// The choices of select and multi_select fields.
+		field.Strings("options").
+			Optional(),
+		field.Bool("required").
+			Default(false),
+		// Prefilled into new entities, in the text form of the type.
+		field.String("default_value").
+			MaxLen(500).
+			Optional(),
+		field.Int("position").
+			Default(0),
+	}
+}
+
+// Edges of the EntityFieldDefinition.
+func (EntityFieldDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entity_type", EntityType.Type).
+			Field("entity_type_id").
+			Ref("field_definitions").
+			Required().
+			Unique(),
+	}
+}
@@ -17,6 +17,7 @@ func (EntityType) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.BaseMixin{},
 		mixins.DetailsMixin{},
+		mixins.DepreciationMixin{},
 		GroupMixin{ref: "entity_types"},
 	}
 }
@@ -41,5 +42,9 @@ func (EntityType) Edges() []ent.Edge {
 			}),
 		edge.To("default_template", EntityTemplate.Type).
 			Unique(),
+		edge.To("field_definitions", EntityFieldDefinition.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// ExchangeRate is what one unit of the base currency is worth in the quote
+// currency on a date. Reports convert amounts into the group's currency with
+// these rates.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+func (ExchangeRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "exchange_rates",
+			field: "group_id",
+		},
+	}
+}
+
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("base").
+			MaxLen(16).
+			NotEmpty(),
+		field.String("quote").
+			MaxLen(16).
+			NotEmpty(),
+		field.Float("rate").
+			Positive(),
+		field.Time("date"),
+	}
+}
+
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "base", "quote", "date").
+			Unique(),
+	}
+}
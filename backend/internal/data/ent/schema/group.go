@@ -29,6 +29,21 @@ func (Group) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("currency").
 			Default("usd"),
+		// Whether amounts in other currencies are converted at the rate of
+		// their purchase date or at the latest rate.
+		field.Enum("currency_conversion").
+			Values("purchase_date", "today").
+			Default("purchase_date"),
+		// Days before warranty_expires at which active notifiers get a
+		// reminder, e.g. [30, 7]. Empty disables warranty reminders.
+		field.JSON("warranty_notify_days", []int{}).
+			Optional(),
+		// HMAC key shared by all of the group's webhooks. Generated the
+		// first time it is requested.
+		field.String("webhook_secret").
+			Sensitive().
+			MaxLen(255).
+			Optional(),
 	}
 }
 
@@ -53,6 +68,12 @@ func (Group) Edges() []ent.Edge {
 		owned("notifiers", Notifier.Type),
 		owned("entity_templates", EntityTemplate.Type),
 		owned("exports", Export.Type),
+		owned("audit_logs", AuditLog.Type),
+		owned("webhooks", Webhook.Type),
+		owned("saved_searches", SavedSearch.Type),
+		owned("exchange_rates", ExchangeRate.Type),
+		owned("attachment_uploads", AttachmentUpload.Type),
+		owned("blobs", Blob.Type),
 		// $scaffold_edge
 	}
 }
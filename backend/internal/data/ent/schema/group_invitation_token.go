@@ -29,6 +29,10 @@ func (GroupInvitationToken) Fields() []ent.Field {
 			Default(func() time.Time { return time.Now().Add(time.Hour * 24 * 7) }),
 		field.Int("uses").
 			Default(0),
+		field.Enum("role").
+			Comment("Role given to members who join with the invitation").
+			Values("viewer", "contributor", "editor").
+			Default("editor"),
 	}
 }
 
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// Loan records an entity being lent out. A loan is open until it has a
+// return date; an entity has at most one open loan.
+type Loan struct {
+	ent.Schema
+}
+
+func (Loan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (Loan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_id", "checked_out_at"),
+		// At most one open loan per entity.
+		index.Fields("entity_id").
+			Unique().
+			Annotations(entsql.IndexWhere("returned_at IS NULL")),
+		index.Fields("returned_at", "due_at"),
+	}
+}
+
+func (Loan) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("entity_id", uuid.UUID{}),
+		// The borrower is either a member of the collection or a free-text
+		// contact. Members are kept without an edge so the history outlives
+		// them; their name is copied into borrower_name.
+		field.UUID("borrower_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("borrower_name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("borrower_contact").
+			MaxLen(255).
+			Optional(),
+		field.String("notes").
+			MaxLen(1000).
+			Optional(),
+		field.Time("checked_out_at"),
+		field.Time("due_at").
+			Optional().
+			Nillable(),
+		field.Time("returned_at").
+			Optional().
+			Nillable(),
+		// Set once the overdue reminder went out, so it is only sent once.
+		field.Time("overdue_notified_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Loan.
+func (Loan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entity", Entity.Type).
+			Field("entity_id").
+			Ref("loans").
+			Required().
+			Unique(),
+	}
+}
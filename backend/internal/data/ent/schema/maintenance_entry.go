@@ -33,6 +33,16 @@ func (MaintenanceEntry) Fields() []ent.Field {
 			Optional(),
 		field.Float("cost").
 			Default(0.0),
+		// Empty when the cost is in the group's currency.
+		field.String("cost_currency").
+			MaxLen(16).
+			Optional(),
+		// RRULE subset (FREQ, INTERVAL, UNTIL) for repeating maintenance. The
+		// rule lives on the open occurrence and moves to the next one when it
+		// is completed.
+		field.String("recurrence_rule").
+			MaxLen(255).
+			Optional(),
 	}
 }
 
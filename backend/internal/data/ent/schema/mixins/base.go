@@ -40,3 +40,24 @@ func (DetailsMixin) Fields() []ent.Field {
 			Optional(),
 	}
 }
+
+// DepreciationMixin holds how an asset loses value. On an entity type the
+// fields are defaults for its entities; an entity's own fields win where set.
+type DepreciationMixin struct {
+	mixin.Schema
+}
+
+func (DepreciationMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("depreciation_method").
+			Values("none", "straight_line", "declining_balance", "manual").
+			Optional().
+			Nillable(),
+		field.Float("useful_life_years").
+			Optional().
+			Nillable(),
+		field.Float("salvage_value").
+			Optional().
+			Nillable(),
+	}
+}
@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
@@ -41,6 +43,16 @@ func (Notifier) Fields() []ent.Field {
 	}
 }
 
+// Edges of the Notifier.
+func (Notifier) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("subscriptions", NotifierSubscription.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
 func (Notifier) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// NotifierSubscription opts a notifier into one kind of event, optionally
+// with a text/template body that replaces the default message and a saved
+// search that limits which entities it reports on.
+type NotifierSubscription struct {
+	ent.Schema
+}
+
+func (NotifierSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (NotifierSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("notifier_id", uuid.UUID{}),
+		field.Enum("event").
+			Values(
+				"maintenance_due",
+				"warranty_expiring",
+				"export_completed",
+				"export_failed",
+				"import_finished",
+				"low_stock",
+				"member_joined",
+				"loan_overdue",
+			),
+		field.Text("template").
+			MaxLen(4000).
+			Optional(),
+		field.UUID("saved_search_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+func (NotifierSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("notifier", Notifier.Type).
+			Field("notifier_id").
+			Ref("subscriptions").
+			Required().
+			Unique(),
+		edge.From("saved_search", SavedSearch.Type).
+			Field("saved_search_id").
+			Ref("notifier_subscriptions").
+			Unique(),
+	}
+}
+
+func (NotifierSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("notifier_id", "event").
+			Unique(),
+		index.Fields("event"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// SavedSearch is a named entity query shared by everyone in the group. It
+// mirrors the filters of GET /v1/entities plus the table columns to show.
+type SavedSearch struct {
+	ent.Schema
+}
+
+func (SavedSearch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		mixins.DetailsMixin{},
+		GroupMixin{
+			ref:   "saved_searches",
+			field: "group_id",
+		},
+	}
+}
+
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		// Search string, including any filter expressions.
+		field.String("query").
+			MaxLen(2000).
+			Optional(),
+		field.String("order_by").
+			MaxLen(32).
+			Optional(),
+		field.JSON("tag_ids", []uuid.UUID{}).
+			Optional(),
+		field.JSON("parent_ids", []uuid.UUID{}).
+			Optional(),
+		field.Bool("negate_tags").
+			Default(false),
+		field.Bool("only_with_photo").
+			Default(false),
+		field.Bool("only_without_photo").
+			Default(false),
+		field.Bool("include_archived").
+			Default(false),
+		field.JSON("columns", []string{}).
+			Optional(),
+	}
+}
+
+func (SavedSearch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("notifier_subscriptions", NotifierSubscription.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (SavedSearch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// StockMovement is an entry in an entity's stock ledger. Every change to the
+// quantity of an entity is recorded, whether it came from the consume and
+// restock endpoints or from editing the entity.
+type StockMovement struct {
+	ent.Schema
+}
+
+func (StockMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_id", "created_at"),
+	}
+}
+
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("entity_id", uuid.UUID{}),
+		field.Enum("kind").
+			Values("consume", "restock", "adjust"),
+		field.Float("change").
+			Comment("Signed change to the quantity"),
+		field.Float("quantity_after"),
+		field.String("note").
+			MaxLen(1000).
+			Optional(),
+		// Kept without an edge so the ledger outlives the user.
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entity", Entity.Type).
+			Field("entity_id").
+			Ref("stock_movements").
+			Required().
+			Unique(),
+	}
+}
@@ -11,7 +11,10 @@ import (
 
 // UserGroup is the through entity for the User<->Group M:M relation. It carries
 // the per-membership role so that "owner" is scoped to a single group rather
-// than being a global flag on the user.
+// than being a global flag on the user. Viewers can only read, contributors can
+// add and change inventory but not delete it, editors can do anything short of
+// administering the collection, which is left to owners. Members other than
+// owners can also be limited to a few location subtrees.
 type UserGroup struct {
 	ent.Schema
 }
@@ -28,8 +31,11 @@ func (UserGroup) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}),
 		field.UUID("group_id", uuid.UUID{}),
 		field.Enum("role").
-			Values("user", "owner").
-			Default("user"),
+			Values("viewer", "contributor", "editor", "owner").
+			Default("editor"),
+		field.JSON("location_ids", []uuid.UUID{}).
+			Comment("Locations the member is limited to, along with everything below them. Empty means the whole collection.").
+			Optional(),
 	}
 }
 
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// WarrantyNotification records that an expiry reminder was sent for an
+// entity at a given lead time, so each entity/threshold pair is only
+// notified once. expires_on is part of the key: if the warranty date is
+// changed (e.g. extended), the reminders fire again for the new date.
+type WarrantyNotification struct {
+	ent.Schema
+}
+
+func (WarrantyNotification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (WarrantyNotification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("entity_id", uuid.UUID{}),
+		field.Int("lead_days").
+			NonNegative(),
+		field.Time("expires_on"),
+	}
+}
+
+func (WarrantyNotification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entity", Entity.Type).
+			Field("entity_id").
+			Ref("warranty_notifications").
+			Required().
+			Unique(),
+	}
+}
+
+func (WarrantyNotification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_id", "lead_days", "expires_on").
+			Unique(),
+	}
+}
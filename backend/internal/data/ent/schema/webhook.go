@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// Webhook is an HTTP endpoint that receives the group's mutation events as
+// signed JSON POSTs.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+		GroupMixin{
+			ref:   "webhooks",
+			field: "group_id",
+		},
+	}
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("url").
+			MaxLen(2083).
+			NotEmpty(),
+		// Event names the webhook receives, e.g. ["entity.mutation"].
+		field.JSON("events", []string{}),
+		field.Bool("is_active").
+			Default(true),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id"),
+		index.Fields("group_id", "is_active"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/schema/mixins"
+)
+
+// WebhookDelivery is one event queued for a webhook, together with the
+// outcome of the attempts made to deliver it.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.BaseMixin{},
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.String("event").
+			MaxLen(64),
+		// payload is the exact request body, so retries are signed over
+		// the same bytes.
+		field.Text("payload"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable(),
+		field.Int("response_status").
+			Optional(),
+		field.String("error").
+			MaxLen(1000).
+			Optional(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Field("webhook_id").
+			Ref("deliveries").
+			Required().
+			Unique(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
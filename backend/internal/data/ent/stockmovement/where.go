@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockmovement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Change applies equality check predicate on the "change" field. It's identical to ChangeEQ.
+func Change(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldChange, v))
+}
+
+// QuantityAfter applies equality check predicate on the "quantity_after" field. It's identical to QuantityAfterEQ.
+func QuantityAfter(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldNote, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ChangeEQ applies the EQ predicate on the "change" field.
+func ChangeEQ(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldChange, v))
+}
+
+// ChangeNEQ applies the NEQ predicate on the "change" field.
+func ChangeNEQ(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldChange, v))
+}
+
+// ChangeIn applies the In predicate on the "change" field.
+func ChangeIn(vs ...float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldChange, vs...))
+}
+
+// ChangeNotIn applies the NotIn predicate on the "change" field.
+func ChangeNotIn(vs ...float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldChange, vs...))
+}
+
+// ChangeGT applies the GT predicate on the "change" field.
+func ChangeGT(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldChange, v))
+}
+
+// ChangeGTE applies the GTE predicate on the "change" field.
+func ChangeGTE(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldChange, v))
+}
+
+// ChangeLT applies the LT predicate on the "change" field.
+func ChangeLT(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldChange, v))
+}
+
+// ChangeLTE applies the LTE predicate on the "change" field.
+func ChangeLTE(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldChange, v))
+}
+
+// QuantityAfterEQ applies the EQ predicate on the "quantity_after" field.
+func QuantityAfterEQ(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterNEQ applies the NEQ predicate on the "quantity_after" field.
+func QuantityAfterNEQ(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterIn applies the In predicate on the "quantity_after" field.
+func QuantityAfterIn(vs ...float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterNotIn applies the NotIn predicate on the "quantity_after" field.
+func QuantityAfterNotIn(vs ...float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterGT applies the GT predicate on the "quantity_after" field.
+func QuantityAfterGT(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterGTE applies the GTE predicate on the "quantity_after" field.
+func QuantityAfterGTE(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLT applies the LT predicate on the "quantity_after" field.
+func QuantityAfterLT(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLTE applies the LTE predicate on the "quantity_after" field.
+func QuantityAfterLTE(v float64) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldQuantityAfter, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldContainsFold(FieldNote, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.StockMovement {
+	return predicate.StockMovement(sql.FieldNotNull(FieldUserID))
+}
+
+// HasEntity applies the HasEdge predicate on the "entity" edge.
+func HasEntity() predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntityTable, EntityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntityWith applies the HasEdge predicate on the "entity" edge with a given conditions (other predicates).
+func HasEntityWith(preds ...predicate.Entity) predicate.StockMovement {
+	return predicate.StockMovement(func(s *sql.Selector) {
+		step := newEntityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockMovement) predicate.StockMovement {
+	return predicate.StockMovement(sql.NotPredicates(p))
+}
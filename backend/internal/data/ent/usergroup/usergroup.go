@@ -18,6 +18,8 @@ const (
 	FieldGroupID = "group_id"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldLocationIds holds the string denoting the location_ids field in the database.
+	FieldLocationIds = "location_ids"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -49,6 +51,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldGroupID,
 	FieldRole,
+	FieldLocationIds,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -64,13 +67,15 @@ func ValidColumn(column string) bool {
 // Role defines the type for the "role" enum field.
 type Role string
 
-// RoleUser is the default value of the Role enum.
-const DefaultRole = RoleUser
+// RoleEditor is the default value of the Role enum.
+const DefaultRole = RoleEditor
 
 // Role values.
 const (
-	RoleUser  Role = "user"
-	RoleOwner Role = "owner"
+	RoleViewer      Role = "viewer"
+	RoleContributor Role = "contributor"
+	RoleEditor      Role = "editor"
+	RoleOwner       Role = "owner"
 )
 
 func (r Role) String() string {
@@ -80,7 +85,7 @@ func (r Role) String() string {
 // RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
 func RoleValidator(r Role) error {
 	switch r {
-	case RoleUser, RoleOwner:
+	case RoleViewer, RoleContributor, RoleEditor, RoleOwner:
 		return nil
 	default:
 		return fmt.Errorf("usergroup: invalid enum value for role field: %q", r)
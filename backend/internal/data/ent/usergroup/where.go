@@ -79,6 +79,16 @@ func RoleNotIn(vs ...Role) predicate.UserGroup {
 	return predicate.UserGroup(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// LocationIdsIsNil applies the IsNil predicate on the "location_ids" field.
+func LocationIdsIsNil() predicate.UserGroup {
+	return predicate.UserGroup(sql.FieldIsNull(FieldLocationIds))
+}
+
+// LocationIdsNotNil applies the NotNil predicate on the "location_ids" field.
+func LocationIdsNotNil() predicate.UserGroup {
+	return predicate.UserGroup(sql.FieldNotNull(FieldLocationIds))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserGroup {
 	return predicate.UserGroup(func(s *sql.Selector) {
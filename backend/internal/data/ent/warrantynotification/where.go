@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package warrantynotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldEntityID, v))
+}
+
+// LeadDays applies equality check predicate on the "lead_days" field. It's identical to LeadDaysEQ.
+func LeadDays(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// ExpiresOn applies equality check predicate on the "expires_on" field. It's identical to ExpiresOnEQ.
+func ExpiresOn(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldExpiresOn, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uuid.UUID) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// LeadDaysEQ applies the EQ predicate on the "lead_days" field.
+func LeadDaysEQ(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldLeadDays, v))
+}
+
+// LeadDaysNEQ applies the NEQ predicate on the "lead_days" field.
+func LeadDaysNEQ(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldLeadDays, v))
+}
+
+// LeadDaysIn applies the In predicate on the "lead_days" field.
+func LeadDaysIn(vs ...int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysNotIn applies the NotIn predicate on the "lead_days" field.
+func LeadDaysNotIn(vs ...int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldLeadDays, vs...))
+}
+
+// LeadDaysGT applies the GT predicate on the "lead_days" field.
+func LeadDaysGT(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGT(FieldLeadDays, v))
+}
+
+// LeadDaysGTE applies the GTE predicate on the "lead_days" field.
+func LeadDaysGTE(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGTE(FieldLeadDays, v))
+}
+
+// LeadDaysLT applies the LT predicate on the "lead_days" field.
+func LeadDaysLT(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLT(FieldLeadDays, v))
+}
+
+// LeadDaysLTE applies the LTE predicate on the "lead_days" field.
+func LeadDaysLTE(v int) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLTE(FieldLeadDays, v))
+}
+
+// ExpiresOnEQ applies the EQ predicate on the "expires_on" field.
+func ExpiresOnEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldEQ(FieldExpiresOn, v))
+}
+
+// ExpiresOnNEQ applies the NEQ predicate on the "expires_on" field.
+func ExpiresOnNEQ(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNEQ(FieldExpiresOn, v))
+}
+
+// ExpiresOnIn applies the In predicate on the "expires_on" field.
+func ExpiresOnIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldIn(FieldExpiresOn, vs...))
+}
+
+// ExpiresOnNotIn applies the NotIn predicate on the "expires_on" field.
+func ExpiresOnNotIn(vs ...time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldNotIn(FieldExpiresOn, vs...))
+}
+
+// ExpiresOnGT applies the GT predicate on the "expires_on" field.
+func ExpiresOnGT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGT(FieldExpiresOn, v))
+}
+
+// ExpiresOnGTE applies the GTE predicate on the "expires_on" field.
+func ExpiresOnGTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldGTE(FieldExpiresOn, v))
+}
+
+// ExpiresOnLT applies the LT predicate on the "expires_on" field.
+func ExpiresOnLT(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLT(FieldExpiresOn, v))
+}
+
+// ExpiresOnLTE applies the LTE predicate on the "expires_on" field.
+func ExpiresOnLTE(v time.Time) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.FieldLTE(FieldExpiresOn, v))
+}
+
+// HasEntity applies the HasEdge predicate on the "entity" edge.
+func HasEntity() predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntityTable, EntityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntityWith applies the HasEdge predicate on the "entity" edge with a given conditions (other predicates).
+func HasEntityWith(preds ...predicate.Entity) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(func(s *sql.Selector) {
+		step := newEntityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WarrantyNotification) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WarrantyNotification) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WarrantyNotification) predicate.WarrantyNotification {
+	return predicate.WarrantyNotification(sql.NotPredicates(p))
+}
@@ -0,0 +1,27 @@
+-- +goose Up
+-- Create "audit_logs" table
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "entity_id" uuid NOT NULL,
+    "entity_name" character varying(255) NULL,
+    "user_id" uuid NULL,
+    "user_name" character varying(255) NULL,
+    "action" character varying NOT NULL
+        CHECK ("action" IN ('create', 'update', 'delete')),
+    "source" character varying NOT NULL DEFAULT 'system'
+        CHECK ("source" IN ('web', 'api_key', 'import', 'system')),
+    "field_name" character varying(255) NULL,
+    "old_value" text NULL,
+    "new_value" text NULL,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "audit_logs_groups_audit_logs" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "auditlog_group_id" to table: "audit_logs"
+CREATE INDEX IF NOT EXISTS "auditlog_group_id" ON "audit_logs" ("group_id");
+-- Create index "auditlog_group_id_created_at" to table: "audit_logs"
+CREATE INDEX IF NOT EXISTS "auditlog_group_id_created_at" ON "audit_logs" ("group_id", "created_at");
+-- Create index "auditlog_entity_id" to table: "audit_logs"
+CREATE INDEX IF NOT EXISTS "auditlog_entity_id" ON "audit_logs" ("entity_id");
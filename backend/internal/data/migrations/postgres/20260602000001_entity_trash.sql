@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE "entities"
+    ADD COLUMN "deleted_at" timestamptz NULL,
+    ADD COLUMN "trash_root_id" uuid NULL;
+-- Create index "entity_deleted_at" to table: "entities"
+CREATE INDEX IF NOT EXISTS "entity_deleted_at" ON "entities" ("deleted_at");
+-- Create index "entity_trash_root_id" to table: "entities"
+CREATE INDEX IF NOT EXISTS "entity_trash_root_id" ON "entities" ("trash_root_id");
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE "groups" ADD COLUMN "warranty_notify_days" jsonb NULL;
+-- Create "warranty_notifications" table
+CREATE TABLE IF NOT EXISTS "warranty_notifications" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "lead_days" bigint NOT NULL,
+    "expires_on" timestamptz NOT NULL,
+    "entity_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "warranty_notifications_entities_warranty_notifications" FOREIGN KEY ("entity_id") REFERENCES "entities" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "warrantynotification_entity_id_lead_days_expires_on" to table: "warranty_notifications"
+CREATE UNIQUE INDEX IF NOT EXISTS "warrantynotification_entity_id_lead_days_expires_on" ON "warranty_notifications" ("entity_id", "lead_days", "expires_on");
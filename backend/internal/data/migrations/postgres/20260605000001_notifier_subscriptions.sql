@@ -0,0 +1,23 @@
+-- +goose Up
+-- Create "notifier_subscriptions" table
+CREATE TABLE IF NOT EXISTS "notifier_subscriptions" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "event" character varying NOT NULL
+        CHECK ("event" IN ('maintenance_due', 'warranty_expiring', 'export_completed', 'export_failed', 'import_finished', 'low_stock', 'member_joined')),
+    "template" character varying(4000) NULL,
+    "notifier_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "notifier_subscriptions_notifiers_subscriptions" FOREIGN KEY ("notifier_id") REFERENCES "notifiers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notifiersubscription_notifier_id_event" to table: "notifier_subscriptions"
+CREATE UNIQUE INDEX IF NOT EXISTS "notifiersubscription_notifier_id_event" ON "notifier_subscriptions" ("notifier_id", "event");
+-- Create index "notifiersubscription_event" to table: "notifier_subscriptions"
+CREATE INDEX IF NOT EXISTS "notifiersubscription_event" ON "notifier_subscriptions" ("event");
+-- Existing notifiers keep receiving the scheduled digests they got before
+-- subscriptions existed.
+INSERT INTO "notifier_subscriptions" ("id", "created_at", "updated_at", "event", "notifier_id")
+SELECT gen_random_uuid(), now(), now(), e."event", n."id"
+FROM "notifiers" n
+CROSS JOIN (VALUES ('maintenance_due'), ('warranty_expiring')) AS e("event");
@@ -0,0 +1,41 @@
+-- +goose Up
+ALTER TABLE "groups" ADD COLUMN "webhook_secret" character varying(255) NULL;
+-- Create "webhooks" table
+CREATE TABLE IF NOT EXISTS "webhooks" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "name" character varying(255) NOT NULL,
+    "url" character varying(2083) NOT NULL,
+    "events" jsonb NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "webhooks_groups_webhooks" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhook_group_id" to table: "webhooks"
+CREATE INDEX IF NOT EXISTS "webhook_group_id" ON "webhooks" ("group_id");
+-- Create index "webhook_group_id_is_active" to table: "webhooks"
+CREATE INDEX IF NOT EXISTS "webhook_group_id_is_active" ON "webhooks" ("group_id", "is_active");
+-- Create "webhook_deliveries" table
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "event" character varying(64) NOT NULL,
+    "payload" text NOT NULL,
+    "status" character varying NOT NULL DEFAULT 'pending'
+        CHECK ("status" IN ('pending', 'succeeded', 'failed')),
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamptz NULL,
+    "response_status" bigint NULL,
+    "error" character varying(1000) NULL,
+    "delivered_at" timestamptz NULL,
+    "webhook_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhookdelivery_webhook_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX IF NOT EXISTS "webhookdelivery_webhook_id_created_at" ON "webhook_deliveries" ("webhook_id", "created_at");
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX IF NOT EXISTS "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Create "entity_search" table. Rows are maintained by the application;
+-- existing entities are indexed on the first start after this migration.
+CREATE TABLE IF NOT EXISTS "entity_search" (
+    "entity_id" uuid NOT NULL,
+    "group_id" uuid NOT NULL,
+    "document" tsvector NOT NULL,
+    PRIMARY KEY ("entity_id"),
+    CONSTRAINT "entity_search_entities_search" FOREIGN KEY ("entity_id") REFERENCES "entities" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "entitysearch_document" to table: "entity_search"
+CREATE INDEX IF NOT EXISTS "entitysearch_document" ON "entity_search" USING GIN ("document");
+-- Create index "entitysearch_group_id" to table: "entity_search"
+CREATE INDEX IF NOT EXISTS "entitysearch_group_id" ON "entity_search" ("group_id");
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Create "saved_searches" table
+CREATE TABLE IF NOT EXISTS "saved_searches" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "name" character varying(255) NOT NULL,
+    "description" character varying(1000) NULL,
+    "query" character varying(2000) NULL,
+    "order_by" character varying(32) NULL,
+    "tag_ids" jsonb NULL,
+    "parent_ids" jsonb NULL,
+    "negate_tags" boolean NOT NULL DEFAULT false,
+    "only_with_photo" boolean NOT NULL DEFAULT false,
+    "only_without_photo" boolean NOT NULL DEFAULT false,
+    "include_archived" boolean NOT NULL DEFAULT false,
+    "columns" jsonb NULL,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "saved_searches_groups_saved_searches" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "savedsearch_group_id" to table: "saved_searches"
+CREATE INDEX IF NOT EXISTS "savedsearch_group_id" ON "saved_searches" ("group_id");
+-- Modify "notifier_subscriptions" table
+ALTER TABLE "notifier_subscriptions" ADD COLUMN "saved_search_id" uuid NULL,
+    ADD CONSTRAINT "notifier_subscriptions_saved_searches_notifier_subscriptions" FOREIGN KEY ("saved_search_id") REFERENCES "saved_searches" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
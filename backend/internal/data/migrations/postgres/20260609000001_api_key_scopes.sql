@@ -0,0 +1,5 @@
+-- +goose Up
+-- Modify "api_keys" table
+ALTER TABLE "api_keys" ADD COLUMN "scopes" jsonb NULL,
+    ADD COLUMN "group_id" uuid NULL,
+    ADD COLUMN "location_id" uuid NULL;
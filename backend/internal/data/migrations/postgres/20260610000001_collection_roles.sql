@@ -0,0 +1,8 @@
+-- +goose Up
+-- Members used to be either "user" or "owner"; a "user" could do everything
+-- but administer the collection, which is what "editor" means now.
+UPDATE "user_groups" SET "role" = 'editor' WHERE "role" = 'user';
+-- Modify "user_groups" table
+ALTER TABLE "user_groups" ALTER COLUMN "role" SET DEFAULT 'editor';
+-- Modify "group_invitation_tokens" table
+ALTER TABLE "group_invitation_tokens" ADD COLUMN "role" character varying NOT NULL DEFAULT 'editor';
@@ -0,0 +1,3 @@
+-- +goose Up
+-- Modify "user_groups" table
+ALTER TABLE "user_groups" ADD COLUMN "location_ids" jsonb NULL;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "min_quantity" double precision NULL,
+    ADD COLUMN "reorder_point" double precision NULL,
+    ADD COLUMN "unit" character varying(32) NULL;
+-- Create "stock_movements" table
+CREATE TABLE IF NOT EXISTS "stock_movements" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "kind" character varying NOT NULL,
+    "change" double precision NOT NULL,
+    "quantity_after" double precision NOT NULL,
+    "note" character varying(1000) NULL,
+    "user_id" uuid NULL,
+    "entity_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "stock_movements_entities_stock_movements" FOREIGN KEY ("entity_id") REFERENCES "entities" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "stockmovement_entity_id_created_at" to table: "stock_movements"
+CREATE INDEX IF NOT EXISTS "stockmovement_entity_id_created_at" ON "stock_movements" ("entity_id", "created_at");
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Create "loans" table
+CREATE TABLE IF NOT EXISTS "loans" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "borrower_id" uuid NULL,
+    "borrower_name" character varying(255) NOT NULL,
+    "borrower_contact" character varying(255) NULL,
+    "notes" character varying(1000) NULL,
+    "checked_out_at" timestamptz NOT NULL,
+    "due_at" timestamptz NULL,
+    "returned_at" timestamptz NULL,
+    "overdue_notified_at" timestamptz NULL,
+    "entity_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "loans_entities_loans" FOREIGN KEY ("entity_id") REFERENCES "entities" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "loan_entity_id_checked_out_at" to table: "loans"
+CREATE INDEX IF NOT EXISTS "loan_entity_id_checked_out_at" ON "loans" ("entity_id", "checked_out_at");
+-- Create index "loan_entity_id" to table: "loans"
+CREATE UNIQUE INDEX IF NOT EXISTS "loan_entity_id" ON "loans" ("entity_id") WHERE (returned_at IS NULL);
+-- Create index "loan_returned_at_due_at" to table: "loans"
+CREATE INDEX IF NOT EXISTS "loan_returned_at_due_at" ON "loans" ("returned_at", "due_at");
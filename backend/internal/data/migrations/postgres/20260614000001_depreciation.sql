@@ -0,0 +1,10 @@
+-- +goose Up
+-- Modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "depreciation_method" character varying NULL,
+    ADD COLUMN "useful_life_years" double precision NULL,
+    ADD COLUMN "salvage_value" double precision NULL,
+    ADD COLUMN "manual_value" double precision NULL;
+-- Modify "entity_types" table
+ALTER TABLE "entity_types" ADD COLUMN "depreciation_method" character varying NULL,
+    ADD COLUMN "useful_life_years" double precision NULL,
+    ADD COLUMN "salvage_value" double precision NULL;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Modify "groups" table
+ALTER TABLE "groups" ADD COLUMN "currency_conversion" character varying NOT NULL DEFAULT 'purchase_date';
+-- Modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "purchase_currency" character varying(16) NULL,
+    ADD COLUMN "sold_currency" character varying(16) NULL;
+-- Modify "maintenance_entries" table
+ALTER TABLE "maintenance_entries" ADD COLUMN "cost_currency" character varying(16) NULL;
+-- Create "exchange_rates" table
+CREATE TABLE IF NOT EXISTS "exchange_rates" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "base" character varying(16) NOT NULL,
+    "quote" character varying(16) NOT NULL,
+    "rate" double precision NOT NULL,
+    "date" timestamptz NOT NULL,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "exchange_rates_groups_exchange_rates" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "exchangerate_group_id_base_quote_date" to table: "exchange_rates"
+CREATE UNIQUE INDEX IF NOT EXISTS "exchangerate_group_id_base_quote_date" ON "exchange_rates" ("group_id", "base", "quote", "date");
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Modify "entity_fields" table
+ALTER TABLE "entity_fields" ADD COLUMN "decimal_value" double precision NULL;
+-- Create "entity_field_definitions" table
+CREATE TABLE IF NOT EXISTS "entity_field_definitions" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "name" character varying(255) NOT NULL,
+    "description" character varying(1000) NULL,
+    "type" character varying NOT NULL,
+    "options" jsonb NULL,
+    "required" boolean NOT NULL DEFAULT false,
+    "default_value" character varying(500) NULL,
+    "position" bigint NOT NULL DEFAULT 0,
+    "entity_type_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "entity_field_definitions_entity_types_field_definitions" FOREIGN KEY ("entity_type_id") REFERENCES "entity_types" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "entityfielddefinition_entity_type_id_name" to table: "entity_field_definitions"
+CREATE UNIQUE INDEX IF NOT EXISTS "entityfielddefinition_entity_type_id_name" ON "entity_field_definitions" ("entity_type_id", "name");
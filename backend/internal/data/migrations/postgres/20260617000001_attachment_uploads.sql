@@ -0,0 +1,20 @@
+-- +goose Up
+-- Create "attachment_uploads" table
+CREATE TABLE IF NOT EXISTS "attachment_uploads" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "entity_id" uuid NOT NULL,
+    "title" character varying(255) NOT NULL,
+    "type" character varying NOT NULL DEFAULT 'attachment',
+    "primary" boolean NOT NULL DEFAULT false,
+    "size" bigint NOT NULL,
+    "received" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz NOT NULL,
+    "attachment_id" uuid NULL,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "attachment_uploads_groups_attachment_uploads" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "attachmentupload_expires_at" to table: "attachment_uploads"
+CREATE INDEX IF NOT EXISTS "attachmentupload_expires_at" ON "attachment_uploads" ("expires_at");
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Create "blobs" table
+CREATE TABLE IF NOT EXISTS "blobs" (
+    "id" uuid NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    "path" character varying NOT NULL,
+    "size" bigint NOT NULL DEFAULT 0,
+    "mime_type" character varying NOT NULL DEFAULT 'application/octet-stream',
+    "ref_count" bigint NOT NULL DEFAULT 0,
+    "unreferenced_at" timestamptz NULL,
+    "group_id" uuid NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "blobs_groups_blobs" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "blobs_path_key" to table: "blobs"
+CREATE UNIQUE INDEX IF NOT EXISTS "blobs_path_key" ON "blobs" ("path");
+-- Create index "blob_ref_count_unreferenced_at" to table: "blobs"
+CREATE INDEX IF NOT EXISTS "blob_ref_count_unreferenced_at" ON "blobs" ("ref_count", "unreferenced_at");
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Modify "attachments" table
+ALTER TABLE "attachments" ADD COLUMN "captured_at" timestamptz NULL,
+    ADD COLUMN "latitude" double precision NULL,
+    ADD COLUMN "longitude" double precision NULL,
+    ADD COLUMN "width" bigint NULL,
+    ADD COLUMN "height" bigint NULL,
+    ADD COLUMN "document_title" character varying(255) NULL,
+    ADD COLUMN "document_author" character varying(255) NULL,
+    ADD COLUMN "page_count" bigint NULL;
@@ -0,0 +1,35 @@
+-- +goose Up
+create table if not exists audit_logs
+(
+    id          uuid                      not null
+        primary key,
+    created_at  datetime                  not null,
+    updated_at  datetime                  not null,
+    entity_id   uuid                      not null,
+    entity_name text
+        check (entity_name is null or length(entity_name) <= 255),
+    user_id     uuid,
+    user_name   text
+        check (user_name is null or length(user_name) <= 255),
+    action      text                      not null
+        check (action in ('create', 'update', 'delete')),
+    source      text     default 'system' not null
+        check (source in ('web', 'api_key', 'import', 'system')),
+    field_name  text
+        check (field_name is null or length(field_name) <= 255),
+    old_value   text,
+    new_value   text,
+    group_id    uuid                      not null
+        constraint audit_logs_groups_audit_logs
+            references groups
+            on delete cascade
+);
+
+create index if not exists auditlog_group_id
+    on audit_logs (group_id);
+
+create index if not exists auditlog_group_id_created_at
+    on audit_logs (group_id, created_at);
+
+create index if not exists auditlog_entity_id
+    on audit_logs (entity_id);
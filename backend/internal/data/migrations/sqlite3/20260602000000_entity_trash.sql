@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE entities ADD COLUMN deleted_at datetime;
+ALTER TABLE entities ADD COLUMN trash_root_id uuid;
+
+create index if not exists entity_deleted_at
+    on entities (deleted_at);
+
+create index if not exists entity_trash_root_id
+    on entities (trash_root_id);
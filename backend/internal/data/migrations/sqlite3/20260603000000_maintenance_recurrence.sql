@@ -0,0 +1,2 @@
+-- +goose Up
+ALTER TABLE maintenance_entries ADD COLUMN recurrence_rule text;
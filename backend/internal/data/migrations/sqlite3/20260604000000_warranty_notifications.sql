@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE groups ADD COLUMN warranty_notify_days json;
+
+create table if not exists warranty_notifications
+(
+    id         uuid     not null
+        primary key,
+    created_at datetime not null,
+    updated_at datetime not null,
+    lead_days  integer  not null,
+    expires_on datetime not null,
+    entity_id  uuid     not null
+        constraint warranty_notifications_entities_warranty_notifications
+            references entities
+            on delete cascade
+);
+
+create unique index if not exists warrantynotification_entity_id_lead_days_expires_on
+    on warranty_notifications (entity_id, lead_days, expires_on);
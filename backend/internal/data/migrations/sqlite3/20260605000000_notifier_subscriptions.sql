@@ -0,0 +1,35 @@
+-- +goose Up
+create table if not exists notifier_subscriptions
+(
+    id          uuid     not null
+        primary key,
+    created_at  datetime not null,
+    updated_at  datetime not null,
+    event       text     not null
+        check (event in ('maintenance_due', 'warranty_expiring', 'export_completed', 'export_failed',
+                         'import_finished', 'low_stock', 'member_joined')),
+    template    text,
+    notifier_id uuid     not null
+        constraint notifier_subscriptions_notifiers_subscriptions
+            references notifiers
+            on delete cascade
+);
+
+create unique index if not exists notifiersubscription_notifier_id_event
+    on notifier_subscriptions (notifier_id, event);
+
+create index if not exists notifiersubscription_event
+    on notifier_subscriptions (event);
+
+-- Existing notifiers keep receiving the scheduled digests they got before
+-- subscriptions existed.
+insert into notifier_subscriptions (id, created_at, updated_at, event, notifier_id)
+select lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' ||
+             substr('89ab', abs(random()) % 4 + 1, 1) || substr(hex(randomblob(2)), 2) || '-' ||
+             hex(randomblob(6))),
+       datetime('now'),
+       datetime('now'),
+       e.event,
+       n.id
+from notifiers n
+         cross join (select 'maintenance_due' as event union all select 'warranty_expiring') e;
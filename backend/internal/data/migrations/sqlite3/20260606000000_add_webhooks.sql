@@ -0,0 +1,52 @@
+-- +goose Up
+ALTER TABLE groups ADD COLUMN webhook_secret text;
+
+create table if not exists webhooks
+(
+    id         uuid                 not null
+        primary key,
+    created_at datetime             not null,
+    updated_at datetime             not null,
+    name       text                 not null,
+    url        text                 not null,
+    events     json                 not null,
+    is_active  bool    default true not null,
+    group_id   uuid                 not null
+        constraint webhooks_groups_webhooks
+            references groups
+            on delete cascade
+);
+
+create index if not exists webhook_group_id
+    on webhooks (group_id);
+
+create index if not exists webhook_group_id_is_active
+    on webhooks (group_id, is_active);
+
+create table if not exists webhook_deliveries
+(
+    id              uuid                       not null
+        primary key,
+    created_at      datetime                   not null,
+    updated_at      datetime                   not null,
+    event           text                       not null,
+    payload         text                       not null,
+    status          text     default 'pending' not null
+        check (status in ('pending', 'succeeded', 'failed')),
+    attempts        integer  default 0         not null,
+    next_attempt_at datetime,
+    response_status integer,
+    error           text
+        check (error is null or length(error) <= 1000),
+    delivered_at    datetime,
+    webhook_id      uuid                       not null
+        constraint webhook_deliveries_webhooks_deliveries
+            references webhooks
+            on delete cascade
+);
+
+create index if not exists webhookdelivery_webhook_id_created_at
+    on webhook_deliveries (webhook_id, created_at);
+
+create index if not exists webhookdelivery_status_next_attempt_at
+    on webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Full-text index over entities. Rows are maintained by the application;
+-- existing entities are indexed on the first start after this migration.
+create virtual table if not exists entity_search using fts5
+(
+    entity_id unindexed,
+    group_id unindexed,
+    name,
+    identifiers,
+    content,
+    extra,
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3'
+);
@@ -0,0 +1,32 @@
+-- +goose Up
+create table if not exists saved_searches
+(
+    id                 uuid                  not null
+        primary key,
+    created_at         datetime              not null,
+    updated_at         datetime              not null,
+    name               text                  not null,
+    description        text,
+    query              text,
+    order_by           text,
+    tag_ids            json,
+    parent_ids         json,
+    negate_tags        bool    default false not null,
+    only_with_photo    bool    default false not null,
+    only_without_photo bool    default false not null,
+    include_archived   bool    default false not null,
+    columns            json,
+    group_id           uuid                  not null
+        constraint saved_searches_groups_saved_searches
+            references groups
+            on delete cascade
+);
+
+create index if not exists savedsearch_group_id
+    on saved_searches (group_id);
+
+alter table notifier_subscriptions
+    add column saved_search_id uuid
+        constraint notifier_subscriptions_saved_searches_notifier_subscriptions
+            references saved_searches
+            on delete cascade;
@@ -0,0 +1,4 @@
+-- +goose Up
+alter table api_keys add column scopes json;
+alter table api_keys add column group_id uuid;
+alter table api_keys add column location_id uuid;
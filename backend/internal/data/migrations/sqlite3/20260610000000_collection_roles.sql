@@ -0,0 +1,6 @@
+-- +goose Up
+-- Members used to be either "user" or "owner"; a "user" could do everything
+-- but administer the collection, which is what "editor" means now.
+update user_groups set role = 'editor' where role = 'user';
+
+alter table group_invitation_tokens add column role text default 'editor' not null;
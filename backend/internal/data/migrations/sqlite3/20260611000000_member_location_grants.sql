@@ -0,0 +1,2 @@
+-- +goose Up
+alter table user_groups add column location_ids json;
@@ -0,0 +1,24 @@
+-- +goose Up
+alter table entities add column min_quantity real;
+alter table entities add column reorder_point real;
+alter table entities add column unit text;
+
+create table if not exists stock_movements
+(
+    id             uuid     not null
+        primary key,
+    created_at     datetime not null,
+    updated_at     datetime not null,
+    kind           text     not null,
+    change         real     not null,
+    quantity_after real     not null,
+    note           text,
+    user_id        uuid,
+    entity_id      uuid     not null
+        constraint stock_movements_entities_stock_movements
+            references entities
+            on delete cascade
+);
+
+create index if not exists stockmovement_entity_id_created_at
+    on stock_movements (entity_id, created_at);
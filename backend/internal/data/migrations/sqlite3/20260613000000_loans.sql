@@ -0,0 +1,30 @@
+-- +goose Up
+create table if not exists loans
+(
+    id                  uuid     not null
+        primary key,
+    created_at          datetime not null,
+    updated_at          datetime not null,
+    borrower_id         uuid,
+    borrower_name       text     not null,
+    borrower_contact    text,
+    notes               text,
+    checked_out_at      datetime not null,
+    due_at              datetime,
+    returned_at         datetime,
+    overdue_notified_at datetime,
+    entity_id           uuid     not null
+        constraint loans_entities_loans
+            references entities
+            on delete cascade
+);
+
+create index if not exists loan_entity_id_checked_out_at
+    on loans (entity_id, checked_out_at);
+
+create unique index if not exists loan_entity_id
+    on loans (entity_id)
+    where returned_at is null;
+
+create index if not exists loan_returned_at_due_at
+    on loans (returned_at, due_at);
@@ -0,0 +1,9 @@
+-- +goose Up
+alter table entities add column depreciation_method text;
+alter table entities add column useful_life_years real;
+alter table entities add column salvage_value real;
+alter table entities add column manual_value real;
+
+alter table entity_types add column depreciation_method text;
+alter table entity_types add column useful_life_years real;
+alter table entity_types add column salvage_value real;
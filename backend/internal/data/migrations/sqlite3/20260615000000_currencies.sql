@@ -0,0 +1,24 @@
+-- +goose Up
+alter table groups add column currency_conversion text default 'purchase_date' not null;
+alter table entities add column purchase_currency text;
+alter table entities add column sold_currency text;
+alter table maintenance_entries add column cost_currency text;
+
+create table if not exists exchange_rates
+(
+    id         uuid     not null
+        primary key,
+    created_at datetime not null,
+    updated_at datetime not null,
+    base       text     not null,
+    quote      text     not null,
+    rate       real     not null,
+    date       datetime not null,
+    group_id   uuid     not null
+        constraint exchange_rates_groups_exchange_rates
+            references groups
+            on delete cascade
+);
+
+create unique index if not exists exchangerate_group_id_base_quote_date
+    on exchange_rates (group_id, base, quote, date);
@@ -0,0 +1,24 @@
+-- +goose Up
+alter table entity_fields add column decimal_value real;
+
+create table if not exists entity_field_definitions
+(
+    id             uuid     not null
+        primary key,
+    created_at     datetime not null,
+    updated_at     datetime not null,
+    name           text     not null,
+    description    text,
+    type           text     not null,
+    options        json,
+    required       bool    default false not null,
+    default_value  text,
+    position       integer default 0     not null,
+    entity_type_id uuid     not null
+        constraint entity_field_definitions_entity_types_field_definitions
+            references entity_types
+            on delete cascade
+);
+
+create unique index if not exists entityfielddefinition_entity_type_id_name
+    on entity_field_definitions (entity_type_id, name);
@@ -0,0 +1,23 @@
+-- +goose Up
+create table if not exists attachment_uploads
+(
+    id         uuid     not null
+        primary key,
+    created_at datetime not null,
+    updated_at datetime not null,
+    entity_id  uuid     not null,
+    title      text     not null,
+    type       text     default 'attachment' not null,
+    "primary"  bool     default false        not null,
+    size       integer  not null,
+    received   integer  default 0            not null,
+    expires_at datetime not null,
+    attachment_id uuid,
+    group_id   uuid     not null
+        constraint attachment_uploads_groups_attachment_uploads
+            references groups
+            on delete cascade
+);
+
+create index if not exists attachmentupload_expires_at
+    on attachment_uploads (expires_at);
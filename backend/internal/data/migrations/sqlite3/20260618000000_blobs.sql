@@ -0,0 +1,23 @@
+-- +goose Up
+create table if not exists blobs
+(
+    id              uuid     not null
+        primary key,
+    created_at      datetime not null,
+    updated_at      datetime not null,
+    path            text     not null,
+    size            integer  default 0                          not null,
+    mime_type       text     default 'application/octet-stream' not null,
+    ref_count       integer  default 0                          not null,
+    unreferenced_at datetime,
+    group_id        uuid     not null
+        constraint blobs_groups_blobs
+            references groups
+            on delete cascade
+);
+
+create unique index if not exists blobs_path_key
+    on blobs (path);
+
+create index if not exists blob_ref_count_unreferenced_at
+    on blobs (ref_count, unreferenced_at);
@@ -0,0 +1,9 @@
+-- +goose Up
+alter table attachments add column captured_at datetime;
+alter table attachments add column latitude real;
+alter table attachments add column longitude real;
+alter table attachments add column width integer;
+alter table attachments add column height integer;
+alter table attachments add column document_title text;
+alter table attachments add column document_author text;
+alter table attachments add column page_count integer;
@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/migrations"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 	_ "github.com/sysadminsmedia/homebox/backend/pkgs/cgofreesqlite"
 	"github.com/sysadminsmedia/homebox/backend/pkgs/faker"
@@ -41,6 +44,25 @@ func bootstrap() {
 	}
 }
 
+// createSearchIndex applies the entity_search migration, which
+// Schema.Create knows nothing about.
+func createSearchIndex(client *ent.Client) error {
+	files, err := migrations.Migrations(config.DriverSqlite3)
+	if err != nil {
+		return err
+	}
+	matches, err := fs.Glob(files, "sqlite3/*_entity_search.sql")
+	if err != nil || len(matches) != 1 {
+		return fmt.Errorf("entity_search migration not found: %v", err)
+	}
+	ddl, err := fs.ReadFile(files, matches[0])
+	if err != nil {
+		return err
+	}
+	_, err = client.Sql().Exec(string(ddl))
+	return err
+}
+
 func MainNoExit(m *testing.M) int {
 	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1&_time_format=sqlite")
 	if err != nil {
@@ -56,6 +78,10 @@ func MainNoExit(m *testing.M) int {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	if err := createSearchIndex(client); err != nil {
+		log.Fatalf("failed creating search index: %v", err)
+	}
+
 	tClient = client
 	tRepos = New(tClient, tbus, config.Storage{
 		PrefixPath: "/",
@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceFreq is the unit a maintenance schedule repeats in.
+type RecurrenceFreq string
+
+const (
+	RecurrenceDaily   RecurrenceFreq = "DAILY"
+	RecurrenceWeekly  RecurrenceFreq = "WEEKLY"
+	RecurrenceMonthly RecurrenceFreq = "MONTHLY"
+	RecurrenceYearly  RecurrenceFreq = "YEARLY"
+)
+
+// maxRecurrenceSteps bounds how far NextAfter will walk forward from an old
+// anchor date, e.g. a daily rule completed decades late.
+const maxRecurrenceSteps = 100_000
+
+// Recurrence is the subset of RFC 5545 RRULE supported for maintenance
+// entries: FREQ, INTERVAL and a date-only UNTIL.
+//
+// Examples:
+//
+//	"FREQ=MONTHLY;INTERVAL=3"               -> every 3 months
+//	"FREQ=WEEKLY;INTERVAL=2;UNTIL=20271231" -> every 2 weeks until the end of 2027
+type Recurrence struct {
+	Freq     RecurrenceFreq
+	Interval int
+	Until    time.Time
+}
+
+// ParseRecurrence parses an RRULE subset. An optional "RRULE:" prefix is
+// accepted and keys are case-insensitive; any other rule part is rejected so
+// a client never thinks a BYDAY or COUNT was honoured.
+func ParseRecurrence(s string) (Recurrence, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.ToUpper(s), "RRULE:")
+	if s == "" {
+		return Recurrence{}, errors.New("recurrence rule is empty")
+	}
+
+	rec := Recurrence{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			switch f := RecurrenceFreq(value); f {
+			case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+				rec.Freq = f
+			default:
+				return Recurrence{}, fmt.Errorf("unsupported recurrence frequency %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > 1000 {
+				return Recurrence{}, fmt.Errorf("invalid recurrence interval %q", value)
+			}
+			rec.Interval = n
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(value)
+			if err != nil {
+				return Recurrence{}, err
+			}
+			rec.Until = until
+		default:
+			return Recurrence{}, fmt.Errorf("unsupported recurrence rule part %q", key)
+		}
+	}
+
+	if rec.Freq == "" {
+		return Recurrence{}, errors.New("recurrence rule is missing FREQ")
+	}
+
+	return rec, nil
+}
+
+func parseRecurrenceUntil(v string) (time.Time, error) {
+	for _, layout := range []string{"20060102", "20060102T150405Z"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid recurrence UNTIL %q", v)
+}
+
+// String returns the normalised rule, which is what gets stored.
+func (rec Recurrence) String() string {
+	var b strings.Builder
+	b.WriteString("FREQ=")
+	b.WriteString(string(rec.Freq))
+	if rec.Interval > 1 {
+		b.WriteString(";INTERVAL=")
+		b.WriteString(strconv.Itoa(rec.Interval))
+	}
+	if !rec.Until.IsZero() {
+		b.WriteString(";UNTIL=")
+		b.WriteString(rec.Until.Format("20060102"))
+	}
+	return b.String()
+}
+
+// step returns the k-th occurrence after anchor. Months and years are clamped
+// to the end of the month, so Jan 31 + 1 month is Feb 28 rather than Mar 3.
+func (rec Recurrence) step(anchor time.Time, k int) time.Time {
+	n := k * rec.Interval
+	switch rec.Freq {
+	case RecurrenceDaily:
+		return anchor.AddDate(0, 0, n)
+	case RecurrenceWeekly:
+		return anchor.AddDate(0, 0, 7*n)
+	case RecurrenceMonthly:
+		return addMonthsClamped(anchor, n)
+	default:
+		return addMonthsClamped(anchor, 12*n)
+	}
+}
+
+// NextAfter returns the first occurrence of the schedule anchored at anchor
+// that falls strictly after after. ok is false once the schedule has ended.
+func (rec Recurrence) NextAfter(anchor, after time.Time) (next time.Time, ok bool) {
+	for k := 1; k <= maxRecurrenceSteps; k++ {
+		next = rec.step(anchor, k)
+		if !rec.Until.IsZero() && next.After(rec.Until) {
+			return time.Time{}, false
+		}
+		if next.After(after) {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	if d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
@@ -0,0 +1,115 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recurrenceDate(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{name: "monthly", rule: "FREQ=MONTHLY;INTERVAL=3", want: "FREQ=MONTHLY;INTERVAL=3"},
+		{name: "prefix and case", rule: "rrule:freq=weekly", want: "FREQ=WEEKLY"},
+		{name: "interval one is dropped", rule: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "until datetime", rule: "FREQ=YEARLY;UNTIL=20300101T120000Z", want: "FREQ=YEARLY;UNTIL=20300101"},
+		{name: "missing freq", rule: "INTERVAL=2", wantErr: true},
+		{name: "unsupported part", rule: "FREQ=WEEKLY;BYDAY=MO", wantErr: true},
+		{name: "unsupported freq", rule: "FREQ=HOURLY", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "bad until", rule: "FREQ=DAILY;UNTIL=tomorrow", wantErr: true},
+		{name: "empty", rule: " ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRecurrence(tt.rule)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestRecurrence_NextAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   string
+		anchor time.Time
+		after  time.Time
+		want   time.Time
+		ok     bool
+	}{
+		{
+			name:   "on time",
+			rule:   "FREQ=WEEKLY;INTERVAL=2",
+			anchor: recurrenceDate(2026, 3, 2),
+			after:  recurrenceDate(2026, 3, 2),
+			want:   recurrenceDate(2026, 3, 16),
+			ok:     true,
+		},
+		{
+			name:   "completed early keeps the cadence",
+			rule:   "FREQ=MONTHLY;INTERVAL=3",
+			anchor: recurrenceDate(2026, 3, 1),
+			after:  recurrenceDate(2026, 2, 20),
+			want:   recurrenceDate(2026, 6, 1),
+			ok:     true,
+		},
+		{
+			name:   "missed occurrences are skipped",
+			rule:   "FREQ=MONTHLY;INTERVAL=3",
+			anchor: recurrenceDate(2026, 1, 1),
+			after:  recurrenceDate(2026, 5, 15),
+			want:   recurrenceDate(2026, 7, 1),
+			ok:     true,
+		},
+		{
+			name:   "end of month is clamped",
+			rule:   "FREQ=MONTHLY",
+			anchor: recurrenceDate(2026, 1, 31),
+			after:  recurrenceDate(2026, 1, 31),
+			want:   recurrenceDate(2026, 2, 28),
+			ok:     true,
+		},
+		{
+			name:   "leap day yearly",
+			rule:   "FREQ=YEARLY",
+			anchor: recurrenceDate(2028, 2, 29),
+			after:  recurrenceDate(2028, 2, 29),
+			want:   recurrenceDate(2029, 2, 28),
+			ok:     true,
+		},
+		{
+			name:   "until reached",
+			rule:   "FREQ=MONTHLY;UNTIL=20260215",
+			anchor: recurrenceDate(2026, 1, 20),
+			after:  recurrenceDate(2026, 1, 20),
+			ok:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := ParseRecurrence(tt.rule)
+			require.NoError(t, err)
+
+			got, ok := rec.NextAfter(tt.anchor, tt.after)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/apikey"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -27,26 +33,62 @@ func NewAPIKeyRepository(db *ent.Client) *APIKeyRepository {
 				CreatedAt:  k.CreatedAt,
 				ExpiresAt:  k.ExpiresAt,
 				LastUsedAt: k.LastUsedAt,
+				Scopes:     emptyIfNil(lo.Map(k.Scopes, func(s string, _ int) APIKeyScope { return APIKeyScope(s) })),
+				GroupID:    k.GroupID,
+				LocationID: k.LocationID,
 			}
 		},
 	}
 }
 
+// APIKeyScope narrows what an API key may do. A key without scopes has the
+// same access as its user.
+type APIKeyScope string
+
+const (
+	// APIKeyScopeRead allows read-only requests to every endpoint.
+	APIKeyScopeRead             APIKeyScope = "read"
+	APIKeyScopeEntitiesRead     APIKeyScope = "entities:read"
+	APIKeyScopeEntitiesWrite    APIKeyScope = "entities:write"
+	APIKeyScopeAttachmentsRead  APIKeyScope = "attachments:read"
+	APIKeyScopeAttachmentsWrite APIKeyScope = "attachments:write"
+)
+
+// APIKeyResource names the group of endpoints a request belongs to for the
+// purpose of scope checks. The zero value is everything that isn't inventory
+// data: account, collection and admin endpoints.
+type APIKeyResource string
+
+const (
+	APIKeyResourceEntities    APIKeyResource = "entities"
+	APIKeyResourceAttachments APIKeyResource = "attachments"
+)
+
 type (
 	APIKeyCreate struct {
 		Name      string     `json:"name"      validate:"required,min=1,max=255"`
 		ExpiresAt *time.Time `json:"expiresAt" extensions:"x-nullable"`
+		// Scopes limits the key; leave empty for full access.
+		Scopes []APIKeyScope `json:"scopes" validate:"omitempty,max=5,dive,oneof=read entities:read entities:write attachments:read attachments:write"`
+		// GroupID pins the key to one of the user's collections.
+		GroupID *uuid.UUID `json:"groupId" extensions:"x-nullable"`
+		// LocationID pins the key to a location and everything below it.
+		// It implies the location's collection.
+		LocationID *uuid.UUID `json:"locationId" extensions:"x-nullable"`
 	}
 
 	// APIKeyOut is the metadata of an API key, returned for list views. The raw
 	// token is never included here — see APIKeyCreatedOut for that.
 	APIKeyOut struct {
-		ID         uuid.UUID  `json:"id"`
-		UserID     uuid.UUID  `json:"userId"`
-		Name       string     `json:"name"`
-		CreatedAt  time.Time  `json:"createdAt"`
-		ExpiresAt  *time.Time `json:"expiresAt"  extensions:"x-nullable"`
-		LastUsedAt *time.Time `json:"lastUsedAt" extensions:"x-nullable"`
+		ID         uuid.UUID     `json:"id"`
+		UserID     uuid.UUID     `json:"userId"`
+		Name       string        `json:"name"`
+		CreatedAt  time.Time     `json:"createdAt"`
+		ExpiresAt  *time.Time    `json:"expiresAt"  extensions:"x-nullable"`
+		LastUsedAt *time.Time    `json:"lastUsedAt" extensions:"x-nullable"`
+		Scopes     []APIKeyScope `json:"scopes"`
+		GroupID    *uuid.UUID    `json:"groupId"    extensions:"x-nullable"`
+		LocationID *uuid.UUID    `json:"locationId" extensions:"x-nullable"`
 	}
 
 	// APIKeyCreatedOut is returned exactly once at creation time and contains
@@ -57,24 +99,84 @@ type (
 	}
 )
 
+// Allows reports whether the key may make a request against res. Scopes
+// grant read to every resource ("read") or read or write to one resource;
+// write implies read. A location-restricted key can only reach inventory
+// data, since the rest of the API isn't limited to a subtree.
+func (k APIKeyOut) Allows(res APIKeyResource, write bool) bool {
+	if k.LocationID != nil && res == "" {
+		return false
+	}
+	if len(k.Scopes) == 0 {
+		return true
+	}
+
+	for _, s := range k.Scopes {
+		switch {
+		case s == APIKeyScopeRead && !write:
+			return true
+		case res == "":
+			continue
+		case s == APIKeyScope(res+":write"):
+			return true
+		case s == APIKeyScope(res+":read") && !write:
+			return true
+		}
+	}
+	return false
+}
+
 // Create persists a new API key for the given user. The caller supplies the
-// pre-hashed token bytes; the raw token is never stored.
-func (r *APIKeyRepository) Create(ctx context.Context, userID uuid.UUID, name string, tokenHash []byte, expiresAt *time.Time) (APIKeyOut, error) {
+// pre-hashed token bytes; the raw token is never stored. The group and
+// location must belong to the user, or Create fails with a not found error.
+func (r *APIKeyRepository) Create(ctx context.Context, userID uuid.UUID, tokenHash []byte, data APIKeyCreate) (APIKeyOut, error) {
 	ctx, span := entityTracer().Start(ctx, "repo.APIKeyRepository.Create",
 		trace.WithAttributes(
 			attribute.String("user.id", userID.String()),
-			attribute.Int("api_key.name.length", len(name)),
-			attribute.Bool("api_key.has_expiration", expiresAt != nil),
+			attribute.Int("api_key.name.length", len(data.Name)),
+			attribute.Bool("api_key.has_expiration", data.ExpiresAt != nil),
+			attribute.Int("api_key.scopes.count", len(data.Scopes)),
+			attribute.Bool("api_key.has_group", data.GroupID != nil),
+			attribute.Bool("api_key.has_location", data.LocationID != nil),
 		))
 	defer span.End()
 
+	groupID := data.GroupID
+	if data.LocationID != nil {
+		where := []predicate.Entity{
+			entity.ID(*data.LocationID),
+			entity.HasEntityTypeWith(entitytype.IsLocation(true)),
+			entity.HasGroupWith(group.HasUsersWith(user.ID(userID))),
+		}
+		if groupID != nil {
+			where = append(where, entity.HasGroupWith(group.ID(*groupID)))
+		}
+		loc, err := r.db.Entity.Query().Where(where...).WithGroup().Only(ctx)
+		if err != nil {
+			recordSpanError(span, err)
+			return APIKeyOut{}, err
+		}
+		groupID = &loc.Edges.Group.ID
+	} else if groupID != nil {
+		_, err := r.db.Group.Query().
+			Where(group.ID(*groupID), group.HasUsersWith(user.ID(userID))).
+			Only(ctx)
+		if err != nil {
+			recordSpanError(span, err)
+			return APIKeyOut{}, err
+		}
+	}
+
 	q := r.db.APIKey.Create().
 		SetUserID(userID).
-		SetName(name).
-		SetToken(tokenHash)
+		SetName(data.Name).
+		SetToken(tokenHash).
+		SetNillableExpiresAt(data.ExpiresAt).
+		SetNillableGroupID(groupID).
+		SetNillableLocationID(data.LocationID)
 
-	if expiresAt != nil {
-		q.SetExpiresAt(*expiresAt)
+	if len(data.Scopes) > 0 {
+		q.SetScopes(lo.Uniq(lo.Map(data.Scopes, func(s APIKeyScope, _ int) string { return string(s) })))
 	}
 
 	key, err := q.Save(ctx)
@@ -87,9 +189,9 @@ func (r *APIKeyRepository) Create(ctx context.Context, userID uuid.UUID, name st
 }
 
 // GetUserFromToken returns the user that owns the API key with the given hash,
-// if it exists and has not expired. The matching key's ID is returned so that
-// the caller can update last_used_at.
-func (r *APIKeyRepository) GetUserFromToken(ctx context.Context, tokenHash []byte) (UserOut, uuid.UUID, error) {
+// if it exists and has not expired. The matching key is returned so that the
+// caller can apply its restrictions and update last_used_at.
+func (r *APIKeyRepository) GetUserFromToken(ctx context.Context, tokenHash []byte) (UserOut, APIKeyOut, error) {
 	ctx, span := entityTracer().Start(ctx, "repo.APIKeyRepository.GetUserFromToken",
 		trace.WithAttributes(attribute.Int("token.hash.length", len(tokenHash))))
 	defer span.End()
@@ -108,7 +210,7 @@ func (r *APIKeyRepository) GetUserFromToken(ctx context.Context, tokenHash []byt
 		if !ent.IsNotFound(err) {
 			recordSpanError(span, err)
 		}
-		return UserOut{}, uuid.Nil, err
+		return UserOut{}, APIKeyOut{}, err
 	}
 
 	if key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now()) {
@@ -116,7 +218,7 @@ func (r *APIKeyRepository) GetUserFromToken(ctx context.Context, tokenHash []byt
 			attribute.Bool("api_key.found", true),
 			attribute.Bool("api_key.expired", true),
 		)
-		return UserOut{}, uuid.Nil, &ent.NotFoundError{}
+		return UserOut{}, APIKeyOut{}, &ent.NotFoundError{}
 	}
 
 	out := mapUserOut(key.Edges.User)
@@ -125,7 +227,7 @@ func (r *APIKeyRepository) GetUserFromToken(ctx context.Context, tokenHash []byt
 		attribute.String("api_key.id", key.ID.String()),
 	)
 	span.SetAttributes(userSpanAttrs(out)...)
-	return out, key.ID, nil
+	return out, r.mapper.Map(key), nil
 }
 
 // TouchLastUsed updates the last_used_at timestamp on the given API key.
@@ -0,0 +1,142 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+)
+
+func TestAPIKeyOut_Allows(t *testing.T) {
+	loc := uuid.New()
+	tests := []struct {
+		name  string
+		key   APIKeyOut
+		res   APIKeyResource
+		write bool
+		want  bool
+	}{
+		{"unscoped write", APIKeyOut{}, "", true, true},
+		{"read only reads", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeRead}}, APIKeyResourceEntities, false, true},
+		{"read only can't write", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeRead}}, "", true, false},
+		{"entities write", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeEntitiesWrite}}, APIKeyResourceEntities, true, true},
+		{"entities write reads", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeEntitiesWrite}}, APIKeyResourceEntities, false, true},
+		{"entities write can't wipe", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeEntitiesWrite}}, "", true, false},
+		{"entities read can't write", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeEntitiesRead}}, APIKeyResourceEntities, true, false},
+		{"attachments read elsewhere", APIKeyOut{Scopes: []APIKeyScope{APIKeyScopeAttachmentsRead}}, APIKeyResourceEntities, false, false},
+		{"location on entities", APIKeyOut{LocationID: &loc}, APIKeyResourceEntities, true, true},
+		{"location elsewhere", APIKeyOut{LocationID: &loc, Scopes: []APIKeyScope{APIKeyScopeRead}}, "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.key.Allows(tt.res, tt.write))
+		})
+	}
+}
+
+func TestAPIKeyRepository_CreateRestricted(t *testing.T) {
+	ctx := context.Background()
+	locType, err := tRepos.EntityTypes.GetDefault(ctx, tGroup.ID, true)
+	require.NoError(t, err)
+	itemType, err := tRepos.EntityTypes.GetDefault(ctx, tGroup.ID, false)
+	require.NoError(t, err)
+
+	garage, err := tRepos.Entities.Create(ctx, tGroup.ID, EntityCreate{Name: "Garage", EntityTypeID: locType.ID})
+	require.NoError(t, err)
+	drill, err := tRepos.Entities.Create(ctx, tGroup.ID, EntityCreate{Name: "Drill", EntityTypeID: itemType.ID, ParentID: garage.ID})
+	require.NoError(t, err)
+
+	key, err := tRepos.APIKeys.Create(ctx, tUser.ID, []byte(fk.Str(32)), APIKeyCreate{
+		Name:       "scanner",
+		Scopes:     []APIKeyScope{APIKeyScopeEntitiesWrite},
+		LocationID: &garage.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []APIKeyScope{APIKeyScopeEntitiesWrite}, key.Scopes)
+	require.NotNil(t, key.GroupID)
+	assert.Equal(t, tGroup.ID, *key.GroupID)
+
+	// Items aren't locations, and other users' collections are off limits.
+	_, err = tRepos.APIKeys.Create(ctx, tUser.ID, []byte(fk.Str(32)), APIKeyCreate{Name: "item", LocationID: &drill.ID})
+	assert.True(t, ent.IsNotFound(err))
+
+	other, _ := useSearchGroup(t)
+	_, err = tRepos.APIKeys.Create(ctx, tUser.ID, []byte(fk.Str(32)), APIKeyCreate{Name: "foreign", GroupID: &other})
+	assert.True(t, ent.IsNotFound(err))
+	_, err = tRepos.APIKeys.Create(ctx, tUser.ID, []byte(fk.Str(32)), APIKeyCreate{Name: "mismatch", GroupID: &other, LocationID: &garage.ID})
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestEntityScope(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+	locType, err := tRepos.EntityTypes.GetDefault(ctx, gid, true)
+	require.NoError(t, err)
+
+	garage, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Garage", EntityTypeID: locType.ID})
+	require.NoError(t, err)
+	shelf, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Shelf", EntityTypeID: locType.ID, ParentID: garage.ID})
+	require.NoError(t, err)
+	drill, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Drill", EntityTypeID: itemType, ParentID: shelf.ID})
+	require.NoError(t, err)
+	kitchen, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Kitchen", EntityTypeID: locType.ID})
+	require.NoError(t, err)
+	kettle, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Kettle", EntityTypeID: itemType, ParentID: kitchen.ID})
+	require.NoError(t, err)
+
+	scoped := WithEntityScope(ctx, garage.ID)
+
+	all, err := tRepos.Entities.GetAll(scoped, gid)
+	require.NoError(t, err)
+	names := make([]string, len(all))
+	for i, e := range all {
+		names[i] = e.Name
+	}
+	assert.ElementsMatch(t, []string{"Garage", "Shelf", "Drill"}, names)
+
+	_, err = tRepos.Entities.GetOneByGroup(scoped, gid, kettle.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = tRepos.Entities.PathForEntity(scoped, gid, kettle.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	path, err := tRepos.Entities.PathForEntity(scoped, gid, drill.ID)
+	require.NoError(t, err)
+	require.Len(t, path, 3)
+	assert.Equal(t, garage.ID, path[0].ID)
+
+	tree, err := tRepos.Entities.Tree(scoped, gid, TreeQuery{WithItems: true})
+	require.NoError(t, err)
+	require.Len(t, tree, 1)
+	assert.Equal(t, garage.ID, tree[0].ID)
+
+	// The history of entities outside the subtree is hidden too.
+	hidden, err := tRepos.AuditLog.GetByEntity(scoped, gid, kettle.ID, -1, -1)
+	require.NoError(t, err)
+	assert.Empty(t, hidden.Items)
+	feed, err := tRepos.AuditLog.GetByGroup(scoped, gid, -1, -1)
+	require.NoError(t, err)
+	for _, e := range feed.Items {
+		assert.NotEqual(t, kettle.ID, e.EntityID)
+	}
+	history, err := tRepos.AuditLog.GetByEntity(scoped, gid, drill.ID, -1, -1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, history.Items)
+
+	// Parents outside the subtree don't exist, and the top level is refused.
+	_, err = tRepos.Entities.Create(scoped, gid, EntityCreate{Name: "Spoon", EntityTypeID: itemType, ParentID: kitchen.ID})
+	assert.True(t, ent.IsNotFound(err))
+	_, err = tRepos.Entities.Create(scoped, gid, EntityCreate{Name: "Attic", EntityTypeID: locType.ID})
+	assert.True(t, errors.Is(err, ErrOutsideEntityScope))
+	_, err = tRepos.Entities.Create(scoped, gid, EntityCreate{Name: "Saw", EntityTypeID: itemType, ParentID: shelf.ID})
+	require.NoError(t, err)
+
+	// Deleting outside the subtree touches nothing.
+	_ = tRepos.Entities.DeleteByGroup(scoped, gid, kettle.ID)
+	_, err = tRepos.Entities.GetOneByGroup(ctx, gid, kettle.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,337 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register the decoders for image.DecodeConfig
+	_ "image/jpeg" // register the decoders for image.DecodeConfig
+	_ "image/png"  // register the decoders for image.DecodeConfig
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/evanoberholster/imagemeta"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gocloud.dev/blob"
+	"rsc.io/pdf"
+)
+
+// maxMetadataFileSize is the largest PDF metadata is read from. Images only
+// have their headers read, whatever their size.
+const maxMetadataFileSize = 100 << 20
+
+// CoordinatesFieldName is the custom field ApplyAttachmentMetadata writes the
+// coordinates of a photo to, as "latitude, longitude".
+const CoordinatesFieldName = "Coordinates"
+
+type (
+	// AttachmentMetadata is read from the file of an attachment when it is
+	// uploaded: the capture date, position and size of photos, and the
+	// title, author and page count of PDFs. What a file doesn't carry is
+	// left empty.
+	AttachmentMetadata struct {
+		CapturedAt *time.Time `json:"capturedAt,omitempty" extensions:"x-nullable,x-omitempty"`
+		Latitude   *float64   `json:"latitude,omitempty"   extensions:"x-nullable,x-omitempty"`
+		Longitude  *float64   `json:"longitude,omitempty"  extensions:"x-nullable,x-omitempty"`
+		Width      int        `json:"width,omitempty"`
+		Height     int        `json:"height,omitempty"`
+		Title      string     `json:"title,omitempty"`
+		Author     string     `json:"author,omitempty"`
+		PageCount  int        `json:"pageCount,omitempty"`
+	}
+
+	// AttachmentMetadataApply picks what ApplyAttachmentMetadata copies from
+	// an attachment to its entity.
+	AttachmentMetadataApply struct {
+		// PurchaseDate sets the purchase date to the day the photo was taken.
+		PurchaseDate bool `json:"purchaseDate"`
+		// Coordinates sets the Coordinates custom field to where it was taken.
+		Coordinates bool `json:"coordinates"`
+	}
+)
+
+func (m AttachmentMetadata) empty() bool {
+	return m == AttachmentMetadata{}
+}
+
+func toAttachmentMetadata(a *ent.Attachment) *AttachmentMetadata {
+	m := AttachmentMetadata{
+		CapturedAt: a.CapturedAt,
+		Latitude:   a.Latitude,
+		Longitude:  a.Longitude,
+		Width:      a.Width,
+		Height:     a.Height,
+		Title:      a.DocumentTitle,
+		Author:     a.DocumentAuthor,
+		PageCount:  a.PageCount,
+	}
+	if m.empty() {
+		return nil
+	}
+	return &m
+}
+
+// setAttachmentMetadata sets the metadata fields of an attachment.
+func setAttachmentMetadata(b *ent.AttachmentCreate, m AttachmentMetadata) *ent.AttachmentCreate {
+	return b.
+		SetNillableCapturedAt(m.CapturedAt).
+		SetNillableLatitude(m.Latitude).
+		SetNillableLongitude(m.Longitude).
+		SetWidth(m.Width).
+		SetHeight(m.Height).
+		SetDocumentTitle(m.Title).
+		SetDocumentAuthor(m.Author).
+		SetPageCount(m.PageCount)
+}
+
+// extractMetadata reads the metadata of a stored file. Metadata is a nicety,
+// so a file it can't be read from is logged and gets none.
+func (r *AttachmentRepo) extractMetadata(ctx context.Context, path, contentType, title string) AttachmentMetadata {
+	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.extractMetadata",
+		trace.WithAttributes(attribute.String("attachment.mime_type", contentType)))
+	defer span.End()
+
+	lower := strings.ToLower(title)
+	isImage := strings.HasPrefix(contentType, "image/") ||
+		strings.HasSuffix(lower, ".heic") || strings.HasSuffix(lower, ".heif")
+	isPDF := contentType == "application/pdf"
+	if !isImage && !isPDF {
+		return AttachmentMetadata{}
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		log.Err(err).Msg("failed to open bucket for metadata extraction")
+		return AttachmentMetadata{}
+	}
+	defer func() { _ = bucket.Close() }()
+
+	rd, err := bucket.NewReader(ctx, r.fullPath(path), nil)
+	if err != nil {
+		log.Err(err).Str("path", path).Msg("failed to open file for metadata extraction")
+		return AttachmentMetadata{}
+	}
+	defer func() { _ = rd.Close() }()
+
+	if isImage {
+		return extractImageMetadata(rd)
+	}
+
+	if rd.Size() > maxMetadataFileSize {
+		return AttachmentMetadata{}
+	}
+	m, err := extractPDFMetadata(&seekReaderAt{rs: rd}, rd.Size())
+	if err != nil {
+		log.Debug().Err(err).Str("path", path).Msg("failed to read PDF metadata")
+	}
+	return m
+}
+
+// extractImageMetadata reads the capture date and GPS position from the EXIF
+// data of an image, and its size from its header. The size is the one the
+// image is shown at, so it is swapped for photos taken on their side.
+func extractImageMetadata(rs io.ReadSeeker) AttachmentMetadata {
+	var m AttachmentMetadata
+
+	var orientation uint16 = 1
+	if e, err := imagemeta.Decode(rs); err == nil {
+		captured := e.ExifIFD.DateTimeOriginal
+		if captured.IsZero() {
+			captured = e.ExifIFD.CreateDate
+		}
+		if !captured.IsZero() {
+			m.CapturedAt = &captured
+		}
+
+		lat, lng := e.GPS.Latitude(), e.GPS.Longitude()
+		if lat != 0 || lng != 0 {
+			m.Latitude, m.Longitude = &lat, &lng
+		}
+
+		m.Width, m.Height = int(e.ExifIFD.PixelXDimension), int(e.ExifIFD.PixelYDimension)
+		orientation = uint16(e.IFD0.Orientation)
+	}
+
+	if _, err := rs.Seek(0, io.SeekStart); err == nil {
+		if cfg, _, err := image.DecodeConfig(rs); err == nil {
+			m.Width, m.Height = cfg.Width, cfg.Height
+		}
+	}
+
+	// Orientations 5 to 8 rotate the image by 90 degrees.
+	if orientation >= 5 && orientation <= 8 {
+		m.Width, m.Height = m.Height, m.Width
+	}
+	return m
+}
+
+// extractPDFMetadata reads the title and author from the document information
+// of a PDF, and counts its pages.
+func extractPDFMetadata(ra io.ReaderAt, size int64) (m AttachmentMetadata, err error) {
+	// The parser panics on some malformed files.
+	defer func() {
+		if v := recover(); v != nil {
+			m, err = AttachmentMetadata{}, fmt.Errorf("malformed PDF: %v", v)
+		}
+	}()
+
+	doc, err := pdf.NewReader(ra, size)
+	if err != nil {
+		return AttachmentMetadata{}, err
+	}
+
+	info := doc.Trailer().Key("Info")
+	m.Title = truncateRunes(strings.TrimSpace(info.Key("Title").Text()), 255)
+	m.Author = truncateRunes(strings.TrimSpace(info.Key("Author").Text()), 255)
+	m.PageCount = doc.NumPage()
+	return m, nil
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// seekReaderAt reads at an offset by seeking, for readers from the bucket,
+// which can seek but not read at an offset.
+type seekReaderAt struct {
+	mu sync.Mutex
+	rs io.ReadSeeker
+}
+
+func (s *seekReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.rs.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(s.rs, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// ApplyAttachmentMetadata copies the capture date or coordinates of a photo
+// attached to an entity to the entity itself.
+func (r *EntityRepository) ApplyAttachmentMetadata(ctx context.Context, gid, id, attachmentID uuid.UUID, data AttachmentMetadataApply) (EntityOut, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.ApplyAttachmentMetadata",
+		trace.WithAttributes(
+			attribute.String("group.id", gid.String()),
+			attribute.String("entity.id", id.String()),
+			attribute.String("attachment.id", attachmentID.String()),
+			attribute.Bool("apply.purchase_date", data.PurchaseDate),
+			attribute.Bool("apply.coordinates", data.Coordinates),
+		))
+	defer span.End()
+
+	att, err := r.db.Attachment.Query().
+		Where(
+			attachment.ID(attachmentID),
+			attachment.HasEntityWith(entity.ID(id), entity.HasGroupWith(group.ID(gid))),
+		).
+		Only(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
+	var errs EntityFieldErrors
+	if data.PurchaseDate && att.CapturedAt == nil {
+		errs = append(errs, EntityFieldError{Field: "purchaseDate", Msg: "can't be set, the attachment has no capture date"})
+	}
+	if data.Coordinates && (att.Latitude == nil || att.Longitude == nil) {
+		errs = append(errs, EntityFieldError{Field: "coordinates", Msg: "can't be set, the attachment has no position"})
+	}
+	if len(errs) > 0 {
+		return EntityOut{}, errs
+	}
+
+	before, beforeErr := r.GetOneByGroup(ctx, gid, id)
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction while applying attachment metadata")
+			}
+		}
+	}()
+
+	if data.PurchaseDate {
+		err := tx.Entity.UpdateOneID(id).
+			SetPurchaseDate(types.DateFromTime(*att.CapturedAt).Time()).
+			Exec(ctx)
+		if err != nil {
+			recordSpanError(span, err)
+			return EntityOut{}, err
+		}
+	}
+
+	if data.Coordinates {
+		value := fmt.Sprintf("%.6f, %.6f", *att.Latitude, *att.Longitude)
+		n, err := tx.EntityField.Update().
+			Where(
+				entityfield.HasEntityWith(entity.ID(id)),
+				entityfield.NameEqualFold(CoordinatesFieldName),
+			).
+			SetTextValue(value).
+			Save(ctx)
+		if err != nil {
+			recordSpanError(span, err)
+			return EntityOut{}, err
+		}
+		if n == 0 {
+			err := createEntityField(ctx, tx.EntityField, id, EntityFieldData{
+				Type:      entityfield.TypeText.String(),
+				Name:      CoordinatesFieldName,
+				TextValue: value,
+			})
+			if err != nil {
+				recordSpanError(span, err)
+				return EntityOut{}, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+	committed = true
+
+	out, err := r.GetOneByGroup(ctx, gid, id)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate, id)
+	if beforeErr == nil {
+		r.audit.recordBestEffort(ctx, gid, id, out.Name, AuditActionUpdate, diffEntities(before, out))
+	}
+	return out, nil
+}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+	"time"
+
+	"codeberg.org/go-pdf/fpdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+)
+
+func TestExtractPDFMetadata(t *testing.T) {
+	doc := fpdf.New("P", "mm", "A4", "")
+	doc.SetTitle("Dishwasher Manual", true)
+	doc.SetAuthor("Appliance Co.", true)
+	doc.AddPage()
+	doc.AddPage()
+
+	var buf bytes.Buffer
+	require.NoError(t, doc.Output(&buf))
+
+	m, err := extractPDFMetadata(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, "Dishwasher Manual", m.Title)
+	assert.Equal(t, "Appliance Co.", m.Author)
+	assert.Equal(t, 2, m.PageCount)
+
+	_, err = extractPDFMetadata(bytes.NewReader([]byte("not a pdf")), 9)
+	require.Error(t, err)
+}
+
+func TestEntityRepository_ApplyAttachmentMetadata(t *testing.T) {
+	e := useEntities(t, 1)[0]
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 40, 30))))
+
+	att, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "photo.png",
+		Content: bytes.NewReader(buf.Bytes()),
+	}, attachment.TypePhoto, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Attachments.Delete(context.Background(), tGroup.ID, att.ID) })
+	assert.Equal(t, 40, att.Width)
+	assert.Equal(t, 30, att.Height)
+
+	// A PNG has no capture date or position to apply.
+	_, err = tRepos.Entities.ApplyAttachmentMetadata(ctx, tGroup.ID, e.ID, att.ID, AttachmentMetadataApply{PurchaseDate: true, Coordinates: true})
+	var ferrs EntityFieldErrors
+	require.ErrorAs(t, err, &ferrs)
+	assert.Len(t, ferrs, 2)
+
+	captured := time.Date(2024, 5, 17, 14, 30, 0, 0, time.UTC)
+	require.NoError(t, tRepos.Attachments.db.Attachment.UpdateOneID(att.ID).
+		SetCapturedAt(captured).
+		SetLatitude(52.520008).
+		SetLongitude(13.404954).
+		Exec(ctx))
+
+	out, err := tRepos.Entities.ApplyAttachmentMetadata(ctx, tGroup.ID, e.ID, att.ID, AttachmentMetadataApply{PurchaseDate: true, Coordinates: true})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-05-17", out.PurchaseDate.Time().Format("2006-01-02"))
+
+	coordinates := func(out EntityOut) []string {
+		var values []string
+		for _, f := range out.Fields {
+			if f.Name == CoordinatesFieldName {
+				values = append(values, f.TextValue)
+			}
+		}
+		return values
+	}
+	assert.Equal(t, []string{"52.520008, 13.404954"}, coordinates(out))
+
+	// Applying again updates the field instead of adding another.
+	require.NoError(t, tRepos.Attachments.db.Attachment.UpdateOneID(att.ID).SetLatitude(48.137154).SetLongitude(11.576124).Exec(ctx))
+	out, err = tRepos.Entities.ApplyAttachmentMetadata(ctx, tGroup.ID, e.ID, att.ID, AttachmentMetadataApply{Coordinates: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"48.137154, 11.576124"}, coordinates(out))
+}
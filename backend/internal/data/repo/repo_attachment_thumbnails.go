@@ -0,0 +1,294 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/evanoberholster/imagemeta"
+	"github.com/gen2brain/avif"
+	"github.com/gen2brain/heic"
+	"github.com/gen2brain/jpegxl"
+	"github.com/gen2brain/webp"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/utils"
+)
+
+// maxThumbnailImageSize is the largest image thumbnails are made from, as
+// images are decoded in memory. Files rendered by a command are streamed to
+// disk and have no limit.
+const maxThumbnailImageSize = 100 << 20
+
+// thumbnailCommandTimeout bounds how long a thumbnail command may run.
+const thumbnailCommandTimeout = time.Minute
+
+// thumbnailCommandScale bounds the image a thumbnail command may write, as a
+// multiple of the thumbnail area. Its output may hold that many pixels, at up
+// to four bytes each.
+const thumbnailCommandScale = 4
+
+// thumbnailCommandStderrLimit is how much of the error output of a thumbnail
+// command is kept for the error message.
+const thumbnailCommandStderrLimit = 4 << 10
+
+// thumbnailRenderer renders the image a thumbnail is made from, for the files
+// it accepts. CreateThumbnail uses the first renderer accepting a file.
+type thumbnailRenderer interface {
+	accepts(contentType string) bool
+	// render reads the file from src. The image it returns is scaled down
+	// to the thumbnail size afterwards.
+	render(ctx context.Context, src io.Reader, contentType string) (image.Image, error)
+}
+
+// newThumbnailRenderers returns the image decoders, followed by the commands
+// for PDFs and videos that are configured and installed.
+func newThumbnailRenderers(cfg config.Thumbnail) []thumbnailRenderer {
+	renderers := []thumbnailRenderer{imageRenderer{}}
+
+	commands := []struct {
+		kind    string
+		command string
+		accepts func(string) bool
+	}{
+		{"pdf", cfg.PDFCommand, func(ct string) bool { return ct == "application/pdf" }},
+		{"video", cfg.VideoCommand, func(ct string) bool { return strings.HasPrefix(ct, "video/") }},
+	}
+	for _, c := range commands {
+		fields := strings.Fields(c.command)
+		if len(fields) == 0 {
+			continue
+		}
+		if _, err := exec.LookPath(fields[0]); err != nil {
+			log.Info().Str("command", fields[0]).Msgf("%s thumbnails disabled, the command was not found", c.kind)
+			continue
+		}
+		args, err := parseCommandArgs(fields)
+		if err != nil {
+			log.Warn().Err(err).Str("command", fields[0]).Msgf("%s thumbnails disabled, the command is invalid", c.kind)
+			continue
+		}
+		renderers = append(renderers, &commandRenderer{
+			name:       fields[0],
+			args:       args,
+			width:      cfg.Width,
+			height:     cfg.Height,
+			acceptType: c.accepts,
+		})
+	}
+	return renderers
+}
+
+// parseCommandArgs parses each argument of a thumbnail command as a template.
+func parseCommandArgs(fields []string) ([]*template.Template, error) {
+	args := make([]*template.Template, len(fields))
+	for i, field := range fields {
+		tmpl, err := template.New("arg").Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid thumbnail command template: %w", err)
+		}
+		args[i] = tmpl
+	}
+	return args, nil
+}
+
+// thumbnailRenderer returns the renderer for files of the content type, or
+// nil if thumbnails can't be made of them.
+func (r *AttachmentRepo) thumbnailRenderer(contentType string) thumbnailRenderer {
+	for _, renderer := range r.renderers {
+		if renderer.accepts(contentType) {
+			return renderer
+		}
+	}
+	return nil
+}
+
+// thumbnailContentType is the content type detected for a file, or the one
+// its extension implies for formats the detection doesn't know.
+func thumbnailContentType(contentType, title string) string {
+	if contentType != "" && contentType != "application/octet-stream" {
+		return contentType
+	}
+
+	switch strings.ToLower(filepath.Ext(title)) {
+	case ".heic", ".heif":
+		return "image/heic"
+	case ".avif":
+		return "image/avif"
+	case ".jxl":
+		return "image/jxl"
+	case ".mov":
+		return "video/quicktime"
+	case ".mkv":
+		return "video/x-matroska"
+	case ".m4v":
+		return "video/x-m4v"
+	}
+	return contentType
+}
+
+// imageRenderer decodes images in memory, turning photos taken on their side
+// upright.
+type imageRenderer struct{}
+
+func (imageRenderer) accepts(contentType string) bool {
+	switch contentType {
+	case "image/webp", "image/avif", "image/heic", "image/heif", "image/jxl":
+		return true
+	}
+	return isImageFile(contentType)
+}
+
+func (imageRenderer) render(_ context.Context, src io.Reader, contentType string) (image.Image, error) {
+	content, err := io.ReadAll(io.LimitReader(src, maxThumbnailImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxThumbnailImageSize {
+		return nil, errors.New("image is too large to create a thumbnail")
+	}
+
+	var img image.Image
+	switch contentType {
+	case "image/webp":
+		img, err = webp.Decode(bytes.NewReader(content))
+	case "image/avif":
+		img, err = avif.Decode(bytes.NewReader(content))
+	case "image/heic", "image/heif":
+		img, err = heic.Decode(bytes.NewReader(content))
+	case "image/jxl":
+		img, err = jpegxl.Decode(bytes.NewReader(content))
+	default:
+		img, _, err = image.Decode(bytes.NewReader(content))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s image: %w", contentType, err)
+	}
+
+	// AVIF and JPEG XL carry no EXIF orientation.
+	if contentType != "image/avif" && contentType != "image/jxl" {
+		if meta, err := imagemeta.Decode(bytes.NewReader(content)); err == nil {
+			// imagemeta v1 groups the primary image tags under IFD0 rather
+			// than exposing Orientation directly on Exif.
+			if orientation := uint16(meta.IFD0.Orientation); orientation > 1 {
+				img = utils.ApplyOrientation(img, orientation)
+			}
+		}
+	}
+	return img, nil
+}
+
+// commandRenderer renders a file with a local tool, such as pdftoppm for the
+// first page of a PDF or ffmpeg for a poster frame of a video. The file is
+// written to a temporary file for the command, which writes a PNG or JPEG
+// image to stdout. A command writing more than an image of
+// thumbnailCommandScale times the thumbnail area is killed.
+//
+// Arguments are templates; {{.Input}} is the path of the file and
+// {{.Width}} and {{.Height}} the thumbnail size. Each argument is rendered on
+// its own, so a value can't add arguments.
+type commandRenderer struct {
+	name          string
+	args          []*template.Template
+	width, height int
+	acceptType    func(contentType string) bool
+}
+
+func (c *commandRenderer) accepts(contentType string) bool {
+	return c.acceptType(contentType)
+}
+
+func (c *commandRenderer) render(ctx context.Context, src io.Reader, _ string) (image.Image, error) {
+	tmp, err := os.CreateTemp("", "homebox-thumbnail-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = io.Copy(tmp, src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		Input         string
+		Width, Height int
+	}{tmp.Name(), c.width, c.height}
+
+	argv := make([]string, 0, len(c.args))
+	for _, tmpl := range c.args {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, data); err != nil {
+			return nil, err
+		}
+		argv = append(argv, b.String())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, thumbnailCommandTimeout)
+	defer cancel()
+
+	maxPixels := thumbnailCommandScale * c.width * c.height
+	stdout := &cappedWriter{limit: 4 * maxPixels, exceeded: cancel}
+	stderr := &cappedWriter{limit: thumbnailCommandStderrLimit}
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		if stdout.over {
+			return nil, fmt.Errorf("output of thumbnail command %s exceeds %d bytes", c.name, stdout.limit)
+		}
+		return nil, fmt.Errorf("thumbnail command %s failed: %w: %s", c.name, err, strings.TrimSpace(stderr.buf.String()))
+	}
+
+	// Check the size before decoding, as a small PNG can claim a huge image.
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(stdout.buf.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode output of thumbnail command %s: %w", c.name, err)
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("thumbnail command %s rendered a %dx%d image, more than %d pixels", c.name, cfg.Width, cfg.Height, maxPixels)
+	}
+
+	img, _, err := image.Decode(&stdout.buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode output of thumbnail command %s: %w", c.name, err)
+	}
+	return img, nil
+}
+
+// errOutputTooLarge is returned by a cappedWriter with exceeded set once
+// more than its limit is written.
+var errOutputTooLarge = errors.New("output too large")
+
+// cappedWriter keeps up to limit bytes. When more arrive, it calls exceeded
+// and fails if exceeded is set, and drops them otherwise.
+type cappedWriter struct {
+	buf      bytes.Buffer
+	limit    int
+	exceeded func()
+	over     bool
+}
+
+func (w *cappedWriter) Write(p []byte) (int, error) {
+	if room := w.limit - w.buf.Len(); len(p) > room {
+		w.over = true
+		if w.exceeded != nil {
+			w.exceeded()
+			return 0, errOutputTooLarge
+		}
+		w.buf.Write(p[:max(room, 0)])
+		return len(p), nil
+	}
+	return w.buf.Write(p)
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+func TestThumbnailContentType(t *testing.T) {
+	assert.Equal(t, "application/pdf", thumbnailContentType("application/pdf", "manual.mov"))
+	assert.Equal(t, "image/heic", thumbnailContentType("application/octet-stream", "IMG_0001.HEIC"))
+	assert.Equal(t, "video/quicktime", thumbnailContentType("", "unboxing.mov"))
+	assert.Equal(t, "application/octet-stream", thumbnailContentType("application/octet-stream", "firmware.bin"))
+}
+
+func TestAttachmentRepo_CommandThumbnails(t *testing.T) {
+	if _, err := exec.LookPath("cat"); err != nil {
+		t.Skip("cat is not available")
+	}
+	ctx := context.Background()
+
+	// The command stands in for pdftoppm: whatever the PDF, it writes the
+	// same page rendered at the thumbnail size.
+	var page bytes.Buffer
+	require.NoError(t, png.Encode(&page, image.NewGray(image.Rect(0, 0, 72, 100))))
+	pagePath := filepath.Join(t.TempDir(), "page.png")
+	require.NoError(t, os.WriteFile(pagePath, page.Bytes(), 0o600))
+
+	repos := New(tClient, tbus, config.Storage{
+		PrefixPath: "/",
+		ConnString: "file://" + os.TempDir(),
+	}, "mem://{{ .Topic }}", config.Thumbnail{
+		Enabled:    true,
+		Width:      100,
+		Height:     100,
+		PDFCommand: "cat " + pagePath,
+	})
+
+	e := useEntities(t, 1)[0]
+	manual, err := repos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "manual.pdf",
+		Content: bytes.NewReader([]byte("%PDF-1.4\n" + fk.Str(20))),
+	}, attachment.TypeManual, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repos.Attachments.Delete(context.Background(), tGroup.ID, manual.ID) })
+
+	notes, err := repos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "notes.txt",
+		Content: bytes.NewReader([]byte("notes " + fk.Str(20))),
+	}, attachment.TypeAttachment, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repos.Attachments.Delete(context.Background(), tGroup.ID, notes.ID) })
+
+	// Only the PDF can get a thumbnail.
+	count, err := repos.Attachments.CreateMissingThumbnails(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.Error(t, repos.Attachments.CreateThumbnail(ctx, tGroup.ID, notes.ID, notes.Title, notes.Path))
+	require.NoError(t, repos.Attachments.CreateThumbnail(ctx, tGroup.ID, manual.ID, manual.Title, manual.Path))
+
+	got, err := repos.Attachments.Get(ctx, tGroup.ID, manual.ID)
+	require.NoError(t, err)
+	thumb := ToItemAttachment(got).Thumbnail
+	require.NotNil(t, thumb)
+	assert.Equal(t, "image/webp", thumb.MimeType)
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(readAttachmentContent(t, thumb.Path)))
+	require.NoError(t, err)
+	assert.Equal(t, 100, cfg.Height)
+
+	count, err = repos.Attachments.CreateMissingThumbnails(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestCommandRenderer_Limits(t *testing.T) {
+	if _, err := exec.LookPath("head"); err != nil {
+		t.Skip("head is not available")
+	}
+
+	// Templates are parsed when the renderers are built, and a command with
+	// an invalid one is left out.
+	renderers := newThumbnailRenderers(config.Thumbnail{Width: 100, Height: 100, PDFCommand: "head {{.Input"})
+	assert.Len(t, renderers, 1)
+
+	renderers = newThumbnailRenderers(config.Thumbnail{Width: 100, Height: 100, PDFCommand: "head -c 10000000 /dev/zero"})
+	require.Len(t, renderers, 2)
+	_, err := renderers[1].render(context.Background(), bytes.NewReader([]byte("%PDF-1.4")), "application/pdf")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds")
+}
@@ -0,0 +1,419 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachmentupload"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"go.opentelemetry.io/otel"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// attachmentUploadTTL is how long an upload is kept after its last chunk
+// before it is purged.
+const attachmentUploadTTL = 24 * time.Hour
+
+type (
+	AttachmentUploadCreate struct {
+		Title   string `json:"title"   validate:"required,max=255"`
+		Type    string `json:"type"    validate:"omitempty,oneof=photo manual warranty attachment receipt"`
+		Primary bool   `json:"primary"`
+		// Size is the length of the whole file in bytes.
+		Size int64 `json:"size" validate:"gt=0"`
+	}
+
+	AttachmentUploadOut struct {
+		ID       uuid.UUID `json:"id"`
+		EntityID uuid.UUID `json:"entityId"`
+		Title    string    `json:"title"`
+		Type     string    `json:"type"`
+		Primary  bool      `json:"primary"`
+		Size     int64     `json:"size"`
+		// Received is the number of bytes stored, the offset of the next chunk.
+		Received  int64     `json:"received"`
+		ExpiresAt time.Time `json:"expiresAt"`
+		CreatedAt time.Time `json:"createdAt"`
+		// AttachmentID is set once the last chunk has arrived and the upload
+		// has become an attachment.
+		AttachmentID *uuid.UUID `json:"attachmentId,omitempty" extensions:"x-nullable,x-omitempty"`
+	}
+)
+
+// UploadOffsetError reports a chunk that doesn't start where the stored
+// content of the upload ends.
+type UploadOffsetError struct {
+	Offset   int64
+	Received int64
+}
+
+func (e *UploadOffsetError) Error() string {
+	return fmt.Sprintf("chunk starts at offset %d, but %d bytes have been received", e.Offset, e.Received)
+}
+
+// uploadLocks serializes the chunks written to each upload.
+var uploadLocks sync.Map
+
+// ErrUploadCompleted is returned when another request turned the upload into
+// an attachment first.
+var ErrUploadCompleted = errors.New("upload was completed by another request")
+
+// ErrUploadTooLarge is returned for chunks that run past the declared size of
+// their upload.
+var ErrUploadTooLarge = errors.New("chunk runs past the size of the upload")
+
+func mapAttachmentUpload(u *ent.AttachmentUpload) AttachmentUploadOut {
+	return AttachmentUploadOut{
+		ID:           u.ID,
+		EntityID:     u.EntityID,
+		Title:        u.Title,
+		Type:         u.Type.String(),
+		Primary:      u.Primary,
+		Size:         u.Size,
+		Received:     u.Received,
+		ExpiresAt:    u.ExpiresAt,
+		CreatedAt:    u.CreatedAt,
+		AttachmentID: u.AttachmentID,
+	}
+}
+
+// uploadPrefix is the key prefix of the chunks of an upload.
+func (r *AttachmentRepo) uploadPrefix(gid, id uuid.UUID) string {
+	return r.fullPath(fmt.Sprintf("%s/uploads/%s", gid.String(), id.String())) + "/"
+}
+
+// chunkKey is the key of the chunk at offset. Offsets are zero padded, so
+// listing the chunks returns them in order.
+func (r *AttachmentRepo) chunkKey(gid, id uuid.UUID, offset int64) string {
+	return fmt.Sprintf("%s%020d", r.uploadPrefix(gid, id), offset)
+}
+
+func (r *AttachmentRepo) getUpload(ctx context.Context, gid, entityID, id uuid.UUID) (*ent.AttachmentUpload, error) {
+	return r.db.AttachmentUpload.Query().
+		Where(
+			attachmentupload.ID(id),
+			attachmentupload.EntityID(entityID),
+			attachmentupload.HasGroupWith(group.ID(gid)),
+		).
+		Only(ctx)
+}
+
+// CreateUpload starts a resumable upload of an attachment of entityID, which
+// the caller has checked belongs to the group.
+func (r *AttachmentRepo) CreateUpload(ctx context.Context, gid, entityID uuid.UUID, data AttachmentUploadCreate) (AttachmentUploadOut, error) {
+	typ := attachment.TypeAttachment.String()
+	if data.Type != "" {
+		typ = data.Type
+	}
+
+	u, err := r.db.AttachmentUpload.Create().
+		SetGroupID(gid).
+		SetEntityID(entityID).
+		SetTitle(data.Title).
+		SetType(attachmentupload.Type(typ)).
+		SetPrimary(data.Primary).
+		SetSize(data.Size).
+		SetExpiresAt(time.Now().Add(attachmentUploadTTL)).
+		Save(ctx)
+	if err != nil {
+		return AttachmentUploadOut{}, err
+	}
+	return mapAttachmentUpload(u), nil
+}
+
+// GetUpload returns the state of an upload, to resume it from Received.
+func (r *AttachmentRepo) GetUpload(ctx context.Context, gid, entityID, id uuid.UUID) (AttachmentUploadOut, error) {
+	u, err := r.getUpload(ctx, gid, entityID, id)
+	if err != nil {
+		return AttachmentUploadOut{}, err
+	}
+	return mapAttachmentUpload(u), nil
+}
+
+// WriteUploadChunk stores content as the chunk of the upload at offset, which
+// must be the number of bytes received so far. If content fails part way, the
+// bytes read until then are kept and the error is returned with the new
+// state. The chunk that completes the upload turns it into an attachment.
+func (r *AttachmentRepo) WriteUploadChunk(ctx context.Context, gid, entityID, id uuid.UUID, offset int64, content io.Reader) (AttachmentUploadOut, error) {
+	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.WriteUploadChunk")
+	defer span.End()
+
+	// Chunks of one upload are written one at a time, so a chunk key is
+	// only ever written by the request that moves the counter past it.
+	mu, _ := uploadLocks.LoadOrStore(id, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	defer mu.(*sync.Mutex).Unlock()
+
+	u, err := r.getUpload(ctx, gid, entityID, id)
+	if err != nil {
+		return AttachmentUploadOut{}, err
+	}
+	// A retry of the last chunk after the upload became an attachment.
+	if u.AttachmentID != nil {
+		return mapAttachmentUpload(u), nil
+	}
+	if offset != u.Received {
+		return mapAttachmentUpload(u), &UploadOffsetError{Offset: offset, Received: u.Received}
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		log.Err(err).Msg("failed to open bucket")
+		return AttachmentUploadOut{}, err
+	}
+	defer func(bucket *blob.Bucket) {
+		err := bucket.Close()
+		if err != nil {
+			log.Err(err).Msg("failed to close bucket")
+		}
+	}(bucket)
+
+	// All content arrived before, but creating the attachment failed.
+	if u.Received == u.Size {
+		return r.finishUpload(ctx, bucket, u)
+	}
+
+	// A dropped connection cancels ctx, but the bytes that made it are
+	// still stored and counted.
+	storeCtx := context.WithoutCancel(ctx)
+	key := r.chunkKey(gid, id, offset)
+	writeCtx, cancel := context.WithCancel(storeCtx)
+	defer cancel()
+	w, err := bucket.NewWriter(writeCtx, key, &blob.WriterOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return AttachmentUploadOut{}, err
+	}
+
+	// Read one byte more than fits to tell a chunk that is too long.
+	remaining := u.Size - u.Received
+	n, readErr := io.Copy(w, io.LimitReader(content, remaining+1))
+	if n > remaining || n == 0 {
+		cancel()
+		_ = w.Close()
+		if n > remaining {
+			return mapAttachmentUpload(u), ErrUploadTooLarge
+		}
+		return mapAttachmentUpload(u), readErr
+	}
+	if err := w.Close(); err != nil {
+		return AttachmentUploadOut{}, err
+	}
+
+	u, err = r.db.AttachmentUpload.UpdateOne(u).
+		SetReceived(offset + n).
+		SetExpiresAt(time.Now().Add(attachmentUploadTTL)).
+		Save(storeCtx)
+	if err != nil {
+		return AttachmentUploadOut{}, err
+	}
+	if readErr != nil || u.Received < u.Size {
+		return mapAttachmentUpload(u), readErr
+	}
+	return r.finishUpload(ctx, bucket, u)
+}
+
+// finishUpload completes u and returns its final state.
+func (r *AttachmentRepo) finishUpload(ctx context.Context, bucket *blob.Bucket, u *ent.AttachmentUpload) (AttachmentUploadOut, error) {
+	out := mapAttachmentUpload(u)
+	att, err := r.completeUpload(ctx, bucket, u)
+	if err != nil {
+		return out, err
+	}
+	out.AttachmentID = &att.ID
+	return out, nil
+}
+
+// completeUpload creates the attachment from the chunks of u and records it
+// on the upload in the same transaction, so the upload can't become a second
+// attachment. The upload is kept until it expires, for retries of the last
+// chunk to find the attachment, but its chunks are removed.
+func (r *AttachmentRepo) completeUpload(ctx context.Context, bucket *blob.Bucket, u *ent.AttachmentUpload) (*ent.Attachment, error) {
+	gid, err := u.QueryGroup().OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := r.chunkKeys(ctx, bucket, gid, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	content := &chunkReader{ctx: ctx, bucket: bucket, keys: keys}
+	defer content.Close()
+
+	att, err := r.create(ctx, u.EntityID, ItemCreateAttachment{Title: u.Title, Content: content}, attachment.Type(u.Type.String()), u.Primary,
+		func(tx *ent.Tx, att *ent.Attachment) error {
+			n, err := tx.AttachmentUpload.Update().
+				Where(attachmentupload.ID(u.ID), attachmentupload.AttachmentIDIsNil()).
+				SetAttachmentID(att.ID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return ErrUploadCompleted
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	_ = content.Close()
+	if err := r.removeChunks(ctx, bucket, keys); err != nil {
+		log.Err(err).Str("upload", u.ID.String()).Msg("failed to remove chunks of completed upload")
+	}
+	return att, nil
+}
+
+// DeleteUpload abandons an upload and its chunks.
+func (r *AttachmentRepo) DeleteUpload(ctx context.Context, gid, entityID, id uuid.UUID) error {
+	if _, err := r.getUpload(ctx, gid, entityID, id); err != nil {
+		return err
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		log.Err(err).Msg("failed to open bucket")
+		return err
+	}
+	defer func(bucket *blob.Bucket) {
+		err := bucket.Close()
+		if err != nil {
+			log.Err(err).Msg("failed to close bucket")
+		}
+	}(bucket)
+
+	return r.removeUpload(ctx, bucket, gid, id)
+}
+
+// PurgeExpiredUploads removes the uploads that haven't received a chunk
+// within attachmentUploadTTL.
+func (r *AttachmentRepo) PurgeExpiredUploads(ctx context.Context) (int, error) {
+	expired, err := r.db.AttachmentUpload.Query().
+		Where(attachmentupload.ExpiresAtLT(time.Now())).
+		WithGroup().
+		All(ctx)
+	if err != nil || len(expired) == 0 {
+		return 0, err
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		log.Err(err).Msg("failed to open bucket")
+		return 0, err
+	}
+	defer func(bucket *blob.Bucket) {
+		err := bucket.Close()
+		if err != nil {
+			log.Err(err).Msg("failed to close bucket")
+		}
+	}(bucket)
+
+	purged := 0
+	for _, u := range expired {
+		if err := r.removeUpload(ctx, bucket, u.Edges.Group.ID, u.ID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// removeUpload deletes the chunks of an upload, then the upload.
+func (r *AttachmentRepo) removeUpload(ctx context.Context, bucket *blob.Bucket, gid, id uuid.UUID) error {
+	keys, err := r.chunkKeys(ctx, bucket, gid, id)
+	if err != nil {
+		return err
+	}
+	if err := r.removeChunks(ctx, bucket, keys); err != nil {
+		return err
+	}
+
+	err = r.db.AttachmentUpload.DeleteOneID(id).Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	uploadLocks.Delete(id)
+	return nil
+}
+
+// removeChunks deletes the chunks at keys.
+func (r *AttachmentRepo) removeChunks(ctx context.Context, bucket *blob.Bucket, keys []string) error {
+	for _, key := range keys {
+		if err := bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkKeys lists the chunks of an upload in order.
+func (r *AttachmentRepo) chunkKeys(ctx context.Context, bucket *blob.Bucket, gid, id uuid.UUID) ([]string, error) {
+	var keys []string
+	iter := bucket.List(&blob.ListOptions{Prefix: r.uploadPrefix(gid, id)})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return keys, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !obj.IsDir {
+			keys = append(keys, obj.Key)
+		}
+	}
+}
+
+// chunkReader reads the chunks of an upload one after the other, with only
+// one of them open at a time.
+type chunkReader struct {
+	ctx    context.Context
+	bucket *blob.Bucket
+	keys   []string
+	cur    *blob.Reader
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if c.cur == nil {
+			if len(c.keys) == 0 {
+				return 0, io.EOF
+			}
+			rd, err := c.bucket.NewReader(c.ctx, c.keys[0], nil)
+			if err != nil {
+				return 0, err
+			}
+			c.cur, c.keys = rd, c.keys[1:]
+		}
+
+		n, err := c.cur.Read(p)
+		if errors.Is(err, io.EOF) {
+			_ = c.cur.Close()
+			c.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (c *chunkReader) Close() error {
+	if c.cur == nil {
+		return nil
+	}
+	err := c.cur.Close()
+	c.cur = nil
+	return err
+}
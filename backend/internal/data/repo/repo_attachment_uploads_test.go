@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/zeebo/blake3"
+	"gocloud.dev/blob"
+)
+
+func readAttachmentContent(t *testing.T, path string) []byte {
+	t.Helper()
+
+	bucket, err := blob.OpenBucket(context.Background(), tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+
+	data, err := bucket.ReadAll(context.Background(), tRepos.Attachments.GetFullPath(path))
+	require.NoError(t, err)
+	return data
+}
+
+func TestAttachmentRepo_UploadFile_ContentAddress(t *testing.T) {
+	content := bytes.Repeat([]byte("streamed content "), 10_000)
+
+	res, err := tRepos.Attachments.UploadFile(context.Background(), tRepos.Attachments.db.Blob, &ent.Group{ID: tGroup.ID}, ItemCreateAttachment{
+		Title:   "large.txt",
+		Content: bytes.NewReader(content),
+	})
+	require.NoError(t, err)
+
+	// Streaming must keep the keys of files stored before it.
+	key := make([]byte, 32)
+	blake3.DeriveKey(tGroup.ID.String(), content, key)
+	assert.Equal(t, tRepos.Attachments.path(tGroup.ID, fmt.Sprintf("%x", key)), res.Path)
+	assert.Equal(t, "text/plain; charset=utf-8", res.ContentType)
+	assert.Equal(t, content, readAttachmentContent(t, res.Path))
+
+	again, err := tRepos.Attachments.UploadFile(context.Background(), tRepos.Attachments.db.Blob, &ent.Group{ID: tGroup.ID}, ItemCreateAttachment{
+		Title:   "copy.txt",
+		Content: bytes.NewReader(content),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, res.Path, again.Path)
+}
+
+func TestAttachmentRepo_ResumableUpload(t *testing.T) {
+	entity := useEntities(t, 1)[0]
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("0123456789"), 1_000)
+
+	up, err := tRepos.Attachments.CreateUpload(ctx, tGroup.ID, entity.ID, AttachmentUploadCreate{
+		Title: "manual.pdf",
+		Type:  "manual",
+		Size:  int64(len(content)),
+	})
+	require.NoError(t, err)
+
+	// The connection drops after 3000 bytes; they are kept.
+	out, err := tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 0,
+		iotest.TimeoutReader(io.MultiReader(bytes.NewReader(content[:3000]), bytes.NewReader(content[3000:]))))
+	require.Error(t, err)
+	assert.Equal(t, int64(3000), out.Received)
+
+	// Resuming from the wrong offset is refused.
+	_, err = tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 0, bytes.NewReader(content))
+	var offsetErr *UploadOffsetError
+	require.True(t, errors.As(err, &offsetErr), "got %v", err)
+	assert.Equal(t, int64(3000), offsetErr.Received)
+
+	got, err := tRepos.Attachments.GetUpload(ctx, tGroup.ID, entity.ID, up.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), got.Received)
+
+	// More than the declared size is refused.
+	_, err = tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 3000, bytes.NewReader(append(content[3000:], 'x')))
+	require.ErrorIs(t, err, ErrUploadTooLarge)
+
+	out, err = tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 3000, bytes.NewReader(content[3000:6000]))
+	require.NoError(t, err)
+	assert.Nil(t, out.AttachmentID)
+
+	out, err = tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 6000, bytes.NewReader(content[6000:]))
+	require.NoError(t, err)
+	require.NotNil(t, out.AttachmentID)
+	t.Cleanup(func() { _ = tRepos.Attachments.Delete(context.Background(), tGroup.ID, *out.AttachmentID) })
+
+	att, err := tRepos.Attachments.Get(ctx, tGroup.ID, *out.AttachmentID)
+	require.NoError(t, err)
+	assert.Equal(t, "manual.pdf", att.Title)
+	assert.Equal(t, "manual", att.Type.String())
+	assert.Equal(t, content, readAttachmentContent(t, att.Path))
+
+	// The chunks are gone, but the upload remembers its attachment, so a
+	// retry of the last chunk doesn't create another one.
+	bucket, err := blob.OpenBucket(ctx, tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+	keys, err := tRepos.Attachments.chunkKeys(ctx, bucket, tGroup.ID, up.ID)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	retry, err := tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 6000, bytes.NewReader(content[6000:]))
+	require.NoError(t, err)
+	require.NotNil(t, retry.AttachmentID)
+	assert.Equal(t, *out.AttachmentID, *retry.AttachmentID)
+
+	got, err = tRepos.Attachments.GetUpload(ctx, tGroup.ID, entity.ID, up.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.AttachmentID)
+	assert.Equal(t, *out.AttachmentID, *got.AttachmentID)
+
+	stored, err := tRepos.Entities.GetOneByGroup(ctx, tGroup.ID, entity.ID)
+	require.NoError(t, err)
+	assert.Len(t, stored.Attachments, 1)
+}
+
+func TestAttachmentRepo_DeleteUpload(t *testing.T) {
+	entity := useEntities(t, 1)[0]
+	ctx := context.Background()
+
+	up, err := tRepos.Attachments.CreateUpload(ctx, tGroup.ID, entity.ID, AttachmentUploadCreate{Title: "video.mp4", Size: 100})
+	require.NoError(t, err)
+	_, err = tRepos.Attachments.WriteUploadChunk(ctx, tGroup.ID, entity.ID, up.ID, 0, bytes.NewReader(make([]byte, 50)))
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.Attachments.DeleteUpload(ctx, tGroup.ID, entity.ID, up.ID))
+
+	bucket, err := blob.OpenBucket(ctx, tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+	keys, err := tRepos.Attachments.chunkKeys(ctx, bucket, tGroup.ID, up.ID)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -0,0 +1,314 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditlog"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// AuditSource identifies the channel a change came in through.
+type AuditSource string
+
+const (
+	AuditSourceWeb    AuditSource = "web"
+	AuditSourceAPIKey AuditSource = "api_key"
+	AuditSourceImport AuditSource = "import"
+	AuditSourceSystem AuditSource = "system"
+)
+
+// AuditAction is the kind of change an audit row describes.
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+// AuditActor is the "who" attached to every audit row. It travels on the
+// request context so the entity repository can attribute changes without
+// every method growing a user parameter. The repo package can't import
+// services (services imports repo), hence the separate key here.
+type AuditActor struct {
+	UserID   uuid.UUID
+	UserName string
+	Source   AuditSource
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a copy of ctx carrying actor.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// WithAuditSource overrides only the source of the actor already on ctx,
+// e.g. to mark rows written by the CSV importer while keeping the user.
+func WithAuditSource(ctx context.Context, source AuditSource) context.Context {
+	actor := auditActorFromCtx(ctx)
+	actor.Source = source
+	return WithAuditActor(ctx, actor)
+}
+
+func auditActorFromCtx(ctx context.Context) AuditActor {
+	if actor, ok := ctx.Value(auditActorKey{}).(AuditActor); ok {
+		if actor.Source == "" {
+			actor.Source = AuditSourceSystem
+		}
+		return actor
+	}
+	return AuditActor{Source: AuditSourceSystem}
+}
+
+type AuditLogRepository struct {
+	db *ent.Client
+}
+
+type (
+	// AuditChange is a single field-level change. Field is empty for
+	// create/delete rows.
+	AuditChange struct {
+		Field    string
+		OldValue string
+		NewValue string
+	}
+
+	AuditLogQuery struct {
+		Page     int `json:"page"     schema:"page"`
+		PageSize int `json:"pageSize" schema:"pageSize"`
+	}
+
+	AuditEntryOut struct {
+		ID         uuid.UUID  `json:"id"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		EntityID   uuid.UUID  `json:"entityId"`
+		EntityName string     `json:"entityName"`
+		UserID     *uuid.UUID `json:"userId,omitempty" extensions:"x-nullable,x-omitempty"`
+		UserName   string     `json:"userName"`
+		Action     string     `json:"action"`
+		Source     string     `json:"source"`
+		Field      string     `json:"field,omitempty"`
+		OldValue   string     `json:"oldValue,omitempty"`
+		NewValue   string     `json:"newValue,omitempty"`
+	}
+)
+
+func mapAuditEntry(a *ent.AuditLog) AuditEntryOut {
+	return AuditEntryOut{
+		ID:         a.ID,
+		CreatedAt:  a.CreatedAt,
+		EntityID:   a.EntityID,
+		EntityName: a.EntityName,
+		UserID:     a.UserID,
+		UserName:   a.UserName,
+		Action:     string(a.Action),
+		Source:     string(a.Source),
+		Field:      a.FieldName,
+		OldValue:   a.OldValue,
+		NewValue:   a.NewValue,
+	}
+}
+
+// Record writes one row per change, attributed to the actor on ctx. A create
+// or delete with no changes still writes a single row with an empty field.
+func (r *AuditLogRepository) Record(ctx context.Context, gid, entityID uuid.UUID, entityName string, action AuditAction, changes []AuditChange) error {
+	actor := auditActorFromCtx(ctx)
+	if len(changes) == 0 {
+		if action == AuditActionUpdate {
+			return nil
+		}
+		changes = []AuditChange{{}}
+	}
+
+	builders := make([]*ent.AuditLogCreate, 0, len(changes))
+	for _, c := range changes {
+		b := r.db.AuditLog.Create().
+			SetGroupID(gid).
+			SetEntityID(entityID).
+			SetEntityName(truncateUTF8(entityName, 255)).
+			SetUserName(truncateUTF8(actor.UserName, 255)).
+			SetAction(auditlog.Action(action)).
+			SetSource(auditlog.Source(actor.Source)).
+			SetFieldName(truncateUTF8(c.Field, 255)).
+			SetOldValue(c.OldValue).
+			SetNewValue(c.NewValue)
+		if actor.UserID != uuid.Nil {
+			b.SetUserID(actor.UserID)
+		}
+		builders = append(builders, b)
+	}
+
+	return r.db.AuditLog.CreateBulk(builders...).Exec(ctx)
+}
+
+// recordBestEffort is the variant used from mutation paths: the change has
+// already been committed, so a failure to audit is logged rather than
+// surfaced to the caller.
+func (r *AuditLogRepository) recordBestEffort(ctx context.Context, gid, entityID uuid.UUID, entityName string, action AuditAction, changes []AuditChange) {
+	if r == nil {
+		return
+	}
+	if err := r.Record(ctx, gid, entityID, entityName, action, changes); err != nil {
+		log.Warn().Err(err).Str("entity_id", entityID.String()).Msg("failed to record audit log")
+	}
+}
+
+// GetByEntity returns the history of a single entity, newest first. Rows are
+// scoped to gid and to the entity scope of ctx, so a foreign or hidden entity
+// id simply yields an empty page.
+func (r *AuditLogRepository) GetByEntity(ctx context.Context, gid, entityID uuid.UUID, page, pageSize int) (PaginationResult[AuditEntryOut], error) {
+	return r.query(ctx, page, pageSize, auditlog.GroupID(gid), auditlog.EntityID(entityID))
+}
+
+// GetByGroup returns the group-wide activity feed, newest first. Under an
+// entity scope it only covers the entities in scope.
+func (r *AuditLogRepository) GetByGroup(ctx context.Context, gid uuid.UUID, page, pageSize int) (PaginationResult[AuditEntryOut], error) {
+	return r.query(ctx, page, pageSize, auditlog.GroupID(gid))
+}
+
+func (r *AuditLogRepository) query(ctx context.Context, page, pageSize int, where ...predicate.AuditLog) (PaginationResult[AuditEntryOut], error) {
+	qb := r.db.AuditLog.Query().Where(where...)
+
+	count, err := qb.Count(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	qb = qb.Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID))
+	if pageSize > 0 {
+		qb = qb.Limit(pageSize).Offset(calculateOffset(page, pageSize))
+	}
+
+	rows, err := qb.All(ctx)
+	if err != nil {
+		return PaginationResult[AuditEntryOut]{}, err
+	}
+
+	return PaginationResult[AuditEntryOut]{
+		Page:     page,
+		PageSize: pageSize,
+		Total:    count,
+		Items:    mapEach(rows, mapAuditEntry),
+	}, nil
+}
+
+// diffEntities compares two snapshots of the same entity and returns the
+// user-visible fields that changed. Custom fields are keyed by name and
+// reported as "field:<name>".
+func diffEntities(before, after EntityOut) []AuditChange {
+	var changes []AuditChange
+	add := func(name, oldV, newV string) {
+		if oldV != newV {
+			changes = append(changes, AuditChange{Field: name, OldValue: oldV, NewValue: newV})
+		}
+	}
+	fmtFloat := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+
+	add("name", before.Name, after.Name)
+	add("description", before.Description, after.Description)
+	add("assetId", before.AssetID.String(), after.AssetID.String())
+	add("quantity", fmtFloat(before.Quantity), fmtFloat(after.Quantity))
+	add("insured", strconv.FormatBool(before.Insured), strconv.FormatBool(after.Insured))
+	add("archived", strconv.FormatBool(before.Archived), strconv.FormatBool(after.Archived))
+	add("serialNumber", before.SerialNumber, after.SerialNumber)
+	add("modelNumber", before.ModelNumber, after.ModelNumber)
+	add("manufacturer", before.Manufacturer, after.Manufacturer)
+	add("lifetimeWarranty", strconv.FormatBool(before.LifetimeWarranty), strconv.FormatBool(after.LifetimeWarranty))
+	add("warrantyExpires", before.WarrantyExpires.String(), after.WarrantyExpires.String())
+	add("warrantyDetails", before.WarrantyDetails, after.WarrantyDetails)
+	add("purchaseDate", before.PurchaseDate.String(), after.PurchaseDate.String())
+	add("purchaseFrom", before.PurchaseFrom, after.PurchaseFrom)
+	add("purchasePrice", fmtFloat(before.PurchasePrice), fmtFloat(after.PurchasePrice))
+	add("soldDate", before.SoldDate.String(), after.SoldDate.String())
+	add("soldTo", before.SoldTo, after.SoldTo)
+	add("soldPrice", fmtFloat(before.SoldPrice), fmtFloat(after.SoldPrice))
+	add("soldNotes", before.SoldNotes, after.SoldNotes)
+	add("notes", before.Notes, after.Notes)
+	add("parent", auditSummaryName(before.Parent), auditSummaryName(after.Parent))
+	add("entityType", auditEntityTypeName(before.EntityType), auditEntityTypeName(after.EntityType))
+	add("tags", auditTagNames(before.Tags), auditTagNames(after.Tags))
+
+	oldFields := auditFieldValues(before.Fields)
+	newFields := auditFieldValues(after.Fields)
+	names := make([]string, 0, len(oldFields)+len(newFields))
+	for n := range oldFields {
+		names = append(names, n)
+	}
+	for n := range newFields {
+		if _, ok := oldFields[n]; !ok {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		add("field:"+n, oldFields[n], newFields[n])
+	}
+
+	return changes
+}
+
+func auditSummaryName(s *EntitySummary) string {
+	if s == nil {
+		return ""
+	}
+	return s.Name
+}
+
+func auditEntityTypeName(s *EntityTypeSummary) string {
+	if s == nil {
+		return ""
+	}
+	return s.Name
+}
+
+func auditTagNames(tags []TagSummary) string {
+	names := make([]string, len(tags))
+	for i, t := range tags {
+		names[i] = t.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func auditFieldValues(fields []EntityFieldData) map[string]string {
+	out := make(map[string]string, len(fields))
+	for _, f := range fields {
+		switch f.Type {
+		case "number":
+			out[f.Name] = strconv.Itoa(f.NumberValue)
+		case "boolean":
+			out[f.Name] = strconv.FormatBool(f.BooleanValue)
+		case "decimal", "date", "currency":
+			out[f.Name] = fieldText(f)
+		default:
+			out[f.Name] = f.TextValue
+		}
+	}
+	return out
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a multibyte rune
+// (see ExportRepository.SetFailed for why that matters on Postgres).
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	cut := 0
+	for i, r := range s {
+		end := i + utf8.RuneLen(r)
+		if end > n {
+			break
+		}
+		cut = end
+	}
+	return s[:cut]
+}
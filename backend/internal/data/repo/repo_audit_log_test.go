@@ -0,0 +1,126 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditFieldsByName(entries []AuditEntryOut) map[string]AuditEntryOut {
+	out := make(map[string]AuditEntryOut, len(entries))
+	for _, e := range entries {
+		out[e.Field] = e
+	}
+	return out
+}
+
+func TestAuditLog_UpdateRecordsFieldDiff(t *testing.T) {
+	entity := useEntities(t, 1)[0]
+
+	ctx := WithAuditActor(context.Background(), AuditActor{
+		UserID:   tUser.ID,
+		UserName: tUser.Name,
+		Source:   AuditSourceWeb,
+	})
+
+	update := EntityUpdate{
+		ID:           entity.ID,
+		Name:         "renamed",
+		Description:  entity.Description,
+		ParentID:     entity.Parent.ID,
+		EntityTypeID: entity.EntityType.ID,
+		Quantity:     3,
+		Fields: []EntityFieldData{
+			{Type: "text", Name: "Voltage", TextValue: "12"},
+		},
+	}
+	_, err := tRepos.Entities.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+
+	history, err := tRepos.AuditLog.GetByEntity(context.Background(), tGroup.ID, entity.ID, 1, 50)
+	require.NoError(t, err)
+
+	byField := auditFieldsByName(history.Items)
+
+	name, ok := byField["name"]
+	require.True(t, ok, "expected a name change row")
+	assert.Equal(t, entity.Name, name.OldValue)
+	assert.Equal(t, "renamed", name.NewValue)
+	assert.Equal(t, string(AuditActionUpdate), name.Action)
+	assert.Equal(t, string(AuditSourceWeb), name.Source)
+	require.NotNil(t, name.UserID)
+	assert.Equal(t, tUser.ID, *name.UserID)
+
+	qty, ok := byField["quantity"]
+	require.True(t, ok, "expected a quantity change row")
+	assert.Equal(t, "3", qty.NewValue)
+
+	field, ok := byField["field:Voltage"]
+	require.True(t, ok, "expected a custom field change row")
+	assert.Empty(t, field.OldValue)
+	assert.Equal(t, "12", field.NewValue)
+
+	_, ok = byField["description"]
+	assert.False(t, ok, "unchanged fields must not be recorded")
+
+	create, ok := byField[""]
+	require.True(t, ok, "expected the create row")
+	assert.Equal(t, string(AuditActionCreate), create.Action)
+	assert.Equal(t, string(AuditSourceSystem), create.Source)
+}
+
+func TestAuditLog_PatchAndImportSource(t *testing.T) {
+	entity := useEntities(t, 1)[0]
+
+	ctx := WithAuditActor(context.Background(), AuditActor{UserID: tUser.ID, UserName: tUser.Name, Source: AuditSourceWeb})
+	ctx = WithAuditSource(ctx, AuditSourceImport)
+
+	qty := float64(7)
+	require.NoError(t, tRepos.Entities.Patch(ctx, tGroup.ID, entity.ID, EntityPatch{ID: entity.ID, Quantity: &qty}))
+
+	history, err := tRepos.AuditLog.GetByEntity(context.Background(), tGroup.ID, entity.ID, 1, 50)
+	require.NoError(t, err)
+
+	row, ok := auditFieldsByName(history.Items)["quantity"]
+	require.True(t, ok)
+	assert.Equal(t, "7", row.NewValue)
+	assert.Equal(t, string(AuditSourceImport), row.Source)
+	assert.Equal(t, tUser.Name, row.UserName)
+}
+
+func TestAuditLog_DeleteSurvivesEntity(t *testing.T) {
+	itm := entityFactory()
+	itm.EntityTypeID = useItemEntityType(t).ID
+	entity, err := tRepos.Entities.Create(context.Background(), tGroup.ID, itm)
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(context.Background(), tGroup.ID, entity.ID))
+
+	history, err := tRepos.AuditLog.GetByEntity(context.Background(), tGroup.ID, entity.ID, 1, 50)
+	require.NoError(t, err)
+	require.Equal(t, 2, history.Total)
+
+	// Newest first: the delete precedes the create.
+	assert.Equal(t, string(AuditActionDelete), history.Items[0].Action)
+	assert.Equal(t, entity.Name, history.Items[0].EntityName)
+	assert.Equal(t, string(AuditActionCreate), history.Items[1].Action)
+
+	feed, err := tRepos.AuditLog.GetByGroup(context.Background(), tGroup.ID, 1, 1)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, feed.Total, 2)
+	assert.Len(t, feed.Items, 1)
+}
+
+func TestAuditLog_ScopedToGroup(t *testing.T) {
+	entity := useEntities(t, 1)[0]
+
+	other, err := tRepos.Groups.GroupCreate(context.Background(), "audit-other-"+fk.Str(6), tUser.ID)
+	require.NoError(t, err)
+
+	history, err := tRepos.AuditLog.GetByEntity(context.Background(), other.ID, entity.ID, 1, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 0, history.Total)
+	assert.Empty(t, history.Items)
+}
@@ -0,0 +1,463 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	entblob "github.com/sysadminsmedia/homebox/backend/internal/data/ent/blob"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// BlobGracePeriod is how long a file stays in the bucket after its last
+// reference went away. An upload of the same content within it finds the
+// file intact instead of racing the garbage collector.
+const BlobGracePeriod = time.Hour
+
+type (
+	// BlobCollection is the outcome of a garbage collection run.
+	BlobCollection struct {
+		Deleted int
+		Freed   int64
+	}
+
+	StorageReportBlob struct {
+		Path string `json:"path"`
+		Size int64  `json:"size"`
+		// References is the number of attachments pointing at the file.
+		References int `json:"references"`
+	}
+
+	// StorageReport compares the files in the bucket with the attachments
+	// that reference them. Orphaned files are referenced by nothing and are
+	// removed by the garbage collector; missing files are referenced but
+	// gone from the bucket, and can only be uploaded again.
+	StorageReport struct {
+		Used        int64               `json:"used"`
+		Files       int                 `json:"files"`
+		Reclaimable int64               `json:"reclaimable"`
+		Orphaned    []StorageReportBlob `json:"orphaned"`
+		Missing     []StorageReportBlob `json:"missing"`
+	}
+)
+
+// retainBlob adds a reference to the blob at path, creating its record on
+// the first one, and reports whether it created the record. Call it in the
+// transaction that saves the attachment.
+func (r *AttachmentRepo) retainBlob(ctx context.Context, c *ent.BlobClient, gid uuid.UUID, path string, size int64, mimeType string) (bool, error) {
+	for range 2 {
+		n, err := c.Update().
+			Where(entblob.Path(path)).
+			AddRefCount(1).
+			ClearUnreferencedAt().
+			Save(ctx)
+		if err != nil || n > 0 {
+			return false, err
+		}
+
+		err = c.Create().
+			SetGroupID(gid).
+			SetPath(path).
+			SetSize(size).
+			SetMimeType(mimeType).
+			SetRefCount(1).
+			Exec(ctx)
+		// Another upload of the same content created the record first.
+		if !ent.IsConstraintError(err) {
+			return err == nil, err
+		}
+	}
+	return false, errors.New("failed to retain blob " + path)
+}
+
+// releaseBlob drops a reference to the blob at path. The file stays in the
+// bucket until the garbage collector finds it unreferenced for longer than
+// BlobGracePeriod.
+func (r *AttachmentRepo) releaseBlob(ctx context.Context, c *ent.BlobClient, path string) error {
+	_, err := c.Update().
+		Where(entblob.Path(path), entblob.RefCountGT(0)).
+		AddRefCount(-1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Update().
+		Where(entblob.Path(path), entblob.RefCount(0), entblob.UnreferencedAtIsNil()).
+		SetUnreferencedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// referencingAttachments matches the attachments that keep a file alive:
+// stored files, and thumbnails still linked from the attachment they were
+// made for.
+func referencingAttachments() predicate.Attachment {
+	hasParent := predicate.Attachment(func(s *sql.Selector) {
+		parents := sql.Table(attachment.Table).As("parents")
+		s.Where(sql.Exists(
+			sql.Select(parents.C(attachment.FieldID)).
+				From(parents).
+				Where(sql.ColumnsEQ(parents.C(attachment.ThumbnailColumn), s.C(attachment.FieldID))),
+		))
+	})
+
+	return attachment.And(
+		attachment.PathNEQ(""),
+		attachment.MimeTypeNotIn(externalLinkMimeTypes...),
+		attachment.Or(attachment.TypeNEQ(attachment.TypeThumbnail), hasParent),
+	)
+}
+
+// blobReferences counts the attachments referencing each file, restricted to
+// the files of one group unless gid is uuid.Nil.
+func (r *AttachmentRepo) blobReferences(ctx context.Context, gid uuid.UUID) (map[string]int, error) {
+	q := r.db.Attachment.Query().Where(referencingAttachments())
+	if gid != uuid.Nil {
+		q = q.Where(attachment.PathHasPrefix(gid.String() + "/"))
+	}
+
+	var rows []struct {
+		Path  string `json:"path"`
+		Count int    `json:"count"`
+	}
+	if err := q.GroupBy(attachment.FieldPath).Aggregate(ent.Count()).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]int, len(rows))
+	for _, row := range rows {
+		refs[row.Path] = row.Count
+	}
+	return refs, nil
+}
+
+// storedBlobs lists the content-addressed files of a group in the bucket by
+// their path relative to the storage prefix.
+func (r *AttachmentRepo) storedBlobs(ctx context.Context, bucket *blob.Bucket, gid uuid.UUID) (map[string]*blob.ListObject, error) {
+	prefix := r.fullPath(r.path(gid, "")) + "/"
+	relative := r.path(gid, "")
+
+	out := make(map[string]*blob.ListObject)
+	iter := bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !obj.IsDir {
+			out[relative+strings.TrimPrefix(obj.Key, prefix)] = obj
+		}
+	}
+}
+
+// groupStorageDirs are the directories the files of a group are stored in:
+// its content-addressed files, uploads being written and resumable upload
+// chunks.
+var groupStorageDirs = []string{"documents", "tmp", "uploads"}
+
+// DeleteGroupFiles removes every file stored for a group. GroupDelete calls
+// it once the group is gone.
+func (r *AttachmentRepo) DeleteGroupFiles(ctx context.Context, gid uuid.UUID) error {
+	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.DeleteGroupFiles")
+	defer span.End()
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = bucket.Close() }()
+
+	deleted := 0
+	for _, dir := range groupStorageDirs {
+		iter := bucket.List(&blob.ListOptions{Prefix: r.fullPath(gid.String()+"/"+dir) + "/"})
+		for {
+			obj, err := iter.Next(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if obj.IsDir {
+				continue
+			}
+			if err := bucket.Delete(ctx, obj.Key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+				return err
+			}
+			deleted++
+		}
+	}
+
+	span.SetAttributes(attribute.Int("blobs.deleted.count", deleted))
+	return nil
+}
+
+// groupFromPath returns the group a content-addressed path belongs to.
+func groupFromPath(path string) (uuid.UUID, bool) {
+	head, _, ok := strings.Cut(path, "/")
+	if !ok {
+		return uuid.Nil, false
+	}
+	gid, err := uuid.Parse(head)
+	return gid, err == nil
+}
+
+// CollectBlobs is the garbage collector of the content store. It brings the
+// reference counts in line with the attachments, which rows removed by
+// database cascades bypass, starts tracking files in the bucket that nothing
+// references, and removes the files unreferenced since before the cutoff.
+// The references of a file are counted again right before it is removed.
+// It is run by the collect-blobs recurring task and spans all groups.
+func (r *AttachmentRepo) CollectBlobs(ctx context.Context, before time.Time) (BlobCollection, error) {
+	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.CollectBlobs")
+	defer span.End()
+
+	// Thumbnails of attachments removed by a cascade are left behind.
+	dangling, err := r.db.Attachment.Delete().
+		Where(attachment.TypeEQ(attachment.TypeThumbnail), attachment.Not(referencingAttachments())).
+		Exec(ctx)
+	if err != nil {
+		return BlobCollection{}, err
+	}
+	span.SetAttributes(attribute.Int("thumbnails.dangling.count", dangling))
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		return BlobCollection{}, err
+	}
+	defer func() { _ = bucket.Close() }()
+
+	if err := r.reconcileBlobs(ctx, bucket); err != nil {
+		return BlobCollection{}, err
+	}
+
+	candidates, err := r.db.Blob.Query().
+		Where(entblob.RefCount(0), entblob.UnreferencedAtLT(before)).
+		All(ctx)
+	if err != nil {
+		return BlobCollection{}, err
+	}
+
+	var out BlobCollection
+	for _, b := range candidates {
+		live, err := r.db.Attachment.Query().
+			Where(referencingAttachments(), attachment.Path(b.Path)).
+			Count(ctx)
+		if err != nil {
+			return out, err
+		}
+		if live > 0 {
+			err = r.db.Blob.UpdateOneID(b.ID).SetRefCount(live).ClearUnreferencedAt().Exec(ctx)
+			if err != nil {
+				return out, err
+			}
+			continue
+		}
+
+		deleted, err := r.deleteBlob(ctx, bucket, b)
+		if err != nil {
+			return out, err
+		}
+		if !deleted {
+			continue
+		}
+		out.Deleted++
+		out.Freed += b.Size
+	}
+
+	span.SetAttributes(
+		attribute.Int("blobs.deleted.count", out.Deleted),
+		attribute.Int64("blobs.freed.bytes", out.Freed),
+	)
+	return out, nil
+}
+
+// deleteBlob removes the record of an unreferenced blob and its file. The
+// file is deleted while the transaction removing the record is open, so a
+// concurrent upload retaining the blob either wins and keeps both, or waits
+// for the record to be gone and stores the file again under a new one.
+func (r *AttachmentRepo) deleteBlob(ctx context.Context, bucket *blob.Bucket, b *ent.Blob) (bool, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := tx.Blob.Delete().Where(entblob.ID(b.ID), entblob.RefCount(0)).Exec(ctx)
+	if err != nil || n == 0 {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return false, rbErr
+		}
+		return false, err
+	}
+
+	err = bucket.Delete(ctx, r.fullPath(b.Path))
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		// The record stays, so the next run tries again.
+		log.Err(err).Str("path", b.Path).Msg("failed to delete unreferenced blob")
+		return false, tx.Rollback()
+	}
+	return true, tx.Commit()
+}
+
+// reconcileBlobs sets the reference count of every blob record to the number
+// of attachments referencing it, and creates records for files without one.
+func (r *AttachmentRepo) reconcileBlobs(ctx context.Context, bucket *blob.Bucket) error {
+	refs, err := r.blobReferences(ctx, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	records, err := r.db.Blob.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	known := make(map[string]bool, len(records))
+	for _, b := range records {
+		known[b.Path] = true
+
+		actual := refs[b.Path]
+		if b.Size == 0 && actual > 0 {
+			// Records created while duplicating attachments of files stored
+			// before the content store existed don't know their size.
+			if attrs, err := bucket.Attributes(ctx, r.fullPath(b.Path)); err == nil && attrs.Size > 0 {
+				if err := r.db.Blob.UpdateOneID(b.ID).SetSize(attrs.Size).Exec(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		if actual == b.RefCount {
+			continue
+		}
+		// Only correct counts no reference changed since they were read;
+		// the next run catches up with the rest.
+		upd := r.db.Blob.Update().Where(entblob.ID(b.ID), entblob.RefCount(b.RefCount)).SetRefCount(actual)
+		if actual == 0 {
+			upd = upd.SetUnreferencedAt(now)
+		} else {
+			upd = upd.ClearUnreferencedAt()
+		}
+		if _, err := upd.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Files stored before the content store existed.
+	for path, count := range refs {
+		if known[path] {
+			continue
+		}
+		gid, ok := groupFromPath(path)
+		if !ok {
+			continue
+		}
+
+		create := r.db.Blob.Create().SetGroupID(gid).SetPath(path).SetRefCount(count)
+		attrs, err := bucket.Attributes(ctx, r.fullPath(path))
+		switch {
+		case err == nil:
+			create = create.SetSize(attrs.Size)
+			if attrs.ContentType != "" {
+				create = create.SetMimeType(attrs.ContentType)
+			}
+		case gcerrors.Code(err) != gcerrors.NotFound:
+			return err
+		}
+		if err := create.Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+		known[path] = true
+	}
+
+	// Files nothing references are tracked from now on, and removed once the
+	// grace period has passed.
+	groups, err := r.db.Group.Query().IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, gid := range groups {
+		stored, err := r.storedBlobs(ctx, bucket, gid)
+		if err != nil {
+			return err
+		}
+		for path, obj := range stored {
+			if known[path] {
+				continue
+			}
+			err := r.db.Blob.Create().
+				SetGroupID(gid).
+				SetPath(path).
+				SetSize(obj.Size).
+				SetUnreferencedAt(now).
+				Exec(ctx)
+			if err != nil && !ent.IsConstraintError(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// StorageReport compares the files of a group in the bucket with the
+// attachments referencing them.
+func (r *AttachmentRepo) StorageReport(ctx context.Context, gid uuid.UUID) (StorageReport, error) {
+	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.StorageReport")
+	defer span.End()
+
+	refs, err := r.blobReferences(ctx, gid)
+	if err != nil {
+		return StorageReport{}, err
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
+	if err != nil {
+		return StorageReport{}, err
+	}
+	defer func() { _ = bucket.Close() }()
+
+	stored, err := r.storedBlobs(ctx, bucket, gid)
+	if err != nil {
+		return StorageReport{}, err
+	}
+
+	out := StorageReport{
+		Orphaned: []StorageReportBlob{},
+		Missing:  []StorageReportBlob{},
+	}
+	for path, obj := range stored {
+		if refs[path] == 0 {
+			out.Orphaned = append(out.Orphaned, StorageReportBlob{Path: path, Size: obj.Size})
+			out.Reclaimable += obj.Size
+			continue
+		}
+		out.Files++
+		out.Used += obj.Size
+	}
+	for path, count := range refs {
+		if _, ok := stored[path]; !ok {
+			out.Missing = append(out.Missing, StorageReportBlob{Path: path, References: count})
+		}
+	}
+
+	sort.Slice(out.Orphaned, func(i, j int) bool { return out.Orphaned[i].Path < out.Orphaned[j].Path })
+	sort.Slice(out.Missing, func(i, j int) bool { return out.Missing[i].Path < out.Missing[j].Path })
+	return out, nil
+}
@@ -0,0 +1,240 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	entblob "github.com/sysadminsmedia/homebox/backend/internal/data/ent/blob"
+	"gocloud.dev/blob"
+)
+
+func blobExists(t *testing.T, path string) bool {
+	t.Helper()
+
+	bucket, err := blob.OpenBucket(context.Background(), tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+
+	ok, err := bucket.Exists(context.Background(), tRepos.Attachments.GetFullPath(path))
+	require.NoError(t, err)
+	return ok
+}
+
+func TestAttachmentRepo_SharedBlobs(t *testing.T) {
+	entities := useEntities(t, 2)
+	ctx := context.Background()
+	content := []byte("manual shared by two entities " + fk.Str(10))
+
+	var atts []*ent.Attachment
+	for _, e := range entities {
+		att, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+			Title:   "manual.txt",
+			Content: bytes.NewReader(content),
+		}, attachment.TypeManual, false)
+		require.NoError(t, err)
+		atts = append(atts, att)
+	}
+	require.Equal(t, atts[0].Path, atts[1].Path)
+
+	rec, err := tRepos.Attachments.db.Blob.Query().Where(entblob.Path(atts[0].Path)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rec.RefCount)
+	assert.Equal(t, int64(len(content)), rec.Size)
+
+	stats, err := tRepos.Groups.StatsGroup(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, stats.StorageUsed, int64(len(content)))
+
+	// Deleting one of the entities keeps the file of the other.
+	require.NoError(t, tRepos.Attachments.Delete(ctx, tGroup.ID, atts[0].ID))
+	_, err = tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, blobExists(t, atts[1].Path))
+	assert.Equal(t, content, readAttachmentContent(t, atts[1].Path))
+
+	// Without references it is kept for the grace period, then collected.
+	require.NoError(t, tRepos.Attachments.Delete(ctx, tGroup.ID, atts[1].ID))
+	_, err = tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(-BlobGracePeriod))
+	require.NoError(t, err)
+	assert.True(t, blobExists(t, atts[1].Path))
+
+	res, err := tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, res.Deleted, 1)
+	assert.False(t, blobExists(t, atts[1].Path))
+}
+
+func TestAttachmentRepo_CollectBlobs_Reconciles(t *testing.T) {
+	e := useEntities(t, 1)[0]
+	ctx := context.Background()
+
+	att, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "receipt.txt",
+		Content: bytes.NewReader([]byte("receipt " + fk.Str(10))),
+	}, attachment.TypeReceipt, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Attachments.Delete(context.Background(), tGroup.ID, att.ID) })
+
+	// A lost record and a count that drifted are both corrected, and the
+	// file is kept.
+	_, err = tRepos.Attachments.db.Blob.Delete().Where(entblob.Path(att.Path)).Exec(ctx)
+	require.NoError(t, err)
+	_, err = tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	rec, err := tRepos.Attachments.db.Blob.Query().Where(entblob.Path(att.Path)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rec.RefCount)
+	assert.Positive(t, rec.Size)
+
+	require.NoError(t, tRepos.Attachments.db.Blob.UpdateOneID(rec.ID).SetRefCount(0).SetUnreferencedAt(time.Now().Add(-24*time.Hour)).Exec(ctx))
+	_, err = tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, blobExists(t, att.Path))
+}
+
+func TestAttachmentRepo_CollectBlobs_DuringUpload(t *testing.T) {
+	e := useEntities(t, 1)[0]
+	ctx := context.Background()
+	content := []byte("uploaded again " + fk.Str(10))
+
+	att, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "warranty.txt",
+		Content: bytes.NewReader(content),
+	}, attachment.TypeWarranty, false)
+	require.NoError(t, err)
+	require.NoError(t, tRepos.Attachments.Delete(ctx, tGroup.ID, att.ID))
+
+	bucket, err := blob.OpenBucket(ctx, tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+
+	// The same content is uploaded again, and the collector removes the
+	// unreferenced file after it is staged but before it is retained.
+	staged, err := tRepos.Attachments.stageFile(ctx, bucket, tGroup.ID, ItemCreateAttachment{
+		Title:   "warranty.txt",
+		Content: bytes.NewReader(content),
+	})
+	require.NoError(t, err)
+	defer tRepos.Attachments.discardStaged(ctx, bucket, staged)
+	require.Equal(t, att.Path, staged.result.Path)
+
+	_, err = tRepos.Attachments.CollectBlobs(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, blobExists(t, att.Path))
+
+	tx, err := tRepos.Attachments.db.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tRepos.Attachments.storeStaged(ctx, bucket, tx.Blob, tGroup.ID, staged)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	assert.Equal(t, content, readAttachmentContent(t, att.Path))
+	rec, err := tRepos.Attachments.db.Blob.Query().Where(entblob.Path(att.Path)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rec.RefCount)
+
+	require.NoError(t, tRepos.Attachments.db.Blob.DeleteOneID(rec.ID).Exec(ctx))
+}
+
+func TestAttachmentRepo_StorageReport(t *testing.T) {
+	e := useEntities(t, 1)[0]
+	ctx := context.Background()
+
+	att, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "photo.txt",
+		Content: bytes.NewReader([]byte("kept " + fk.Str(10))),
+	}, attachment.TypeAttachment, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Attachments.Delete(context.Background(), tGroup.ID, att.ID) })
+
+	orphan, err := tRepos.Attachments.UploadFile(ctx, tRepos.Attachments.db.Blob, &ent.Group{ID: tGroup.ID}, ItemCreateAttachment{
+		Title:   "orphan.txt",
+		Content: bytes.NewReader([]byte("orphan " + fk.Str(10))),
+	})
+	require.NoError(t, err)
+
+	missing, err := tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+		Title:   "lost.txt",
+		Content: bytes.NewReader([]byte("lost " + fk.Str(10))),
+	}, attachment.TypeAttachment, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Attachments.Delete(context.Background(), tGroup.ID, missing.ID) })
+
+	bucket, err := blob.OpenBucket(ctx, tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	require.NoError(t, bucket.Delete(ctx, tRepos.Attachments.GetFullPath(missing.Path)))
+	_ = bucket.Close()
+
+	report, err := tRepos.Attachments.StorageReport(ctx, tGroup.ID)
+	require.NoError(t, err)
+
+	paths := func(blobs []StorageReportBlob) []string {
+		out := make([]string, 0, len(blobs))
+		for _, b := range blobs {
+			out = append(out, b.Path)
+		}
+		return out
+	}
+	assert.Contains(t, paths(report.Orphaned), orphan.Path)
+	assert.NotContains(t, paths(report.Orphaned), att.Path)
+	assert.Contains(t, paths(report.Missing), missing.Path)
+	assert.Positive(t, report.Used)
+}
+
+func TestGroupRepository_GroupDelete_RemovesFiles(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	g, err := tRepos.Groups.GroupCreate(ctx, "delete-"+fk.Str(6), owner.ID)
+	require.NoError(t, err)
+	itemType, err := tRepos.EntityTypes.GetDefault(ctx, g.ID, false)
+	require.NoError(t, err)
+
+	var entities []EntityOut
+	for _, name := range []string{"Kept", "Trashed"} {
+		e, err := tRepos.Entities.Create(ctx, g.ID, EntityCreate{Name: name, EntityTypeID: itemType.ID})
+		require.NoError(t, err)
+		_, err = tRepos.Attachments.Create(ctx, e.ID, ItemCreateAttachment{
+			Title:   name + ".txt",
+			Content: bytes.NewReader([]byte(name + " " + fk.Str(10))),
+		}, attachment.TypeManual, false)
+		require.NoError(t, err)
+		entities = append(entities, e)
+	}
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, g.ID, entities[1].ID))
+
+	up, err := tRepos.Attachments.CreateUpload(ctx, g.ID, entities[0].ID, AttachmentUploadCreate{Title: "video.mp4", Size: 100})
+	require.NoError(t, err)
+	_, err = tRepos.Attachments.WriteUploadChunk(ctx, g.ID, entities[0].ID, up.ID, 0, bytes.NewReader(make([]byte, 50)))
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.Groups.GroupDelete(ctx, g.ID))
+
+	bucket, err := blob.OpenBucket(ctx, tRepos.Attachments.GetConnString())
+	require.NoError(t, err)
+	defer func() { _ = bucket.Close() }()
+
+	var keys []string
+	iter := bucket.List(&blob.ListOptions{Prefix: tRepos.Attachments.GetFullPath(g.ID.String()) + "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if !obj.IsDir {
+			keys = append(keys, obj.Key)
+		}
+	}
+	assert.Empty(t, keys)
+}
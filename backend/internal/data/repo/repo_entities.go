@@ -16,12 +16,15 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfielddefinition"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/tag"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -44,6 +47,7 @@ type EntityRepository struct {
 	db          *ent.Client
 	bus         *eventbus.EventBus
 	attachments *AttachmentRepo
+	audit       *AuditLogRepository
 }
 
 type (
@@ -69,6 +73,8 @@ type (
 		OnlyWithPhoto    bool    `json:"onlyWithPhoto"`
 		IncludeArchived  bool    `json:"includeArchived"`
 		FilterChildren   bool    `json:"filterChildren"` // when true, only return root entities (no parent)
+		OnLoan           bool    `json:"onLoan"`         // when true, only return entities currently lent out
+		Insured          bool    `json:"insured"`        // when true, only return insured entities
 	}
 
 	DuplicateOptions struct {
@@ -85,6 +91,10 @@ type (
 		TextValue    string    `json:"textValue"`
 		NumberValue  int       `json:"numberValue"`
 		BooleanValue bool      `json:"booleanValue"`
+		// DecimalValue is the value of decimal fields and the amount of
+		// currency fields, whose TextValue is the currency code.
+		DecimalValue float64    `json:"decimalValue"`
+		DateValue    types.Date `json:"dateValue"`
 	}
 
 	EntityCreate struct {
@@ -121,6 +131,9 @@ type (
 		PurchaseFrom    string `json:"purchaseFrom"    validate:"max=255"`
 		SoldTo          string `json:"soldTo"          validate:"max=255"`
 		SoldNotes       string `json:"soldNotes"`
+		// Currencies are empty for prices in the group's currency
+		PurchaseCurrency string `json:"purchaseCurrency" validate:"max=16"`
+		SoldCurrency     string `json:"soldCurrency"     validate:"max=16"`
 		// Extras
 		Notes string `json:"notes"`
 		// Edges
@@ -138,6 +151,15 @@ type (
 		SyncChildEntityLocations bool              `json:"syncChildEntityLocations"`
 		// Warranty
 		LifetimeWarranty bool `json:"lifetimeWarranty"`
+		// Stock
+		MinQuantity  *float64 `json:"minQuantity"  extensions:"x-nullable,x-omitempty"`
+		ReorderPoint *float64 `json:"reorderPoint" extensions:"x-nullable,x-omitempty"`
+		Unit         string   `json:"unit"         validate:"max=32"`
+		// Depreciation; unset settings fall back to the entity type
+		DepreciationMethod *entity.DepreciationMethod `json:"depreciationMethod" validate:"omitempty,oneof=none straight_line declining_balance manual" extensions:"x-nullable,x-omitempty"`
+		UsefulLifeYears    *float64                   `json:"usefulLifeYears"    validate:"omitempty,gt=0"                                           extensions:"x-nullable,x-omitempty"`
+		SalvageValue       *float64                   `json:"salvageValue"       validate:"omitempty,gte=0"                                          extensions:"x-nullable,x-omitempty"`
+		ManualValue        *float64                   `json:"manualValue"        validate:"omitempty,gte=0"                                          extensions:"x-nullable,x-omitempty"`
 	}
 
 	EntityPatch struct {
@@ -156,12 +178,15 @@ type (
 		Name        string    `json:"name"`
 		Description string    `json:"description"`
 		Quantity    float64   `json:"quantity"`
+		Unit        string    `json:"unit"`
+		LowStock    bool      `json:"lowStock"`
 		Insured     bool      `json:"insured"`
 		Archived    bool      `json:"archived"`
 		CreatedAt   time.Time `json:"createdAt"`
 		UpdatedAt   time.Time `json:"updatedAt"`
 
-		PurchasePrice float64 `json:"purchasePrice"`
+		PurchasePrice    float64 `json:"purchasePrice"`
+		PurchaseCurrency string  `json:"purchaseCurrency,omitempty"`
 
 		// Edges
 		Parent     *EntitySummary     `json:"parent,omitempty"     extensions:"x-nullable,x-omitempty"`
@@ -204,10 +229,26 @@ type (
 		PurchaseFrom string     `json:"purchaseFrom"`
 
 		// Sold
-		SoldDate  types.Date `json:"soldDate"`
-		SoldTo    string     `json:"soldTo"`
-		SoldPrice float64    `json:"soldPrice"`
-		SoldNotes string     `json:"soldNotes"`
+		SoldDate     types.Date `json:"soldDate"`
+		SoldTo       string     `json:"soldTo"`
+		SoldPrice    float64    `json:"soldPrice"`
+		SoldCurrency string     `json:"soldCurrency,omitempty"`
+		SoldNotes    string     `json:"soldNotes"`
+
+		// Stock
+		MinQuantity  *float64 `json:"minQuantity,omitempty"  extensions:"x-nullable,x-omitempty"`
+		ReorderPoint *float64 `json:"reorderPoint,omitempty" extensions:"x-nullable,x-omitempty"`
+
+		// Depreciation as set on the entity; CurrentValue is the per-unit
+		// value today, with unset settings taken from the entity type
+		DepreciationMethod *entity.DepreciationMethod `json:"depreciationMethod,omitempty" extensions:"x-nullable,x-omitempty"`
+		UsefulLifeYears    *float64                   `json:"usefulLifeYears,omitempty"    extensions:"x-nullable,x-omitempty"`
+		SalvageValue       *float64                   `json:"salvageValue,omitempty"       extensions:"x-nullable,x-omitempty"`
+		ManualValue        *float64                   `json:"manualValue,omitempty"        extensions:"x-nullable,x-omitempty"`
+		CurrentValue       float64                    `json:"currentValue"`
+
+		// Loans, newest first
+		Loans []LoanOut `json:"loans"`
 
 		// Extras
 		Notes string `json:"notes"`
@@ -258,16 +299,19 @@ func mapEntitySummary(e *ent.Entity) EntitySummary {
 	}
 
 	return EntitySummary{
-		ID:            e.ID,
-		AssetID:       AssetID(e.AssetID),
-		Name:          e.Name,
-		Description:   e.Description,
-		ImportRef:     e.ImportRef,
-		Quantity:      e.Quantity,
-		CreatedAt:     e.CreatedAt,
-		UpdatedAt:     e.UpdatedAt,
-		Archived:      e.Archived,
-		PurchasePrice: e.PurchasePrice,
+		ID:               e.ID,
+		AssetID:          AssetID(e.AssetID),
+		Name:             e.Name,
+		Description:      e.Description,
+		ImportRef:        e.ImportRef,
+		Quantity:         e.Quantity,
+		Unit:             e.Unit,
+		LowStock:         lowStock(e.Quantity, e.MinQuantity, e.ReorderPoint),
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+		Archived:         e.Archived,
+		PurchasePrice:    e.PurchasePrice,
+		PurchaseCurrency: e.PurchaseCurrency,
 
 		// Edges
 		Parent:     parent,
@@ -298,10 +342,20 @@ func mapEntityFields(fields []*ent.EntityField) []EntityFieldData {
 			TextValue:    f.TextValue,
 			NumberValue:  f.NumberValue,
 			BooleanValue: f.BooleanValue,
+			DecimalValue: f.DecimalValue,
+			DateValue:    fieldDate(f),
 		}
 	})
 }
 
+// fieldDate is the value of a date field; other fields have none.
+func fieldDate(f *ent.EntityField) types.Date {
+	if f.Type != entityfield.TypeDate || f.TimeValue.Before(minQueryDate) {
+		return types.Date{}
+	}
+	return types.DateFromTime(f.TimeValue)
+}
+
 func mapEntityOut(e *ent.Entity) EntityOut {
 	var attachments []ItemAttachment
 	if e.Edges.Attachments != nil {
@@ -319,6 +373,11 @@ func mapEntityOut(e *ent.Entity) EntityOut {
 		parent = &p
 	}
 
+	loans := []LoanOut{}
+	if e.Edges.Loans != nil {
+		loans = mapEach(e.Edges.Loans, mapLoanOut)
+	}
+
 	var children []EntitySummary
 	if e.Edges.Children != nil {
 		// Only include location-type children (sub-containers), not items
@@ -349,10 +408,24 @@ func mapEntityOut(e *ent.Entity) EntityOut {
 		PurchaseFrom: e.PurchaseFrom,
 
 		// Sold
-		SoldDate:  types.DateFromTime(e.SoldDate),
-		SoldTo:    e.SoldTo,
-		SoldPrice: e.SoldPrice,
-		SoldNotes: e.SoldNotes,
+		SoldDate:     types.DateFromTime(e.SoldDate),
+		SoldTo:       e.SoldTo,
+		SoldPrice:    e.SoldPrice,
+		SoldCurrency: e.SoldCurrency,
+		SoldNotes:    e.SoldNotes,
+
+		// Stock
+		MinQuantity:  e.MinQuantity,
+		ReorderPoint: e.ReorderPoint,
+
+		// Depreciation
+		DepreciationMethod: e.DepreciationMethod,
+		UsefulLifeYears:    e.UsefulLifeYears,
+		SalvageValue:       e.SalvageValue,
+		ManualValue:        e.ManualValue,
+		CurrentValue:       currentValue(e),
+
+		Loans: loans,
 
 		// Extras
 		Notes:       e.Notes,
@@ -410,10 +483,41 @@ func (r *EntityRepository) resolveDefaultEntityType(ctx context.Context, gid uui
 	return et.ID, nil
 }
 
-func (r *EntityRepository) publishMutationEvent(gid uuid.UUID) {
+func (r *EntityRepository) publishMutationEvent(ctx context.Context, gid uuid.UUID, action eventbus.MutationAction, ids ...uuid.UUID) {
 	if r.bus != nil {
-		r.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: gid})
+		r.bus.Publish(eventbus.EventEntityMutation, newMutationEvent(ctx, gid, action, ids))
+	}
+}
+
+// publishUpdateEvent publishes an update of id, or a move when its parent
+// changed from fromParent to toParent.
+func (r *EntityRepository) publishUpdateEvent(ctx context.Context, gid, id, fromParent, toParent uuid.UUID) {
+	if r.bus == nil {
+		return
 	}
+	evt := newMutationEvent(ctx, gid, eventbus.MutationUpdate, []uuid.UUID{id})
+	if fromParent != toParent {
+		evt.Action = eventbus.MutationMove
+		evt.FromParentID = fromParent
+	}
+	r.bus.Publish(eventbus.EventEntityMutation, evt)
+}
+
+// newMutationEvent builds a mutation event attributed to the user on ctx.
+func newMutationEvent(ctx context.Context, gid uuid.UUID, action eventbus.MutationAction, ids []uuid.UUID) eventbus.GroupMutationEvent {
+	return eventbus.GroupMutationEvent{
+		GID:     gid,
+		Action:  action,
+		IDs:     ids,
+		ActorID: auditActorFromCtx(ctx).UserID,
+	}
+}
+
+func entityParentID(e EntityOut) uuid.UUID {
+	if e.Parent == nil {
+		return uuid.Nil
+	}
+	return e.Parent.ID
 }
 
 func (r *EntityRepository) getOneTx(ctx context.Context, tx *ent.Tx, where ...predicate.Entity) (EntityOut, error) {
@@ -443,6 +547,9 @@ func (r *EntityRepository) getOneTx(ctx context.Context, tx *ent.Tx, where ...pr
 			eq.WithEntityType()
 		}).
 		WithAttachments().
+		WithLoans(func(lq *ent.LoanQuery) {
+			lq.Order(ent.Desc(loan.FieldCheckedOutAt))
+		}).
 		Only(ctx)
 	if err != nil {
 		recordSpanError(span, err)
@@ -586,6 +693,8 @@ func entityQuerySpanAttrs(gid uuid.UUID, q EntityQuery) []attribute.KeyValue {
 		attribute.Bool("query.only_without_photo", q.OnlyWithoutPhoto),
 		attribute.Bool("query.include_archived", q.IncludeArchived),
 		attribute.Bool("query.filter_children", q.FilterChildren),
+		attribute.Bool("query.on_loan", q.OnLoan),
+		attribute.Bool("query.insured", q.Insured),
 		attribute.String("query.order_by", q.OrderBy),
 		attribute.Bool("query.is_location.set", isLocSet),
 		attribute.Bool("query.is_location.value", isLocValue),
@@ -599,6 +708,87 @@ func (r *EntityRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q En
 		trace.WithAttributes(entityQuerySpanAttrs(gid, q)...))
 	defer span.End()
 
+	qb, terms, err := r.groupQuery(ctx, gid, q)
+	if err != nil {
+		recordSpanError(span, err)
+		return PaginationResult[EntitySummary]{}, err
+	}
+
+	countCtx, countSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.count")
+	count, err := qb.Count(countCtx)
+	if err != nil {
+		recordSpanError(countSpan, err)
+		countSpan.End()
+		recordSpanError(span, err)
+		return PaginationResult[EntitySummary]{}, err
+	}
+	countSpan.SetAttributes(attribute.Int("query.total.count", count))
+	countSpan.End()
+
+	qb = orderEntityQuery(qb, q.OrderBy, terms)
+
+	qb = qb.
+		WithTag().
+		WithParent().
+		WithEntityType().
+		WithAttachments(func(aq *ent.AttachmentQuery) {
+			aq.Where(
+				attachment.Primary(true),
+			)
+			aq.WithThumbnail()
+		})
+
+	if q.Page != -1 || q.PageSize != -1 {
+		qb = qb.
+			Offset(calculateOffset(q.Page, q.PageSize)).
+			Limit(q.PageSize)
+	}
+
+	fetchCtx, fetchSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.fetch")
+	entities, err := mapEntitiesSummaryErr(qb.All(fetchCtx))
+	if err != nil {
+		recordSpanError(fetchSpan, err)
+		fetchSpan.End()
+		recordSpanError(span, err)
+		return PaginationResult[EntitySummary]{}, err
+	}
+	fetchSpan.SetAttributes(attribute.Int("query.results.count", len(entities)))
+	fetchSpan.End()
+
+	// Populate ItemCount for location-type entities
+	if q.IsLocation != nil && *q.IsLocation && len(entities) > 0 {
+		childCtx, childSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.childItemCounts",
+			trace.WithAttributes(attribute.Int("locations.count", len(entities))))
+		ids := lo.Map(entities, func(e EntitySummary, _ int) uuid.UUID { return e.ID })
+		counts, cErr := r.getChildItemCounts(childCtx, gid, ids)
+		if cErr != nil {
+			recordSpanError(childSpan, cErr)
+		} else {
+			for i := range entities {
+				if c, ok := counts[entities[i].ID]; ok {
+					entities[i].ItemCount = c
+				}
+			}
+		}
+		childSpan.End()
+	}
+
+	span.SetAttributes(
+		attribute.Int("query.results.count", len(entities)),
+		attribute.Int("query.total.count", count),
+	)
+
+	return PaginationResult[EntitySummary]{
+		Page:     q.Page,
+		PageSize: q.PageSize,
+		Total:    count,
+		Items:    entities,
+	}, nil
+}
+
+// groupQuery builds the filtered, unordered entity query for q and returns
+// the parsed search terms so callers can order by relevance.
+func (r *EntityRepository) groupQuery(ctx context.Context, gid uuid.UUID, q EntityQuery) (*ent.EntityQuery, []searchTerm, error) {
 	qb := r.db.Entity.Query().Where(
 		entity.HasGroupWith(group.ID(gid)),
 	)
@@ -622,28 +812,45 @@ func (r *EntityRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q En
 		qb = qb.Where(entity.Not(entity.HasParent()))
 	}
 
-	if q.IncludeArchived {
+	// The search string may carry filters (see repo_entity_query.go); plain
+	// text ANDed at the top level is searched and ranked through the index.
+	expr, err := parseEntityQuery(q.Search)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter, search := splitQueryText(expr)
+
+	compiler := entityQueryCompiler{r: r, gid: gid}
+	if filter != nil {
+		pred, err := compiler.compile(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		qb = qb.Where(pred)
+	}
+
+	switch {
+	case compiler.usesArchived:
+		// The query decides.
+	case q.IncludeArchived:
 		qb = qb.Where(
 			entity.Or(
 				entity.Archived(true),
 				entity.Archived(false),
 			),
 		)
-	} else {
+	default:
 		qb = qb.Where(entity.Archived(false))
 	}
 
-	if q.Search != "" {
-		qb.Where(
-			entity.Or(
-				entity.NameContainsFold(q.Search),
-				entity.DescriptionContainsFold(q.Search),
-				entity.SerialNumberContainsFold(q.Search),
-				entity.ModelNumberContainsFold(q.Search),
-				entity.ManufacturerContainsFold(q.Search),
-				entity.NotesContainsFold(q.Search),
-			),
-		)
+	terms := parseSearchQuery(search)
+	switch {
+	case len(terms) > 0:
+		qb = qb.Where(entitySearchMatch(gid, terms))
+	case search != "":
+		// Nothing indexable (e.g. only punctuation); fall back to substring
+		// matching on the entity's own text.
+		qb = qb.Where(entityTextContains(search))
 	}
 
 	if !q.AssetID.Nil() {
@@ -702,6 +909,14 @@ func (r *EntityRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q En
 			)
 		}
 
+		if q.OnLoan {
+			andPredicates = append(andPredicates, entity.HasLoansWith(loan.ReturnedAtIsNil()))
+		}
+
+		if q.Insured {
+			andPredicates = append(andPredicates, entity.Insured(true))
+		}
+
 		if len(q.ParentIDs) > 0 {
 			parentPredicates := lo.Map(q.ParentIDs, func(l uuid.UUID, _ int) predicate.Entity {
 				return entity.HasParentWith(entity.ID(l))
@@ -730,88 +945,26 @@ func (r *EntityRepository) QueryByGroup(ctx context.Context, gid uuid.UUID, q En
 		qb = qb.Where(entity.And(andPredicates...))
 	}
 
-	span.SetAttributes(attribute.Int("query.predicates.and.count", len(andPredicates)))
-
-	countCtx, countSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.count")
-	count, err := qb.Count(countCtx)
-	if err != nil {
-		recordSpanError(countSpan, err)
-		countSpan.End()
-		recordSpanError(span, err)
-		return PaginationResult[EntitySummary]{}, err
-	}
-	countSpan.SetAttributes(attribute.Int("query.total.count", count))
-	countSpan.End()
+	return qb, terms, nil
+}
 
-	// Order
-	switch q.OrderBy {
+// orderEntityQuery applies an EntityQuery.OrderBy value.
+func orderEntityQuery(qb *ent.EntityQuery, orderBy string, terms []searchTerm) *ent.EntityQuery {
+	switch orderBy {
+	case "relevance":
+		if len(terms) > 0 {
+			return qb.Order(entitySearchRankOrder(), ent.Asc(entity.FieldName))
+		}
+		return qb.Order(ent.Asc(entity.FieldName))
 	case "createdAt":
-		qb = qb.Order(ent.Desc(entity.FieldCreatedAt))
+		return qb.Order(ent.Desc(entity.FieldCreatedAt))
 	case "updatedAt":
-		qb = qb.Order(ent.Desc(entity.FieldUpdatedAt))
+		return qb.Order(ent.Desc(entity.FieldUpdatedAt))
 	case "assetId":
-		qb = qb.Order(ent.Asc(entity.FieldAssetID))
+		return qb.Order(ent.Asc(entity.FieldAssetID))
 	default: // "name"
-		qb = qb.Order(ent.Asc(entity.FieldName))
-	}
-
-	qb = qb.
-		WithTag().
-		WithParent().
-		WithEntityType().
-		WithAttachments(func(aq *ent.AttachmentQuery) {
-			aq.Where(
-				attachment.Primary(true),
-			)
-			aq.WithThumbnail()
-		})
-
-	if q.Page != -1 || q.PageSize != -1 {
-		qb = qb.
-			Offset(calculateOffset(q.Page, q.PageSize)).
-			Limit(q.PageSize)
-	}
-
-	fetchCtx, fetchSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.fetch")
-	entities, err := mapEntitiesSummaryErr(qb.All(fetchCtx))
-	if err != nil {
-		recordSpanError(fetchSpan, err)
-		fetchSpan.End()
-		recordSpanError(span, err)
-		return PaginationResult[EntitySummary]{}, err
+		return qb.Order(ent.Asc(entity.FieldName))
 	}
-	fetchSpan.SetAttributes(attribute.Int("query.results.count", len(entities)))
-	fetchSpan.End()
-
-	// Populate ItemCount for location-type entities
-	if q.IsLocation != nil && *q.IsLocation && len(entities) > 0 {
-		childCtx, childSpan := entityTracer().Start(ctx, "repo.EntityRepository.QueryByGroup.childItemCounts",
-			trace.WithAttributes(attribute.Int("locations.count", len(entities))))
-		ids := lo.Map(entities, func(e EntitySummary, _ int) uuid.UUID { return e.ID })
-		counts, cErr := r.getChildItemCounts(childCtx, gid, ids)
-		if cErr != nil {
-			recordSpanError(childSpan, cErr)
-		} else {
-			for i := range entities {
-				if c, ok := counts[entities[i].ID]; ok {
-					entities[i].ItemCount = c
-				}
-			}
-		}
-		childSpan.End()
-	}
-
-	span.SetAttributes(
-		attribute.Int("query.results.count", len(entities)),
-		attribute.Int("query.total.count", count),
-	)
-
-	return PaginationResult[EntitySummary]{
-		Page:     q.Page,
-		PageSize: q.PageSize,
-		Total:    count,
-		Items:    entities,
-	}, nil
 }
 
 // getChildItemCounts returns a map of entity ID → sum of child item quantities for the given location IDs.
@@ -843,6 +996,7 @@ func (r *EntityRepository) getChildItemCounts(ctx context.Context, gid uuid.UUID
 		WHERE e.group_entities = $1
 			AND et.is_location = false
 			AND e.archived = false
+			AND e.deleted_at IS NULL
 			AND e.entity_children IN (%s)
 		GROUP BY e.entity_children
 	`, strings.Join(placeholders, ","))
@@ -938,6 +1092,42 @@ func (r *EntityRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]EntityO
 	return out, nil
 }
 
+// GetAllMatching returns every entity matching q, in q's order, with the same
+// edges as GetAll. Paging is ignored.
+func (r *EntityRepository) GetAllMatching(ctx context.Context, gid uuid.UUID, q EntityQuery) ([]EntityOut, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.GetAllMatching",
+		trace.WithAttributes(entityQuerySpanAttrs(gid, q)...))
+	defer span.End()
+
+	qb, terms, err := r.groupQuery(ctx, gid, q)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	out, err := mapEntitiesOutErr(orderEntityQuery(qb, q.OrderBy, terms).
+		WithTag().
+		WithParent().
+		WithEntityType().
+		WithFields().
+		All(ctx))
+	if err != nil {
+		recordSpanError(span, err)
+		return out, err
+	}
+	span.SetAttributes(attribute.Int("entities.count", len(out)))
+	return out, nil
+}
+
+// MatchingIDs returns the IDs of the entities matching q. Paging is ignored.
+func (r *EntityRepository) MatchingIDs(ctx context.Context, gid uuid.UUID, q EntityQuery) ([]uuid.UUID, error) {
+	qb, _, err := r.groupQuery(ctx, gid, q)
+	if err != nil {
+		return nil, err
+	}
+	return qb.IDs(ctx)
+}
+
 func (r *EntityRepository) GetAllZeroAssetID(ctx context.Context, gid uuid.UUID) ([]EntitySummary, error) {
 	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.GetAllZeroAssetID",
 		trace.WithAttributes(attribute.String("group.id", gid.String())))
@@ -967,6 +1157,10 @@ func (r *EntityRepository) GetHighestAssetIDTx(ctx context.Context, tx *ent.Tx,
 		))
 	defer span.End()
 
+	// Trashed entities keep their asset ids so a restore can't collide, and
+	// ids are unique across the group even under an entity scope.
+	ctx = withTrashed(withoutEntityScope(ctx))
+
 	var q *ent.EntityQuery
 	if tx != nil {
 		q = tx.Entity.Query().Where(
@@ -1078,17 +1272,16 @@ func (r *EntityRepository) Create(ctx context.Context, gid uuid.UUID, data Entit
 		q.SetParentID(data.ParentID)
 	}
 
-	if data.EntityTypeID != uuid.Nil {
-		q.SetEntityTypeID(data.EntityTypeID)
-	} else {
+	if data.EntityTypeID == uuid.Nil {
 		// Auto-resolve default "Item" entity type for the group
 		etID, err := r.resolveDefaultEntityType(ctx, gid, false)
 		if err != nil {
 			recordSpanError(span, err)
 			return EntityOut{}, err
 		}
-		q.SetEntityTypeID(etID)
+		data.EntityTypeID = etID
 	}
+	q.SetEntityTypeID(data.EntityTypeID)
 
 	if len(data.TagIDs) > 0 {
 		q.AddTagIDs(data.TagIDs...)
@@ -1100,8 +1293,14 @@ func (r *EntityRepository) Create(ctx context.Context, gid uuid.UUID, data Entit
 		return EntityOut{}, err
 	}
 
+	if err := prefillEntityFields(ctx, r.db.EntityFieldDefinition, r.db.EntityField, result.ID, data.EntityTypeID); err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
 	span.SetAttributes(attribute.String("entity.id", result.ID.String()))
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate, result.ID)
+	r.audit.recordBestEffort(ctx, gid, result.ID, result.Name, AuditActionCreate, nil)
 	out, err := r.GetOne(ctx, result.ID)
 	recordSpanError(span, err)
 	return out, err
@@ -1250,6 +1449,16 @@ func (r *EntityRepository) CreateFromTemplate(ctx context.Context, gid uuid.UUID
 		fieldsSpan.End()
 	}
 
+	// Template fields win over the defaults of the entity type.
+	names := make([]string, len(data.Fields))
+	for i, f := range data.Fields {
+		names[i] = f.Name
+	}
+	if err := prefillEntityFields(ctx, tx.EntityFieldDefinition, tx.EntityField, newEntityID, data.EntityTypeID, names...); err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
 	_, commitSpan := entityTracer().Start(ctx, "repo.EntityRepository.CreateFromTemplate.commit")
 	if err = tx.Commit(); err != nil {
 		recordSpanError(commitSpan, err)
@@ -1260,7 +1469,8 @@ func (r *EntityRepository) CreateFromTemplate(ctx context.Context, gid uuid.UUID
 	commitSpan.End()
 	committed = true
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate, newEntityID)
+	r.audit.recordBestEffort(ctx, gid, newEntityID, data.Name, AuditActionCreate, nil)
 	out, err := r.GetOne(ctx, newEntityID)
 	recordSpanError(span, err)
 	return out, err
@@ -1318,10 +1528,16 @@ func (r *EntityRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	deleteSpan.End()
 
-	r.publishMutationEvent(id)
+	if gid != uuid.Nil {
+		r.publishMutationEvent(ctx, gid, eventbus.MutationDelete, id)
+		r.audit.recordBestEffort(ctx, gid, id, e.Name, AuditActionDelete, nil)
+	}
 	return nil
 }
 
+// DeleteByGroup moves an entity and its whole subtree to the group's trash.
+// Attachments and history are kept until the trash is purged; see
+// RestoreFromTrash and PurgeTrash.
 func (r *EntityRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) error {
 	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.DeleteByGroup",
 		trace.WithAttributes(
@@ -1330,53 +1546,15 @@ func (r *EntityRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID)
 		))
 	defer span.End()
 
-	loadCtx, loadSpan := entityTracer().Start(ctx, "repo.EntityRepository.DeleteByGroup.load")
-	e, err := r.db.Entity.Query().
-		Where(
-			entity.ID(id),
-			entity.HasGroupWith(group.ID(gid)),
-		).
-		WithAttachments().
-		Only(loadCtx)
-	if err != nil {
-		recordSpanError(loadSpan, err)
-		loadSpan.End()
-		recordSpanError(span, err)
-		return err
-	}
-	loadSpan.End()
-
-	span.SetAttributes(attribute.Int("entity.attachments.count", len(e.Edges.Attachments)))
-
-	if len(e.Edges.Attachments) > 0 {
-		attCtx, attSpan := entityTracer().Start(ctx, "repo.EntityRepository.DeleteByGroup.attachments",
-			trace.WithAttributes(attribute.Int("attachments.count", len(e.Edges.Attachments))))
-		for _, att := range e.Edges.Attachments {
-			err := r.attachments.Delete(attCtx, gid, att.ID)
-			if err != nil {
-				recordSpanError(attSpan, err)
-				log.Err(err).Str("attachment_id", att.ID.String()).Msg("failed to delete attachment during entity deletion")
-			}
-		}
-		attSpan.End()
-	}
-
-	_, deleteSpan := entityTracer().Start(ctx, "repo.EntityRepository.DeleteByGroup.entity")
-	_, err = r.db.Entity.
-		Delete().
-		Where(
-			entity.ID(id),
-			entity.HasGroupWith(group.ID(gid)),
-		).Exec(ctx)
+	name, trashed, err := r.trashSubtree(ctx, gid, id)
 	if err != nil {
-		recordSpanError(deleteSpan, err)
-		deleteSpan.End()
 		recordSpanError(span, err)
 		return err
 	}
-	deleteSpan.End()
+	span.SetAttributes(attribute.Int("entities.trashed.count", trashed))
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete, id)
+	r.audit.recordBestEffort(ctx, gid, id, name, AuditActionDelete, nil)
 	return nil
 }
 
@@ -1390,6 +1568,9 @@ func (r *EntityRepository) WipeInventory(ctx context.Context, gid uuid.UUID, wip
 		))
 	defer span.End()
 
+	// A wipe is permanent, so it also empties the trash.
+	ctx = withTrashed(ctx)
+
 	deleted := 0
 
 	// Wipe maintenance records if requested
@@ -1451,6 +1632,7 @@ func (r *EntityRepository) WipeInventory(ctx context.Context, gid uuid.UUID, wip
 			continue
 		}
 
+		r.audit.recordBestEffort(entCtx, gid, e.ID, e.Name, AuditActionDelete, nil)
 		entityDeleted++
 	}
 	entSpan.SetAttributes(
@@ -1495,7 +1677,7 @@ func (r *EntityRepository) WipeInventory(ctx context.Context, gid uuid.UUID, wip
 	}
 
 	span.SetAttributes(attribute.Int("deleted.count.total", deleted))
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete)
 	return deleted, nil
 }
 
@@ -1553,6 +1735,31 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 		return EntityOut{}, err
 	}
 
+	// Snapshot the entity before the write so the audit log can record a
+	// per-field diff once the update lands.
+	before, err := r.GetOneByGroup(ctx, gid, data.ID)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
+	// Check the fields against the definitions of the entity's type, the new
+	// one if it changes.
+	typeID := data.EntityTypeID
+	if typeID == uuid.Nil && before.EntityType != nil {
+		typeID = before.EntityType.ID
+	}
+	defs, err := entityFieldDefinitions(ctx, r.db.EntityFieldDefinition, typeID)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+	data.Fields, err = applyFieldDefinitions(defs, data.Fields, time.Now())
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
 	q := r.db.Entity.Update().Where(entity.ID(data.ID), entity.HasGroupWith(group.ID(gid))).
 		SetName(data.Name).
 		SetDescription(data.Description).
@@ -1562,8 +1769,10 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 		SetArchived(data.Archived).
 		SetPurchaseFrom(data.PurchaseFrom).
 		SetPurchasePrice(data.PurchasePrice).
+		SetPurchaseCurrency(strings.ToUpper(data.PurchaseCurrency)).
 		SetSoldTo(data.SoldTo).
 		SetSoldPrice(data.SoldPrice).
+		SetSoldCurrency(strings.ToUpper(data.SoldCurrency)).
 		SetSoldNotes(data.SoldNotes).
 		SetNotes(data.Notes).
 		SetLifetimeWarranty(data.LifetimeWarranty).
@@ -1571,7 +1780,33 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 		SetWarrantyDetails(data.WarrantyDetails).
 		SetQuantity(data.Quantity).
 		SetAssetID(int64(data.AssetID)).
-		SetSyncChildEntityLocations(data.SyncChildEntityLocations)
+		SetSyncChildEntityLocations(data.SyncChildEntityLocations).
+		SetNillableMinQuantity(data.MinQuantity).
+		SetNillableReorderPoint(data.ReorderPoint).
+		SetUnit(data.Unit).
+		SetNillableDepreciationMethod(data.DepreciationMethod).
+		SetNillableUsefulLifeYears(data.UsefulLifeYears).
+		SetNillableSalvageValue(data.SalvageValue).
+		SetNillableManualValue(data.ManualValue)
+
+	if data.MinQuantity == nil {
+		q.ClearMinQuantity()
+	}
+	if data.ReorderPoint == nil {
+		q.ClearReorderPoint()
+	}
+	if data.DepreciationMethod == nil {
+		q.ClearDepreciationMethod()
+	}
+	if data.UsefulLifeYears == nil {
+		q.ClearUsefulLifeYears()
+	}
+	if data.SalvageValue == nil {
+		q.ClearSalvageValue()
+	}
+	if data.ManualValue == nil {
+		q.ClearManualValue()
+	}
 
 	// Date fields are nullable. Writing types.Date{}.Time() would persist
 	// the 0001-01-01 sentinel that ZeroOutTimeFields then has to chase —
@@ -1671,14 +1906,7 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 	// Update Existing Fields
 	for _, f := range data.Fields {
 		if f.ID == uuid.Nil {
-			_, err = r.db.EntityField.Create().
-				SetEntityID(data.ID).
-				SetType(entityfield.Type(f.Type)).
-				SetName(f.Name).
-				SetTextValue(f.TextValue).
-				SetNumberValue(f.NumberValue).
-				SetBooleanValue(f.BooleanValue).
-				Save(fieldsCtx)
+			err = createEntityField(fieldsCtx, r.db.EntityField, data.ID, f)
 			if err != nil {
 				recordSpanError(fieldsSpan, err)
 				fieldsSpan.End()
@@ -1697,7 +1925,11 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 			SetName(f.Name).
 			SetTextValue(f.TextValue).
 			SetNumberValue(f.NumberValue).
+			SetDecimalValue(f.DecimalValue).
 			SetBooleanValue(f.BooleanValue)
+		if f.Type == entityfield.TypeDate.String() {
+			opt.SetTimeValue(f.DateValue.Time())
+		}
 
 		_, err = opt.Save(fieldsCtx)
 		if err != nil {
@@ -1733,12 +1965,19 @@ func (r *EntityRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, dat
 	)
 	fieldsSpan.End()
 
-	r.publishMutationEvent(gid)
+	r.recordStockAdjustment(ctx, r.db.StockMovement, data.ID, before.Quantity, data.Quantity)
+	r.publishUpdateEvent(ctx, gid, data.ID, entityParentID(before), data.ParentID)
+	if crossedThreshold(before.Quantity, data.Quantity, data.MinQuantity, data.ReorderPoint) {
+		r.publishLowStockEvent(ctx, gid, data.ID)
+	}
 	// Fetch the returned record scoped to the caller's group. The update above is
 	// group-scoped and a no-op across tenants, so an unscoped GetOne would return
 	// another group's entity in the response body. GetOneByGroup returns not-found
 	// for a foreign entity, matching the 404 behavior of GET/DELETE.
 	out, err := r.GetOneByGroup(ctx, gid, data.ID)
+	if err == nil {
+		r.audit.recordBestEffort(ctx, gid, data.ID, out.Name, AuditActionUpdate, diffEntities(before, out))
+	}
 	recordSpanError(span, err)
 	return out, err
 }
@@ -1851,6 +2090,14 @@ func (r *EntityRepository) Patch(ctx context.Context, gid, id uuid.UUID, data En
 		}
 	}
 
+	// A foreign, missing or trashed id is not found; any other failed
+	// snapshot only means there is nothing to audit.
+	before, beforeErr := r.GetOneByGroup(ctx, gid, id)
+	if ent.IsNotFound(beforeErr) {
+		recordSpanError(span, beforeErr)
+		return beforeErr
+	}
+
 	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		recordSpanError(span, err)
@@ -1865,11 +2112,8 @@ func (r *EntityRepository) Patch(ctx context.Context, gid, id uuid.UUID, data En
 		}
 	}()
 
-	q := tx.Entity.Update().
-		Where(
-			entity.ID(id),
-			entity.HasGroupWith(group.ID(gid)),
-		)
+	q := tx.Entity.UpdateOneID(id).
+		Where(entity.HasGroupWith(group.ID(gid)))
 
 	if data.ImportRef != nil {
 		q.SetImportRef(*data.ImportRef)
@@ -1909,6 +2153,10 @@ func (r *EntityRepository) Patch(ctx context.Context, gid, id uuid.UUID, data En
 		}
 	}
 
+	if data.Quantity != nil && beforeErr == nil {
+		r.recordStockAdjustment(ctx, tx.StockMovement, id, before.Quantity, *data.Quantity)
+	}
+
 	// A parent change deliberately leaves children alone: they stay attached
 	// to this entity and follow it through the ancestor chain (#1591).
 
@@ -1922,7 +2170,24 @@ func (r *EntityRepository) Patch(ctx context.Context, gid, id uuid.UUID, data En
 	commitSpan.End()
 	committed = true
 
-	r.publishMutationEvent(gid)
+	if beforeErr == nil {
+		to := entityParentID(before)
+		if data.ParentID != uuid.Nil {
+			to = data.ParentID
+		}
+		r.publishUpdateEvent(ctx, gid, id, entityParentID(before), to)
+	} else {
+		r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate, id)
+	}
+	if data.Quantity != nil && beforeErr == nil &&
+		crossedThreshold(before.Quantity, *data.Quantity, before.MinQuantity, before.ReorderPoint) {
+		r.publishLowStockEvent(ctx, gid, id)
+	}
+	if beforeErr == nil {
+		if after, err := r.GetOneByGroup(ctx, gid, id); err == nil {
+			r.audit.recordBestEffort(ctx, gid, id, after.Name, AuditActionUpdate, diffEntities(before, after))
+		}
+	}
 	return nil
 }
 
@@ -1961,6 +2226,33 @@ func (r *EntityRepository) GetAllCustomFieldValues(ctx context.Context, gid uuid
 		return f.Value
 	})
 
+	// The options of select fields come first. Multi-select values combine
+	// options, so only the options are offered.
+	defs, err := r.db.EntityFieldDefinition.Query().
+		Where(
+			entityfielddefinition.Name(name),
+			entityfielddefinition.TypeIn(entityfielddefinition.TypeSelect, entityfielddefinition.TypeMultiSelect),
+			entityfielddefinition.HasEntityTypeWith(entitytype.HasGroupWith(group.ID(gid))),
+		).
+		All(ctx)
+	if err != nil {
+		wrapped := fmt.Errorf("failed to get field options: %w", err)
+		recordSpanError(span, wrapped)
+		return nil, wrapped
+	}
+	if len(defs) > 0 {
+		var options []string
+		multi := false
+		for _, d := range defs {
+			options = append(options, d.Options...)
+			multi = multi || d.Type == entityfielddefinition.TypeMultiSelect
+		}
+		if !multi {
+			options = append(options, valueStrings...)
+		}
+		valueStrings = lo.Uniq(options)
+	}
+
 	span.SetAttributes(attribute.Int("values.count", len(valueStrings)))
 	return valueStrings, nil
 }
@@ -1990,9 +2282,27 @@ func (r *EntityRepository) GetAllCustomFieldNames(ctx context.Context, gid uuid.
 		return nil, wrapped
 	}
 
-	fieldNames := lo.Map(fields, func(f st, _ int) string {
-		return f.Name
-	})
+	// Defined names come first and stand in for other spellings of them.
+	fieldNames, err := r.db.EntityFieldDefinition.Query().
+		Where(entityfielddefinition.HasEntityTypeWith(entitytype.HasGroupWith(group.ID(gid)))).
+		Unique(true).
+		Order(entityfielddefinition.ByName()).
+		Select(entityfielddefinition.FieldName).
+		Strings(ctx)
+	if err != nil {
+		wrapped := fmt.Errorf("failed to get custom field definitions: %w", err)
+		recordSpanError(span, wrapped)
+		return nil, wrapped
+	}
+	defined := make(map[string]bool, len(fieldNames))
+	for _, n := range fieldNames {
+		defined[fieldKey(n)] = true
+	}
+	for _, f := range fields {
+		if !defined[fieldKey(f.Name)] {
+			fieldNames = append(fieldNames, f.Name)
+		}
+	}
 
 	span.SetAttributes(attribute.Int("names.count", len(fieldNames)))
 	return fieldNames, nil
@@ -2223,13 +2533,22 @@ func (r *EntityRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opt
 		SetWarrantyDetails(originalEntity.WarrantyDetails).
 		SetPurchaseFrom(originalEntity.PurchaseFrom).
 		SetPurchasePrice(originalEntity.PurchasePrice).
+		SetPurchaseCurrency(originalEntity.PurchaseCurrency).
 		SetSoldTo(originalEntity.SoldTo).
 		SetSoldPrice(originalEntity.SoldPrice).
+		SetSoldCurrency(originalEntity.SoldCurrency).
 		SetSoldNotes(originalEntity.SoldNotes).
 		SetNotes(originalEntity.Notes).
 		SetInsured(originalEntity.Insured).
 		SetArchived(originalEntity.Archived).
-		SetSyncChildEntityLocations(originalEntity.SyncChildEntityLocations)
+		SetSyncChildEntityLocations(originalEntity.SyncChildEntityLocations).
+		SetNillableMinQuantity(originalEntity.MinQuantity).
+		SetNillableReorderPoint(originalEntity.ReorderPoint).
+		SetUnit(originalEntity.Unit).
+		SetNillableDepreciationMethod(originalEntity.DepreciationMethod).
+		SetNillableUsefulLifeYears(originalEntity.UsefulLifeYears).
+		SetNillableSalvageValue(originalEntity.SalvageValue).
+		SetNillableManualValue(originalEntity.ManualValue)
 
 	// Skip Set on zero dates so the duplicate's nullable date columns end up
 	// NULL rather than the 0001-01-01 sentinel.
@@ -2274,14 +2593,7 @@ func (r *EntityRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opt
 			trace.WithAttributes(attribute.Int("fields.count", len(originalEntity.Fields))))
 		copied := 0
 		for _, field := range originalEntity.Fields {
-			_, err = tx.EntityField.Create().
-				SetEntityID(newEntityID).
-				SetType(entityfield.Type(field.Type)).
-				SetName(field.Name).
-				SetTextValue(field.TextValue).
-				SetNumberValue(field.NumberValue).
-				SetBooleanValue(field.BooleanValue).
-				Save(fieldsCtx)
+			err = createEntityField(fieldsCtx, tx.EntityField, newEntityID, field)
 			if err != nil {
 				recordSpanError(fieldsSpan, err)
 				log.Warn().Err(err).Str("field_name", field.Name).Msg("failed to copy custom field during duplication")
@@ -2321,6 +2633,13 @@ func (r *EntityRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opt
 				log.Warn().Err(err).Str("original_attachment_id", att.ID.String()).Msg("failed to copy attachment during duplication")
 				continue
 			}
+			if !isExternalLink(originalAttachment.MimeType) {
+				_, err = r.attachments.retainBlob(attCtx, tx.Blob, gid, originalAttachment.Path, 0, originalAttachment.MimeType)
+				if err != nil {
+					recordSpanError(attSpan, err)
+					log.Warn().Err(err).Str("original_attachment_id", att.ID.String()).Msg("failed to reference attachment file during duplication")
+				}
+			}
 			copied++
 		}
 		attSpan.SetAttributes(attribute.Int("attachments.copied.count", copied))
@@ -2346,6 +2665,7 @@ func (r *EntityRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opt
 					SetName(entry.Name).
 					SetDescription(entry.Description).
 					SetCost(entry.Cost).
+					SetCostCurrency(entry.CostCurrency).
 					Save(maintCtx)
 				if err != nil {
 					recordSpanError(maintSpan, err)
@@ -2369,7 +2689,8 @@ func (r *EntityRepository) Duplicate(ctx context.Context, gid, id uuid.UUID, opt
 	commitSpan.End()
 	committed = true
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate, newEntityID)
+	r.audit.recordBestEffort(ctx, gid, newEntityID, options.CopyPrefix+originalEntity.Name, AuditActionCreate, nil)
 	out, err := r.GetOne(ctx, newEntityID)
 	recordSpanError(span, err)
 	return out, err
@@ -2409,6 +2730,7 @@ func (r *EntityRepository) GetAllContainers(ctx context.Context, gid uuid.UUID,
 				WHERE
 					child.entity_children = e.id
 					AND child.archived = false
+					AND child.deleted_at IS NULL
 					AND ct.is_location = false
 			) as item_count
 		FROM
@@ -2418,18 +2740,35 @@ func (r *EntityRepository) GetAllContainers(ctx context.Context, gid uuid.UUID,
 		WHERE
 			e.group_entities = $1
 			AND et.is_location = true
+			AND e.deleted_at IS NULL
+			{{ SCOPE }}
 			{{ FILTER_CHILDREN }}
 		ORDER BY
 			e.name ASC
 `
 
-	if filter.FilterChildren {
-		query = strings.Replace(query, "{{ FILTER_CHILDREN }}", "AND e.entity_children IS NULL", 1)
+	// Under an entity scope only visible containers are listed, and the top
+	// level is made of those whose parent isn't visible.
+	args := []any{gid}
+	scope, scopeArgs := entityScopeSQL(ctx, "e.id", 2)
+	parentScope, _ := entityScopeSQL(ctx, "e.entity_children", 2)
+	if scope != "" {
+		query = strings.Replace(query, "{{ SCOPE }}", "AND "+scope, 1)
+		args = append(args, scopeArgs...)
 	} else {
+		query = strings.Replace(query, "{{ SCOPE }}", "", 1)
+	}
+
+	switch {
+	case filter.FilterChildren && scope != "":
+		query = strings.Replace(query, "{{ FILTER_CHILDREN }}", "AND (e.entity_children IS NULL OR NOT ("+parentScope+"))", 1)
+	case filter.FilterChildren:
+		query = strings.Replace(query, "{{ FILTER_CHILDREN }}", "AND e.entity_children IS NULL", 1)
+	default:
 		query = strings.Replace(query, "{{ FILTER_CHILDREN }}", "", 1)
 	}
 
-	rows, err := r.db.Sql().QueryContext(ctx, query, gid)
+	rows, err := r.db.Sql().QueryContext(ctx, query, args...)
 	if err != nil {
 		recordSpanError(span, err)
 		return nil, err
@@ -2563,7 +2902,8 @@ func (r *EntityRepository) CreateContainer(ctx context.Context, gid uuid.UUID, d
 
 	span.SetAttributes(attribute.String("entity.id", result.ID.String()))
 	result.Edges.Group = &ent.Group{ID: gid}
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate, result.ID)
+	r.audit.recordBestEffort(ctx, gid, result.ID, result.Name, AuditActionCreate, nil)
 	return mapEntityOut(result), nil
 }
 
@@ -2602,6 +2942,8 @@ func (r *EntityRepository) UpdateContainer(ctx context.Context, gid, id uuid.UUI
 		validateSpan.End()
 	}
 
+	before, beforeErr := r.GetOneByGroup(ctx, gid, id)
+
 	q := r.db.Entity.Update().
 		Where(
 			entity.ID(id),
@@ -2622,16 +2964,24 @@ func (r *EntityRepository) UpdateContainer(ctx context.Context, gid, id uuid.UUI
 		return EntityOut{}, err
 	}
 
-	r.publishMutationEvent(gid)
+	if beforeErr == nil {
+		r.publishUpdateEvent(ctx, gid, id, entityParentID(before), data.ParentID)
+	} else {
+		r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate, id)
+	}
 	// Scope the returned record to the caller's group (see UpdateByGroup). The update
 	// is group-scoped, so an unscoped GetOne would return a foreign group's entity
 	// when id belongs to another tenant.
 	out, err := r.GetOneByGroup(ctx, gid, id)
+	if err == nil && beforeErr == nil {
+		r.audit.recordBestEffort(ctx, gid, id, out.Name, AuditActionUpdate, diffEntities(before, out))
+	}
 	recordSpanError(span, err)
 	return out, err
 }
 
-// DeleteContainerByGroup deletes a container entity by group.
+// DeleteContainerByGroup moves a container entity and everything inside it to
+// the group's trash.
 func (r *EntityRepository) DeleteContainerByGroup(ctx context.Context, gid, id uuid.UUID) error {
 	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.DeleteContainerByGroup",
 		trace.WithAttributes(
@@ -2640,12 +2990,15 @@ func (r *EntityRepository) DeleteContainerByGroup(ctx context.Context, gid, id u
 		))
 	defer span.End()
 
-	_, err := r.db.Entity.Delete().Where(entity.ID(id), entity.HasGroupWith(group.ID(gid))).Exec(ctx)
+	name, trashed, err := r.trashSubtree(ctx, gid, id)
 	if err != nil {
 		recordSpanError(span, err)
 		return err
 	}
-	r.publishMutationEvent(gid)
+	span.SetAttributes(attribute.Int("entities.trashed.count", trashed))
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete, id)
+	r.audit.recordBestEffort(ctx, gid, id, name, AuditActionDelete, nil)
 	return nil
 }
 
@@ -2698,19 +3051,37 @@ func (r *EntityRepository) PathForEntity(ctx context.Context, gid, entityID uuid
 		FROM entities
 		WHERE id = $1
 		AND group_entities = $2
+		AND deleted_at IS NULL
+		{{ SCOPE }}
 
 		UNION ALL
 
 		SELECT e.id, e.name, e.entity_children
 		FROM entities e
 		JOIN entity_path ep ON e.id = ep.entity_children
+		{{ SCOPE_PARENT }}
 	  )
 
 	  SELECT id, name
 	  FROM entity_path`
 
+	// Under an entity scope the path stops at the last visible ancestor, and
+	// entities outside of it don't exist.
+	args := []any{entityID, gid}
+	scoped := EntityScoped(ctx)
+	if scoped {
+		cond, scopeArgs := entityScopeSQL(ctx, "id", 3)
+		parentCond, _ := entityScopeSQL(ctx, "e.id", 3)
+		query = strings.ReplaceAll(query, "{{ SCOPE }}", "AND "+cond)
+		query = strings.ReplaceAll(query, "{{ SCOPE_PARENT }}", "WHERE "+parentCond)
+		args = append(args, scopeArgs...)
+	} else {
+		query = strings.ReplaceAll(query, "{{ SCOPE }}", "")
+		query = strings.ReplaceAll(query, "{{ SCOPE_PARENT }}", "")
+	}
+
 	queryCtx, querySpan := entityTracer().Start(ctx, "repo.EntityRepository.PathForEntity.query")
-	rows, err := r.db.Sql().QueryContext(queryCtx, query, entityID, gid)
+	rows, err := r.db.Sql().QueryContext(queryCtx, query, args...)
 	if err != nil {
 		recordSpanError(querySpan, err)
 		querySpan.End()
@@ -2742,6 +3113,10 @@ func (r *EntityRepository) PathForEntity(ctx context.Context, gid, entityID uuid
 	querySpan.SetAttributes(attribute.Int("path.depth", len(path)))
 	querySpan.End()
 
+	if scoped && len(path) == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+
 	// Reverse the order so that the root is first
 	mutable.Reverse(path)
 
@@ -2749,6 +3124,47 @@ func (r *EntityRepository) PathForEntity(ctx context.Context, gid, entityID uuid
 	return path, nil
 }
 
+// AncestorIDs returns ids together with every ancestor of them. Trashed
+// entities are included so the result is still meaningful for an entity that
+// was just deleted.
+func (r *EntityRepository) AncestorIDs(ctx context.Context, gid uuid.UUID, ids ...uuid.UUID) ([]uuid.UUID, error) {
+	ctx = withTrashed(ctx)
+
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	frontier := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == uuid.Nil {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+		frontier = append(frontier, id)
+	}
+
+	for depth := 0; len(frontier) > 0 && depth < maxAncestorDepth; depth++ {
+		parents, err := r.db.Entity.Query().
+			Where(entity.IDIn(frontier...), entity.HasGroupWith(group.ID(gid))).
+			QueryParent().
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = frontier[:0]
+		for _, p := range parents {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			out = append(out, p)
+			frontier = append(frontier, p)
+		}
+	}
+
+	return out, nil
+}
+
 func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery) ([]TreeItem, error) {
 	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.Tree",
 		trace.WithAttributes(
@@ -2767,9 +3183,10 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 					CASE WHEN et.is_location THEN 'location' ELSE 'item' END AS node_type
 			FROM    entities e
 			JOIN    entity_types et ON et.id = e.entity_type_entities
-			WHERE   e.entity_children IS NULL
+			WHERE   {{ ROOTS }}
 			AND     e.group_entities = $1
 			AND     et.is_location = true
+			AND     e.deleted_at IS NULL
 
 			UNION ALL
 			SELECT  c.id,
@@ -2783,6 +3200,7 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 			ON     c.entity_children = p.id
 			WHERE  level < 10 -- prevent infinite loop & excessive recursion
 			AND    ct.is_location = true
+			AND    c.deleted_at IS NULL
 		){{ WITH_ITEMS }}
 
 		SELECT   id,
@@ -2812,6 +3230,7 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 			FROM    entities e
 			JOIN    entity_types et ON et.id = e.entity_type_entities
 			WHERE   et.is_location = false
+			AND     e.deleted_at IS NULL
 			AND     e.entity_children IN (SELECT id FROM entity_tree)
 
 			UNION ALL
@@ -2826,6 +3245,7 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 			JOIN    item_tree p
 			ON      c.entity_children = p.id
 			WHERE   ct.is_location = false
+			AND     c.deleted_at IS NULL
 			AND     level < 10 -- prevent infinite loop & excessive recursion
 		)`
 
@@ -2841,8 +3261,33 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 		query = strings.ReplaceAll(query, "{{ WITH_ITEMS_FROM }}", "")
 	}
 
+	// Under an entity scope the tree starts at the visible entities whose
+	// parent isn't, instead of the top-level locations.
+	args := []any{gid}
+	scoped := EntityScoped(ctx)
+	roots := set.New[uuid.UUID]()
+	if scoped {
+		ids, err := entityScopeRoots(ctx, r.db, gid)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return []TreeItem{}, nil
+		}
+		placeholders := make([]string, len(ids))
+		for i, id := range ids {
+			placeholders[i] = fmt.Sprintf("$%d", i+2)
+			args = append(args, id)
+			roots.Insert(id)
+		}
+		query = strings.ReplaceAll(query, "{{ ROOTS }}", "e.id IN ("+strings.Join(placeholders, ", ")+")")
+	} else {
+		query = strings.ReplaceAll(query, "{{ ROOTS }}", "e.entity_children IS NULL")
+	}
+
 	queryCtx, querySpan := entityTracer().Start(ctx, "repo.EntityRepository.Tree.query")
-	rows, err := r.db.Sql().QueryContext(queryCtx, query, gid)
+	rows, err := r.db.Sql().QueryContext(queryCtx, query, args...)
 	if err != nil {
 		recordSpanError(querySpan, err)
 		querySpan.End()
@@ -2860,6 +3305,9 @@ func (r *EntityRepository) Tree(ctx context.Context, gid uuid.UUID, tq TreeQuery
 			recordSpanError(span, err)
 			return nil, err
 		}
+		if scoped && roots.Contains(item.ID) {
+			item.ParentID = uuid.Nil
+		}
 		flatItems = append(flatItems, item)
 	}
 
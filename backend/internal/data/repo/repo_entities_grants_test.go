@@ -0,0 +1,252 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
+)
+
+// TestEntityRepository_MemberLocationGrants covers a member limited to one
+// location of a shared collection, such as a tenant who should only see their
+// own apartment. Everything outside the grant must behave as if it belonged to
+// another tenant: not listed, not counted, and not reachable by ID.
+func TestEntityRepository_MemberLocationGrants(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	house, err := tRepos.Groups.GroupCreate(ctx, "grants-"+fk.Str(6), owner.ID)
+	require.NoError(t, err)
+
+	locType, err := tRepos.EntityTypes.GetDefault(ctx, house.ID, true)
+	require.NoError(t, err)
+	itemType, err := tRepos.EntityTypes.GetDefault(ctx, house.ID, false)
+	require.NoError(t, err)
+
+	create := func(name string, typeID, parentID uuid.UUID) EntityOut {
+		t.Helper()
+		out, err := tRepos.Entities.Create(ctx, house.ID, EntityCreate{Name: name, EntityTypeID: typeID, ParentID: parentID})
+		require.NoError(t, err)
+		return out
+	}
+
+	building := create("Building", locType.ID, uuid.Nil)
+	apt1 := create("Apartment 1", locType.ID, building.ID)
+	apt2 := create("Apartment 2", locType.ID, building.ID)
+	kitchen := create("Kitchen", locType.ID, apt2.ID)
+	kettle := create("Kettle", itemType.ID, kitchen.ID)
+	sofa := create("Sofa", itemType.ID, apt2.ID)
+	tv := create("TV", itemType.ID, apt1.ID)
+
+	tenant := addGroupMember(t, house.ID, usergroup.RoleContributor)
+	require.NoError(t, tRepos.Groups.SetMemberLocations(ctx, house.ID, tenant, []uuid.UUID{apt2.ID}))
+
+	grants, err := tRepos.Groups.MemberLocations(ctx, house.ID, tenant)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{apt2.ID}, grants)
+	scoped := WithEntityScope(ctx, grants...)
+
+	t.Run("query", func(t *testing.T) {
+		res, err := tRepos.Entities.QueryByGroup(scoped, house.ID, EntityQuery{Page: -1, PageSize: -1})
+		require.NoError(t, err)
+		names := make([]string, len(res.Items))
+		for i, e := range res.Items {
+			names[i] = e.Name
+		}
+		assert.ElementsMatch(t, []string{"Kettle", "Sofa"}, names)
+		assert.Equal(t, 2, res.Total)
+
+		_, err = tRepos.Entities.GetOneByGroup(scoped, house.ID, tv.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+
+	t.Run("tree", func(t *testing.T) {
+		tree, err := tRepos.Entities.Tree(scoped, house.ID, TreeQuery{WithItems: true})
+		require.NoError(t, err)
+		require.Len(t, tree, 1)
+		assert.Equal(t, apt2.ID, tree[0].ID)
+		assert.Len(t, tree[0].Children, 2)
+	})
+
+	t.Run("path", func(t *testing.T) {
+		path, err := tRepos.Entities.PathForEntity(scoped, house.ID, kettle.ID)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, len(path))
+		for i, p := range path {
+			ids[i] = p.ID
+		}
+		assert.Equal(t, []uuid.UUID{apt2.ID, kitchen.ID, kettle.ID}, ids)
+
+		_, err = tRepos.Entities.PathForEntity(scoped, house.ID, tv.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+
+	t.Run("containers", func(t *testing.T) {
+		top, err := tRepos.Entities.GetAllContainers(scoped, house.ID, ContainerQuery{FilterChildren: true})
+		require.NoError(t, err)
+		require.Len(t, top, 1)
+		assert.Equal(t, apt2.ID, top[0].ID)
+
+		all, err := tRepos.Entities.GetAllContainers(scoped, house.ID, ContainerQuery{})
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	t.Run("statistics", func(t *testing.T) {
+		stats, err := tRepos.Groups.StatsGroup(scoped, house.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.TotalItems)
+		assert.Equal(t, 2, stats.TotalLocations)
+
+		full, err := tRepos.Groups.StatsGroup(ctx, house.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, full.TotalItems)
+		assert.Equal(t, 4, full.TotalLocations)
+	})
+
+	t.Run("history", func(t *testing.T) {
+		feed, err := tRepos.AuditLog.GetByGroup(scoped, house.ID, -1, -1)
+		require.NoError(t, err)
+		names := make([]string, len(feed.Items))
+		for i, e := range feed.Items {
+			names[i] = e.EntityName
+		}
+		assert.Contains(t, names, "Kettle")
+		assert.NotContains(t, names, "TV")
+		assert.NotContains(t, names, "Apartment 1")
+
+		hidden, err := tRepos.AuditLog.GetByEntity(scoped, house.ID, tv.ID, -1, -1)
+		require.NoError(t, err)
+		assert.Empty(t, hidden.Items)
+		assert.Zero(t, hidden.Total)
+
+		visible, err := tRepos.AuditLog.GetByEntity(scoped, house.ID, sofa.ID, -1, -1)
+		require.NoError(t, err)
+		assert.NotEmpty(t, visible.Items)
+
+		// An API key pinned to the kitchen narrows it further.
+		keyed, err := tRepos.AuditLog.GetByEntity(WithEntityScope(scoped, kitchen.ID), house.ID, sofa.ID, -1, -1)
+		require.NoError(t, err)
+		assert.Empty(t, keyed.Items)
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		_, err := tRepos.Entities.Create(scoped, house.ID, EntityCreate{Name: "Lamp", EntityTypeID: itemType.ID, ParentID: apt1.ID})
+		assert.True(t, ent.IsNotFound(err))
+		_, err = tRepos.Entities.Create(scoped, house.ID, EntityCreate{Name: "Lamp", EntityTypeID: itemType.ID, ParentID: kitchen.ID})
+		require.NoError(t, err)
+
+		_ = tRepos.Entities.DeleteByGroup(scoped, house.ID, tv.ID)
+		_, err = tRepos.Entities.GetOneByGroup(ctx, house.ID, tv.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("stacked with an api key", func(t *testing.T) {
+		// A key limited to the kitchen only narrows the member's grant, and a
+		// key for another apartment sees nothing at all.
+		res, err := tRepos.Entities.QueryByGroup(WithEntityScope(scoped, kitchen.ID), house.ID, EntityQuery{Page: -1, PageSize: -1})
+		require.NoError(t, err)
+		assert.Equal(t, 2, res.Total) // the kettle and the lamp
+
+		tree, err := tRepos.Entities.Tree(WithEntityScope(scoped, apt1.ID), house.ID, TreeQuery{WithItems: true})
+		require.NoError(t, err)
+		assert.Empty(t, tree)
+	})
+
+	t.Run("multiple grants", func(t *testing.T) {
+		both := WithEntityScope(ctx, apt1.ID, kitchen.ID)
+		tree, err := tRepos.Entities.Tree(both, house.ID, TreeQuery{})
+		require.NoError(t, err)
+		roots := []uuid.UUID{tree[0].ID, tree[1].ID}
+		assert.ElementsMatch(t, []uuid.UUID{apt1.ID, kitchen.ID}, roots)
+
+		_, err = tRepos.Entities.GetOneByGroup(both, house.ID, sofa.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
+
+func TestGroupRepository_SetMemberLocations(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	house, err := tRepos.Groups.GroupCreate(ctx, "grants-"+fk.Str(6), owner.ID)
+	require.NoError(t, err)
+	locType, err := tRepos.EntityTypes.GetDefault(ctx, house.ID, true)
+	require.NoError(t, err)
+	itemType, err := tRepos.EntityTypes.GetDefault(ctx, house.ID, false)
+	require.NoError(t, err)
+
+	garage, err := tRepos.Entities.Create(ctx, house.ID, EntityCreate{Name: "Garage", EntityTypeID: locType.ID})
+	require.NoError(t, err)
+	drill, err := tRepos.Entities.Create(ctx, house.ID, EntityCreate{Name: "Drill", EntityTypeID: itemType.ID, ParentID: garage.ID})
+	require.NoError(t, err)
+	foreignType, err := tRepos.EntityTypes.GetDefault(ctx, tGroup.ID, true)
+	require.NoError(t, err)
+	foreign, err := tRepos.Entities.Create(ctx, tGroup.ID, EntityCreate{Name: "Shed", EntityTypeID: foreignType.ID})
+	require.NoError(t, err)
+
+	member := addGroupMember(t, house.ID, usergroup.RoleEditor)
+
+	// Items and other collections' locations can't be granted.
+	err = tRepos.Groups.SetMemberLocations(ctx, house.ID, member, []uuid.UUID{drill.ID})
+	assert.True(t, ent.IsNotFound(err))
+	err = tRepos.Groups.SetMemberLocations(ctx, house.ID, member, []uuid.UUID{foreign.ID})
+	assert.True(t, ent.IsNotFound(err))
+
+	// Owners always see everything.
+	err = tRepos.Groups.SetMemberLocations(ctx, house.ID, owner.ID, []uuid.UUID{garage.ID})
+	require.ErrorIs(t, err, ErrOwnerUnrestricted)
+
+	require.NoError(t, tRepos.Groups.SetMemberLocations(ctx, house.ID, member, []uuid.UUID{garage.ID, garage.ID}))
+	members, err := tRepos.Groups.GetMembers(ctx, house.ID)
+	require.NoError(t, err)
+	for _, m := range members {
+		if m.ID == member {
+			assert.Equal(t, []uuid.UUID{garage.ID}, m.LocationIDs)
+		} else {
+			assert.Empty(t, m.LocationIDs)
+		}
+	}
+
+	// Promotion to owner lifts the limit, as does an empty list.
+	require.NoError(t, tRepos.Groups.SetMemberRole(ctx, house.ID, member, usergroup.RoleOwner))
+	grants, err := tRepos.Groups.MemberLocations(ctx, house.ID, member)
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+
+	require.NoError(t, tRepos.Groups.SetMemberRole(ctx, house.ID, member, usergroup.RoleEditor))
+	require.NoError(t, tRepos.Groups.SetMemberLocations(ctx, house.ID, member, []uuid.UUID{garage.ID}))
+	require.NoError(t, tRepos.Groups.SetMemberLocations(ctx, house.ID, member, nil))
+	grants, err = tRepos.Groups.MemberLocations(ctx, house.ID, member)
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+}
+
+// addGroupMember invites a new user to gid with role and returns their ID.
+func addGroupMember(t *testing.T, gid uuid.UUID, role usergroup.Role) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+
+	invitation, err := tRepos.Groups.InvitationCreate(ctx, gid, GroupInvitationCreate{
+		Token:     []byte(fk.Str(32)),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Uses:      1,
+		Role:      role,
+	})
+	require.NoError(t, err)
+	token, err := tClient.GroupInvitationToken.Get(ctx, invitation.ID)
+	require.NoError(t, err)
+
+	usr, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	_, err = tRepos.Groups.InvitationAccept(ctx, token.Token, usr.ID)
+	require.NoError(t, err)
+	return usr.ID
+}
@@ -114,6 +114,26 @@ func TestEntityRepository_RecursiveRelationships(t *testing.T) {
 	}
 }
 
+func TestEntityRepository_AncestorIDs(t *testing.T) {
+	child := useEntities(t, 1)[0]
+	require.NotNil(t, child.Parent)
+
+	other := useEntities(t, 1)[0]
+
+	ids, err := tRepos.Entities.AncestorIDs(context.Background(), tGroup.ID, child.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{child.ID, child.Parent.ID}, ids)
+
+	// Another group sees nothing above the entity itself.
+	ids, err = tRepos.Entities.AncestorIDs(context.Background(), uuid.New(), child.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{child.ID}, ids)
+
+	ids, err = tRepos.Entities.AncestorIDs(context.Background(), tGroup.ID, child.ID, other.ID, child.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{child.ID, child.Parent.ID, other.ID, other.Parent.ID}, ids)
+}
+
 func TestEntityRepository_GetOne(t *testing.T) {
 	entities := useEntities(t, 3)
 
@@ -634,7 +654,14 @@ func TestEntityRepository_DeleteByGroupWithAttachments(t *testing.T) {
 	_, err = tRepos.Entities.GetOneByGroup(context.Background(), tGroup.ID, e.ID)
 	require.Error(t, err)
 
-	// Verify the attachment is also deleted
+	// The entity is only in the trash, so its attachment is kept for a restore
+	_, err = tRepos.Attachments.Get(context.Background(), tGroup.ID, att.ID)
+	require.NoError(t, err)
+
+	// Purging the trash removes the attachment for good
+	_, err = tRepos.Entities.PurgeTrash(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
 	_, err = tRepos.Attachments.Get(context.Background(), tGroup.ID, att.ID)
 	require.Error(t, err)
 }
@@ -0,0 +1,317 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type includeTrashedKey struct{}
+
+// withTrashed returns a context under which entity queries also see
+// soft-deleted rows. Only the trash endpoints, the purge task and the few
+// places that must account for every row (asset id allocation, wipes) use it.
+func withTrashed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeTrashedKey{}, true)
+}
+
+// entityTrashInterceptor hides soft-deleted entities from every ent query on
+// the Entity client, including eager-loaded edges. Raw SQL in this package
+// filters on deleted_at explicitly.
+func entityTrashInterceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if v, _ := ctx.Value(includeTrashedKey{}).(bool); v {
+			return nil
+		}
+		if eq, ok := q.(*ent.EntityQuery); ok {
+			eq.Where(entity.DeletedAtIsNil())
+		}
+		return nil
+	})
+}
+
+// entityTrashHook keeps updates away from soft-deleted entities, which
+// entityTrashInterceptor only hides from queries. An update of a trashed
+// entity matches nothing, so UpdateOne fails with a not found error. Restoring
+// uses withTrashed to reach them.
+func entityTrashHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if v, _ := ctx.Value(includeTrashedKey{}).(bool); v || !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+			if em, ok := m.(*ent.EntityMutation); ok {
+				em.Where(entity.DeletedAtIsNil())
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+type TrashEntry struct {
+	ID         uuid.UUID          `json:"id"`
+	Name       string             `json:"name"`
+	DeletedAt  time.Time          `json:"deletedAt"`
+	EntityType *EntityTypeSummary `json:"entityType,omitempty" extensions:"x-nullable,x-omitempty"`
+	Parent     *EntitySummary     `json:"parent,omitempty"     extensions:"x-nullable,x-omitempty"`
+	// Descendants is the number of entities trashed together with this one.
+	Descendants int `json:"descendants"`
+}
+
+// trashSubtree soft-deletes id and every live descendant in a single
+// transaction. All rows share trash_root_id = id so they can be restored or
+// purged together. Returns the root's name and the number of rows moved to
+// the trash.
+func (r *EntityRepository) trashSubtree(ctx context.Context, gid, id uuid.UUID) (string, int, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction while trashing entity")
+			}
+		}
+	}()
+
+	root, err := tx.Entity.Query().
+		Where(entity.ID(id), entity.HasGroupWith(group.ID(gid))).
+		Only(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ids := []uuid.UUID{id}
+	seen := map[uuid.UUID]struct{}{id: {}}
+	frontier := ids
+	for len(frontier) > 0 {
+		children, err := tx.Entity.Query().
+			Where(
+				entity.HasGroupWith(group.ID(gid)),
+				entity.HasParentWith(entity.IDIn(frontier...)),
+			).
+			IDs(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+		frontier = frontier[:0:0]
+		for _, c := range children {
+			if _, ok := seen[c]; ok {
+				continue
+			}
+			seen[c] = struct{}{}
+			frontier = append(frontier, c)
+		}
+		ids = append(ids, frontier...)
+	}
+
+	n, err := tx.Entity.Update().
+		Where(entity.IDIn(ids...), entity.HasGroupWith(group.ID(gid))).
+		SetDeletedAt(time.Now()).
+		SetTrashRootID(id).
+		Save(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", 0, err
+	}
+	committed = true
+	return root.Name, n, nil
+}
+
+// GetTrash lists the entities a user deleted in gid, newest first. Only the
+// roots are listed; descendants that went to the trash with them are counted
+// in Descendants.
+func (r *EntityRepository) GetTrash(ctx context.Context, gid uuid.UUID) ([]TrashEntry, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.GetTrash",
+		trace.WithAttributes(attribute.String("group.id", gid.String())))
+	defer span.End()
+
+	ctx = withTrashed(ctx)
+
+	roots, err := r.db.Entity.Query().
+		Where(
+			entity.HasGroupWith(group.ID(gid)),
+			entity.DeletedAtNotNil(),
+			entity.TrashRootIDNotNil(),
+			predicate.Entity(func(s *sql.Selector) {
+				s.Where(sql.ColumnsEQ(s.C(entity.FieldID), s.C(entity.FieldTrashRootID)))
+			}),
+		).
+		WithEntityType().
+		WithParent().
+		Order(ent.Desc(entity.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	out := make([]TrashEntry, 0, len(roots))
+	for _, e := range roots {
+		count, err := r.db.Entity.Query().
+			Where(entity.TrashRootID(e.ID), entity.IDNEQ(e.ID)).
+			Count(ctx)
+		if err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+
+		entry := TrashEntry{
+			ID:          e.ID,
+			Name:        e.Name,
+			DeletedAt:   *e.DeletedAt,
+			Descendants: count,
+		}
+		if e.Edges.EntityType != nil {
+			et := mapEntityTypeSummary(e.Edges.EntityType)
+			entry.EntityType = &et
+		}
+		if e.Edges.Parent != nil {
+			p := mapEntitySummary(e.Edges.Parent)
+			entry.Parent = &p
+		}
+		out = append(out, entry)
+	}
+
+	span.SetAttributes(attribute.Int("trash.count", len(out)))
+	return out, nil
+}
+
+// RestoreFromTrash brings back a trashed entity and everything trashed with
+// it. If the original parent is itself in the trash (or gone), the entity is
+// restored at the top level rather than under an invisible parent.
+func (r *EntityRepository) RestoreFromTrash(ctx context.Context, gid, id uuid.UUID) (EntityOut, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.RestoreFromTrash",
+		trace.WithAttributes(
+			attribute.String("group.id", gid.String()),
+			attribute.String("entity.id", id.String()),
+		))
+	defer span.End()
+
+	trashedCtx := withTrashed(ctx)
+
+	root, err := r.db.Entity.Query().
+		Where(
+			entity.ID(id),
+			entity.HasGroupWith(group.ID(gid)),
+			entity.DeletedAtNotNil(),
+			entity.TrashRootID(id),
+		).
+		WithParent().
+		Only(trashedCtx)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during trash restore")
+			}
+		}
+	}()
+
+	n, err := tx.Entity.Update().
+		Where(entity.TrashRootID(id), entity.HasGroupWith(group.ID(gid))).
+		ClearDeletedAt().
+		ClearTrashRootID().
+		Save(trashedCtx)
+	if err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+
+	if p := root.Edges.Parent; p != nil && p.DeletedAt != nil {
+		if err := tx.Entity.UpdateOneID(id).ClearParent().Exec(trashedCtx); err != nil {
+			recordSpanError(span, err)
+			return EntityOut{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		recordSpanError(span, err)
+		return EntityOut{}, err
+	}
+	committed = true
+
+	span.SetAttributes(attribute.Int("entities.restored.count", n))
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate, id)
+	r.audit.recordBestEffort(ctx, gid, id, root.Name, AuditActionUpdate, []AuditChange{
+		{Field: "deletedAt", OldValue: root.DeletedAt.Format(time.RFC3339)},
+	})
+
+	out, err := r.GetOneByGroup(ctx, gid, id)
+	recordSpanError(span, err)
+	return out, err
+}
+
+// PurgeTrash permanently deletes every entity that has been in the trash
+// since before the cutoff, along with its attachments. It is run by the
+// purge-trash recurring task and spans all groups.
+func (r *EntityRepository) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.PurgeTrash",
+		trace.WithAttributes(attribute.String("cutoff", before.Format(time.RFC3339))))
+	defer span.End()
+
+	ctx = withTrashed(ctx)
+
+	rows, err := r.db.Entity.Query().
+		Where(entity.DeletedAtNotNil(), entity.DeletedAtLT(before)).
+		WithGroup().
+		WithAttachments().
+		All(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return 0, err
+	}
+
+	purged := 0
+	for _, e := range rows {
+		var gid uuid.UUID
+		if e.Edges.Group != nil {
+			gid = e.Edges.Group.ID
+		}
+
+		for _, att := range e.Edges.Attachments {
+			if err := r.attachments.Delete(ctx, gid, att.ID); err != nil {
+				recordSpanError(span, err)
+				log.Err(err).Str("attachment_id", att.ID.String()).Msg("failed to delete attachment during trash purge")
+			}
+		}
+
+		if err := r.db.Entity.DeleteOneID(e.ID).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			recordSpanError(span, err)
+			log.Err(err).Str("entity_id", e.ID.String()).Msg("failed to purge trashed entity")
+			continue
+		}
+		purged++
+	}
+
+	span.SetAttributes(attribute.Int("entities.purged.count", purged))
+	return purged, nil
+}
@@ -0,0 +1,164 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+)
+
+func TestEntityRepository_TrashSubtreeAndRestore(t *testing.T) {
+	ctx := context.Background()
+	entities := useEntities(t, 2)
+	container := entities[0].Parent
+	require.NotNil(t, container)
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, container.ID))
+
+	// The container and everything inside it are hidden.
+	_, err := tRepos.Entities.GetOneByGroup(ctx, tGroup.ID, container.ID)
+	require.Error(t, err)
+	for _, e := range entities {
+		_, err := tRepos.Entities.GetOneByGroup(ctx, tGroup.ID, e.ID)
+		require.Error(t, err)
+	}
+
+	results, err := tRepos.Entities.QueryByGroup(ctx, tGroup.ID, EntityQuery{ParentIDs: []uuid.UUID{container.ID}})
+	require.NoError(t, err)
+	assert.Empty(t, results.Items)
+
+	// Only the deleted root is listed; its children are counted.
+	trash, err := tRepos.Entities.GetTrash(ctx, tGroup.ID)
+	require.NoError(t, err)
+	var entry *TrashEntry
+	for i := range trash {
+		if trash[i].ID == container.ID {
+			entry = &trash[i]
+		}
+		assert.NotEqual(t, entities[0].ID, trash[i].ID)
+	}
+	require.NotNil(t, entry)
+	assert.Equal(t, 2, entry.Descendants)
+
+	// Children can't be restored on their own.
+	_, err = tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, entities[0].ID)
+	require.Error(t, err)
+
+	restored, err := tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, container.ID)
+	require.NoError(t, err)
+	assert.Equal(t, container.ID, restored.ID)
+
+	for _, e := range entities {
+		got, err := tRepos.Entities.GetOneByGroup(ctx, tGroup.ID, e.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.Parent)
+		assert.Equal(t, container.ID, got.Parent.ID)
+	}
+}
+
+func TestEntityRepository_RestoreUnderTrashedParent(t *testing.T) {
+	ctx := context.Background()
+	entities := useEntities(t, 1)
+	child := entities[0]
+	container := child.Parent
+
+	// Child first, then its container: two separate trash entries.
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, child.ID))
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, container.ID))
+
+	restored, err := tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, child.ID)
+	require.NoError(t, err)
+	assert.Nil(t, restored.Parent, "restoring under a trashed parent must detach the entity")
+
+	// The container is still in the trash.
+	_, err = tRepos.Entities.GetOneByGroup(ctx, tGroup.ID, container.ID)
+	require.Error(t, err)
+}
+
+func TestEntityRepository_PurgeTrashRespectsCutoff(t *testing.T) {
+	ctx := context.Background()
+	e := useEntities(t, 1)[0]
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, e.ID))
+
+	_, err := tRepos.Entities.PurgeTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	// Still restorable: it was trashed after the cutoff.
+	_, err = tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, e.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, e.ID))
+	_, err = tRepos.Entities.PurgeTrash(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	_, err = tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, e.ID)
+	require.Error(t, err)
+}
+
+func TestEntityRepository_TrashIsGroupScoped(t *testing.T) {
+	ctx := context.Background()
+	e := useEntities(t, 1)[0]
+
+	other, err := tRepos.Groups.GroupCreate(ctx, "trash-other-"+fk.Str(6), uuid.Nil)
+	require.NoError(t, err)
+
+	require.Error(t, tRepos.Entities.DeleteByGroup(ctx, other.ID, e.ID))
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, e.ID))
+
+	trash, err := tRepos.Entities.GetTrash(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	_, err = tRepos.Entities.RestoreFromTrash(ctx, other.ID, e.ID)
+	require.Error(t, err)
+}
+
+func TestEntityRepository_TrashedEntitiesAreReadOnly(t *testing.T) {
+	ctx := context.Background()
+	entities := useEntities(t, 2)
+	e, other := entities[0], entities[1]
+
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, tGroup.ID, e.ID))
+
+	qty := e.Quantity + 5
+	err := tRepos.Entities.Patch(ctx, tGroup.ID, e.ID, EntityPatch{
+		Quantity: &qty,
+		ParentID: other.ID,
+		TagIDs:   []uuid.UUID{},
+	})
+	require.Error(t, err)
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = tRepos.Entities.UpdateByGroup(ctx, tGroup.ID, EntityUpdate{
+		ID:           e.ID,
+		Name:         "renamed in the trash",
+		EntityTypeID: e.EntityType.ID,
+		Quantity:     qty,
+	})
+	require.Error(t, err)
+
+	// Bulk updates skip it too.
+	n, err := tClient.Entity.Update().Where(entity.ID(e.ID)).SetQuantity(qty).Save(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	restored, err := tRepos.Entities.RestoreFromTrash(ctx, tGroup.ID, e.ID)
+	require.NoError(t, err)
+	assert.Equal(t, e.Name, restored.Name)
+	assert.Equal(t, e.Quantity, restored.Quantity)
+	require.NotNil(t, restored.Parent)
+	assert.Equal(t, e.Parent.ID, restored.Parent.ID)
+
+	history, err := tRepos.AuditLog.GetByEntity(ctx, tGroup.ID, e.ID, -1, -1)
+	require.NoError(t, err)
+	for _, h := range history.Items {
+		assert.NotEqual(t, "quantity", h.Field)
+	}
+}
@@ -0,0 +1,167 @@
+package repo
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+)
+
+const daysPerYear = 365.25
+
+// depreciation is the schedule an entity's value follows, resolved from the
+// entity and its type.
+type depreciation struct {
+	method  entity.DepreciationMethod
+	life    float64 // useful life in years
+	salvage float64
+	manual  *float64
+}
+
+// entityDepreciation resolves the schedule of e. Each setting the entity
+// leaves unset falls back to its entity type, when that edge is loaded.
+func entityDepreciation(e *ent.Entity) depreciation {
+	d := depreciation{method: entity.DepreciationMethodNone, manual: e.ManualValue}
+
+	if et := e.Edges.EntityType; et != nil {
+		if et.DepreciationMethod != nil {
+			d.method = entity.DepreciationMethod(*et.DepreciationMethod)
+		}
+		d.life = orDefault(et.UsefulLifeYears, 0)
+		d.salvage = orDefault(et.SalvageValue, 0)
+	}
+
+	if e.DepreciationMethod != nil {
+		d.method = *e.DepreciationMethod
+	}
+	if e.UsefulLifeYears != nil {
+		d.life = *e.UsefulLifeYears
+	}
+	if e.SalvageValue != nil {
+		d.salvage = *e.SalvageValue
+	}
+	return d
+}
+
+// valueAt returns the value at time at of one unit bought for price on
+// purchased. Straight-line loses the same amount every year, declining
+// balance loses twice the straight-line rate of what is left every year;
+// both stop at the salvage value once the useful life is up. Without a
+// purchase date or a useful life there is nothing to depreciate from and the
+// price is kept.
+func (d depreciation) valueAt(price float64, purchased, at time.Time) float64 {
+	switch d.method {
+	case entity.DepreciationMethodManual:
+		return orDefault(d.manual, price)
+	case entity.DepreciationMethodStraightLine, entity.DepreciationMethodDecliningBalance:
+	default:
+		return price
+	}
+
+	if d.life <= 0 || purchased.IsZero() || !at.After(purchased) {
+		return price
+	}
+
+	salvage := math.Max(0, math.Min(d.salvage, price))
+	years := at.Sub(purchased).Hours() / 24 / daysPerYear
+
+	var value float64
+	if d.method == entity.DepreciationMethodStraightLine {
+		value = price - (price-salvage)*years/d.life
+	} else {
+		rate := math.Min(2/d.life, 1)
+		value = price * math.Pow(1-rate, years)
+	}
+	return math.Max(value, salvage)
+}
+
+// currentValue is the per-unit value of e today, in the currency of its
+// purchase price.
+func currentValue(e *ent.Entity) float64 {
+	return entityDepreciation(e).valueAt(e.PurchasePrice, e.PurchaseDate, time.Now())
+}
+
+// purchasePrice is the per-unit purchase price of e in the group's currency.
+func purchasePrice(conv *CurrencyConverter, e *ent.Entity) float64 {
+	return conv.Convert(e.PurchasePrice, e.PurchaseCurrency, e.PurchaseDate)
+}
+
+// valuedEntities returns the items of gid counted in value statistics, with
+// their entity types loaded.
+func (r *GroupRepository) valuedEntities(ctx context.Context, gid uuid.UUID) ([]*ent.Entity, error) {
+	return r.db.Entity.Query().
+		Where(
+			entity.HasGroupWith(group.ID(gid)),
+			entity.Archived(false),
+			entity.HasEntityTypeWith(entitytype.IsLocation(false)),
+		).
+		WithEntityType().
+		All(ctx)
+}
+
+// valueOn returns the total value of entities on day at in the group's
+// currency. An entity counts from its purchase date, or its creation without
+// one, until it is sold.
+func valueOn(entities []*ent.Entity, conv *CurrencyConverter, at time.Time) float64 {
+	var total float64
+	for _, e := range entities {
+		since := e.PurchaseDate
+		if since.IsZero() {
+			since = e.CreatedAt
+		}
+		if since.After(at) || (!e.SoldDate.IsZero() && !e.SoldDate.After(at)) {
+			continue
+		}
+		total += entityDepreciation(e).valueAt(purchasePrice(conv, e), e.PurchaseDate, at) * e.Quantity
+	}
+	return total
+}
+
+// valueStep picks the spacing of a value series between start and end:
+// daily up to three months, weekly up to two years and monthly beyond.
+func valueStep(start, end time.Time) func(time.Time) time.Time {
+	switch span := end.Sub(start); {
+	case span <= 92*24*time.Hour:
+		return func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case span <= 2*366*24*time.Hour:
+		return func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	default:
+		return func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	}
+}
+
+// StatsValue returns the depreciated value of the group's items over time,
+// sampled between start and end. Sold and archived items are left out.
+func (r *GroupRepository) StatsValue(ctx context.Context, gid uuid.UUID, start, end time.Time) (*ValueOverTime, error) {
+	conv, err := newCurrencyConverter(ctx, r.db, gid)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := r.valuedEntities(ctx, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := ValueOverTime{
+		PriceAtStart: valueOn(entities, conv, start),
+		PriceAtEnd:   valueOn(entities, conv, end),
+		Start:        start,
+		End:          end,
+		Entries:      []ValueOverTimeEntry{},
+	}
+
+	next := valueStep(start, end)
+	for t := start; !t.After(end); t = next(t) {
+		stats.Entries = append(stats.Entries, ValueOverTimeEntry{Date: t, Value: valueOn(entities, conv, t)})
+	}
+	if n := len(stats.Entries); n == 0 || stats.Entries[n-1].Date.Before(end) {
+		stats.Entries = append(stats.Entries, ValueOverTimeEntry{Date: end, Value: stats.PriceAtEnd})
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestDepreciation_ValueAt(t *testing.T) {
+	purchased := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	manual := 250.0
+
+	tests := []struct {
+		name  string
+		d     depreciation
+		years float64
+		want  float64
+	}{
+		{"none", depreciation{method: entity.DepreciationMethodNone, life: 5}, 3, 1000},
+		{"straight line", depreciation{method: entity.DepreciationMethodStraightLine, life: 5, salvage: 100}, 2, 640},
+		{"straight line past life", depreciation{method: entity.DepreciationMethodStraightLine, life: 5, salvage: 100}, 8, 100},
+		{"declining balance", depreciation{method: entity.DepreciationMethodDecliningBalance, life: 5}, 2, 360},
+		{"declining balance floor", depreciation{method: entity.DepreciationMethodDecliningBalance, life: 5, salvage: 400}, 2, 400},
+		{"manual", depreciation{method: entity.DepreciationMethodManual, manual: &manual}, 1, 250},
+		{"manual unset", depreciation{method: entity.DepreciationMethodManual}, 1, 1000},
+		{"no useful life", depreciation{method: entity.DepreciationMethodStraightLine}, 2, 1000},
+		{"before purchase", depreciation{method: entity.DepreciationMethodStraightLine, life: 5}, -1, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := purchased.Add(time.Duration(tt.years * daysPerYear * 24 * float64(time.Hour)))
+			assert.InDelta(t, tt.want, tt.d.valueAt(1000, purchased, at), 0.01)
+		})
+	}
+
+	assert.InDelta(t, 1000, depreciation{method: entity.DepreciationMethodStraightLine, life: 5}.valueAt(1000, time.Time{}, purchased), 0)
+}
+
+func TestGroupRepository_StatsValue(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+
+	method := entitytype.DepreciationMethodStraightLine
+	life := 4.0
+	tools, err := tRepos.EntityTypes.Create(ctx, gid, EntityTypeCreate{Name: "Tools", DepreciationMethod: &method, UsefulLifeYears: &life})
+	require.NoError(t, err)
+
+	bought := time.Now().AddDate(-2, 0, 0)
+	drill, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Drill", EntityTypeID: tools.ID})
+	require.NoError(t, err)
+	update := stockUpdate(drill)
+	update.Quantity = 1
+	update.PurchasePrice = 400
+	update.PurchaseDate = types.DateFromTime(bought)
+	drill, err = tRepos.Entities.UpdateByGroup(ctx, gid, update)
+	require.NoError(t, err)
+
+	// The entity inherits straight-line over four years from its type.
+	assert.InDelta(t, 200, drill.CurrentValue, 1)
+
+	manual := entity.DepreciationMethodManual
+	value := 50.0
+	painting, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Painting", EntityTypeID: itemType})
+	require.NoError(t, err)
+	update = stockUpdate(painting)
+	update.Quantity = 2
+	update.PurchasePrice = 10
+	update.DepreciationMethod = &manual
+	update.ManualValue = &value
+	painting, err = tRepos.Entities.UpdateByGroup(ctx, gid, update)
+	require.NoError(t, err)
+	assert.InDelta(t, 50, painting.CurrentValue, 0)
+
+	stats, err := tRepos.Groups.StatsGroup(ctx, gid)
+	require.NoError(t, err)
+	assert.InDelta(t, 300, stats.TotalCurrentValue, 1)
+
+	series, err := tRepos.Groups.StatsValue(ctx, gid, bought.AddDate(0, 0, -1), time.Now())
+	require.NoError(t, err)
+	assert.Greater(t, len(series.Entries), 100)
+	assert.Equal(t, series.End, series.Entries[len(series.Entries)-1].Date)
+	// Nothing was bought yet at the start; the painting was only added today.
+	assert.InDelta(t, 0, series.PriceAtStart, 0)
+	assert.InDelta(t, 300, series.PriceAtEnd, 1)
+	assert.InDelta(t, 400, series.Entries[1].Value, 2)
+}
@@ -0,0 +1,570 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfielddefinition"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+type (
+	EntityFieldDefinitionCreate struct {
+		Name        string `json:"name"         validate:"required,min=1,max=255"`
+		Description string `json:"description"  validate:"max=1000"`
+		Type        string `json:"type"         validate:"required,oneof=text number decimal boolean date url email select multi_select currency"`
+		// Options are the choices of select and multi_select fields.
+		Options  []string `json:"options"`
+		Required bool     `json:"required"`
+		// DefaultValue is prefilled into new entities, written like the value
+		// of a text field: "12.5", "true", "2024-12-31" or "today", "A, B" for
+		// multi_select and "0 EUR" for currency.
+		DefaultValue string `json:"defaultValue" validate:"max=500"`
+		Position     int    `json:"position"`
+	}
+
+	EntityFieldDefinitionUpdate struct {
+		ID           uuid.UUID `json:"id"`
+		Name         string    `json:"name"         validate:"required,min=1,max=255"`
+		Description  string    `json:"description"  validate:"max=1000"`
+		Type         string    `json:"type"         validate:"required,oneof=text number decimal boolean date url email select multi_select currency"`
+		Options      []string  `json:"options"`
+		Required     bool      `json:"required"`
+		DefaultValue string    `json:"defaultValue" validate:"max=500"`
+		Position     int       `json:"position"`
+	}
+
+	EntityFieldDefinitionOut struct {
+		ID           uuid.UUID `json:"id"`
+		EntityTypeID uuid.UUID `json:"entityTypeId"`
+		Name         string    `json:"name"`
+		Description  string    `json:"description"`
+		Type         string    `json:"type"`
+		Options      []string  `json:"options"`
+		Required     bool      `json:"required"`
+		DefaultValue string    `json:"defaultValue"`
+		Position     int       `json:"position"`
+		CreatedAt    time.Time `json:"createdAt"`
+		UpdatedAt    time.Time `json:"updatedAt"`
+	}
+)
+
+// EntityFieldError reports a custom field value, or a field definition, that
+// is rejected.
+type EntityFieldError struct {
+	Field string
+	Msg   string
+}
+
+// EntityFieldErrors are all the rejected fields of one write.
+type EntityFieldErrors []EntityFieldError
+
+func (e EntityFieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fmt.Sprintf("field %q %s", fe.Field, fe.Msg)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func mapEntityFieldDefinition(d *ent.EntityFieldDefinition) EntityFieldDefinitionOut {
+	options := d.Options
+	if options == nil {
+		options = []string{}
+	}
+	return EntityFieldDefinitionOut{
+		ID:           d.ID,
+		EntityTypeID: d.EntityTypeID,
+		Name:         d.Name,
+		Description:  d.Description,
+		Type:         d.Type.String(),
+		Options:      options,
+		Required:     d.Required,
+		DefaultValue: d.DefaultValue,
+		Position:     d.Position,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
+
+// entityFieldDefinitions returns the field definitions of entity type
+// typeID in order.
+func entityFieldDefinitions(ctx context.Context, c *ent.EntityFieldDefinitionClient, typeID uuid.UUID) ([]*ent.EntityFieldDefinition, error) {
+	return c.Query().
+		Where(entityfielddefinition.EntityTypeID(typeID)).
+		Order(entityfielddefinition.ByPosition(), entityfielddefinition.ByName()).
+		All(ctx)
+}
+
+func (r *EntityTypeRepository) assertFieldDefinitionType(ctx context.Context, gid, typeID uuid.UUID) error {
+	if typeID == uuid.Nil {
+		return &ent.NotFoundError{}
+	}
+	return assertEntityTypeInGroup(ctx, r.db.EntityType, gid, typeID)
+}
+
+// GetFieldDefinitions returns the field definitions of an entity type.
+func (r *EntityTypeRepository) GetFieldDefinitions(ctx context.Context, gid, typeID uuid.UUID) ([]EntityFieldDefinitionOut, error) {
+	if err := r.assertFieldDefinitionType(ctx, gid, typeID); err != nil {
+		return nil, err
+	}
+
+	defs, err := entityFieldDefinitions(ctx, r.db.EntityFieldDefinition, typeID)
+	if err != nil {
+		return nil, err
+	}
+	return mapEach(defs, mapEntityFieldDefinition), nil
+}
+
+// CreateFieldDefinition adds a field definition to an entity type. Existing
+// entities get the field when they are next updated.
+func (r *EntityTypeRepository) CreateFieldDefinition(ctx context.Context, gid, typeID uuid.UUID, data EntityFieldDefinitionCreate) (EntityFieldDefinitionOut, error) {
+	if err := r.assertFieldDefinitionType(ctx, gid, typeID); err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	data.Name = strings.TrimSpace(data.Name)
+	options, err := checkFieldDefinition(data.Name, data.Type, data.Options, data.DefaultValue)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	def, err := r.db.EntityFieldDefinition.Create().
+		SetEntityTypeID(typeID).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetType(entityfielddefinition.Type(data.Type)).
+		SetOptions(options).
+		SetRequired(data.Required).
+		SetDefaultValue(data.DefaultValue).
+		SetPosition(data.Position).
+		Save(ctx)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, fieldDefinitionSaveError(data.Name, err)
+	}
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate)
+	return mapEntityFieldDefinition(def), nil
+}
+
+// UpdateFieldDefinition changes a field definition. Renaming it renames the
+// field on the entities of the type too.
+func (r *EntityTypeRepository) UpdateFieldDefinition(ctx context.Context, gid, typeID uuid.UUID, data EntityFieldDefinitionUpdate) (EntityFieldDefinitionOut, error) {
+	if err := r.assertFieldDefinitionType(ctx, gid, typeID); err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	before, err := r.db.EntityFieldDefinition.Query().
+		Where(entityfielddefinition.ID(data.ID), entityfielddefinition.EntityTypeID(typeID)).
+		Only(ctx)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	data.Name = strings.TrimSpace(data.Name)
+	options, err := checkFieldDefinition(data.Name, data.Type, data.Options, data.DefaultValue)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	def, err := tx.EntityFieldDefinition.UpdateOne(before).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetType(entityfielddefinition.Type(data.Type)).
+		SetOptions(options).
+		SetRequired(data.Required).
+		SetDefaultValue(data.DefaultValue).
+		SetPosition(data.Position).
+		Save(ctx)
+	if err != nil {
+		return EntityFieldDefinitionOut{}, fieldDefinitionSaveError(data.Name, err)
+	}
+
+	if before.Name != data.Name {
+		err = tx.EntityField.Update().
+			Where(
+				entityfield.Name(before.Name),
+				entityfield.HasEntityWith(entity.HasEntityTypeWith(entitytype.ID(typeID))),
+			).
+			SetName(data.Name).
+			Exec(ctx)
+		if err != nil {
+			return EntityFieldDefinitionOut{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return EntityFieldDefinitionOut{}, err
+	}
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate)
+	return mapEntityFieldDefinition(def), nil
+}
+
+// DeleteFieldDefinition removes a field definition. The fields of the
+// entities of the type are kept as free-form fields.
+func (r *EntityTypeRepository) DeleteFieldDefinition(ctx context.Context, gid, typeID, id uuid.UUID) error {
+	if err := r.assertFieldDefinitionType(ctx, gid, typeID); err != nil {
+		return err
+	}
+
+	n, err := r.db.EntityFieldDefinition.Delete().
+		Where(
+			entityfielddefinition.ID(id),
+			entityfielddefinition.EntityTypeID(typeID),
+			entityfielddefinition.HasEntityTypeWith(entitytype.HasGroupWith(group.ID(gid))),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate)
+	return nil
+}
+
+func fieldDefinitionSaveError(name string, err error) error {
+	if ent.IsConstraintError(err) {
+		return EntityFieldErrors{{Field: name, Msg: "is already defined for this entity type"}}
+	}
+	return err
+}
+
+// checkFieldDefinition validates a definition and returns its options
+// trimmed.
+func checkFieldDefinition(name, typ string, options []string, defaultValue string) ([]string, error) {
+	var errs EntityFieldErrors
+	choice := typ == entityfielddefinition.TypeSelect.String() || typ == entityfielddefinition.TypeMultiSelect.String()
+
+	trimmed := make([]string, 0, len(options))
+	for _, o := range options {
+		o = strings.TrimSpace(o)
+		switch {
+		case o == "":
+			errs = append(errs, EntityFieldError{Field: name, Msg: "has an empty option"})
+		case slices.ContainsFunc(trimmed, func(t string) bool { return strings.EqualFold(t, o) }):
+			errs = append(errs, EntityFieldError{Field: name, Msg: fmt.Sprintf("has the option %q more than once", o)})
+		case typ == entityfielddefinition.TypeMultiSelect.String() && strings.Contains(o, ","):
+			errs = append(errs, EntityFieldError{Field: name, Msg: fmt.Sprintf("option %q can't contain a comma", o)})
+		default:
+			trimmed = append(trimmed, o)
+		}
+	}
+	switch {
+	case choice && len(options) == 0:
+		errs = append(errs, EntityFieldError{Field: name, Msg: "needs at least one option"})
+	case !choice && len(options) > 0:
+		errs = append(errs, EntityFieldError{Field: name, Msg: "can only have options when it is a select or multi_select field"})
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	def := &ent.EntityFieldDefinition{Name: name, Type: entityfielddefinition.Type(typ), Options: trimmed, DefaultValue: defaultValue}
+	if _, err := defaultField(def, time.Now()); err != nil {
+		return nil, EntityFieldErrors{{Field: name, Msg: "has an invalid default: " + err.Error()}}
+	}
+	return trimmed, nil
+}
+
+// applyFieldDefinitions checks fields against the definitions of their
+// entity type. A field named like a definition, ignoring case, takes the
+// definition's name and type; a value of another type is converted through
+// its text. Missing required fields are added from their default.
+func applyFieldDefinitions(defs []*ent.EntityFieldDefinition, fields []EntityFieldData, now time.Time) ([]EntityFieldData, error) {
+	if len(defs) == 0 {
+		return fields, nil
+	}
+
+	byName := make(map[string]*ent.EntityFieldDefinition, len(defs))
+	for _, d := range defs {
+		byName[fieldKey(d.Name)] = d
+	}
+
+	var errs EntityFieldErrors
+	seen := make(map[uuid.UUID]bool, len(defs))
+	out := make([]EntityFieldData, 0, len(fields))
+	for _, f := range fields {
+		def, ok := byName[fieldKey(f.Name)]
+		if !ok {
+			out = append(out, f)
+			continue
+		}
+		if seen[def.ID] {
+			errs = append(errs, EntityFieldError{Field: def.Name, Msg: "is set more than once"})
+			continue
+		}
+		seen[def.ID] = true
+
+		conformed, err := conformField(def, f, now)
+		if err != nil {
+			errs = append(errs, EntityFieldError{Field: def.Name, Msg: err.Error()})
+			continue
+		}
+		if def.Required && fieldEmpty(conformed) {
+			errs = append(errs, EntityFieldError{Field: def.Name, Msg: "is required"})
+			continue
+		}
+		out = append(out, conformed)
+	}
+
+	for _, def := range defs {
+		if seen[def.ID] || !def.Required {
+			continue
+		}
+		f, err := defaultField(def, now)
+		if err != nil || fieldEmpty(f) {
+			errs = append(errs, EntityFieldError{Field: def.Name, Msg: "is required"})
+			continue
+		}
+		out = append(out, f)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return out, nil
+}
+
+// defaultField is the field def prefills into new entities.
+func defaultField(def *ent.EntityFieldDefinition, now time.Time) (EntityFieldData, error) {
+	return conformField(def, EntityFieldData{
+		Type:      entityfield.TypeText.String(),
+		Name:      def.Name,
+		TextValue: def.DefaultValue,
+	}, now)
+}
+
+// conformField returns f as a field of def: converted to its type, with its
+// value checked and normalized.
+func conformField(def *ent.EntityFieldDefinition, f EntityFieldData, now time.Time) (EntityFieldData, error) {
+	out := EntityFieldData{ID: f.ID, Type: def.Type.String(), Name: def.Name}
+	if f.Type == def.Type.String() {
+		out.TextValue = strings.TrimSpace(f.TextValue)
+		out.NumberValue = f.NumberValue
+		out.DecimalValue = f.DecimalValue
+		out.BooleanValue = f.BooleanValue
+		out.DateValue = f.DateValue
+	} else if err := parseFieldText(def, fieldText(f), now, &out); err != nil {
+		return EntityFieldData{}, err
+	}
+
+	switch def.Type {
+	case entityfielddefinition.TypeDecimal, entityfielddefinition.TypeCurrency:
+		if math.IsNaN(out.DecimalValue) || math.IsInf(out.DecimalValue, 0) {
+			return EntityFieldData{}, fmt.Errorf("must be a finite number")
+		}
+	}
+
+	if out.TextValue == "" {
+		return out, nil
+	}
+	switch def.Type {
+	case entityfielddefinition.TypeURL:
+		u, err := url.Parse(out.TextValue)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return EntityFieldData{}, fmt.Errorf("must be a URL like https://example.com")
+		}
+	case entityfielddefinition.TypeEmail:
+		a, err := mail.ParseAddress(out.TextValue)
+		if err != nil || a.Address != out.TextValue {
+			return EntityFieldData{}, fmt.Errorf("must be an email address")
+		}
+	case entityfielddefinition.TypeSelect:
+		option, ok := matchOption(def.Options, out.TextValue)
+		if !ok {
+			return EntityFieldData{}, fmt.Errorf("must be one of %s", strings.Join(def.Options, ", "))
+		}
+		out.TextValue = option
+	case entityfielddefinition.TypeMultiSelect:
+		var chosen []string
+		for _, v := range strings.Split(out.TextValue, ",") {
+			if v = strings.TrimSpace(v); v == "" {
+				continue
+			}
+			option, ok := matchOption(def.Options, v)
+			if !ok {
+				return EntityFieldData{}, fmt.Errorf("can only contain %s", strings.Join(def.Options, ", "))
+			}
+			chosen = append(chosen, option)
+		}
+		// In the order of the options, once each.
+		out.TextValue = strings.Join(slices.DeleteFunc(slices.Clone(def.Options), func(o string) bool {
+			return !slices.Contains(chosen, o)
+		}), ", ")
+	case entityfielddefinition.TypeCurrency:
+		code := strings.ToUpper(out.TextValue)
+		if len(code) != 3 || strings.ContainsFunc(code, func(r rune) bool { return r < 'A' || r > 'Z' }) {
+			return EntityFieldData{}, fmt.Errorf("must have a three-letter currency code like EUR")
+		}
+		out.TextValue = code
+	}
+	return out, nil
+}
+
+// parseFieldText sets the value of out, a field of def, from its text form.
+func parseFieldText(def *ent.EntityFieldDefinition, s string, now time.Time, out *EntityFieldData) error {
+	s = strings.TrimSpace(s)
+	switch def.Type {
+	case entityfielddefinition.TypeNumber:
+		if s == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("must be a whole number")
+		}
+		out.NumberValue = n
+	case entityfielddefinition.TypeDecimal:
+		if s == "" {
+			return nil
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		out.DecimalValue = n
+	case entityfielddefinition.TypeBoolean:
+		if s == "" {
+			return nil
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		out.BooleanValue = b
+	case entityfielddefinition.TypeDate:
+		switch {
+		case s == "":
+		case strings.EqualFold(s, "today"):
+			out.DateValue = types.DateFromTime(now)
+		default:
+			t, err := time.Parse("2006-01-02", s)
+			if err != nil {
+				return fmt.Errorf("must be a date like 2024-12-31")
+			}
+			out.DateValue = types.DateFromTime(t)
+		}
+	case entityfielddefinition.TypeCurrency:
+		// An amount, a code or both, in either order.
+		for _, part := range strings.Fields(s) {
+			if n, err := strconv.ParseFloat(part, 64); err == nil {
+				out.DecimalValue = n
+			} else {
+				out.TextValue = part
+			}
+		}
+	default:
+		out.TextValue = s
+	}
+	return nil
+}
+
+// fieldText is the text form of the value of f.
+func fieldText(f EntityFieldData) string {
+	switch entityfield.Type(f.Type) {
+	case entityfield.TypeNumber:
+		return strconv.Itoa(f.NumberValue)
+	case entityfield.TypeDecimal:
+		return strconv.FormatFloat(f.DecimalValue, 'f', -1, 64)
+	case entityfield.TypeBoolean:
+		return strconv.FormatBool(f.BooleanValue)
+	case entityfield.TypeDate:
+		return f.DateValue.String()
+	case entityfield.TypeCurrency:
+		return strings.TrimSpace(strconv.FormatFloat(f.DecimalValue, 'f', -1, 64) + " " + f.TextValue)
+	default:
+		return f.TextValue
+	}
+}
+
+// fieldEmpty reports whether f has no value. Numbers and booleans always
+// have one.
+func fieldEmpty(f EntityFieldData) bool {
+	switch entityfield.Type(f.Type) {
+	case entityfield.TypeNumber, entityfield.TypeDecimal, entityfield.TypeBoolean:
+		return false
+	case entityfield.TypeDate:
+		return f.DateValue.Time().IsZero()
+	default:
+		return f.TextValue == ""
+	}
+}
+
+func matchOption(options []string, v string) (string, bool) {
+	for _, o := range options {
+		if strings.EqualFold(o, v) {
+			return o, true
+		}
+	}
+	return "", false
+}
+
+func fieldKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// createEntityField adds f to entity entityID.
+func createEntityField(ctx context.Context, c *ent.EntityFieldClient, entityID uuid.UUID, f EntityFieldData) error {
+	q := c.Create().
+		SetEntityID(entityID).
+		SetType(entityfield.Type(f.Type)).
+		SetName(f.Name).
+		SetTextValue(f.TextValue).
+		SetNumberValue(f.NumberValue).
+		SetDecimalValue(f.DecimalValue).
+		SetBooleanValue(f.BooleanValue)
+	if f.Type == entityfield.TypeDate.String() {
+		q.SetTimeValue(f.DateValue.Time())
+	}
+	_, err := q.Save(ctx)
+	return err
+}
+
+// prefillEntityFields adds the fields defined for entity type typeID to a new
+// entity, with their defaults, except those named in skip.
+func prefillEntityFields(ctx context.Context, defs *ent.EntityFieldDefinitionClient, fields *ent.EntityFieldClient, entityID, typeID uuid.UUID, skip ...string) error {
+	all, err := entityFieldDefinitions(ctx, defs, typeID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, def := range all {
+		if slices.ContainsFunc(skip, func(name string) bool { return fieldKey(name) == fieldKey(def.Name) }) {
+			continue
+		}
+		f, err := defaultField(def, now)
+		if err != nil {
+			// Defaults are checked when saved; an empty field is still useful.
+			f = EntityFieldData{Type: def.Type.String(), Name: def.Name}
+		}
+		if err := createEntityField(ctx, fields, entityID, f); err != nil {
+			return fmt.Errorf("failed to prefill field %s: %w", def.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfielddefinition"
+)
+
+func useFieldEntityType(t *testing.T) EntityTypeSummary {
+	t.Helper()
+
+	et, err := tRepos.EntityTypes.Create(context.Background(), tGroup.ID, EntityTypeCreate{Name: fk.Str(10)})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tRepos.EntityTypes.Delete(context.Background(), tGroup.ID, et.ID)
+	})
+	return et
+}
+
+func fieldsByName(fields []EntityFieldData) map[string]EntityFieldData {
+	out := make(map[string]EntityFieldData, len(fields))
+	for _, f := range fields {
+		out[f.Name] = f
+	}
+	return out
+}
+
+func TestEntityTypeRepository_CreateFieldDefinition_Invalid(t *testing.T) {
+	et := useFieldEntityType(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		data EntityFieldDefinitionCreate
+	}{
+		{name: "select without options", data: EntityFieldDefinitionCreate{Name: "Color", Type: "select"}},
+		{name: "options on a text field", data: EntityFieldDefinitionCreate{Name: "Notes", Type: "text", Options: []string{"a"}}},
+		{name: "comma in multi_select option", data: EntityFieldDefinitionCreate{Name: "Tags", Type: "multi_select", Options: []string{"a, b"}}},
+		{name: "duplicate option", data: EntityFieldDefinitionCreate{Name: "Size", Type: "select", Options: []string{"S", "s"}}},
+		{name: "default not an option", data: EntityFieldDefinitionCreate{Name: "Size", Type: "select", Options: []string{"S"}, DefaultValue: "M"}},
+		{name: "default not a decimal", data: EntityFieldDefinitionCreate{Name: "Weight", Type: "decimal", DefaultValue: "heavy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tRepos.EntityTypes.CreateFieldDefinition(ctx, tGroup.ID, et.ID, tt.data)
+			var ferrs EntityFieldErrors
+			require.True(t, errors.As(err, &ferrs), "got %v", err)
+		})
+	}
+
+	_, err := tRepos.EntityTypes.CreateFieldDefinition(ctx, tGroup.ID, et.ID, EntityFieldDefinitionCreate{Name: "Voltage", Type: "decimal"})
+	require.NoError(t, err)
+	_, err = tRepos.EntityTypes.CreateFieldDefinition(ctx, tGroup.ID, et.ID, EntityFieldDefinitionCreate{Name: "Voltage", Type: "text"})
+	var ferrs EntityFieldErrors
+	require.True(t, errors.As(err, &ferrs), "got %v", err)
+
+	_, err = tRepos.EntityTypes.CreateFieldDefinition(ctx, uuid.New(), et.ID, EntityFieldDefinitionCreate{Name: "Other", Type: "text"})
+	require.True(t, ent.IsNotFound(err), "got %v", err)
+}
+
+func TestApplyFieldDefinitions(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	defs := []*ent.EntityFieldDefinition{
+		{ID: uuid.New(), Name: "Voltage", Type: entityfielddefinition.TypeDecimal},
+		{ID: uuid.New(), Name: "Colors", Type: entityfielddefinition.TypeMultiSelect, Options: []string{"Red", "Green", "Blue"}},
+		{ID: uuid.New(), Name: "Manual", Type: entityfielddefinition.TypeURL},
+		{ID: uuid.New(), Name: "Bought", Type: entityfielddefinition.TypeDate, Required: true, DefaultValue: "today"},
+	}
+
+	out, err := applyFieldDefinitions(defs, []EntityFieldData{
+		{Type: "text", Name: "voltage", TextValue: "18.5"},
+		{Type: "text", Name: "Colors", TextValue: "blue, red, Blue"},
+		{Type: "text", Name: "Notes", TextValue: "free-form"},
+	}, now)
+	require.NoError(t, err)
+
+	byName := fieldsByName(out)
+	assert.Equal(t, "decimal", byName["Voltage"].Type)
+	assert.InDelta(t, 18.5, byName["Voltage"].DecimalValue, 0.001)
+	assert.Equal(t, "Red, Blue", byName["Colors"].TextValue)
+	assert.Equal(t, "free-form", byName["Notes"].TextValue)
+	assert.Equal(t, "2024-05-01", byName["Bought"].DateValue.String())
+
+	tests := []struct {
+		name   string
+		fields []EntityFieldData
+	}{
+		{name: "unknown option", fields: []EntityFieldData{{Type: "text", Name: "Colors", TextValue: "Purple"}}},
+		{name: "bad url", fields: []EntityFieldData{{Type: "text", Name: "Manual", TextValue: "not a link"}}},
+		{name: "set twice", fields: []EntityFieldData{{Type: "text", Name: "Manual"}, {Type: "text", Name: "manual"}}},
+		{name: "required left empty", fields: []EntityFieldData{{Type: "date", Name: "Bought"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := applyFieldDefinitions(defs, tt.fields, now)
+			var ferrs EntityFieldErrors
+			require.True(t, errors.As(err, &ferrs), "got %v", err)
+			assert.Len(t, ferrs, 1)
+		})
+	}
+}
+
+func TestEntityRepository_FieldDefinitions(t *testing.T) {
+	et := useFieldEntityType(t)
+	ctx := context.Background()
+
+	_, err := tRepos.EntityTypes.CreateFieldDefinition(ctx, tGroup.ID, et.ID, EntityFieldDefinitionCreate{
+		Name: "Condition", Type: "select", Options: []string{"New", "Used"}, Required: true, DefaultValue: "New",
+	})
+	require.NoError(t, err)
+	_, err = tRepos.EntityTypes.CreateFieldDefinition(ctx, tGroup.ID, et.ID, EntityFieldDefinitionCreate{
+		Name: "Replacement", Type: "currency", DefaultValue: "0 EUR", Position: 1,
+	})
+	require.NoError(t, err)
+
+	types, err := tRepos.EntityTypes.GetAll(ctx, tGroup.ID)
+	require.NoError(t, err)
+	for _, s := range types {
+		if s.ID == et.ID {
+			require.Len(t, s.FieldDefinitions, 2)
+			assert.Equal(t, "Condition", s.FieldDefinitions[0].Name)
+		}
+	}
+
+	data := entityFactory()
+	data.EntityTypeID = et.ID
+	e, err := tRepos.Entities.Create(ctx, tGroup.ID, data)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tRepos.Entities.Delete(context.Background(), e.ID) })
+
+	byName := fieldsByName(e.Fields)
+	require.Len(t, byName, 2)
+	assert.Equal(t, "New", byName["Condition"].TextValue)
+	assert.Equal(t, "EUR", byName["Replacement"].TextValue)
+
+	update := EntityUpdate{
+		ID:           e.ID,
+		Name:         e.Name,
+		EntityTypeID: et.ID,
+		Quantity:     1,
+		Fields: []EntityFieldData{
+			{ID: byName["Condition"].ID, Type: "select", Name: "Condition", TextValue: "Broken"},
+		},
+	}
+	_, err = tRepos.Entities.UpdateByGroup(ctx, tGroup.ID, update)
+	var ferrs EntityFieldErrors
+	require.True(t, errors.As(err, &ferrs), "got %v", err)
+
+	update.Fields[0].TextValue = "used"
+	out, err := tRepos.Entities.UpdateByGroup(ctx, tGroup.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, "Used", fieldsByName(out.Fields)["Condition"].TextValue)
+
+	names, err := tRepos.Entities.GetAllCustomFieldNames(ctx, tGroup.ID)
+	require.NoError(t, err)
+	assert.Contains(t, names, "Condition")
+	assert.Contains(t, names, "Replacement")
+
+	values, err := tRepos.Entities.GetAllCustomFieldValues(ctx, tGroup.ID, "Condition")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"New", "Used"}, values)
+}
@@ -0,0 +1,946 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/tag"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
+)
+
+// The entity query language extends the free-text search string with
+// filters:
+//
+//	tag:garage AND purchasePrice>100 AND NOT archived
+//	(type:tool OR type:"Power Tool") warrantyExpires<2027-01-01
+//	field:"Voltage">=12 NOT has:receipt
+//
+// Terms are combined with AND, OR and NOT (upper case only) and parentheses;
+// adjacent terms are ANDed. Anything that isn't a filter is searched as text.
+const (
+	maxQueryTerms = 32
+	maxQueryDepth = 8
+)
+
+// EntityQueryError reports a malformed query. Offset is the byte offset in
+// the query string.
+type EntityQueryError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *EntityQueryError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Offset+1, e.Msg)
+}
+
+type queryOp string
+
+const (
+	queryOpHas queryOp = ":"
+	queryOpEQ  queryOp = "="
+	queryOpNEQ queryOp = "!="
+	queryOpGT  queryOp = ">"
+	queryOpGTE queryOp = ">="
+	queryOpLT  queryOp = "<"
+	queryOpLTE queryOp = "<="
+)
+
+func (op queryOp) ordered() bool {
+	return op == queryOpGT || op == queryOpGTE || op == queryOpLT || op == queryOpLTE
+}
+
+type (
+	queryExpr interface{ queryExpr() }
+
+	queryAnd  struct{ exprs []queryExpr }
+	queryOr   struct{ exprs []queryExpr }
+	queryNot  struct{ expr queryExpr }
+	queryText struct{ raw string }
+
+	// queryFilter is a "key op value" term. field holds the custom field
+	// name for field: filters; op is empty for field:"Name" and bare flags.
+	queryFilter struct {
+		offset int
+		key    queryKey
+		field  string
+		op     queryOp
+		value  string
+	}
+)
+
+func (queryAnd) queryExpr()    {}
+func (queryOr) queryExpr()     {}
+func (queryNot) queryExpr()    {}
+func (queryText) queryExpr()   {}
+func (queryFilter) queryExpr() {}
+
+type queryKind int
+
+const (
+	queryKindString queryKind = iota
+	queryKindNumber
+	queryKindDate
+	queryKindBool
+	queryKindAssetID
+	queryKindTag
+	queryKindLocation
+	queryKindType
+	queryKindHas
+	queryKindField
+)
+
+type queryKey struct {
+	name   string
+	column string
+	kind   queryKind
+}
+
+// entityQueryKeys maps the lower-cased filter names to what they filter on.
+var entityQueryKeys = func() map[string]queryKey {
+	keys := map[string]queryKey{}
+	add := func(kind queryKind, column string, names ...string) {
+		for _, n := range names {
+			keys[strings.ToLower(n)] = queryKey{name: names[0], column: column, kind: kind}
+		}
+	}
+
+	add(queryKindString, entity.FieldName, "name")
+	add(queryKindString, entity.FieldDescription, "description")
+	add(queryKindString, entity.FieldNotes, "notes")
+	add(queryKindString, entity.FieldSerialNumber, "serialNumber", "serial")
+	add(queryKindString, entity.FieldModelNumber, "modelNumber", "model")
+	add(queryKindString, entity.FieldManufacturer, "manufacturer")
+	add(queryKindString, entity.FieldPurchaseFrom, "purchaseFrom")
+	add(queryKindString, entity.FieldSoldTo, "soldTo")
+
+	add(queryKindNumber, entity.FieldQuantity, "quantity")
+	add(queryKindNumber, entity.FieldPurchasePrice, "purchasePrice", "price")
+	add(queryKindNumber, entity.FieldSoldPrice, "soldPrice")
+	add(queryKindAssetID, entity.FieldAssetID, "assetId")
+
+	add(queryKindDate, entity.FieldPurchaseDate, "purchaseDate")
+	add(queryKindDate, entity.FieldSoldDate, "soldDate")
+	add(queryKindDate, entity.FieldWarrantyExpires, "warrantyExpires")
+	add(queryKindDate, entity.FieldCreatedAt, "createdAt")
+	add(queryKindDate, entity.FieldUpdatedAt, "updatedAt")
+
+	add(queryKindBool, entity.FieldArchived, "archived")
+	add(queryKindBool, entity.FieldInsured, "insured")
+	add(queryKindBool, entity.FieldLifetimeWarranty, "lifetimeWarranty")
+
+	add(queryKindTag, "", "tag", "tags")
+	add(queryKindLocation, "", "location", "in")
+	add(queryKindType, "", "type")
+	add(queryKindHas, "", "has")
+	add(queryKindField, "", "field")
+	return keys
+}()
+
+// ---------------------------------------------------------------------------
+// Parsing
+
+type queryParser struct {
+	src   string
+	pos   int
+	depth int
+	terms int
+}
+
+// parseEntityQuery parses a query string. An empty query yields nil.
+func parseEntityQuery(src string) (queryExpr, error) {
+	p := &queryParser{src: src}
+	p.skipSpace()
+	if p.eof() {
+		return nil, nil
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); !p.eof() {
+		return nil, p.errorf(p.pos, "unexpected %q", p.src[p.pos])
+	}
+	return expr, nil
+}
+
+func (p *queryParser) errorf(offset int, format string, args ...any) error {
+	return &EntityQueryError{Offset: offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *queryParser) eof() bool { return p.pos >= len(p.src) }
+
+func (p *queryParser) skipSpace() {
+	for !p.eof() && isQuerySpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func isQuerySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// keyword consumes kw if it is the next whole word.
+func (p *queryParser) keyword(kw string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.pos:], kw) {
+		return false
+	}
+	end := p.pos + len(kw)
+	if end < len(p.src) && !isQuerySpace(p.src[end]) && p.src[end] != '(' && p.src[end] != ')' {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []queryExpr{first}
+	for p.keyword("OR") {
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return queryOr{exprs: exprs}, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []queryExpr{first}
+	for {
+		if p.keyword("AND") {
+			next, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, next)
+			continue
+		}
+
+		start := p.pos
+		if p.eof() || p.src[p.pos] == ')' || p.keyword("OR") {
+			p.pos = start
+			break
+		}
+		next, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return queryAnd{exprs: exprs}, nil
+}
+
+func (p *queryParser) parseUnary() (queryExpr, error) {
+	if p.keyword("NOT") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return queryNot{expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryExpr, error) {
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf(p.pos, "unexpected end of query")
+	}
+
+	switch p.src[p.pos] {
+	case '(':
+		open := p.pos
+		if p.depth++; p.depth > maxQueryDepth {
+			return nil, p.errorf(open, "too many nested parentheses")
+		}
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.skipSpace(); p.eof() || p.src[p.pos] != ')' {
+			return nil, p.errorf(open, "unclosed parenthesis")
+		}
+		p.pos++
+		p.depth--
+		return expr, nil
+	case ')':
+		return nil, p.errorf(p.pos, "unexpected %q", ')')
+	}
+
+	if p.terms++; p.terms > maxQueryTerms {
+		return nil, p.errorf(p.pos, "too many terms (at most %d)", maxQueryTerms)
+	}
+	return p.parseTerm()
+}
+
+func (p *queryParser) parseTerm() (queryExpr, error) {
+	start := p.pos
+
+	if p.src[p.pos] == '"' {
+		p.readQuoted()
+		if !p.eof() && p.src[p.pos] == '*' {
+			p.pos++
+		}
+		return queryText{raw: p.src[start:p.pos]}, nil
+	}
+
+	ident := p.readIdent()
+	if ident != "" {
+		key, known := entityQueryKeys[strings.ToLower(ident)]
+
+		if op := p.readOp(); op != "" {
+			switch {
+			case known:
+				return p.parseFilter(start, key, op)
+			case op != queryOpHas:
+				return nil, p.errorf(start, "unknown filter %q", ident)
+			}
+			// Not a filter ("http://…"); search it as text.
+		} else if known && key.kind == queryKindBool && p.atTermEnd() {
+			return queryFilter{offset: start, key: key}, nil
+		}
+	}
+
+	p.pos = start
+	for !p.atTermEnd() {
+		p.pos++
+	}
+	return queryText{raw: p.src[start:p.pos]}, nil
+}
+
+func (p *queryParser) parseFilter(start int, key queryKey, op queryOp) (queryExpr, error) {
+	f := queryFilter{offset: start, key: key, op: op}
+
+	if key.kind == queryKindField {
+		if op != queryOpHas {
+			return nil, p.errorf(start, "expected field:\"Name\"")
+		}
+		name, err := p.readValue(true)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(name) == "" {
+			return nil, p.errorf(start, "expected a custom field name after field:")
+		}
+		f.field = name
+
+		f.op = p.readOp()
+		if f.op == "" {
+			// field:"Name" on its own tests that the field is set.
+			if !p.atTermEnd() {
+				return nil, p.errorf(p.pos, "unexpected %q", p.src[p.pos])
+			}
+			return f, nil
+		}
+	}
+
+	valueAt := p.pos
+	value, err := p.readValue(false)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, p.errorf(valueAt, "expected a value after %s%s", key.name, f.op)
+	}
+	f.value = value
+	return f, nil
+}
+
+func (p *queryParser) atTermEnd() bool {
+	return p.eof() || isQuerySpace(p.src[p.pos]) || p.src[p.pos] == '(' || p.src[p.pos] == ')'
+}
+
+func (p *queryParser) readIdent() string {
+	start := p.pos
+	for !p.eof() {
+		c := p.src[p.pos]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (p.pos > start && c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *queryParser) readOp() queryOp {
+	for _, op := range []queryOp{queryOpNEQ, queryOpGTE, queryOpLTE, queryOpHas, queryOpEQ, queryOpGT, queryOpLT} {
+		if strings.HasPrefix(p.src[p.pos:], string(op)) {
+			p.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+// readQuoted consumes a double-quoted string and returns its contents. An
+// unterminated quote runs to the end of the query.
+func (p *queryParser) readQuoted() (string, bool) {
+	p.pos++
+	end := strings.IndexByte(p.src[p.pos:], '"')
+	if end < 0 {
+		s := p.src[p.pos:]
+		p.pos = len(p.src)
+		return s, false
+	}
+	s := p.src[p.pos : p.pos+end]
+	p.pos += end + 1
+	return s, true
+}
+
+// readValue reads a quoted or bare value. Bare field names also stop at an
+// operator so field:Voltage>=12 parses.
+func (p *queryParser) readValue(fieldName bool) (string, error) {
+	if !p.eof() && p.src[p.pos] == '"' {
+		start := p.pos
+		s, ok := p.readQuoted()
+		if !ok {
+			return "", p.errorf(start, "unterminated quote")
+		}
+		return s, nil
+	}
+
+	start := p.pos
+	for !p.atTermEnd() {
+		if fieldName && strings.ContainsRune(":=!<>", rune(p.src[p.pos])) {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos], nil
+}
+
+// splitQueryText separates the text terms ANDed at the top level of expr,
+// which are searched and ranked as one search string, from the rest of the
+// query.
+func splitQueryText(expr queryExpr) (queryExpr, string) {
+	switch e := expr.(type) {
+	case nil:
+		return nil, ""
+	case queryText:
+		return nil, e.raw
+	case queryAnd:
+		var (
+			rest []queryExpr
+			text []string
+		)
+		for _, sub := range e.exprs {
+			if t, ok := sub.(queryText); ok {
+				text = append(text, t.raw)
+			} else {
+				rest = append(rest, sub)
+			}
+		}
+		switch len(rest) {
+		case 0:
+			return nil, strings.Join(text, " ")
+		case 1:
+			return rest[0], strings.Join(text, " ")
+		}
+		return queryAnd{exprs: rest}, strings.Join(text, " ")
+	}
+	return expr, ""
+}
+
+// ---------------------------------------------------------------------------
+// Compiling
+
+// entityQueryCompiler turns a parsed query into entity predicates. Lookups
+// (tag names, locations, custom field values) are resolved as it goes.
+type entityQueryCompiler struct {
+	r   *EntityRepository
+	gid uuid.UUID
+
+	// usesArchived is set when the query filters on archived itself, so the
+	// default of hiding archived entities doesn't apply.
+	usesArchived bool
+}
+
+func (c *entityQueryCompiler) compile(ctx context.Context, expr queryExpr) (predicate.Entity, error) {
+	switch e := expr.(type) {
+	case queryAnd:
+		preds, err := c.compileAll(ctx, e.exprs)
+		if err != nil {
+			return nil, err
+		}
+		return entity.And(preds...), nil
+	case queryOr:
+		preds, err := c.compileAll(ctx, e.exprs)
+		if err != nil {
+			return nil, err
+		}
+		return entity.Or(preds...), nil
+	case queryNot:
+		pred, err := c.compile(ctx, e.expr)
+		if err != nil {
+			return nil, err
+		}
+		return entity.Not(pred), nil
+	case queryText:
+		terms := parseSearchQuery(e.raw)
+		if len(terms) == 0 {
+			return entityTextContains(e.raw), nil
+		}
+		return entitySearchIn(c.gid, terms), nil
+	case queryFilter:
+		return c.compileFilter(ctx, e)
+	}
+	return nil, fmt.Errorf("unexpected query expression %T", expr)
+}
+
+func (c *entityQueryCompiler) compileAll(ctx context.Context, exprs []queryExpr) ([]predicate.Entity, error) {
+	preds := make([]predicate.Entity, len(exprs))
+	for i, sub := range exprs {
+		pred, err := c.compile(ctx, sub)
+		if err != nil {
+			return nil, err
+		}
+		preds[i] = pred
+	}
+	return preds, nil
+}
+
+func (c *entityQueryCompiler) errorf(f queryFilter, format string, args ...any) error {
+	return &EntityQueryError{Offset: f.offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (c *entityQueryCompiler) compileFilter(ctx context.Context, f queryFilter) (predicate.Entity, error) {
+	switch f.key.kind {
+	case queryKindString:
+		return c.compileString(f)
+	case queryKindNumber:
+		n, err := strconv.ParseFloat(f.value, 64)
+		if err != nil {
+			return nil, c.errorf(f, "%s expects a number, got %q", f.key.name, f.value)
+		}
+		return compareColumn(f.key.column, f.op, n), nil
+	case queryKindAssetID:
+		aid, ok := ParseAssetID(f.value)
+		if !ok {
+			return nil, c.errorf(f, "%s expects an asset ID, got %q", f.key.name, f.value)
+		}
+		return compareColumn(f.key.column, f.op, int64(aid)), nil
+	case queryKindDate:
+		from, to, ok := parseQueryDate(f.value)
+		if !ok {
+			return nil, c.errorf(f, "%s expects a date like 2006-01-02, got %q", f.key.name, f.value)
+		}
+		return compareDateColumn(f.key.column, f.op, from, to), nil
+	case queryKindBool:
+		return c.compileBool(f)
+	case queryKindTag:
+		return c.compileTag(ctx, f)
+	case queryKindLocation:
+		return c.compileLocation(ctx, f)
+	case queryKindType:
+		if err := c.requireEquality(f); err != nil {
+			return nil, err
+		}
+		return negateIf(f.op, entity.HasEntityTypeWith(entitytype.NameEqualFold(f.value))), nil
+	case queryKindHas:
+		return c.compileHas(f)
+	case queryKindField:
+		return c.compileField(ctx, f)
+	}
+	return nil, c.errorf(f, "unsupported filter %s", f.key.name)
+}
+
+func (c *entityQueryCompiler) requireEquality(f queryFilter) error {
+	if f.op.ordered() {
+		return c.errorf(f, "%s doesn't support %s", f.key.name, f.op)
+	}
+	return nil
+}
+
+func negateIf(op queryOp, pred predicate.Entity) predicate.Entity {
+	if op == queryOpNEQ {
+		return entity.Not(pred)
+	}
+	return pred
+}
+
+func (c *entityQueryCompiler) compileString(f queryFilter) (predicate.Entity, error) {
+	if err := c.requireEquality(f); err != nil {
+		return nil, err
+	}
+
+	switch f.op {
+	case queryOpHas:
+		return predicate.Entity(entsql.FieldContainsFold(f.key.column, f.value)), nil
+	case queryOpNEQ:
+		return entity.Not(predicate.Entity(entsql.FieldEqualFold(f.key.column, f.value))), nil
+	default:
+		return predicate.Entity(entsql.FieldEqualFold(f.key.column, f.value)), nil
+	}
+}
+
+func (c *entityQueryCompiler) compileBool(f queryFilter) (predicate.Entity, error) {
+	if f.key.column == entity.FieldArchived {
+		c.usesArchived = true
+	}
+
+	v := true
+	if f.op != "" {
+		if err := c.requireEquality(f); err != nil {
+			return nil, err
+		}
+		b, ok := parseQueryBool(f.value)
+		if !ok {
+			return nil, c.errorf(f, "%s expects true or false, got %q", f.key.name, f.value)
+		}
+		v = b
+	}
+	if f.op == queryOpNEQ {
+		v = !v
+	}
+	return predicate.Entity(entsql.FieldEQ(f.key.column, v)), nil
+}
+
+// compileTag matches entities tagged with the named tag or any of its
+// descendants, like the tags filter does.
+func (c *entityQueryCompiler) compileTag(ctx context.Context, f queryFilter) (predicate.Entity, error) {
+	if err := c.requireEquality(f); err != nil {
+		return nil, err
+	}
+
+	ids, err := c.r.db.Tag.Query().
+		Where(tag.HasGroupWith(group.ID(c.gid)), tag.NameEqualFold(f.value)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		tagRepo := &TagRepository{c.r.db, c.r.bus}
+		descendants, err := tagRepo.GetDescendantTagIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		if len(descendants) > 0 {
+			ids = descendants
+		}
+	}
+	return negateIf(f.op, entity.HasTagWith(tag.IDIn(ids...))), nil
+}
+
+// compileLocation matches entities anywhere below an entity with the given
+// name.
+func (c *entityQueryCompiler) compileLocation(ctx context.Context, f queryFilter) (predicate.Entity, error) {
+	if err := c.requireEquality(f); err != nil {
+		return nil, err
+	}
+
+	roots, err := c.r.db.Entity.Query().
+		Where(entity.HasGroupWith(group.ID(c.gid)), entity.NameEqualFold(f.value)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var inside []uuid.UUID
+	seen := set.New(roots...)
+	frontier := roots
+	for depth := 0; depth < maxAncestorDepth && len(frontier) > 0; depth++ {
+		children, err := c.r.db.Entity.Query().
+			Where(entity.HasParentWith(entity.IDIn(frontier...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = frontier[:0:0]
+		for _, id := range children {
+			if !seen.Contains(id) {
+				seen.Insert(id)
+				frontier = append(frontier, id)
+				inside = append(inside, id)
+			}
+		}
+	}
+	return negateIf(f.op, entity.IDIn(inside...)), nil
+}
+
+// compileHas handles has:photo, has:receipt and the other attachment types,
+// has:attachment, has:tag, has:parent, has:loan for entities currently lent
+// out, and has:<filter> for text and date filters, which tests that the value
+// is set.
+func (c *entityQueryCompiler) compileHas(f queryFilter) (predicate.Entity, error) {
+	if f.op != queryOpHas {
+		return nil, c.errorf(f, "use has:%s", f.value)
+	}
+
+	v := strings.ToLower(f.value)
+	switch v {
+	case "attachment", "attachments":
+		return entity.HasAttachmentsWith(attachment.TypeNEQ(attachment.TypeThumbnail)), nil
+	case "tag", "tags":
+		return entity.HasTag(), nil
+	case "parent", "location":
+		return entity.HasParent(), nil
+	case "loan":
+		return entity.HasLoansWith(loan.ReturnedAtIsNil()), nil
+	}
+
+	if typ := attachment.Type(v); typ != attachment.TypeThumbnail && attachment.TypeValidator(typ) == nil {
+		return entity.HasAttachmentsWith(attachment.TypeEQ(typ)), nil
+	}
+
+	if key, ok := entityQueryKeys[v]; ok {
+		switch key.kind {
+		case queryKindString:
+			return entity.And(
+				predicate.Entity(entsql.FieldNotNull(key.column)),
+				predicate.Entity(entsql.FieldNEQ(key.column, "")),
+			), nil
+		case queryKindDate:
+			return predicate.Entity(entsql.FieldGTE(key.column, minQueryDate)), nil
+		}
+	}
+	return nil, c.errorf(f, "unknown has:%s", f.value)
+}
+
+// compileField matches custom field values. Custom fields are mostly stored
+// as text, so values are compared here rather than in SQL: a number or date
+// in the query compares against any field value that parses as one.
+func (c *entityQueryCompiler) compileField(ctx context.Context, f queryFilter) (predicate.Entity, error) {
+	fields, err := c.r.db.EntityField.Query().
+		Where(
+			entityfield.NameEqualFold(f.field),
+			entityfield.HasEntityWith(entity.HasGroupWith(group.ID(c.gid))),
+		).
+		WithEntity(func(q *ent.EntityQuery) { q.Select(entity.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := c.fieldMatcher(f)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := set.Make[uuid.UUID](len(fields))
+	for _, fld := range fields {
+		if fld.Edges.Entity != nil && match(fld) {
+			ids.Insert(fld.Edges.Entity.ID)
+		}
+	}
+
+	// "!=" means the entity has the field with a different value, so it
+	// isn't simply the negation of "=".
+	return entity.IDIn(ids.Slice()...), nil
+}
+
+func (c *entityQueryCompiler) fieldMatcher(f queryFilter) (func(*ent.EntityField) bool, error) {
+	if f.op == "" {
+		return func(fld *ent.EntityField) bool {
+			return fld.Type != entityfield.TypeText || strings.TrimSpace(fld.TextValue) != ""
+		}, nil
+	}
+
+	if n, err := strconv.ParseFloat(f.value, 64); err == nil {
+		return func(fld *ent.EntityField) bool {
+			v, ok := fieldNumber(fld)
+			return ok && compareOrdered(f.op, v, n)
+		}, nil
+	}
+
+	if from, to, ok := parseQueryDate(f.value); ok {
+		return func(fld *ent.EntityField) bool {
+			v, ok := fieldTime(fld)
+			if !ok {
+				return false
+			}
+			return compareDate(f.op, v, from, to)
+		}, nil
+	}
+
+	if err := c.requireEquality(f); err != nil {
+		return nil, err
+	}
+
+	b, isBool := parseQueryBool(f.value)
+	return func(fld *ent.EntityField) bool {
+		var eq bool
+		switch {
+		case fld.Type == entityfield.TypeBoolean && isBool:
+			eq = fld.BooleanValue == b
+		case f.op == queryOpHas:
+			eq = strings.Contains(strings.ToLower(fld.TextValue), strings.ToLower(f.value))
+		default:
+			eq = strings.EqualFold(strings.TrimSpace(fld.TextValue), f.value)
+		}
+		return eq != (f.op == queryOpNEQ)
+	}, nil
+}
+
+func fieldNumber(fld *ent.EntityField) (float64, bool) {
+	switch fld.Type {
+	case entityfield.TypeNumber:
+		return float64(fld.NumberValue), true
+	case entityfield.TypeDecimal, entityfield.TypeCurrency:
+		return fld.DecimalValue, true
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(fld.TextValue), 64)
+	return n, err == nil
+}
+
+func fieldTime(fld *ent.EntityField) (time.Time, bool) {
+	if fld.Type == entityfield.TypeTime || fld.Type == entityfield.TypeDate {
+		return fld.TimeValue, true
+	}
+	t, _, ok := parseQueryDate(strings.TrimSpace(fld.TextValue))
+	return t, ok
+}
+
+// ---------------------------------------------------------------------------
+// Values
+
+// minQueryDate excludes the 0001-01-01 placeholder older rows may still hold
+// for unset dates.
+var minQueryDate = time.Date(100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// parseQueryDate parses a date or timestamp into the half-open range
+// [from, to) it covers: a whole day for dates.
+func parseQueryDate(s string) (time.Time, time.Time, bool) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, t.AddDate(0, 0, 1), true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, t.Add(time.Nanosecond), true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+func parseQueryBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "1":
+		return true, true
+	case "false", "no", "0":
+		return false, true
+	}
+	return false, false
+}
+
+func compareColumn(column string, op queryOp, v any) predicate.Entity {
+	switch op {
+	case queryOpNEQ:
+		return predicate.Entity(entsql.FieldNEQ(column, v))
+	case queryOpGT:
+		return predicate.Entity(entsql.FieldGT(column, v))
+	case queryOpGTE:
+		return predicate.Entity(entsql.FieldGTE(column, v))
+	case queryOpLT:
+		return predicate.Entity(entsql.FieldLT(column, v))
+	case queryOpLTE:
+		return predicate.Entity(entsql.FieldLTE(column, v))
+	default:
+		return predicate.Entity(entsql.FieldEQ(column, v))
+	}
+}
+
+// compareDateColumn compares a date column against the range [from, to).
+// Unset dates never match.
+func compareDateColumn(column string, op queryOp, from, to time.Time) predicate.Entity {
+	set := predicate.Entity(entsql.FieldGTE(column, minQueryDate))
+	switch op {
+	case queryOpNEQ:
+		return entity.And(set, entity.Or(
+			predicate.Entity(entsql.FieldLT(column, from)),
+			predicate.Entity(entsql.FieldGTE(column, to)),
+		))
+	case queryOpGT:
+		return predicate.Entity(entsql.FieldGTE(column, to))
+	case queryOpGTE:
+		return predicate.Entity(entsql.FieldGTE(column, from))
+	case queryOpLT:
+		return entity.And(set, predicate.Entity(entsql.FieldLT(column, from)))
+	case queryOpLTE:
+		return entity.And(set, predicate.Entity(entsql.FieldLT(column, to)))
+	default:
+		return entity.And(
+			predicate.Entity(entsql.FieldGTE(column, from)),
+			predicate.Entity(entsql.FieldLT(column, to)),
+		)
+	}
+}
+
+func compareOrdered(op queryOp, a, b float64) bool {
+	switch op {
+	case queryOpNEQ:
+		return a != b
+	case queryOpGT:
+		return a > b
+	case queryOpGTE:
+		return a >= b
+	case queryOpLT:
+		return a < b
+	case queryOpLTE:
+		return a <= b
+	default:
+		return a == b
+	}
+}
+
+func compareDate(op queryOp, t, from, to time.Time) bool {
+	switch op {
+	case queryOpNEQ:
+		return t.Before(from) || !t.Before(to)
+	case queryOpGT:
+		return !t.Before(to)
+	case queryOpGTE:
+		return !t.Before(from)
+	case queryOpLT:
+		return t.Before(from)
+	case queryOpLTE:
+		return t.Before(to)
+	default:
+		return !t.Before(from) && t.Before(to)
+	}
+}
+
+// entityTextContains is the substring fallback for search text with nothing
+// the index can match (e.g. only punctuation).
+func entityTextContains(s string) predicate.Entity {
+	s = strings.TrimFunc(s, func(r rune) bool { return r == '"' || r == '*' || unicode.IsSpace(r) })
+	return entity.Or(
+		entity.NameContainsFold(s),
+		entity.DescriptionContainsFold(s),
+		entity.SerialNumberContainsFold(s),
+		entity.ModelNumberContainsFold(s),
+		entity.ManufacturerContainsFold(s),
+		entity.NotesContainsFold(s),
+	)
+}
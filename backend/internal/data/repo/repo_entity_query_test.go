@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestParseEntityQuery(t *testing.T) {
+	tests := []struct {
+		in     string
+		filter bool
+		search string
+	}{
+		{in: "bosch drill", search: "bosch drill"},
+		{in: `"cordless drill"* bosch`, search: `"cordless drill"* bosch`},
+		{in: "http://example.com", search: "http://example.com"},
+		{in: "drill tag:garage", filter: true, search: "drill"},
+		{in: "tag:garage OR drill", filter: true},
+		{in: `field:"Voltage">=12 AND NOT archived`, filter: true},
+		{in: "field:Voltage>=12", filter: true},
+		{in: "(type:tool OR type:\"Power Tool\") warrantyExpires<2027-01-01", filter: true},
+		{in: "salt and pepper", search: "salt and pepper"},
+		{in: "insured", filter: true},
+		{in: "   ", search: ""},
+	}
+
+	for _, tc := range tests {
+		expr, err := parseEntityQuery(tc.in)
+		require.NoError(t, err, tc.in)
+
+		filter, search := splitQueryText(expr)
+		assert.Equal(t, tc.filter, filter != nil, tc.in)
+		assert.Equal(t, tc.search, search, tc.in)
+	}
+}
+
+func TestParseEntityQuery_Errors(t *testing.T) {
+	tests := []struct {
+		in     string
+		offset int
+	}{
+		{in: "purchasePrice>", offset: 14},
+		{in: "purchasPrice>100", offset: 0},
+		{in: "(tag:garage OR tag:shed", offset: 0},
+		{in: "drill )", offset: 6},
+		{in: "drill AND", offset: 9},
+		{in: `name:"unterminated`, offset: 5},
+		{in: "field:>3", offset: 0},
+		{in: strings.Repeat("(", maxQueryDepth+1) + "x" + strings.Repeat(")", maxQueryDepth+1), offset: maxQueryDepth},
+		{in: strings.Repeat("x ", maxQueryTerms+1), offset: maxQueryTerms * 2},
+	}
+
+	for _, tc := range tests {
+		_, err := parseEntityQuery(tc.in)
+		var qerr *EntityQueryError
+		require.True(t, errors.As(err, &qerr), "%s: %v", tc.in, err)
+		assert.Equal(t, tc.offset, qerr.Offset, tc.in)
+	}
+}
+
+func TestEntityQuery_Filters(t *testing.T) {
+	ctx := context.Background()
+	gid, etID := useSearchGroup(t)
+
+	garage, err := tRepos.Tags.Create(ctx, gid, TagCreate{Name: "Garage"})
+	require.NoError(t, err)
+	tools, err := tRepos.Tags.Create(ctx, gid, TagCreate{Name: "Power Tools", ParentID: garage.ID})
+	require.NoError(t, err)
+
+	loc, err := tRepos.Entities.CreateContainer(ctx, gid, EntityCreate{Name: "Shed"})
+	require.NoError(t, err)
+	shelf, err := tRepos.Entities.CreateContainer(ctx, gid, EntityCreate{Name: "Shelf", ParentID: loc.ID})
+	require.NoError(t, err)
+
+	update := func(name string, fn func(u *EntityUpdate)) EntityOut {
+		e, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: name, EntityTypeID: etID})
+		require.NoError(t, err)
+
+		u := EntityUpdate{ID: e.ID, Name: name, EntityTypeID: etID, Quantity: 1}
+		fn(&u)
+		out, err := tRepos.Entities.UpdateByGroup(ctx, gid, u)
+		require.NoError(t, err)
+		return out
+	}
+
+	update("Drill", func(u *EntityUpdate) {
+		u.Insured = true
+		u.PurchasePrice = 650
+		u.TagIDs = []uuid.UUID{tools.ID}
+		u.ParentID = shelf.ID
+		u.WarrantyExpires = types.DateFromTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+		u.Fields = []EntityFieldData{{Type: "text", Name: "Voltage", TextValue: "18"}}
+	})
+	tv := update("Television", func(u *EntityUpdate) {
+		u.Insured = true
+		u.PurchasePrice = 900
+		u.WarrantyExpires = types.DateFromTime(time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC))
+	})
+	update("Lamp", func(u *EntityUpdate) {
+		u.PurchasePrice = 40
+		u.Fields = []EntityFieldData{{Type: "text", Name: "voltage", TextValue: "230"}}
+	})
+	update("Old Radio", func(u *EntityUpdate) {
+		u.Archived = true
+		u.Insured = true
+		u.PurchasePrice = 700
+		u.TagIDs = []uuid.UUID{garage.ID}
+	})
+
+	_, err = tRepos.Attachments.Create(ctx, tv.ID,
+		ItemCreateAttachment{Title: "receipt.pdf", Content: strings.NewReader("receipt")},
+		attachment.TypeReceipt, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"insured purchasePrice>500 NOT has:receipt", []string{"Drill"}},
+		{"insured", []string{"Drill", "Television"}},
+		{"archived", []string{"Old Radio"}},
+		{"insured AND NOT archived", []string{"Drill", "Television"}},
+		{"archived OR price<50", []string{"Lamp", "Old Radio"}},
+		{"tag:garage", []string{"Drill"}},
+		{"tag:garage archived:true", []string{"Old Radio"}},
+		{"tag:nonexistent", nil},
+		{"location:shed", []string{"Drill"}},
+		{"warrantyExpires<2027-01-01", []string{"Drill"}},
+		{"warrantyExpires=2028-01-01", []string{"Television"}},
+		{"warrantyExpires>=2026-06-01", []string{"Drill", "Television"}},
+		{`field:"Voltage">=12`, []string{"Drill", "Lamp"}},
+		{`field:voltage>100`, []string{"Lamp"}},
+		{`field:Voltage`, []string{"Drill", "Lamp"}},
+		{`field:Voltage!=18`, []string{"Lamp"}},
+		{`name:"old radio"`, nil},
+		{`name:tele OR (lamp drill)`, []string{"Television"}},
+		{"lamp OR drill", []string{"Drill", "Lamp"}},
+		{`has:warrantyExpires price>=650`, []string{"Drill", "Television"}},
+	}
+
+	for _, tc := range tests {
+		assert.ElementsMatch(t, tc.want, searchNames(t, gid, tc.query, ""), tc.query)
+	}
+
+	_, err = tRepos.Entities.QueryByGroup(ctx, gid, EntityQuery{Search: "price>cheap"})
+	var qerr *EntityQueryError
+	assert.True(t, errors.As(err, &qerr))
+}
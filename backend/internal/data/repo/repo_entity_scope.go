@@ -0,0 +1,227 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/auditlog"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/predicate"
+)
+
+// ErrOutsideEntityScope is returned when a request limited to location
+// subtrees tries to create or move an entity (or attach something to one)
+// outside of them.
+var ErrOutsideEntityScope = errors.New("entity is outside the locations this request is limited to")
+
+type entityScopeKey struct{}
+
+type noEntityScopeKey struct{}
+
+// WithEntityScope limits every entity, attachment, maintenance and audit log
+// query made with the returned context to roots and their descendants.
+// Mutations outside the subtrees affect nothing, and creating or moving an
+// entity outside of them fails with ErrOutsideEntityScope. Scopes stack, so
+// applying it again narrows the context to entities inside both.
+// Location-restricted API keys and members limited to some locations use it.
+func WithEntityScope(ctx context.Context, roots ...uuid.UUID) context.Context {
+	prev, _ := ctx.Value(entityScopeKey{}).([][]uuid.UUID)
+	scopes := append(slices.Clip(prev), slices.Clone(roots))
+	return context.WithValue(ctx, entityScopeKey{}, scopes)
+}
+
+// EntityScoped reports whether WithEntityScope applies to ctx.
+func EntityScoped(ctx context.Context) bool {
+	return len(entityScopes(ctx)) > 0
+}
+
+func entityScopes(ctx context.Context) [][]uuid.UUID {
+	if v, _ := ctx.Value(noEntityScopeKey{}).(bool); v {
+		return nil
+	}
+	scopes, _ := ctx.Value(entityScopeKey{}).([][]uuid.UUID)
+	return scopes
+}
+
+// withoutEntityScope lifts the scope for bookkeeping that has to see the
+// whole group, such as asset id allocation and search indexing.
+func withoutEntityScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noEntityScopeKey{}, true)
+}
+
+// entityScopeCTE selects the roots bound to its placeholders and everything
+// below them.
+const entityScopeCTE = "WITH RECURSIVE entity_scope(id) AS (SELECT id FROM entities WHERE id IN (%s)" +
+	" UNION SELECT e.id FROM entities e JOIN entity_scope ON e.entity_children = entity_scope.id)" +
+	" SELECT id FROM entity_scope"
+
+// entityScopeP matches rows whose column, which must be qualified, holds an
+// entity visible under every scope in ctx.
+func entityScopeP(ctx context.Context, column string) *sql.Predicate {
+	head, tail, _ := strings.Cut(entityScopeCTE, "%s")
+
+	var ps []*sql.Predicate
+	for _, roots := range entityScopes(ctx) {
+		if len(roots) == 0 {
+			ps = append(ps, sql.False())
+			continue
+		}
+		args := make([]any, len(roots))
+		for i, root := range roots {
+			args[i] = root
+		}
+		ps = append(ps, sql.P(func(b *sql.Builder) {
+			b.WriteString(column).
+				WriteString(" IN (").
+				WriteString(head).
+				Args(args...).
+				WriteString(tail).
+				WriteString(")")
+		}))
+	}
+	return sql.And(ps...)
+}
+
+// inEntityScope applies entityScopeP to an unqualified column of a selector.
+func inEntityScope(ctx context.Context, column string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(entityScopeP(ctx, s.C(column)))
+	}
+}
+
+// entityScopeSQL is entityScopeP for raw queries. It returns a condition on
+// column with placeholders numbered from next, and the arguments to bind. The
+// condition is empty when ctx isn't scoped.
+func entityScopeSQL(ctx context.Context, column string, next int) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	for _, roots := range entityScopes(ctx) {
+		if len(roots) == 0 {
+			conds = append(conds, "1 = 0")
+			continue
+		}
+		placeholders := make([]string, len(roots))
+		for i, root := range roots {
+			placeholders[i] = fmt.Sprintf("$%d", next)
+			args = append(args, root)
+			next++
+		}
+		conds = append(conds, column+" IN ("+fmt.Sprintf(entityScopeCTE, strings.Join(placeholders, ", "))+")")
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// withEntityScopeSQL replaces every {{ SCOPE }} in a raw query with the
+// entity scope condition on column, binding it after args.
+func withEntityScopeSQL(ctx context.Context, query, column string, args []any) (string, []any) {
+	cond, scopeArgs := entityScopeSQL(ctx, column, len(args)+1)
+	if cond == "" {
+		return strings.ReplaceAll(query, "{{ SCOPE }}", ""), args
+	}
+	return strings.ReplaceAll(query, "{{ SCOPE }}", "AND "+cond), append(args, scopeArgs...)
+}
+
+// entityScopeRoots returns the visible entities of a group whose parent isn't
+// visible. Under an entity scope, trees and paths start at them.
+func entityScopeRoots(ctx context.Context, db *ent.Client, gid uuid.UUID) ([]uuid.UUID, error) {
+	return db.Entity.Query().
+		Where(
+			entity.HasGroupWith(group.ID(gid)),
+			func(s *sql.Selector) {
+				parent := s.C(entity.ParentColumn)
+				s.Where(sql.Or(sql.IsNull(parent), sql.Not(entityScopeP(ctx, parent))))
+			},
+		).
+		IDs(ctx)
+}
+
+// entityScopeInterceptor applies WithEntityScope to ent queries, including
+// eager-loaded edges. Raw SQL in this package uses entityScopeSQL itself.
+func entityScopeInterceptor() ent.Interceptor {
+	return ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+		if !EntityScoped(ctx) {
+			return nil
+		}
+		switch q := q.(type) {
+		case *ent.EntityQuery:
+			q.Where(predicate.Entity(inEntityScope(ctx, entity.FieldID)))
+		case *ent.AttachmentQuery:
+			q.Where(predicate.Attachment(inEntityScope(ctx, attachment.EntityColumn)))
+		case *ent.MaintenanceEntryQuery:
+			q.Where(predicate.MaintenanceEntry(inEntityScope(ctx, maintenanceentry.EntityColumn)))
+		case *ent.AuditLogQuery:
+			// The history of entities that were purged has nothing left to
+			// place it, so only unrestricted requests see it.
+			q.Where(predicate.AuditLog(inEntityScope(ctx, auditlog.FieldEntityID)))
+		}
+		return nil
+	})
+}
+
+type wherePMutation interface {
+	WhereP(ps ...func(*sql.Selector))
+}
+
+// entityScopeHook applies WithEntityScope to mutations. Updates and deletes
+// only match rows in the subtrees; creates and re-parenting must point at an
+// entity inside them.
+func entityScopeHook(column string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !EntityScoped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			if !m.Op().Is(ent.OpCreate) {
+				if wm, ok := m.(wherePMutation); ok {
+					wm.WhereP(inEntityScope(ctx, column))
+				}
+			}
+
+			var (
+				client  *ent.Client
+				target  uuid.UUID
+				checked bool
+			)
+			switch m := m.(type) {
+			case *ent.EntityMutation:
+				client = m.Client()
+				target, checked = m.ParentID()
+				if !checked && (m.Op().Is(ent.OpCreate) || m.ParentCleared()) {
+					return nil, ErrOutsideEntityScope
+				}
+			case *ent.AttachmentMutation:
+				client = m.Client()
+				target, checked = m.EntityID()
+			case *ent.MaintenanceEntryMutation:
+				client = m.Client()
+				target, checked = m.EntityID()
+			}
+
+			if checked {
+				exists, err := client.Entity.Query().
+					Where(entity.ID(target)).
+					Exist(withTrashed(ctx))
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, ErrOutsideEntityScope
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
@@ -0,0 +1,625 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entityfield"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/tag"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/textutils"
+)
+
+const (
+	// entitySearchTable is the SQLite FTS5 table or PostgreSQL tsvector table
+	// created by the entity_search migrations.
+	entitySearchTable = "entity_search"
+	// entitySearchAlias names the ranked match set joined into entity
+	// queries; its rank column is higher for better matches.
+	entitySearchAlias = "entity_search_match"
+
+	searchIndexBatchSize = 200
+	maxSearchTerms       = 16
+)
+
+// EntitySearchRepository maintains the full-text index behind entity search.
+// Each entity has one document with four weighted parts:
+//
+//	name         the entity name
+//	identifiers  serial, model and manufacturer, and tag names
+//	content      description and custom field text values
+//	extra        notes, attachment titles and maintenance entries
+//
+// The index follows ent mutations through hooks installed by New; Sync and
+// RebuildGroup cover writes that bypass ent, such as imports.
+type EntitySearchRepository struct {
+	db *ent.Client
+}
+
+type entitySearchDoc struct {
+	EntityID    uuid.UUID
+	GroupID     uuid.UUID
+	Name        string
+	Identifiers string
+	Content     string
+	Extra       string
+}
+
+func newEntitySearchRepository(db *ent.Client) *EntitySearchRepository {
+	r := &EntitySearchRepository{db}
+	db.Entity.Use(r.hook(entitySearchTargets))
+	db.EntityField.Use(r.hook(entityFieldSearchTargets))
+	db.Attachment.Use(r.hook(attachmentSearchTargets))
+	db.MaintenanceEntry.Use(r.hook(maintenanceSearchTargets))
+	db.Tag.Use(r.hook(tagSearchTargets))
+	return r
+}
+
+// Reindex rebuilds the documents of ids. IDs that no longer exist are
+// removed from the index.
+func (r *EntitySearchRepository) Reindex(ctx context.Context, ids ...uuid.UUID) error {
+	ctx = withoutEntityScope(ctx)
+	for _, chunk := range lo.Chunk(lo.Uniq(ids), searchIndexBatchSize) {
+		docs, err := r.buildDocs(ctx, chunk)
+		if err != nil {
+			return err
+		}
+
+		found := make(map[uuid.UUID]struct{}, len(docs))
+		for _, d := range docs {
+			found[d.EntityID] = struct{}{}
+		}
+		missing := lo.Filter(chunk, func(id uuid.UUID, _ int) bool {
+			_, ok := found[id]
+			return !ok
+		})
+
+		if err := r.write(ctx, docs, missing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RebuildGroup drops and rebuilds the index for every entity in the group and
+// returns how many entities were indexed.
+func (r *EntitySearchRepository) RebuildGroup(ctx context.Context, gid uuid.UUID) (int, error) {
+	if _, err := r.db.Sql().ExecContext(ctx,
+		"DELETE FROM "+entitySearchTable+" WHERE group_id = $1", gid); err != nil {
+		return 0, err
+	}
+
+	ids, err := r.db.Entity.Query().
+		Where(entity.HasGroupWith(group.ID(gid))).
+		IDs(withTrashed(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(ids), r.Reindex(ctx, ids...)
+}
+
+// Sync removes documents of entities that no longer exist and indexes
+// entities without a document. It is run at startup so entities created
+// before the index existed become searchable.
+func (r *EntitySearchRepository) Sync(ctx context.Context) (int, error) {
+	if _, err := r.db.Sql().ExecContext(ctx,
+		"DELETE FROM "+entitySearchTable+" WHERE entity_id NOT IN (SELECT id FROM entities)"); err != nil {
+		return 0, err
+	}
+
+	rows, err := r.db.Sql().QueryContext(ctx,
+		"SELECT id FROM entities WHERE id NOT IN (SELECT entity_id FROM "+entitySearchTable+")")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	return len(ids), r.Reindex(ctx, ids...)
+}
+
+// entitySearchFieldTypes are the custom field types whose values are indexed:
+// those holding text, rather than numbers, booleans or dates.
+var entitySearchFieldTypes = []entityfield.Type{
+	entityfield.TypeText,
+	entityfield.TypeURL,
+	entityfield.TypeEmail,
+	entityfield.TypeSelect,
+	entityfield.TypeMultiSelect,
+}
+
+func (r *EntitySearchRepository) buildDocs(ctx context.Context, ids []uuid.UUID) ([]entitySearchDoc, error) {
+	entities, err := r.db.Entity.Query().
+		Where(entity.IDIn(ids...)).
+		WithGroup(func(q *ent.GroupQuery) { q.Select(group.FieldID) }).
+		WithTag(func(q *ent.TagQuery) { q.Select(tag.FieldName) }).
+		WithFields(func(q *ent.EntityFieldQuery) {
+			q.Where(entityfield.TypeIn(entitySearchFieldTypes...))
+		}).
+		WithAttachments(func(q *ent.AttachmentQuery) {
+			q.Where(attachment.TypeNEQ(attachment.TypeThumbnail))
+		}).
+		WithMaintenanceEntries(func(q *ent.MaintenanceEntryQuery) {
+			q.Select(maintenanceentry.FieldName, maintenanceentry.FieldDescription, maintenanceentry.FieldEntityID)
+		}).
+		All(withTrashed(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]entitySearchDoc, 0, len(entities))
+	for _, e := range entities {
+		if e.Edges.Group == nil {
+			continue
+		}
+		docs = append(docs, newEntitySearchDoc(e))
+	}
+	return docs, nil
+}
+
+func newEntitySearchDoc(e *ent.Entity) entitySearchDoc {
+	identifiers := []string{e.SerialNumber, e.ModelNumber, e.Manufacturer}
+	for _, t := range e.Edges.Tag {
+		identifiers = append(identifiers, t.Name)
+	}
+
+	content := []string{e.Description}
+	for _, f := range e.Edges.Fields {
+		content = append(content, f.TextValue)
+	}
+
+	extra := []string{e.Notes}
+	for _, a := range e.Edges.Attachments {
+		extra = append(extra, a.Title)
+	}
+	for _, m := range e.Edges.MaintenanceEntries {
+		extra = append(extra, m.Name, m.Description)
+	}
+
+	return entitySearchDoc{
+		EntityID:    e.ID,
+		GroupID:     e.Edges.Group.ID,
+		Name:        textutils.RemoveAccents(e.Name),
+		Identifiers: joinSearchText(identifiers),
+		Content:     joinSearchText(content),
+		Extra:       joinSearchText(extra),
+	}
+}
+
+// joinSearchText joins the non-empty parts with accents removed, so matching
+// is accent-insensitive on PostgreSQL too.
+func joinSearchText(parts []string) string {
+	return textutils.RemoveAccents(strings.Join(lo.Compact(parts), "\n"))
+}
+
+// write replaces the documents in docs and deletes those of removed.
+func (r *EntitySearchRepository) write(ctx context.Context, docs []entitySearchDoc, removed []uuid.UUID) error {
+	if len(docs) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Sql().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction while writing search index")
+			}
+		}
+	}()
+
+	for _, id := range removed {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+entitySearchTable+" WHERE entity_id = $1", id); err != nil {
+			return err
+		}
+	}
+
+	postgres := r.db.Dialect() == dialect.Postgres
+	for _, d := range docs {
+		if err := writeSearchDoc(ctx, tx, postgres, d); err != nil {
+			return fmt.Errorf("index entity %s: %w", d.EntityID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+func writeSearchDoc(ctx context.Context, tx *sql.Tx, postgres bool, d entitySearchDoc) error {
+	if postgres {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO entity_search (entity_id, group_id, document)
+			VALUES ($1, $2,
+				setweight(to_tsvector('simple', $3), 'A') ||
+				setweight(to_tsvector('simple', $4), 'B') ||
+				setweight(to_tsvector('simple', $5), 'C') ||
+				setweight(to_tsvector('simple', $6), 'D'))
+			ON CONFLICT (entity_id) DO UPDATE
+				SET group_id = EXCLUDED.group_id, document = EXCLUDED.document`,
+			d.EntityID, d.GroupID, d.Name, d.Identifiers, d.Content, d.Extra)
+		return err
+	}
+
+	// FTS5 tables have no unique constraint to upsert against.
+	if _, err := tx.ExecContext(ctx, "DELETE FROM entity_search WHERE entity_id = $1", d.EntityID); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO entity_search (entity_id, group_id, name, identifiers, content, extra)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		d.EntityID, d.GroupID, d.Name, d.Identifiers, d.Content, d.Extra)
+	return err
+}
+
+// =============================================================================
+// Index maintenance
+
+// searchTargetsFunc returns the entities whose documents a mutation changes,
+// or nil when it touches nothing that is indexed. It runs before the
+// mutation so deleted rows can still be resolved.
+type searchTargetsFunc func(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error)
+
+type txMutation interface {
+	Tx() (*ent.Tx, error)
+}
+
+// hook reindexes the targets of a mutation once it is durable: straight away,
+// or after commit when the mutation runs inside a transaction. Indexing
+// failures are logged and never fail the mutation itself.
+func (r *EntitySearchRepository) hook(targets searchTargetsFunc) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ids, err := targets(withTrashed(withoutEntityScope(ctx)), m)
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil || len(ids) == 0 {
+				return v, err
+			}
+
+			if tm, ok := m.(txMutation); ok {
+				if tx, txErr := tm.Tx(); txErr == nil {
+					tx.OnCommit(func(next ent.Committer) ent.Committer {
+						return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+							if err := next.Commit(ctx, tx); err != nil {
+								return err
+							}
+							r.reindexLogged(context.WithoutCancel(ctx), ids)
+							return nil
+						})
+					})
+					return v, nil
+				}
+			}
+
+			r.reindexLogged(ctx, ids)
+			return v, nil
+		})
+	}
+}
+
+func (r *EntitySearchRepository) reindexLogged(ctx context.Context, ids []uuid.UUID) {
+	if err := r.Reindex(ctx, ids...); err != nil {
+		log.Warn().Err(err).Int("entities", len(ids)).Msg("failed to update search index")
+	}
+}
+
+// mutationTouches reports whether m creates or deletes rows, or changes any
+// of fields or edges.
+func mutationTouches(m ent.Mutation, fields []string, edges ...string) bool {
+	if m.Op().Is(ent.OpCreate | ent.OpDelete | ent.OpDeleteOne) {
+		return true
+	}
+	changed := func(names []string, wanted []string) bool {
+		return slices.ContainsFunc(names, func(n string) bool { return slices.Contains(wanted, n) })
+	}
+	return changed(m.Fields(), fields) ||
+		changed(m.ClearedFields(), fields) ||
+		changed(m.AddedEdges(), edges) ||
+		changed(m.RemovedEdges(), edges) ||
+		changed(m.ClearedEdges(), edges)
+}
+
+var entitySearchFields = []string{
+	entity.FieldName,
+	entity.FieldDescription,
+	entity.FieldNotes,
+	entity.FieldSerialNumber,
+	entity.FieldModelNumber,
+	entity.FieldManufacturer,
+}
+
+func entitySearchTargets(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error) {
+	em, ok := m.(*ent.EntityMutation)
+	if !ok || !mutationTouches(m, entitySearchFields, entity.EdgeTag) {
+		return nil, nil
+	}
+	if id, ok := em.ID(); ok && m.Op().Is(ent.OpCreate|ent.OpUpdateOne|ent.OpDeleteOne) {
+		return []uuid.UUID{id}, nil
+	}
+	return em.IDs(ctx)
+}
+
+func entityFieldSearchTargets(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error) {
+	fm, ok := m.(*ent.EntityFieldMutation)
+	if !ok || !mutationTouches(m, []string{entityfield.FieldType, entityfield.FieldTextValue}, entityfield.EdgeEntity) {
+		return nil, nil
+	}
+
+	var owners []uuid.UUID
+	if !m.Op().Is(ent.OpCreate) {
+		ids, err := fm.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		owners, err = fm.Client().EntityField.Query().
+			Where(entityfield.IDIn(ids...)).
+			QueryEntity().
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if id, ok := fm.EntityID(); ok {
+		owners = append(owners, id)
+	}
+	return owners, nil
+}
+
+func attachmentSearchTargets(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error) {
+	am, ok := m.(*ent.AttachmentMutation)
+	if !ok || !mutationTouches(m, []string{attachment.FieldTitle, attachment.FieldType}, attachment.EdgeEntity) {
+		return nil, nil
+	}
+	if t, ok := am.GetType(); ok && t == attachment.TypeThumbnail && m.Op().Is(ent.OpCreate) {
+		return nil, nil
+	}
+
+	var owners []uuid.UUID
+	if !m.Op().Is(ent.OpCreate) {
+		ids, err := am.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		owners, err = am.Client().Attachment.Query().
+			Where(attachment.IDIn(ids...)).
+			QueryEntity().
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if id, ok := am.EntityID(); ok {
+		owners = append(owners, id)
+	}
+	return owners, nil
+}
+
+func maintenanceSearchTargets(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error) {
+	mm, ok := m.(*ent.MaintenanceEntryMutation)
+	fields := []string{maintenanceentry.FieldName, maintenanceentry.FieldDescription, maintenanceentry.FieldEntityID}
+	if !ok || !mutationTouches(m, fields, maintenanceentry.EdgeEntity) {
+		return nil, nil
+	}
+
+	var owners []uuid.UUID
+	if !m.Op().Is(ent.OpCreate) {
+		ids, err := mm.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		owners, err = mm.Client().MaintenanceEntry.Query().
+			Where(maintenanceentry.IDIn(ids...)).
+			QueryEntity().
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if id, ok := mm.EntityID(); ok {
+		owners = append(owners, id)
+	}
+	return owners, nil
+}
+
+func tagSearchTargets(ctx context.Context, m ent.Mutation) ([]uuid.UUID, error) {
+	tm, ok := m.(*ent.TagMutation)
+	if !ok || !mutationTouches(m, []string{tag.FieldName}, tag.EdgeEntities) {
+		return nil, nil
+	}
+
+	owners := append(tm.EntitiesIDs(), tm.RemovedEntitiesIDs()...)
+	if m.Op().Is(ent.OpCreate) {
+		return owners, nil
+	}
+
+	ids, err := tm.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tagged, err := tm.Client().Entity.Query().
+		Where(entity.HasTagWith(tag.IDIn(ids...))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(owners, tagged...), nil
+}
+
+// =============================================================================
+// Querying
+
+// searchTerm is a bare word or a quoted phrase from a search string, split
+// into lexemes the way the database tokenizers split indexed text.
+type searchTerm struct {
+	lexemes []string
+	prefix  bool
+}
+
+// parseSearchQuery splits a search string into terms, all of which must
+// match. Bare words match as prefixes ("bos" finds "Bosch"); "quoted
+// phrases" match their words in order, and as a prefix when followed by *.
+func parseSearchQuery(s string) []searchTerm {
+	var terms []searchTerm
+	for len(terms) < maxSearchTerms {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			break
+		}
+
+		var raw string
+		prefix := true
+		if s[0] == '"' {
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				raw, s = s[1:], ""
+			} else {
+				raw, s = s[1:end+1], s[end+2:]
+			}
+			prefix = strings.HasPrefix(s, "*")
+			s = strings.TrimPrefix(s, "*")
+		} else {
+			end := strings.IndexFunc(s, unicode.IsSpace)
+			if end < 0 {
+				end = len(s)
+			}
+			raw, s = s[:end], s[end:]
+		}
+
+		lexemes := strings.FieldsFunc(textutils.NormalizeSearchQuery(raw), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if len(lexemes) > 0 {
+			terms = append(terms, searchTerm{lexemes: lexemes, prefix: prefix})
+		}
+	}
+	return terms
+}
+
+// fts5Query renders terms as an FTS5 MATCH expression. Lexemes hold only
+// letters and digits, so they never need escaping.
+func fts5Query(terms []searchTerm) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		parts[i] = `"` + strings.Join(t.lexemes, " ") + `"`
+		if t.prefix {
+			parts[i] += "*"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// tsQuery renders terms in PostgreSQL to_tsquery syntax.
+func tsQuery(terms []searchTerm) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		lexemes := make([]string, len(t.lexemes))
+		for j, l := range t.lexemes {
+			lexemes[j] = "'" + l + "'"
+		}
+		parts[i] = strings.Join(lexemes, " <-> ")
+		if t.prefix {
+			parts[i] += ":*"
+		}
+	}
+	return strings.Join(parts, " & ")
+}
+
+type entityPredicate = func(*entsql.Selector)
+
+// entitySearchMatch limits an entity query to entities in gid matching terms
+// and joins their rank as entitySearchAlias.rank.
+func entitySearchMatch(gid uuid.UUID, terms []searchTerm) entityPredicate {
+	return func(s *entsql.Selector) {
+		t := entitySearchSelect(s.Dialect(), gid, terms, true).As(entitySearchAlias)
+		s.Join(t).On(s.C(entity.FieldID), t.C("entity_id"))
+	}
+}
+
+// entitySearchIn limits an entity query to entities in gid matching terms
+// without ranking them, so it can be combined with OR and NOT.
+func entitySearchIn(gid uuid.UUID, terms []searchTerm) entityPredicate {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.In(s.C(entity.FieldID), entitySearchSelect(s.Dialect(), gid, terms, false)))
+	}
+}
+
+// entitySearchSelect selects the IDs of entities in gid matching terms and,
+// when ranked is set, their rank.
+func entitySearchSelect(d string, gid uuid.UUID, terms []searchTerm, ranked bool) *entsql.Selector {
+	if d == dialect.Postgres {
+		q := tsQuery(terms)
+		sel := entsql.Dialect(dialect.Postgres).
+			Select("entity_id").
+			From(entsql.Table(entitySearchTable)).
+			Where(entsql.And(
+				entsql.EQ("group_id", gid),
+				entsql.P(func(b *entsql.Builder) {
+					b.WriteString("document @@ to_tsquery('simple', ").Arg(q).WriteString(")")
+				}),
+			))
+		if ranked {
+			sel.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+				b.WriteString("ts_rank_cd(document, to_tsquery('simple', ").Arg(q).WriteString("))")
+			}), "rank")
+		}
+		return sel
+	}
+
+	sel := entsql.Dialect(dialect.SQLite).
+		Select("entity_id").
+		From(entsql.Table(entitySearchTable)).
+		Where(entsql.And(
+			entsql.EQ("group_id", gid),
+			entsql.P(func(b *entsql.Builder) {
+				b.WriteString("entity_search MATCH ").Arg(fts5Query(terms))
+			}),
+		))
+	if ranked {
+		// bm25 is lower for better matches. Weights follow the column
+		// order, including the two unindexed ID columns.
+		sel.AppendSelectExprAs(entsql.Expr("-bm25(entity_search, 0, 0, 10.0, 5.0, 2.0, 1.0)"), "rank")
+	}
+	return sel
+}
+
+// entitySearchRankOrder orders by the rank joined by entitySearchMatch, best
+// match first.
+func entitySearchRankOrder() entityPredicate {
+	return func(s *entsql.Selector) {
+		s.OrderExpr(entsql.DescExpr(entsql.Expr(entitySearchAlias + ".rank")))
+	}
+}
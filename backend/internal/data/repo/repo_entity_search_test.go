@@ -0,0 +1,175 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func useSearchGroup(t *testing.T) (uuid.UUID, uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+
+	g, err := tRepos.Groups.GroupCreate(ctx, "search-"+fk.Str(6), uuid.Nil)
+	require.NoError(t, err)
+
+	et, err := tRepos.EntityTypes.GetDefault(ctx, g.ID, false)
+	require.NoError(t, err)
+	return g.ID, et.ID
+}
+
+func searchNames(t *testing.T, gid uuid.UUID, search, orderBy string) []string {
+	t.Helper()
+	res, err := tRepos.Entities.QueryByGroup(context.Background(), gid, EntityQuery{
+		Search:   search,
+		OrderBy:  orderBy,
+		Page:     -1,
+		PageSize: -1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, len(res.Items), res.Total)
+
+	names := make([]string, len(res.Items))
+	for i, e := range res.Items {
+		names[i] = e.Name
+	}
+	return names
+}
+
+func TestEntitySearch_RanksAcrossIndexedText(t *testing.T) {
+	ctx := context.Background()
+	gid, etID := useSearchGroup(t)
+
+	create := func(name string) EntityOut {
+		e, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: name, EntityTypeID: etID})
+		require.NoError(t, err)
+		return e
+	}
+
+	drill := create("Bosch Cordless Drill")
+	saw := create("Makita Saw")
+	charger := create("Battery Charger")
+	create("Garden Hose")
+
+	// Custom field value and a maintenance entry on otherwise unrelated items.
+	_, err := tRepos.Entities.UpdateByGroup(ctx, gid, EntityUpdate{
+		ID:           charger.ID,
+		Name:         charger.Name,
+		EntityTypeID: etID,
+		Fields:       []EntityFieldData{{Type: "text", Name: "Compatible", TextValue: "Bosch 18V"}},
+	})
+	require.NoError(t, err)
+
+	// URL, email and select values are text too.
+	_, err = tRepos.Entities.UpdateByGroup(ctx, gid, EntityUpdate{
+		ID:           saw.ID,
+		Name:         saw.Name,
+		EntityTypeID: etID,
+		Fields: []EntityFieldData{
+			{Type: "url", Name: "Manual", TextValue: "https://example.com/manuals/hs7601"},
+			{Type: "email", Name: "Support", TextValue: "service@toolshop.example"},
+			{Type: "select", Name: "Blade", TextValue: "Carbide"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{saw.Name}, searchNames(t, gid, "hs7601", ""))
+	assert.Equal(t, []string{saw.Name}, searchNames(t, gid, "toolshop", ""))
+	assert.Equal(t, []string{saw.Name}, searchNames(t, gid, "carbide", ""))
+
+	hose := searchNames(t, gid, "hose", "")
+	require.Equal(t, []string{"Garden Hose"}, hose)
+
+	res, err := tRepos.Entities.QueryByGroup(ctx, gid, EntityQuery{Search: "hose", Page: -1, PageSize: -1})
+	require.NoError(t, err)
+	_, err = tRepos.MaintEntry.Create(ctx, gid, res.Items[0].ID, MaintenanceEntryCreate{
+		Name:        "Replace washer",
+		Description: "Use the bosch seal kit",
+	})
+	require.NoError(t, err)
+
+	// Name matches rank above field and maintenance matches.
+	names := searchNames(t, gid, "bosch", "relevance")
+	require.Len(t, names, 3)
+	assert.Equal(t, drill.Name, names[0])
+	assert.ElementsMatch(t, []string{"Battery Charger", "Garden Hose"}, names[1:])
+
+	// Prefix, phrase and accent-insensitive matching.
+	assert.Equal(t, []string{drill.Name}, searchNames(t, gid, "cordl", ""))
+	assert.Equal(t, []string{drill.Name}, searchNames(t, gid, `"bosch cordless"`, ""))
+	assert.Empty(t, searchNames(t, gid, `"cordless bosch"`, ""))
+	assert.Equal(t, []string{"Battery Charger"}, searchNames(t, gid, "bösch 18v", ""))
+
+	// Other groups never match.
+	other, _ := useSearchGroup(t)
+	assert.Empty(t, searchNames(t, other, "bosch", "relevance"))
+}
+
+func TestEntitySearch_FollowsMutations(t *testing.T) {
+	ctx := context.Background()
+	gid, etID := useSearchGroup(t)
+
+	e, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Router", EntityTypeID: etID})
+	require.NoError(t, err)
+
+	tg, err := tRepos.Tags.Create(ctx, gid, TagCreate{Name: "Networking"})
+	require.NoError(t, err)
+
+	_, err = tRepos.Entities.UpdateByGroup(ctx, gid, EntityUpdate{
+		ID:           e.ID,
+		Name:         "Router",
+		EntityTypeID: etID,
+		SerialNumber: "SN-4417-AX",
+		TagIDs:       []uuid.UUID{tg.ID},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Router"}, searchNames(t, gid, "networking", ""))
+	assert.Equal(t, []string{"Router"}, searchNames(t, gid, "sn-4417", ""))
+
+	// Renaming the tag reindexes its entities.
+	_, err = tRepos.Tags.UpdateByGroup(ctx, gid, TagUpdate{ID: tg.ID, Name: "Homelab"})
+	require.NoError(t, err)
+	assert.Empty(t, searchNames(t, gid, "networking", ""))
+	assert.Equal(t, []string{"Router"}, searchNames(t, gid, "homelab", ""))
+
+	// A rebuild restores documents written around the hooks.
+	_, err = tClient.Sql().ExecContext(ctx, "DELETE FROM entity_search WHERE group_id = $1", gid)
+	require.NoError(t, err)
+	assert.Empty(t, searchNames(t, gid, "router", ""))
+
+	n, err := tRepos.Search.RebuildGroup(ctx, gid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"Router"}, searchNames(t, gid, "router", ""))
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		in    string
+		fts5  string
+		tsq   string
+		empty bool
+	}{
+		{in: "bosch", fts5: `"bosch"*`, tsq: `'bosch':*`},
+		{in: "  Bosch   Drill ", fts5: `"bosch"* "drill"*`, tsq: `'bosch':* & 'drill':*`},
+		{in: `"cordless drill"`, fts5: `"cordless drill"`, tsq: `'cordless' <-> 'drill'`},
+		{in: `"cordless dri"*`, fts5: `"cordless dri"*`, tsq: `'cordless' <-> 'dri':*`},
+		{in: `SN-123 "unterminated phrase`, fts5: `"sn 123"* "unterminated phrase"`, tsq: `'sn' <-> '123':* & 'unterminated' <-> 'phrase'`},
+		{in: `Électronique`, fts5: `"electronique"*`, tsq: `'electronique':*`},
+		{in: `' OR 1=1 --`, fts5: `"or"* "1 1"*`, tsq: `'or':* & '1' <-> '1':*`},
+		{in: "!!! ---", empty: true},
+	}
+
+	for _, tc := range tests {
+		terms := parseSearchQuery(tc.in)
+		if tc.empty {
+			assert.Empty(t, terms, tc.in)
+			continue
+		}
+		assert.Equal(t, tc.fts5, fts5Query(terms), tc.in)
+		assert.Equal(t, tc.tsq, tsQuery(terms), tc.in)
+	}
+}
@@ -0,0 +1,293 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrInsufficientStock is returned when consuming more than an entity holds.
+var ErrInsufficientStock = errors.New("not enough stock")
+
+type (
+	// StockChange consumes or restocks Quantity units of an entity.
+	StockChange struct {
+		Quantity float64 `json:"quantity" validate:"required,gt=0"`
+		Note     string  `json:"note"     validate:"max=1000"`
+	}
+
+	StockMovement struct {
+		ID            uuid.UUID          `json:"id"`
+		EntityID      uuid.UUID          `json:"entityId"`
+		Kind          stockmovement.Kind `json:"kind"`
+		Change        float64            `json:"change"`
+		QuantityAfter float64            `json:"quantityAfter"`
+		Note          string             `json:"note"`
+		UserID        *uuid.UUID         `json:"userId,omitempty" extensions:"x-nullable,x-omitempty"`
+		CreatedAt     time.Time          `json:"createdAt"`
+	}
+
+	// LowStockEntry is a row of the low-stock report.
+	LowStockEntry struct {
+		EntitySummary
+		MinQuantity  *float64 `json:"minQuantity,omitempty"  extensions:"x-nullable,x-omitempty"`
+		ReorderPoint *float64 `json:"reorderPoint,omitempty" extensions:"x-nullable,x-omitempty"`
+		// Threshold is the quantity at or below which the entity is low:
+		// the reorder point when set, otherwise the minimum quantity.
+		Threshold float64 `json:"threshold"`
+	}
+)
+
+func mapStockMovement(m *ent.StockMovement) StockMovement {
+	return StockMovement{
+		ID:            m.ID,
+		EntityID:      m.EntityID,
+		Kind:          m.Kind,
+		Change:        m.Change,
+		QuantityAfter: m.QuantityAfter,
+		Note:          m.Note,
+		UserID:        m.UserID,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// stockThreshold returns the quantity at or below which an entity is low on
+// stock, and false when it has neither a reorder point nor a minimum.
+func stockThreshold(minQuantity, reorderPoint *float64) (float64, bool) {
+	switch {
+	case reorderPoint != nil:
+		return *reorderPoint, true
+	case minQuantity != nil:
+		return *minQuantity, true
+	}
+	return 0, false
+}
+
+// StockThreshold is stockThreshold for e.
+func (e EntityOut) StockThreshold() (float64, bool) {
+	return stockThreshold(e.MinQuantity, e.ReorderPoint)
+}
+
+func lowStock(quantity float64, minQuantity, reorderPoint *float64) bool {
+	threshold, ok := stockThreshold(minQuantity, reorderPoint)
+	return ok && quantity <= threshold
+}
+
+// crossedThreshold reports whether a quantity change from before to after
+// made an entity low on stock. Changes that stay below the threshold don't
+// count, so an alert goes out once per drop rather than on every consume.
+func crossedThreshold(before, after float64, minQuantity, reorderPoint *float64) bool {
+	return !lowStock(before, minQuantity, reorderPoint) && lowStock(after, minQuantity, reorderPoint)
+}
+
+// ChangeStock consumes or restocks an entity and records the movement in its
+// ledger. Consuming more than the entity holds fails with
+// ErrInsufficientStock; the quantity never goes negative.
+func (r *EntityRepository) ChangeStock(ctx context.Context, gid, id uuid.UUID, kind stockmovement.Kind, data StockChange) (StockMovement, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.ChangeStock",
+		trace.WithAttributes(
+			attribute.String("group.id", gid.String()),
+			attribute.String("entity.id", id.String()),
+			attribute.String("stock.kind", kind.String()),
+			attribute.Float64("stock.quantity", data.Quantity),
+		))
+	defer span.End()
+
+	var change float64
+	switch kind {
+	case stockmovement.KindConsume:
+		change = -data.Quantity
+	case stockmovement.KindRestock:
+		change = data.Quantity
+	default:
+		err := fmt.Errorf("change stock: unsupported kind %q", kind)
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+	if err := validateQuantity("change stock", data.Quantity); err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+	if data.Quantity == 0 {
+		err := errors.New("change stock: invalid quantity: must be positive")
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during stock change")
+			}
+		}
+	}()
+
+	// The quantity is changed in place rather than read and written back, so
+	// two concurrent consumes can't both take the last unit.
+	n, err := tx.Entity.Update().
+		Where(
+			entity.ID(id),
+			entity.HasGroupWith(group.ID(gid)),
+			entity.QuantityGTE(-change),
+		).
+		AddQuantity(change).
+		Save(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+	if n == 0 {
+		if err := assertEntityInGroup(ctx, tx.Entity, gid, id); err != nil {
+			recordSpanError(span, err)
+			return StockMovement{}, err
+		}
+		recordSpanError(span, ErrInsufficientStock)
+		return StockMovement{}, ErrInsufficientStock
+	}
+
+	after, err := tx.Entity.Query().
+		Where(entity.ID(id)).
+		Select(entity.FieldQuantity, entity.FieldMinQuantity, entity.FieldReorderPoint).
+		Only(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+
+	movement, err := createStockMovement(ctx, tx.StockMovement, id, kind, change, after.Quantity, data.Note)
+	if err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		recordSpanError(span, err)
+		return StockMovement{}, err
+	}
+	committed = true
+
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate, id)
+	if crossedThreshold(after.Quantity-change, after.Quantity, after.MinQuantity, after.ReorderPoint) {
+		r.publishLowStockEvent(ctx, gid, id)
+	}
+	return mapStockMovement(movement), nil
+}
+
+// createStockMovement writes a ledger row attributed to the user on ctx.
+func createStockMovement(ctx context.Context, c *ent.StockMovementClient, id uuid.UUID, kind stockmovement.Kind, change, after float64, note string) (*ent.StockMovement, error) {
+	q := c.Create().
+		SetEntityID(id).
+		SetKind(kind).
+		SetChange(change).
+		SetQuantityAfter(after).
+		SetNote(note)
+	if actor := auditActorFromCtx(ctx).UserID; actor != uuid.Nil {
+		q.SetUserID(actor)
+	}
+	return q.Save(ctx)
+}
+
+// recordStockAdjustment logs a quantity edited through an update or patch as
+// an adjustment. Editing an entity shouldn't fail because of its ledger, so
+// errors are only logged.
+func (r *EntityRepository) recordStockAdjustment(ctx context.Context, c *ent.StockMovementClient, id uuid.UUID, before, after float64) {
+	if before == after {
+		return
+	}
+	if _, err := createStockMovement(ctx, c, id, stockmovement.KindAdjust, after-before, after, ""); err != nil {
+		log.Warn().Err(err).Str("entity_id", id.String()).Msg("failed to record stock adjustment")
+	}
+}
+
+func (r *EntityRepository) publishLowStockEvent(ctx context.Context, gid uuid.UUID, ids ...uuid.UUID) {
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventEntityLowStock, newMutationEvent(ctx, gid, eventbus.MutationUpdate, ids))
+	}
+}
+
+// StockMovements returns the ledger of an entity, newest first.
+func (r *EntityRepository) StockMovements(ctx context.Context, gid, id uuid.UUID) ([]StockMovement, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.StockMovements",
+		trace.WithAttributes(
+			attribute.String("group.id", gid.String()),
+			attribute.String("entity.id", id.String()),
+		))
+	defer span.End()
+
+	if err := assertEntityInGroup(ctx, r.db.Entity, gid, id); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	movements, err := r.db.StockMovement.Query().
+		Where(stockmovement.EntityID(id)).
+		Order(ent.Desc(stockmovement.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("movements.count", len(movements)))
+	return mapEach(movements, mapStockMovement), nil
+}
+
+// LowStock lists the unarchived items of a group that are at or below their
+// reorder threshold, ordered by name.
+func (r *EntityRepository) LowStock(ctx context.Context, gid uuid.UUID) ([]LowStockEntry, error) {
+	ctx, span := entityTracer().Start(ctx, "repo.EntityRepository.LowStock",
+		trace.WithAttributes(attribute.String("group.id", gid.String())))
+	defer span.End()
+
+	entities, err := r.db.Entity.Query().
+		Where(
+			entity.HasGroupWith(group.ID(gid)),
+			entity.Archived(false),
+			entity.HasEntityTypeWith(entitytype.IsLocation(false)),
+			entity.Or(entity.MinQuantityNotNil(), entity.ReorderPointNotNil()),
+			func(s *sql.Selector) {
+				s.Where(sql.ExprP(fmt.Sprintf("%s <= COALESCE(%s, %s)",
+					s.C(entity.FieldQuantity), s.C(entity.FieldReorderPoint), s.C(entity.FieldMinQuantity))))
+			},
+		).
+		WithParent().
+		WithEntityType().
+		WithTag().
+		Order(ent.Asc(entity.FieldName)).
+		All(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	out := make([]LowStockEntry, len(entities))
+	for i, e := range entities {
+		threshold, _ := stockThreshold(e.MinQuantity, e.ReorderPoint)
+		out[i] = LowStockEntry{
+			EntitySummary: mapEntitySummary(e),
+			MinQuantity:   e.MinQuantity,
+			ReorderPoint:  e.ReorderPoint,
+			Threshold:     threshold,
+		}
+	}
+	span.SetAttributes(attribute.Int("entities.count", len(out)))
+	return out, nil
+}
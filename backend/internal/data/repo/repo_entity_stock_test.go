@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/stockmovement"
+)
+
+func TestEntityRepository_ChangeStock(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+
+	lowStock := make(chan uuid.UUID, 10)
+	tbus.Subscribe(eventbus.EventEntityLowStock, func(data any) {
+		if evt, ok := data.(eventbus.GroupMutationEvent); ok && evt.GID == gid {
+			lowStock <- evt.IDs[0]
+		}
+	})
+
+	batteries, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "AA Batteries", EntityTypeID: itemType, Quantity: 12})
+	require.NoError(t, err)
+	reorder := 4.0
+	update := stockUpdate(batteries)
+	update.ReorderPoint = &reorder
+	update.Unit = "pcs"
+	batteries, err = tRepos.Entities.UpdateByGroup(ctx, gid, update)
+	require.NoError(t, err)
+	assert.Equal(t, "pcs", batteries.Unit)
+	assert.False(t, batteries.LowStock)
+
+	m, err := tRepos.Entities.ChangeStock(ctx, gid, batteries.ID, stockmovement.KindConsume, StockChange{Quantity: 6, Note: "smoke alarms"})
+	require.NoError(t, err)
+	assert.InDelta(t, -6, m.Change, 0)
+	assert.InDelta(t, 6, m.QuantityAfter, 0)
+
+	// Only the consume that crosses the reorder point raises an alert.
+	_, err = tRepos.Entities.ChangeStock(ctx, gid, batteries.ID, stockmovement.KindConsume, StockChange{Quantity: 2})
+	require.NoError(t, err)
+	_, err = tRepos.Entities.ChangeStock(ctx, gid, batteries.ID, stockmovement.KindConsume, StockChange{Quantity: 1})
+	require.NoError(t, err)
+	select {
+	case id := <-lowStock:
+		assert.Equal(t, batteries.ID, id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no low stock event")
+	}
+
+	_, err = tRepos.Entities.ChangeStock(ctx, gid, batteries.ID, stockmovement.KindConsume, StockChange{Quantity: 4})
+	require.ErrorIs(t, err, ErrInsufficientStock)
+
+	_, err = tRepos.Entities.ChangeStock(ctx, tGroup.ID, batteries.ID, stockmovement.KindRestock, StockChange{Quantity: 1})
+	assert.True(t, ent.IsNotFound(err))
+
+	report, err := tRepos.Entities.LowStock(ctx, gid)
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, batteries.ID, report[0].ID)
+	assert.InDelta(t, 3, report[0].Quantity, 0)
+	assert.InDelta(t, 4, report[0].Threshold, 0)
+
+	// Editing the quantity is recorded as an adjustment.
+	restocked := 20.0
+	require.NoError(t, tRepos.Entities.Patch(ctx, gid, batteries.ID, EntityPatch{ID: batteries.ID, Quantity: &restocked}))
+
+	movements, err := tRepos.Entities.StockMovements(ctx, gid, batteries.ID)
+	require.NoError(t, err)
+	require.Len(t, movements, 4)
+	assert.Equal(t, stockmovement.KindAdjust, movements[0].Kind)
+	assert.InDelta(t, 17, movements[0].Change, 0)
+	assert.Equal(t, "smoke alarms", movements[len(movements)-1].Note)
+
+	report, err = tRepos.Entities.LowStock(ctx, gid)
+	require.NoError(t, err)
+	assert.Empty(t, report)
+
+	select {
+	case <-lowStock:
+		t.Fatal("unexpected low stock event")
+	default:
+	}
+}
+
+// stockUpdate is an update of e that keeps its name, type and stock settings.
+func stockUpdate(e EntityOut) EntityUpdate {
+	return EntityUpdate{
+		ID:           e.ID,
+		Name:         e.Name,
+		Description:  e.Description,
+		Quantity:     e.Quantity,
+		EntityTypeID: e.EntityType.ID,
+		MinQuantity:  e.MinQuantity,
+		ReorderPoint: e.ReorderPoint,
+		Unit:         e.Unit,
+	}
+}
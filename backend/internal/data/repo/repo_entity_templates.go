@@ -209,9 +209,9 @@ func (r *EntityTemplatesRepository) mapTemplateOut(ctx context.Context, template
 	}
 }
 
-func (r *EntityTemplatesRepository) publishMutationEvent(gid uuid.UUID) {
+func (r *EntityTemplatesRepository) publishMutationEvent(ctx context.Context, gid uuid.UUID, action eventbus.MutationAction, ids ...uuid.UUID) {
 	if r.bus != nil {
-		r.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: gid})
+		r.bus.Publish(eventbus.EventEntityMutation, newMutationEvent(ctx, gid, action, ids))
 	}
 }
 
@@ -301,7 +301,7 @@ func (r *EntityTemplatesRepository) Create(ctx context.Context, gid uuid.UUID, d
 		}
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate)
 	return r.GetOne(ctx, gid, template.ID)
 }
 
@@ -416,7 +416,7 @@ func (r *EntityTemplatesRepository) Update(ctx context.Context, gid uuid.UUID, d
 		}
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate)
 	return r.GetOne(ctx, gid, template.ID)
 }
 
@@ -440,6 +440,6 @@ func (r *EntityTemplatesRepository) Delete(ctx context.Context, gid uuid.UUID, i
 		return err
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete)
 	return nil
 }
@@ -1,7 +1,9 @@
 package repo
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +25,10 @@ type (
 		IsLocation        bool       `json:"isLocation"`
 		Icon              string     `json:"icon"`
 		DefaultTemplateID *uuid.UUID `json:"defaultTemplateId,omitempty"`
+		// Depreciation defaults for entities of this type
+		DepreciationMethod *entitytype.DepreciationMethod `json:"depreciationMethod" validate:"omitempty,oneof=none straight_line declining_balance manual" extensions:"x-nullable,x-omitempty"`
+		UsefulLifeYears    *float64                       `json:"usefulLifeYears"    validate:"omitempty,gt=0"                                           extensions:"x-nullable,x-omitempty"`
+		SalvageValue       *float64                       `json:"salvageValue"       validate:"omitempty,gte=0"                                          extensions:"x-nullable,x-omitempty"`
 	}
 
 	EntityTypeUpdate struct {
@@ -31,6 +37,10 @@ type (
 		IsLocation        bool       `json:"isLocation"`
 		Icon              string     `json:"icon"`
 		DefaultTemplateID *uuid.UUID `json:"defaultTemplateId,omitempty"`
+		// Depreciation defaults for entities of this type
+		DepreciationMethod *entitytype.DepreciationMethod `json:"depreciationMethod" validate:"omitempty,oneof=none straight_line declining_balance manual" extensions:"x-nullable,x-omitempty"`
+		UsefulLifeYears    *float64                       `json:"usefulLifeYears"    validate:"omitempty,gt=0"                                           extensions:"x-nullable,x-omitempty"`
+		SalvageValue       *float64                       `json:"salvageValue"       validate:"omitempty,gte=0"                                          extensions:"x-nullable,x-omitempty"`
 	}
 
 	EntityTypeSummary struct {
@@ -41,8 +51,16 @@ type (
 		Icon              string                 `json:"icon"`
 		DefaultTemplateID *uuid.UUID             `json:"defaultTemplateId,omitempty"`
 		DefaultTemplate   *EntityTemplateSummary `json:"defaultTemplate,omitempty"`
-		CreatedAt         time.Time              `json:"createdAt"`
-		UpdatedAt         time.Time              `json:"updatedAt"`
+
+		DepreciationMethod *entitytype.DepreciationMethod `json:"depreciationMethod,omitempty" extensions:"x-nullable,x-omitempty"`
+		UsefulLifeYears    *float64                       `json:"usefulLifeYears,omitempty"    extensions:"x-nullable,x-omitempty"`
+		SalvageValue       *float64                       `json:"salvageValue,omitempty"       extensions:"x-nullable,x-omitempty"`
+
+		// FieldDefinitions are the typed custom fields of entities of this type.
+		FieldDefinitions []EntityFieldDefinitionOut `json:"fieldDefinitions"`
+
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
 	}
 )
 
@@ -55,6 +73,10 @@ func mapEntityTypeSummary(et *ent.EntityType) EntityTypeSummary {
 		Icon:        et.Icon,
 		CreatedAt:   et.CreatedAt,
 		UpdatedAt:   et.UpdatedAt,
+
+		DepreciationMethod: et.DepreciationMethod,
+		UsefulLifeYears:    et.UsefulLifeYears,
+		SalvageValue:       et.SalvageValue,
 	}
 
 	if et.Edges.DefaultTemplate != nil {
@@ -71,12 +93,21 @@ func mapEntityTypeSummary(et *ent.EntityType) EntityTypeSummary {
 		s.DefaultTemplate = &summary
 	}
 
+	s.FieldDefinitions = []EntityFieldDefinitionOut{}
+	if et.Edges.FieldDefinitions != nil {
+		defs := slices.Clone(et.Edges.FieldDefinitions)
+		slices.SortStableFunc(defs, func(a, b *ent.EntityFieldDefinition) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.Name, b.Name))
+		})
+		s.FieldDefinitions = mapEach(defs, mapEntityFieldDefinition)
+	}
+
 	return s
 }
 
-func (r *EntityTypeRepository) publishMutationEvent(gid uuid.UUID) {
+func (r *EntityTypeRepository) publishMutationEvent(ctx context.Context, gid uuid.UUID, action eventbus.MutationAction, ids ...uuid.UUID) {
 	if r.bus != nil {
-		r.bus.Publish(eventbus.EventEntityMutation, eventbus.GroupMutationEvent{GID: gid})
+		r.bus.Publish(eventbus.EventEntityMutation, newMutationEvent(ctx, gid, action, ids))
 	}
 }
 
@@ -85,6 +116,7 @@ func (r *EntityTypeRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]Ent
 	types, err := r.db.EntityType.Query().
 		Where(entitytype.HasGroupWith(group.ID(gid))).
 		WithDefaultTemplate().
+		WithFieldDefinitions().
 		Order(entitytype.ByName()).
 		All(ctx)
 	if err != nil {
@@ -108,6 +140,9 @@ func (r *EntityTypeRepository) Create(ctx context.Context, gid uuid.UUID, data E
 		SetName(data.Name).
 		SetIsLocation(data.IsLocation).
 		SetIcon(data.Icon).
+		SetNillableDepreciationMethod(data.DepreciationMethod).
+		SetNillableUsefulLifeYears(data.UsefulLifeYears).
+		SetNillableSalvageValue(data.SalvageValue).
 		SetGroupID(gid)
 
 	if data.DefaultTemplateID != nil && *data.DefaultTemplateID != uuid.Nil {
@@ -119,7 +154,7 @@ func (r *EntityTypeRepository) Create(ctx context.Context, gid uuid.UUID, data E
 		return EntityTypeSummary{}, err
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationCreate)
 	return mapEntityTypeSummary(et), nil
 }
 
@@ -138,13 +173,25 @@ func (r *EntityTypeRepository) Update(ctx context.Context, gid uuid.UUID, data E
 		).
 		SetName(data.Name).
 		SetIsLocation(data.IsLocation).
-		SetIcon(data.Icon)
+		SetIcon(data.Icon).
+		SetNillableDepreciationMethod(data.DepreciationMethod).
+		SetNillableUsefulLifeYears(data.UsefulLifeYears).
+		SetNillableSalvageValue(data.SalvageValue)
 
 	if data.DefaultTemplateID != nil && *data.DefaultTemplateID != uuid.Nil {
 		q.SetDefaultTemplateID(*data.DefaultTemplateID)
 	} else {
 		q.ClearDefaultTemplate()
 	}
+	if data.DepreciationMethod == nil {
+		q.ClearDepreciationMethod()
+	}
+	if data.UsefulLifeYears == nil {
+		q.ClearUsefulLifeYears()
+	}
+	if data.SalvageValue == nil {
+		q.ClearSalvageValue()
+	}
 
 	_, err := q.Save(ctx)
 	if err != nil {
@@ -154,12 +201,13 @@ func (r *EntityTypeRepository) Update(ctx context.Context, gid uuid.UUID, data E
 	et, err := r.db.EntityType.Query().
 		Where(entitytype.ID(data.ID)).
 		WithDefaultTemplate().
+		WithFieldDefinitions().
 		Only(ctx)
 	if err != nil {
 		return EntityTypeSummary{}, err
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate)
 	return mapEntityTypeSummary(et), nil
 }
 
@@ -174,7 +222,7 @@ func (r *EntityTypeRepository) Delete(ctx context.Context, gid uuid.UUID, id uui
 		return err
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete)
 	return nil
 }
 
@@ -0,0 +1,232 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/exchangerate"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+// ExchangeRateRepository stores the exchange rates a group converts amounts
+// in other currencies with.
+type ExchangeRateRepository struct {
+	db *ent.Client
+}
+
+type (
+	// ExchangeRateCreate says one unit of Base was worth Rate units of Quote
+	// on Date. A rate for the same pair and date replaces the old one.
+	ExchangeRateCreate struct {
+		Base  string     `json:"base"  validate:"required,max=16"`
+		Quote string     `json:"quote" validate:"required,max=16,nefield=Base"`
+		Rate  float64    `json:"rate"  validate:"required,gt=0"`
+		Date  types.Date `json:"date"`
+	}
+
+	ExchangeRateOut struct {
+		ID    uuid.UUID  `json:"id"`
+		Base  string     `json:"base"`
+		Quote string     `json:"quote"`
+		Rate  float64    `json:"rate"`
+		Date  types.Date `json:"date"`
+	}
+)
+
+func mapExchangeRateOut(r *ent.ExchangeRate) ExchangeRateOut {
+	return ExchangeRateOut{
+		ID:    r.ID,
+		Base:  r.Base,
+		Quote: r.Quote,
+		Rate:  r.Rate,
+		Date:  types.DateFromTime(r.Date),
+	}
+}
+
+// GetAll returns the rates of gid by pair, newest first.
+func (r *ExchangeRateRepository) GetAll(ctx context.Context, gid uuid.UUID) ([]ExchangeRateOut, error) {
+	rates, err := r.db.ExchangeRate.Query().
+		Where(exchangerate.GroupID(gid)).
+		Order(ent.Asc(exchangerate.FieldBase), ent.Asc(exchangerate.FieldQuote), ent.Desc(exchangerate.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapEach(rates, mapExchangeRateOut), nil
+}
+
+// Set stores a rate, replacing any rate for the same pair and date. Rates
+// without a date are today's.
+func (r *ExchangeRateRepository) Set(ctx context.Context, gid uuid.UUID, data ExchangeRateCreate) (ExchangeRateOut, error) {
+	return setExchangeRate(ctx, r.db, gid, data)
+}
+
+// Import stores many rates at once, as Set does. Either all of them are
+// stored or none.
+func (r *ExchangeRateRepository) Import(ctx context.Context, gid uuid.UUID, data []ExchangeRateCreate) (int, error) {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback exchange rate import")
+			}
+		}
+	}()
+
+	for _, d := range data {
+		if _, err := setExchangeRate(ctx, tx.Client(), gid, d); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	committed = true
+	return len(data), nil
+}
+
+func setExchangeRate(ctx context.Context, db *ent.Client, gid uuid.UUID, data ExchangeRateCreate) (ExchangeRateOut, error) {
+	base := strings.ToUpper(strings.TrimSpace(data.Base))
+	quote := strings.ToUpper(strings.TrimSpace(data.Quote))
+	date := data.Date.Time()
+	if date.IsZero() {
+		date = types.DateFromTime(time.Now()).Time()
+	}
+
+	existing, err := db.ExchangeRate.Query().
+		Where(
+			exchangerate.GroupID(gid),
+			exchangerate.Base(base),
+			exchangerate.Quote(quote),
+			exchangerate.Date(date),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		existing, err = existing.Update().SetRate(data.Rate).Save(ctx)
+	case ent.IsNotFound(err):
+		existing, err = db.ExchangeRate.Create().
+			SetGroupID(gid).
+			SetBase(base).
+			SetQuote(quote).
+			SetRate(data.Rate).
+			SetDate(date).
+			Save(ctx)
+	}
+	if err != nil {
+		return ExchangeRateOut{}, err
+	}
+	return mapExchangeRateOut(existing), nil
+}
+
+func (r *ExchangeRateRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	n, err := r.db.ExchangeRate.Delete().
+		Where(exchangerate.ID(id), exchangerate.GroupID(gid)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// Converter returns a converter into the currency of gid with its rates.
+func (r *ExchangeRateRepository) Converter(ctx context.Context, gid uuid.UUID) (*CurrencyConverter, error) {
+	return newCurrencyConverter(ctx, r.db, gid)
+}
+
+type datedRate struct {
+	date time.Time
+	rate float64
+}
+
+// CurrencyConverter converts amounts into a group's currency. Only rates
+// between another currency and the group's are used, in either direction;
+// amounts in currencies without one are left as they are.
+type CurrencyConverter struct {
+	// Currency is the code amounts are converted into.
+	Currency string
+
+	today bool
+	rates map[string][]datedRate // by source currency, oldest first
+}
+
+func newCurrencyConverter(ctx context.Context, db *ent.Client, gid uuid.UUID) (*CurrencyConverter, error) {
+	g, err := db.Group.Query().
+		Where(group.ID(gid)).
+		Select(group.FieldCurrency, group.FieldCurrencyConversion).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CurrencyConverter{
+		Currency: strings.ToUpper(g.Currency),
+		today:    g.CurrencyConversion == group.CurrencyConversionToday,
+		rates:    map[string][]datedRate{},
+	}
+
+	rates, err := db.ExchangeRate.Query().
+		Where(
+			exchangerate.GroupID(gid),
+			exchangerate.Or(exchangerate.Base(c.Currency), exchangerate.Quote(c.Currency)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rates {
+		if r.Quote == c.Currency {
+			c.rates[r.Base] = append(c.rates[r.Base], datedRate{date: r.Date, rate: r.Rate})
+		} else {
+			c.rates[r.Quote] = append(c.rates[r.Quote], datedRate{date: r.Date, rate: 1 / r.Rate})
+		}
+	}
+	for _, rs := range c.rates {
+		slices.SortFunc(rs, func(a, b datedRate) int { return a.date.Compare(b.date) })
+	}
+
+	return c, nil
+}
+
+// Convert returns amount, given in currency from, in the group's currency.
+// An empty from is the group's currency. The rate is the latest one on or
+// before date, or the earliest known rate for older dates; groups that
+// convert at today's rate, and amounts without a date, use the latest.
+func (c *CurrencyConverter) Convert(amount float64, from string, date time.Time) float64 {
+	from = strings.ToUpper(from)
+	if c == nil || from == "" || from == c.Currency || amount == 0 {
+		return amount
+	}
+
+	rates := c.rates[from]
+	if len(rates) == 0 {
+		return amount
+	}
+
+	if c.today || date.IsZero() {
+		return amount * rates[len(rates)-1].rate
+	}
+
+	i, _ := slices.BinarySearchFunc(rates, date, func(r datedRate, t time.Time) int {
+		if r.date.After(t) {
+			return 1
+		}
+		return -1
+	})
+	return amount * rates[max(i-1, 0)].rate
+}
@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func day(s string) types.Date {
+	return types.DateFromString(s)
+}
+
+func TestExchangeRateRepository_Convert(t *testing.T) {
+	ctx := context.Background()
+	gid, _ := useSearchGroup(t)
+
+	_, err := tRepos.Groups.GroupUpdate(ctx, gid, GroupUpdate{Name: "Rates", Currency: "usd"})
+	require.NoError(t, err)
+
+	n, err := tRepos.ExchangeRates.Import(ctx, gid, []ExchangeRateCreate{
+		{Base: "eur", Quote: "USD", Rate: 1.1, Date: day("2024-01-01")},
+		{Base: "EUR", Quote: "USD", Rate: 1.2, Date: day("2024-06-01")},
+		{Base: "USD", Quote: "JPY", Rate: 150, Date: day("2024-01-01")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Setting the same pair and date again replaces the rate.
+	_, err = tRepos.ExchangeRates.Set(ctx, gid, ExchangeRateCreate{Base: "EUR", Quote: "USD", Rate: 1.25, Date: day("2024-06-01")})
+	require.NoError(t, err)
+	rates, err := tRepos.ExchangeRates.GetAll(ctx, gid)
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.InDelta(t, 1.25, rates[0].Rate, 0)
+
+	conv, err := tRepos.ExchangeRates.Converter(ctx, gid)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", conv.Currency)
+
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.InDelta(t, 110, conv.Convert(100, "EUR", march), 0.001)
+	assert.InDelta(t, 125, conv.Convert(100, "eur", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), 0.001)
+	assert.InDelta(t, 110, conv.Convert(100, "EUR", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), 0.001, "older dates use the earliest rate")
+	assert.InDelta(t, 125, conv.Convert(100, "EUR", time.Time{}), 0.001)
+	assert.InDelta(t, 2, conv.Convert(300, "JPY", march), 0.001, "inverse pairs convert too")
+	assert.InDelta(t, 100, conv.Convert(100, "GBP", march), 0, "no rate leaves the amount")
+	assert.InDelta(t, 100, conv.Convert(100, "", march), 0)
+
+	_, err = tRepos.Groups.GroupUpdate(ctx, gid, GroupUpdate{Name: "Rates", Currency: "usd", CurrencyConversion: group.CurrencyConversionToday})
+	require.NoError(t, err)
+	conv, err = tRepos.ExchangeRates.Converter(ctx, gid)
+	require.NoError(t, err)
+	assert.InDelta(t, 125, conv.Convert(100, "EUR", march), 0.001)
+
+	require.NoError(t, tRepos.ExchangeRates.Delete(ctx, gid, rates[0].ID))
+	err = tRepos.ExchangeRates.Delete(ctx, tGroup.ID, rates[1].ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestGroupRepository_StatsGroupConvertsCurrencies(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+
+	_, err := tRepos.Groups.GroupUpdate(ctx, gid, GroupUpdate{Name: "Rates", Currency: "USD"})
+	require.NoError(t, err)
+	_, err = tRepos.ExchangeRates.Set(ctx, gid, ExchangeRateCreate{Base: "EUR", Quote: "USD", Rate: 2, Date: day("2024-01-01")})
+	require.NoError(t, err)
+
+	lamp, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Lamp", EntityTypeID: itemType})
+	require.NoError(t, err)
+	update := stockUpdate(lamp)
+	update.Quantity = 3
+	update.PurchasePrice = 10
+	update.PurchaseCurrency = "eur"
+	update.PurchaseDate = day("2024-02-01")
+	lamp, err = tRepos.Entities.UpdateByGroup(ctx, gid, update)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", lamp.PurchaseCurrency)
+
+	stats, err := tRepos.Groups.StatsGroup(ctx, gid)
+	require.NoError(t, err)
+	assert.InDelta(t, 60, stats.TotalItemPrice, 0.001)
+}
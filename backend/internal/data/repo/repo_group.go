@@ -2,14 +2,14 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
-	"github.com/samber/lo"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entitytype"
@@ -19,6 +19,7 @@ import (
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/tag"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/user"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
 )
 
 type GroupRepository struct {
@@ -31,11 +32,13 @@ type GroupRepository struct {
 func NewGroupRepository(db *ent.Client, attachments *AttachmentRepo) *GroupRepository {
 	gmap := func(g *ent.Group) Group {
 		return Group{
-			ID:        g.ID,
-			Name:      g.Name,
-			CreatedAt: g.CreatedAt,
-			UpdatedAt: g.UpdatedAt,
-			Currency:  strings.ToUpper(g.Currency),
+			ID:                 g.ID,
+			Name:               g.Name,
+			CreatedAt:          g.CreatedAt,
+			UpdatedAt:          g.UpdatedAt,
+			Currency:           strings.ToUpper(g.Currency),
+			WarrantyNotifyDays: g.WarrantyNotifyDays,
+			CurrencyConversion: g.CurrencyConversion,
 		}
 	}
 
@@ -44,6 +47,7 @@ func NewGroupRepository(db *ent.Client, attachments *AttachmentRepo) *GroupRepos
 			ID:        i.ID,
 			ExpiresAt: i.ExpiresAt,
 			Uses:      i.Uses,
+			Role:      usergroup.Role(i.Role),
 			Group:     gmap(i.Edges.Group),
 		}
 	}
@@ -58,29 +62,48 @@ func NewGroupRepository(db *ent.Client, attachments *AttachmentRepo) *GroupRepos
 
 type (
 	Group struct {
-		ID        uuid.UUID `json:"id,omitempty"`
-		Name      string    `json:"name,omitempty"`
-		CreatedAt time.Time `json:"createdAt,omitempty"`
-		UpdatedAt time.Time `json:"updatedAt,omitempty"`
-		Currency  string    `json:"currency,omitempty"`
+		ID                 uuid.UUID `json:"id,omitempty"`
+		Name               string    `json:"name,omitempty"`
+		CreatedAt          time.Time `json:"createdAt,omitempty"`
+		UpdatedAt          time.Time `json:"updatedAt,omitempty"`
+		Currency           string    `json:"currency,omitempty"`
+		WarrantyNotifyDays []int     `json:"warrantyNotifyDays"`
+		// CurrencyConversion is whether amounts in other currencies are
+		// converted at the rate of their purchase date or at the latest rate.
+		CurrencyConversion group.CurrencyConversion `json:"currencyConversion"`
 	}
 
 	GroupUpdate struct {
 		Name     string `json:"name"`
 		Currency string `json:"currency"`
+		// WarrantyNotifyDays replaces the warranty reminder lead times when
+		// set; omit it to leave them unchanged.
+		WarrantyNotifyDays *[]int `json:"warrantyNotifyDays,omitempty" extensions:"x-nullable,x-omitempty"`
+		// CurrencyConversion is left unchanged when empty.
+		CurrencyConversion group.CurrencyConversion `json:"currencyConversion,omitempty" validate:"omitempty,oneof=purchase_date today"`
 	}
 
 	GroupInvitationCreate struct {
 		Token     []byte    `json:"-"`
 		ExpiresAt time.Time `json:"expiresAt"`
 		Uses      int       `json:"uses"`
+		// Role is given to members who join with the invitation; it
+		// defaults to editor.
+		Role usergroup.Role `json:"role"`
 	}
 
 	GroupInvitation struct {
-		ID        uuid.UUID `json:"id"`
-		ExpiresAt time.Time `json:"expiresAt"`
-		Uses      int       `json:"uses"`
-		Group     Group     `json:"group"`
+		ID        uuid.UUID      `json:"id"`
+		ExpiresAt time.Time      `json:"expiresAt"`
+		Uses      int            `json:"uses"`
+		Role      usergroup.Role `json:"role"`
+		Group     Group          `json:"group"`
+	}
+
+	GroupMember struct {
+		UserSummary
+		Role        usergroup.Role `json:"role"`
+		LocationIDs []uuid.UUID    `json:"locationIds"`
 	}
 
 	GroupStatistics struct {
@@ -89,7 +112,11 @@ type (
 		TotalLocations    int     `json:"totalLocations"`
 		TotalTags         int     `json:"totalTags"`
 		TotalItemPrice    float64 `json:"totalItemPrice"`
+		TotalCurrentValue float64 `json:"totalCurrentValue"`
 		TotalWithWarranty int     `json:"totalWithWarranty"`
+		// StorageUsed is the size in bytes of the files attached in the
+		// group, counting files shared by several attachments once.
+		StorageUsed int64 `json:"storageUsed"`
 	}
 
 	ValueOverTimeEntry struct {
@@ -122,125 +149,102 @@ func (r *GroupRepository) GetAllGroups(ctx context.Context, userID uuid.UUID) ([
 }
 
 func (r *GroupRepository) StatsLocationsByPurchasePrice(ctx context.Context, gid uuid.UUID) ([]TotalsByOrganizer, error) {
-	var v []TotalsByOrganizer
-
-	// Query entities that are containers (is_location=true) and sum purchase prices of their children
-	q := `
-		SELECT parent.id, parent.name,
-			COALESCE(SUM(child.purchase_price), 0) AS total
-		FROM entities parent
-		JOIN entity_types et ON et.id = parent.entity_type_entities
-		LEFT JOIN entities child ON child.entity_children = parent.id
-			AND child.entity_type_entities IN (SELECT id FROM entity_types WHERE is_location = false)
-		WHERE parent.group_entities = $1 AND et.is_location = true
-		GROUP BY parent.id, parent.name
-		HAVING COALESCE(SUM(child.purchase_price), 0) > 0
-	`
-
-	rows, err := r.db.Sql().QueryContext(ctx, q, gid)
+	conv, err := newCurrencyConverter(ctx, r.db, gid)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = rows.Close() }()
-
-	for rows.Next() {
-		var item TotalsByOrganizer
-		if err := rows.Scan(&item.ID, &item.Name, &item.Total); err != nil {
-			return nil, err
-		}
-		v = append(v, item)
-	}
 
-	return v, rows.Err()
-}
-
-func (r *GroupRepository) StatsTagsByPurchasePrice(ctx context.Context, gid uuid.UUID) ([]TotalsByOrganizer, error) {
-	var v []TotalsByOrganizer
-
-	err := r.db.Tag.Query().
+	// Containers (is_location=true) with the purchase prices of the items
+	// directly inside them
+	locations, err := r.db.Entity.Query().
 		Where(
-			tag.HasGroupWith(group.ID(gid)),
+			entity.HasGroupWith(group.ID(gid)),
+			entity.HasEntityTypeWith(entitytype.IsLocation(true)),
 		).
-		GroupBy(tag.FieldID, tag.FieldName).
-		Aggregate(func(sq *sql.Selector) string {
-			entityTable := sql.Table(entity.Table)
-
-			jt := sql.Table(tag.EntitiesTable)
-
-			sq.Join(jt).On(sq.C(tag.FieldID), jt.C(tag.EntitiesPrimaryKey[0]))
-			sq.Join(entityTable).On(jt.C(tag.EntitiesPrimaryKey[1]), entityTable.C(entity.FieldID))
-
-			return sql.As(sql.Sum(entityTable.C(entity.FieldPurchasePrice)), "total")
+		WithChildren(func(q *ent.EntityQuery) {
+			q.Where(entity.HasEntityTypeWith(entitytype.IsLocation(false)))
 		}).
-		Scan(ctx, &v)
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return v, err
-}
-
-func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, gid uuid.UUID, start, end time.Time) (*ValueOverTime, error) {
-	// Get the Totals for the Start and End of the Given Time Period
-	q := `
-	SELECT
-		SUM(CASE WHEN e.created_at < $1 THEN e.purchase_price ELSE 0 END) AS price_at_start,
-		SUM(CASE WHEN e.created_at < $2 THEN e.purchase_price ELSE 0 END) AS price_at_end
-	FROM entities e
-	JOIN entity_types et ON et.id = e.entity_type_entities
-	WHERE e.group_entities = $3 AND e.archived = false AND et.is_location = false
-`
-	stats := ValueOverTime{
-		Start: start,
-		End:   end,
+	var v []TotalsByOrganizer
+	for _, l := range locations {
+		var total float64
+		for _, child := range l.Edges.Children {
+			total += purchasePrice(conv, child)
+		}
+		if total > 0 {
+			v = append(v, TotalsByOrganizer{ID: l.ID, Name: l.Name, Total: total})
+		}
 	}
 
-	var maybeStart *float64
-	var maybeEnd *float64
+	return v, nil
+}
 
-	row := r.db.Sql().QueryRowContext(ctx, q, sqliteDateFormat(start), sqliteDateFormat(end), gid)
-	err := row.Scan(&maybeStart, &maybeEnd)
+func (r *GroupRepository) StatsTagsByPurchasePrice(ctx context.Context, gid uuid.UUID) ([]TotalsByOrganizer, error) {
+	conv, err := newCurrencyConverter(ctx, r.db, gid)
 	if err != nil {
 		return nil, err
 	}
 
-	stats.PriceAtStart = orDefault(maybeStart, 0)
-	stats.PriceAtEnd = orDefault(maybeEnd, 0)
-
-	type itemPriceEntry struct {
-		Name          string    `json:"name"`
-		CreatedAt     time.Time `json:"created_at"`
-		PurchasePrice float64   `json:"purchase_price"`
+	tags, err := r.db.Tag.Query().
+		Where(tag.HasGroupWith(group.ID(gid))).
+		WithEntities().
+		All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var v []itemPriceEntry
+	var v []TotalsByOrganizer
+	for _, t := range tags {
+		if len(t.Edges.Entities) == 0 {
+			continue
+		}
+		var total float64
+		for _, e := range t.Edges.Entities {
+			total += purchasePrice(conv, e)
+		}
+		v = append(v, TotalsByOrganizer{ID: t.ID, Name: t.Name, Total: total})
+	}
 
-	// Get Created Date and Price of all entities between start and end
-	err = r.db.Entity.Query().
-		Where(
-			entity.HasGroupWith(group.ID(gid)),
-			entity.CreatedAtGTE(start),
-			entity.CreatedAtLTE(end),
-			entity.Archived(false),
-			entity.HasEntityTypeWith(entitytype.IsLocation(false)),
-		).
-		Select(
-			entity.FieldName,
-			entity.FieldCreatedAt,
-			entity.FieldPurchasePrice,
-		).
-		Scan(ctx, &v)
+	return v, nil
+}
 
+func (r *GroupRepository) StatsPurchasePrice(ctx context.Context, gid uuid.UUID, start, end time.Time) (*ValueOverTime, error) {
+	conv, err := newCurrencyConverter(ctx, r.db, gid)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := r.valuedEntities(ctx, gid)
 	if err != nil {
 		return nil, err
 	}
 
-	stats.Entries = lo.Map(v, func(vv itemPriceEntry, _ int) ValueOverTimeEntry {
-		return ValueOverTimeEntry{
-			Date:  vv.CreatedAt,
-			Value: vv.PurchasePrice,
+	stats := ValueOverTime{
+		Start:   start,
+		End:     end,
+		Entries: []ValueOverTimeEntry{},
+	}
+
+	// Totals for the start and end of the period, and the price of every
+	// entity created in between
+	for _, e := range entities {
+		price := purchasePrice(conv, e)
+		if e.CreatedAt.Before(start) {
+			stats.PriceAtStart += price
 		}
-	})
+		if e.CreatedAt.Before(end) {
+			stats.PriceAtEnd += price
+		}
+		if !e.CreatedAt.Before(start) && !e.CreatedAt.After(end) {
+			stats.Entries = append(stats.Entries, ValueOverTimeEntry{
+				Date:  e.CreatedAt,
+				Value: price,
+			})
+		}
+	}
 
 	return &stats, nil
 }
@@ -249,33 +253,51 @@ func (r *GroupRepository) StatsGroup(ctx context.Context, gid uuid.UUID) (GroupS
 	q := `
 		SELECT
             (SELECT COUNT(*) FROM user_groups WHERE group_id = $2) AS total_users,
-            (SELECT COUNT(*) FROM entities e JOIN entity_types et ON et.id = e.entity_type_entities WHERE e.group_entities = $2 AND e.archived = false AND et.is_location = false) AS total_items,
-            (SELECT COUNT(*) FROM entities e JOIN entity_types et ON et.id = e.entity_type_entities WHERE e.group_entities = $2 AND et.is_location = true) AS total_locations,
+            (SELECT COUNT(*) FROM entities e JOIN entity_types et ON et.id = e.entity_type_entities WHERE e.group_entities = $2 AND e.archived = false AND et.is_location = false AND e.deleted_at IS NULL {{ SCOPE }}) AS total_items,
+            (SELECT COUNT(*) FROM entities e JOIN entity_types et ON et.id = e.entity_type_entities WHERE e.group_entities = $2 AND et.is_location = true AND e.deleted_at IS NULL {{ SCOPE }}) AS total_locations,
             (SELECT COUNT(*) FROM tags WHERE group_tags = $2) AS total_tags,
-            (SELECT SUM(e.purchase_price*e.quantity) FROM entities e JOIN entity_types et ON et.id = e.entity_type_entities WHERE e.group_entities = $2 AND e.archived = false AND et.is_location = false) AS total_item_price,
             (SELECT COUNT(*)
                 FROM entities e
                 JOIN entity_types et ON et.id = e.entity_type_entities
                     WHERE e.group_entities = $2
                     AND e.archived = false
                     AND et.is_location = false
+                    AND e.deleted_at IS NULL
                     AND (e.lifetime_warranty = true OR e.warranty_expires > $1)
-                ) AS total_with_warranty;
+                    {{ SCOPE }}
+                ) AS total_with_warranty,
+            (SELECT COALESCE(SUM(size), 0) FROM blobs WHERE group_id = $2 AND ref_count > 0) AS storage_used;
 `
+	args := []any{sqliteDateFormat(time.Now()), gid}
+	q, args = withEntityScopeSQL(ctx, q, "e.id", args)
+
 	var stats GroupStatistics
-	row := r.db.Sql().QueryRowContext(ctx, q, sqliteDateFormat(time.Now()), gid)
+	row := r.db.Sql().QueryRowContext(ctx, q, args...)
 
-	var maybeTotalItemPrice *float64
 	var maybeTotalWithWarranty *int
 
-	err := row.Scan(&stats.TotalUsers, &stats.TotalItems, &stats.TotalLocations, &stats.TotalTags, &maybeTotalItemPrice, &maybeTotalWithWarranty)
+	err := row.Scan(&stats.TotalUsers, &stats.TotalItems, &stats.TotalLocations, &stats.TotalTags, &maybeTotalWithWarranty, &stats.StorageUsed)
 	if err != nil {
 		return GroupStatistics{}, err
 	}
 
-	stats.TotalItemPrice = orDefault(maybeTotalItemPrice, 0)
 	stats.TotalWithWarranty = orDefault(maybeTotalWithWarranty, 0)
 
+	// Prices may be in other currencies, so they are totalled here rather
+	// than in SQL.
+	conv, err := newCurrencyConverter(ctx, r.db, gid)
+	if err != nil {
+		return GroupStatistics{}, err
+	}
+	entities, err := r.valuedEntities(ctx, gid)
+	if err != nil {
+		return GroupStatistics{}, err
+	}
+	for _, e := range entities {
+		stats.TotalItemPrice += purchasePrice(conv, e) * e.Quantity
+	}
+	stats.TotalCurrentValue = valueOn(entities, conv, time.Now())
+
 	return stats, nil
 }
 
@@ -313,10 +335,18 @@ func (r *GroupRepository) GroupCreate(ctx context.Context, name string, userID u
 }
 
 func (r *GroupRepository) GroupUpdate(ctx context.Context, id uuid.UUID, data GroupUpdate) (Group, error) {
-	entity, err := r.db.Group.UpdateOneID(id).
+	q := r.db.Group.UpdateOneID(id).
 		SetName(data.Name).
-		SetCurrency(strings.ToLower(data.Currency)).
-		Save(ctx)
+		SetCurrency(strings.ToLower(data.Currency))
+
+	if data.WarrantyNotifyDays != nil {
+		q.SetWarrantyNotifyDays(*data.WarrantyNotifyDays)
+	}
+	if data.CurrencyConversion != "" {
+		q.SetCurrencyConversion(data.CurrencyConversion)
+	}
+
+	entity, err := q.Save(ctx)
 
 	return r.groupMapper.MapErr(entity, err)
 }
@@ -331,10 +361,12 @@ func (r *GroupRepository) GroupDelete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
+	// Entities in the trash are deleted with the rest, so their attachments
+	// have to be released too.
 	itm, err := tx.Entity.Query().
 		Where(entity.HasGroupWith(group.ID(id))).
 		WithAttachments().
-		All(ctx)
+		All(withTrashed(ctx))
 	if err != nil {
 		if rerr := tx.Rollback(); rerr != nil {
 			log.Error().Err(rerr).Msg("failed to rollback transaction")
@@ -342,16 +374,14 @@ func (r *GroupRepository) GroupDelete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	// Keep attachment row operations on the same transaction.
-	attachments := *r.attachments
-	attachments.db = tx.Client()
-
-	// Delete all attachments (and their files) before deleting the entities.
+	// Release the files of all attachments before deleting the entities.
 	for _, it := range itm {
 		for _, att := range it.Edges.Attachments {
-			if err := attachments.Delete(ctx, id, att.ID); err != nil {
-				log.Err(err).Str("attachment_id", att.ID.String()).Msg("failed to delete attachment during group deletion")
-				// Continue with other attachments even if one fails.
+			if err := r.attachments.deleteReleasing(ctx, tx.Client(), att); err != nil {
+				if rerr := tx.Rollback(); rerr != nil {
+					log.Error().Err(rerr).Msg("failed to rollback transaction")
+				}
+				return err
 			}
 		}
 	}
@@ -384,7 +414,17 @@ func (r *GroupRepository) GroupDelete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// The blob records went with the group, so the garbage collector no
+	// longer knows its files. Nothing of another group is stored under its
+	// prefix, so they are removed here.
+	if err := r.attachments.DeleteGroupFiles(ctx, id); err != nil {
+		log.Err(err).Str("group_id", id.String()).Msg("failed to delete files of deleted group")
+	}
+	return nil
 }
 
 func (r *GroupRepository) InvitationGet(ctx context.Context, token []byte) (GroupInvitation, error) {
@@ -407,12 +447,16 @@ func (r *GroupRepository) InvitationGetAll(ctx context.Context, groupID uuid.UUI
 }
 
 func (r *GroupRepository) InvitationCreate(ctx context.Context, groupID uuid.UUID, invite GroupInvitationCreate) (GroupInvitation, error) {
-	entity, err := r.db.GroupInvitationToken.Create().
+	q := r.db.GroupInvitationToken.Create().
 		SetGroupID(groupID).
 		SetToken(invite.Token).
 		SetExpiresAt(invite.ExpiresAt).
-		SetUses(invite.Uses).
-		Save(ctx)
+		SetUses(invite.Uses)
+	if invite.Role != "" {
+		q.SetRole(groupinvitationtoken.Role(invite.Role))
+	}
+
+	entity, err := q.Save(ctx)
 	if err != nil {
 		return GroupInvitation{}, err
 	}
@@ -472,6 +516,165 @@ func (r *GroupRepository) IsOwnerOf(ctx context.Context, userID, groupID uuid.UU
 		Exist(ctx)
 }
 
+// ErrLastOwner is returned when a change would leave a collection without an
+// owner.
+var ErrLastOwner = errors.New("a collection needs at least one owner")
+
+// ErrOwnerUnrestricted is returned when limiting an owner to some locations.
+// Owners always see the whole collection.
+var ErrOwnerUnrestricted = errors.New("owners can't be limited to locations")
+
+// groupRoleRank orders collection roles from least to most privileged.
+var groupRoleRank = map[usergroup.Role]int{
+	usergroup.RoleViewer:      0,
+	usergroup.RoleContributor: 1,
+	usergroup.RoleEditor:      2,
+	usergroup.RoleOwner:       3,
+}
+
+// GroupRoleAtLeast reports whether have grants at least the rights of want.
+func GroupRoleAtLeast(have, want usergroup.Role) bool {
+	return groupRoleRank[have] >= groupRoleRank[want]
+}
+
+// MemberRole returns userID's role in groupID, or a not found error if they
+// aren't a member.
+func (r *GroupRepository) MemberRole(ctx context.Context, groupID, userID uuid.UUID) (usergroup.Role, error) {
+	m, err := r.db.UserGroup.Query().
+		Where(usergroup.UserID(userID), usergroup.GroupID(groupID)).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	return m.Role, nil
+}
+
+// GetMembers returns the members of groupID with their roles, by name.
+func (r *GroupRepository) GetMembers(ctx context.Context, groupID uuid.UUID) ([]GroupMember, error) {
+	memberships, err := r.db.UserGroup.Query().
+		Where(usergroup.GroupID(groupID)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]GroupMember, len(memberships))
+	for i, m := range memberships {
+		out[i] = GroupMember{
+			UserSummary: mapUserSummary(m.Edges.User),
+			Role:        m.Role,
+			LocationIDs: emptyIfNil(m.LocationIds),
+		}
+	}
+	slices.SortFunc(out, func(a, b GroupMember) int { return strings.Compare(a.Name, b.Name) })
+	return out, nil
+}
+
+// SetMemberRole changes userID's role in groupID. Demoting the last owner
+// fails with ErrLastOwner, and promoting a member to owner lifts their
+// location limits.
+func (r *GroupRepository) SetMemberRole(ctx context.Context, groupID, userID uuid.UUID, role usergroup.Role) error {
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction")
+			}
+		}
+	}()
+
+	q := tx.UserGroup.Update().
+		Where(usergroup.UserID(userID), usergroup.GroupID(groupID)).
+		SetRole(role)
+	if role == usergroup.RoleOwner {
+		q.ClearLocationIds()
+	}
+	n, err := q.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	owners, err := tx.UserGroup.Query().
+		Where(usergroup.GroupID(groupID), usergroup.RoleEQ(usergroup.RoleOwner)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if owners == 0 {
+		return ErrLastOwner
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// MemberLocations returns the locations userID is limited to in groupID. It
+// is empty for members who see the whole collection.
+func (r *GroupRepository) MemberLocations(ctx context.Context, groupID, userID uuid.UUID) ([]uuid.UUID, error) {
+	m, err := r.db.UserGroup.Query().
+		Where(usergroup.UserID(userID), usergroup.GroupID(groupID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m.Role == usergroup.RoleOwner {
+		return nil, nil
+	}
+	return m.LocationIds, nil
+}
+
+// SetMemberLocations limits userID to the given locations of groupID and
+// everything below them. An empty list gives them the whole collection back.
+// Locations that aren't in the group fail with a not found error, and owners
+// can't be limited.
+func (r *GroupRepository) SetMemberLocations(ctx context.Context, groupID, userID uuid.UUID, locationIDs []uuid.UUID) error {
+	locationIDs = set.New(locationIDs...).Slice()
+
+	role, err := r.MemberRole(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if role == usergroup.RoleOwner {
+		return ErrOwnerUnrestricted
+	}
+
+	if len(locationIDs) > 0 {
+		found, err := r.db.Entity.Query().
+			Where(
+				entity.IDIn(locationIDs...),
+				entity.HasGroupWith(group.ID(groupID)),
+				entity.HasEntityTypeWith(entitytype.IsLocation(true)),
+			).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if found != len(locationIDs) {
+			return &ent.NotFoundError{}
+		}
+	}
+
+	q := r.db.UserGroup.Update().
+		Where(usergroup.UserID(userID), usergroup.GroupID(groupID))
+	if len(locationIDs) == 0 {
+		q.ClearLocationIds()
+	} else {
+		q.SetLocationIds(locationIDs)
+	}
+	return q.Exec(ctx)
+}
+
 func (r *GroupRepository) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error {
 	return r.db.Group.UpdateOneID(groupID).RemoveUserIDs(userID).Exec(ctx)
 }
@@ -542,11 +745,13 @@ func (r *GroupRepository) InvitationAccept(ctx context.Context, token []byte, us
 		return Group{}, fmt.Errorf("user already a member of this group")
 	}
 
-	// 4. Add member with role=user; ownership is reserved for whoever created the group.
+	// 4. Add member with the invitation's role; invitations can't grant
+	// ownership, which is reserved for whoever created the group or is
+	// promoted by an owner.
 	if _, err := tx.UserGroup.Create().
 		SetUserID(userID).
 		SetGroupID(invitation.Edges.Group.ID).
-		SetRole(usergroup.RoleUser).
+		SetRole(usergroup.Role(invitation.Role)).
 		Save(ctx); err != nil {
 		if err := tx.Rollback(); err != nil {
 			log.Warn().Err(err).Msg("failed to rollback transaction")
@@ -3,10 +3,13 @@ package repo
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
 )
 
 func Test_Group_Create(t *testing.T) {
@@ -95,3 +98,59 @@ func Test_Group_IsMember(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, isMember)
 }
+
+func Test_Group_MemberRoles(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	group, err := tRepos.Groups.GroupCreate(ctx, "roles", owner.ID)
+	require.NoError(t, err)
+
+	invitation, err := tRepos.Groups.InvitationCreate(ctx, group.ID, GroupInvitationCreate{
+		Token:     []byte(fk.Str(32)),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Uses:      1,
+		Role:      usergroup.RoleViewer,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, usergroup.RoleViewer, invitation.Role)
+
+	token, err := tClient.GroupInvitationToken.Get(ctx, invitation.ID)
+	require.NoError(t, err)
+	relative, err := tRepos.Users.Create(ctx, userFactory())
+	require.NoError(t, err)
+	_, err = tRepos.Groups.InvitationAccept(ctx, token.Token, relative.ID)
+	require.NoError(t, err)
+
+	role, err := tRepos.Groups.MemberRole(ctx, group.ID, relative.ID)
+	require.NoError(t, err)
+	assert.Equal(t, usergroup.RoleViewer, role)
+
+	require.NoError(t, tRepos.Groups.SetMemberRole(ctx, group.ID, relative.ID, usergroup.RoleContributor))
+	members, err := tRepos.Groups.GetMembers(ctx, group.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	roles := map[uuid.UUID]usergroup.Role{}
+	for _, m := range members {
+		roles[m.ID] = m.Role
+	}
+	assert.Equal(t, usergroup.RoleOwner, roles[owner.ID])
+	assert.Equal(t, usergroup.RoleContributor, roles[relative.ID])
+
+	// The only owner can't step down, but can once someone else is owner.
+	err = tRepos.Groups.SetMemberRole(ctx, group.ID, owner.ID, usergroup.RoleEditor)
+	require.ErrorIs(t, err, ErrLastOwner)
+	require.NoError(t, tRepos.Groups.SetMemberRole(ctx, group.ID, relative.ID, usergroup.RoleOwner))
+	require.NoError(t, tRepos.Groups.SetMemberRole(ctx, group.ID, owner.ID, usergroup.RoleEditor))
+
+	_, err = tRepos.Groups.MemberRole(ctx, uuid.New(), relative.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func Test_GroupRoleAtLeast(t *testing.T) {
+	assert.True(t, GroupRoleAtLeast(usergroup.RoleOwner, usergroup.RoleEditor))
+	assert.True(t, GroupRoleAtLeast(usergroup.RoleContributor, usergroup.RoleContributor))
+	assert.False(t, GroupRoleAtLeast(usergroup.RoleViewer, usergroup.RoleContributor))
+	assert.False(t, GroupRoleAtLeast(usergroup.RoleContributor, usergroup.RoleEditor))
+}
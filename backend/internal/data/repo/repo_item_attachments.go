@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"errors"
 	"fmt"
 	"image"
 	"io"
@@ -15,10 +16,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/evanoberholster/imagemeta"
-	"github.com/gen2brain/avif"
-	"github.com/gen2brain/heic"
-	"github.com/gen2brain/jpegxl"
 	"github.com/gen2brain/webp"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -38,6 +35,7 @@ import (
 	_ "gocloud.dev/blob/gcsblob"
 	_ "gocloud.dev/blob/memblob"
 	_ "gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
 
 	"gocloud.dev/pubsub"
 	_ "gocloud.dev/pubsub/awssnssqs"
@@ -56,6 +54,7 @@ type AttachmentRepo struct {
 	storage    config.Storage
 	pubSubConn string
 	thumbnail  config.Thumbnail
+	renderers  []thumbnailRenderer
 }
 
 type (
@@ -69,6 +68,8 @@ type (
 		Title     string          `json:"title"`
 		MimeType  string          `json:"mimeType,omitempty"`
 		Thumbnail *ent.Attachment `json:"thumbnail,omitempty"`
+		// Metadata is read from the file when it is uploaded.
+		Metadata *AttachmentMetadata `json:"metadata,omitempty" extensions:"x-nullable,x-omitempty"`
 	}
 
 	ItemAttachmentUpdate struct {
@@ -119,6 +120,7 @@ func ToItemAttachment(attachment *ent.Attachment) ItemAttachment {
 		Title:     attachment.Title,
 		MimeType:  attachment.MimeType,
 		Thumbnail: attachment.QueryThumbnail().FirstX(context.Background()),
+		Metadata:  toAttachmentMetadata(attachment),
 	}
 }
 
@@ -240,6 +242,11 @@ func (r *AttachmentRepo) path(gid uuid.UUID, hash string) string {
 	return fmt.Sprintf("%s/documents/%s", gid.String(), hash)
 }
 
+// tmpPath is the key of a file of the group that is still being written.
+func (r *AttachmentRepo) tmpPath(gid uuid.UUID, name string) string {
+	return fmt.Sprintf("%s/tmp/%s", gid.String(), name)
+}
+
 func (r *AttachmentRepo) fullPath(relativePath string) string {
 	// Normalize path separators to forward slashes for blob storage
 	// The blob library expects forward slashes in keys regardless of OS
@@ -292,6 +299,12 @@ func (r *AttachmentRepo) GetConnString() string {
 }
 
 func (r *AttachmentRepo) Create(ctx context.Context, itemID uuid.UUID, doc ItemCreateAttachment, typ attachment.Type, primary bool) (*ent.Attachment, error) {
+	return r.create(ctx, itemID, doc, typ, primary, nil)
+}
+
+// create is Create with inTx run in the transaction that saves the
+// attachment, right before it is committed.
+func (r *AttachmentRepo) create(ctx context.Context, itemID uuid.UUID, doc ItemCreateAttachment, typ attachment.Type, primary bool, inTx func(*ent.Tx, *ent.Attachment) error) (*ent.Attachment, error) {
 	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.Create")
 	defer span.End()
 
@@ -372,7 +385,7 @@ func (r *AttachmentRepo) Create(ctx context.Context, itemID uuid.UUID, doc ItemC
 	}
 
 	// Upload the file to the storage bucket
-	uploadResult, err := r.UploadFile(ctx, itemGroup, doc)
+	uploadResult, err := r.UploadFile(ctx, tx.Blob, itemGroup, doc)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			return nil, rollbackErr
@@ -382,6 +395,7 @@ func (r *AttachmentRepo) Create(ctx context.Context, itemID uuid.UUID, doc ItemC
 
 	bldr = bldr.SetMimeType(uploadResult.ContentType)
 	bldr = bldr.SetPath(uploadResult.Path)
+	bldr = setAttachmentMetadata(bldr, r.extractMetadata(ctx, uploadResult.Path, uploadResult.ContentType, doc.Title))
 
 	attachmentDb, err := bldr.Save(ctx)
 	if err != nil {
@@ -393,6 +407,15 @@ func (r *AttachmentRepo) Create(ctx context.Context, itemID uuid.UUID, doc ItemC
 		return nil, err
 	}
 
+	if inTx != nil {
+		if err := inTx(tx, attachmentDb); err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				return nil, rollbackErr
+			}
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		log.Err(err).Msg("failed to commit transaction")
 		return nil, err
@@ -482,6 +505,32 @@ func (r *AttachmentRepo) Get(ctx context.Context, gid uuid.UUID, id uuid.UUID) (
 	}
 }
 
+// PrimaryThumbnailPaths returns the path of the primary photo's thumbnail for
+// each of entityIDs that has one, by entity ID.
+func (r *AttachmentRepo) PrimaryThumbnailPaths(ctx context.Context, gid uuid.UUID, entityIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	photos, err := r.db.Attachment.Query().
+		Where(
+			attachment.Primary(true),
+			attachment.TypeEQ(attachment.TypePhoto),
+			attachment.HasThumbnail(),
+			attachment.HasEntityWith(entity.IDIn(entityIDs...), entity.HasGroupWith(group.ID(gid))),
+		).
+		WithEntity().
+		WithThumbnail().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[uuid.UUID]string, len(photos))
+	for _, p := range photos {
+		if p.Edges.Entity != nil && p.Edges.Thumbnail != nil && p.Edges.Thumbnail.Path != "" {
+			paths[p.Edges.Entity.ID] = p.Edges.Thumbnail.Path
+		}
+	}
+	return paths, nil
+}
+
 func (r *AttachmentRepo) Update(ctx context.Context, gid uuid.UUID, id uuid.UUID, data *ItemAttachmentUpdate) (*ent.Attachment, error) {
 	// Validate that the attachment belongs to the specified group
 	_, err := r.db.Attachment.Query().
@@ -554,52 +603,60 @@ func (r *AttachmentRepo) Delete(ctx context.Context, gid uuid.UUID, id uuid.UUID
 		return r.db.Attachment.DeleteOneID(id).Exec(ctx)
 	}
 
-	all, err := r.db.Attachment.Query().Where(attachment.Path(doc.Path)).All(ctx)
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
-	// If this is the last attachment for this path, delete the file
-	if len(all) == 1 {
-		thumb, err := doc.QueryThumbnail().First(ctx)
-		if err != nil && !ent.IsNotFound(err) {
-			log.Err(err).Msg("failed to query thumbnail for attachment")
-			return err
-		}
-		if thumb != nil {
-			thumbBucket, err := blob.OpenBucket(ctx, r.GetConnString())
-			if err != nil {
-				log.Err(err).Msg("failed to open bucket for thumbnail deletion")
-				return err
-			}
-			err = thumbBucket.Delete(ctx, r.fullPath(thumb.Path))
-			if err != nil {
-				return err
-			}
-			_ = doc.Update().SetNillableThumbnailID(nil).SaveX(ctx)
-			_ = thumb.Update().SetNillableThumbnailID(nil).SaveX(ctx)
-			err = r.db.Attachment.DeleteOneID(thumb.ID).Exec(ctx)
-			if err != nil {
-				return err
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction while deleting attachment")
 			}
 		}
-		bucket, err := blob.OpenBucket(ctx, r.GetConnString())
-		if err != nil {
-			log.Err(err).Msg("failed to open bucket")
+	}()
+
+	if err := r.deleteReleasing(ctx, tx.Client(), doc); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// deleteReleasing deletes an attachment and its thumbnail with c, which must
+// be a transaction, and releases their files. Files are shared by every
+// attachment with the same content, so only the references are released
+// here. The blob garbage collector removes a file once nothing references it.
+func (r *AttachmentRepo) deleteReleasing(ctx context.Context, c *ent.Client, doc *ent.Attachment) error {
+	if isExternalLink(doc.MimeType) {
+		return c.Attachment.DeleteOneID(doc.ID).Exec(ctx)
+	}
+
+	thumb, err := c.Attachment.QueryThumbnail(doc).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Err(err).Msg("failed to query thumbnail for attachment")
+		return err
+	}
+
+	if err := c.Attachment.DeleteOneID(doc.ID).Exec(ctx); err != nil {
+		return err
+	}
+	if err := r.releaseBlob(ctx, c.Blob, doc.Path); err != nil {
+		return err
+	}
+	if thumb != nil {
+		if err := c.Attachment.DeleteOneID(thumb.ID).Exec(ctx); err != nil {
 			return err
 		}
-		defer func(bucket *blob.Bucket) {
-			err := bucket.Close()
-			if err != nil {
-				log.Err(err).Msg("failed to close bucket")
-			}
-		}(bucket)
-		err = bucket.Delete(ctx, r.fullPath(doc.Path))
-		if err != nil {
+		if err := r.releaseBlob(ctx, c.Blob, thumb.Path); err != nil {
 			return err
 		}
 	}
-
-	return r.db.Attachment.DeleteOneID(id).Exec(ctx)
+	return nil
 }
 
 func (r *AttachmentRepo) Rename(ctx context.Context, gid uuid.UUID, id uuid.UUID, title string) (*ent.Attachment, error) {
@@ -617,7 +674,8 @@ func (r *AttachmentRepo) Rename(ctx context.Context, gid uuid.UUID, id uuid.UUID
 	return r.db.Attachment.UpdateOneID(id).SetTitle(title).Save(ctx)
 }
 
-//nolint:gocyclo
+// CreateThumbnail renders a thumbnail of an attachment with the renderer for
+// its file type and links it to the attachment.
 func (r *AttachmentRepo) CreateThumbnail(ctx context.Context, groupId, attachmentId uuid.UUID, title string, path string) error {
 	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.CreateThumbnail")
 	defer span.End()
@@ -683,167 +741,45 @@ func (r *AttachmentRepo) CreateThumbnail(ctx context.Context, groupId, attachmen
 		}
 	}(origFile)
 
-	log.Debug().Msg("stat original file for file size")
-	stats, err := origFile.Stat()
-	if err != nil {
-		err := tx.Rollback()
-		if err != nil {
+	log.Debug().Msg("detecting content type of original file")
+	head := make([]byte, 512)
+	n, err := io.ReadFull(origFile, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		log.Err(err).Msg("failed to read original file content")
+		if err := tx.Rollback(); err != nil {
 			return err
 		}
-		log.Err(err).Msg("failed to stat original file")
 		return err
 	}
+	head = head[:n]
+	contentType := thumbnailContentType(http.DetectContentType(head), title)
 
-	if stats.Size() > 100*1024*1024 {
-		return fmt.Errorf("original file %s is too large to create a thumbnail", title)
+	renderer := r.thumbnailRenderer(contentType)
+	if renderer == nil {
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("file type %s of %s is not supported for thumbnail creation", contentType, title)
 	}
 
-	log.Debug().Msg("reading original file content")
-	// Use LimitReader as a safety measure to prevent reading more than 100MB
-	// even if the stat size is incorrect or tampered
-	limitedReader := io.LimitReader(origFile, 100*1024*1024)
-	contentBytes, err := io.ReadAll(limitedReader)
+	log.Debug().Str("content_type", contentType).Msg("rendering thumbnail")
+	img, err := renderer.render(ctx, io.MultiReader(bytes.NewReader(head), origFile), contentType)
 	if err != nil {
-		err := tx.Rollback()
-		if err != nil {
+		log.Err(err).Msg("failed to render thumbnail")
+		if err := tx.Rollback(); err != nil {
 			return err
 		}
-		log.Err(err).Msg("failed to read original file content")
 		return err
 	}
 
-	log.Debug().Msg("detecting content type of original file")
-	contentType := http.DetectContentType(contentBytes[:min(512, len(contentBytes))])
-
-	if contentType == "application/octet-stream" {
-		switch {
-		case strings.HasSuffix(title, ".heic") || strings.HasSuffix(title, ".heif"):
-			contentType = "image/heic"
-		case strings.HasSuffix(title, ".avif"):
-			contentType = "image/avif"
-		case strings.HasSuffix(title, ".jxl"):
-			contentType = "image/jxl"
-		}
-	}
-
-	// Pre-read orientation once for all image types that support it
-	// This avoids re-decoding the metadata for each image type
-	var orientation uint16 = 1 // Default orientation
-	if contentType != "image/avif" && contentType != "image/jxl" {
-		imageMeta, err := imagemeta.Decode(bytes.NewReader(contentBytes))
-		if err == nil {
-			// imagemeta v1 groups the primary image tags under IFD0 rather than
-			// exposing Orientation directly on Exif.
-			orientation = uint16(imageMeta.IFD0.Orientation)
-		}
-		// If error, just use default orientation (1)
-	}
-
-	switch {
-	case isImageFile(contentType):
-		log.Debug().Msg("creating thumbnail for image file")
-		img, _, err := image.Decode(bytes.NewReader(contentBytes))
-		if err != nil {
-			log.Err(err).Msg("failed to decode image file")
-			err := tx.Rollback()
-			if err != nil {
-				log.Err(err).Msg("failed to rollback transaction")
-				return err
-			}
-			return err
-		}
-		uploadResult, err := r.processThumbnailFromImage(ctx, groupId, img, title, orientation)
-		if err != nil {
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		att.SetPath(uploadResult.Path)
-	case contentType == "image/webp":
-		log.Debug().Msg("creating thumbnail for webp file")
-		img, err := webp.Decode(bytes.NewReader(contentBytes))
-		if err != nil {
-			log.Err(err).Msg("failed to decode webp image")
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		uploadResult, err := r.processThumbnailFromImage(ctx, groupId, img, title, orientation)
-		if err != nil {
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		att.SetPath(uploadResult.Path)
-	case contentType == "image/avif":
-		log.Debug().Msg("creating thumbnail for avif file")
-		img, err := avif.Decode(bytes.NewReader(contentBytes))
-		if err != nil {
-			log.Err(err).Msg("failed to decode avif image")
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		uploadResult, err := r.processThumbnailFromImage(ctx, groupId, img, title, uint16(1))
-		if err != nil {
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		att.SetPath(uploadResult.Path)
-	case contentType == "image/heic" || contentType == "image/heif":
-		log.Debug().Msg("creating thumbnail for heic file")
-		img, err := heic.Decode(bytes.NewReader(contentBytes))
-		if err != nil {
-			log.Err(err).Msg("failed to decode avif image")
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		uploadResult, err := r.processThumbnailFromImage(ctx, groupId, img, title, orientation)
-		if err != nil {
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		att.SetPath(uploadResult.Path)
-	case contentType == "image/jxl":
-		log.Debug().Msg("creating thumbnail for jpegxl file")
-		img, err := jpegxl.Decode(bytes.NewReader(contentBytes))
-		if err != nil {
-			log.Err(err).Msg("failed to decode avif image")
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
-			return err
-		}
-		uploadResult, err := r.processThumbnailFromImage(ctx, groupId, img, title, uint16(1))
-		if err != nil {
-			err := tx.Rollback()
-			if err != nil {
-				return err
-			}
+	thumbResult, err := r.processThumbnailFromImage(ctx, tx.Blob, groupId, img, title)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
 			return err
 		}
-		att.SetPath(uploadResult.Path)
-	default:
-		return fmt.Errorf("file type %s is not supported for thumbnail creation or document thumnails disabled", title)
+		return err
 	}
+	att.SetPath(thumbResult.Path)
 
 	att.SetMimeType("image/webp")
 
@@ -891,6 +827,10 @@ func (r *AttachmentRepo) CreateMissingThumbnails(ctx context.Context, groupId uu
 
 	count := 0
 	for _, attachment := range attachments {
+		// Links have no file, and files no renderer accepts would only fail.
+		if attachment.Path == "" || r.thumbnailRenderer(thumbnailContentType(attachment.MimeType, attachment.Title)) == nil {
+			continue
+		}
 		if r.thumbnail.Enabled {
 			if !attachment.QueryThumbnail().ExistX(ctx) {
 				if count > 0 && count%100 == 0 {
@@ -922,39 +862,18 @@ func (r *AttachmentRepo) CreateMissingThumbnails(ctx context.Context, groupId uu
 type UploadResult struct {
 	Path        string
 	ContentType string
+	Size        int64
 }
 
-func (r *AttachmentRepo) UploadFile(ctx context.Context, itemGroup *ent.Group, doc ItemCreateAttachment) (UploadResult, error) {
+// UploadFile stores the content of doc under its content address and adds a
+// reference to it with blobs, which should belong to the transaction that
+// saves the attachment. The content is streamed to a temporary key while it
+// is hashed, so memory use doesn't grow with the file, then copied to the
+// content-addressed key.
+func (r *AttachmentRepo) UploadFile(ctx context.Context, blobs *ent.BlobClient, itemGroup *ent.Group, doc ItemCreateAttachment) (UploadResult, error) {
 	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.UploadFile")
 	defer span.End()
 
-	// Prepare for the hashing of the file contents
-	hashOut := make([]byte, 32)
-
-	// Use a buffer to store content for blake3 key derivation and storage
-	// While buffering, we compute MD5 and blake3 hashes in parallel for efficiency
-	buf := new(bytes.Buffer)
-
-	// Create hash writers
-	blake3Hasher := blake3.New()
-	md5Hasher := md5.New()
-
-	// Use MultiWriter to write to buffer and both hashers simultaneously
-	multiWriter := io.MultiWriter(buf, blake3Hasher, md5Hasher)
-
-	_, err := io.Copy(multiWriter, doc.Content)
-	if err != nil {
-		log.Err(err).Msg("failed to read file content")
-		return UploadResult{}, err
-	}
-
-	// Now the buffer contains all the data, and streaming hashes are computed
-	contentBytes := buf.Bytes()
-
-	// Derive the blake3 key using the group ID as context
-	// Note: DeriveKey requires the full content buffer (not streaming)
-	blake3.DeriveKey(itemGroup.ID.String(), contentBytes, hashOut)
-
 	// Write the file to the blob storage bucket which might be a local file system or cloud storage
 	bucket, err := blob.OpenBucket(ctx, r.GetConnString())
 	if err != nil {
@@ -968,25 +887,125 @@ func (r *AttachmentRepo) UploadFile(ctx context.Context, itemGroup *ent.Group, d
 		}
 	}(bucket)
 
-	contentType := http.DetectContentType(contentBytes[:min(512, len(contentBytes))])
-	options := &blob.WriterOptions{
-		ContentType: contentType,
-		ContentMD5:  md5Hasher.Sum(nil),
+	staged, err := r.stageFile(ctx, bucket, itemGroup.ID, doc)
+	if err != nil {
+		return UploadResult{}, err
+	}
+	defer r.discardStaged(ctx, bucket, staged)
+
+	return r.storeStaged(ctx, bucket, blobs, itemGroup.ID, staged)
+}
+
+// stagedFile is content written to a temporary key, hashed and waiting to be
+// stored under its content address.
+type stagedFile struct {
+	tmpPath string
+	result  UploadResult
+	md5     []byte
+}
+
+// stageFile writes the content of doc to a temporary key of the group while
+// hashing it.
+func (r *AttachmentRepo) stageFile(ctx context.Context, bucket *blob.Bucket, gid uuid.UUID, doc ItemCreateAttachment) (stagedFile, error) {
+	// Sniff the content type from the first bytes, then put them back in
+	// front of the rest of the content.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(doc.Content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		log.Err(err).Msg("failed to read file content")
+		return stagedFile{}, err
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+
+	// The blake3 key is derived with the group ID as context. Hashing the
+	// stream gives the same key as blake3.DeriveKey over the whole content.
+	keyHasher := blake3.NewDeriveKey(gid.String())
+	md5Hasher := md5.New()
+
+	tmpPath := r.fullPath(r.tmpPath(gid, uuid.New().String()))
+	writeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := bucket.NewWriter(writeCtx, tmpPath, &blob.WriterOptions{ContentType: contentType})
+	if err != nil {
+		log.Err(err).Msg("failed to open temporary file in bucket")
+		return stagedFile{}, err
 	}
-	relativePath := r.path(itemGroup.ID, fmt.Sprintf("%x", hashOut))
-	fullPath := r.fullPath(relativePath)
-	err = bucket.WriteAll(ctx, fullPath, contentBytes, options)
+	content := io.MultiReader(bytes.NewReader(head), doc.Content)
+	size, err := io.Copy(io.MultiWriter(w, keyHasher, md5Hasher), content)
 	if err != nil {
+		// Canceling before Close discards the partial write.
+		cancel()
+		_ = w.Close()
 		log.Err(err).Msg("failed to write file to bucket")
-		return UploadResult{}, err
+		return stagedFile{}, err
+	}
+	if err := w.Close(); err != nil {
+		log.Err(err).Msg("failed to write file to bucket")
+		return stagedFile{}, err
 	}
 
-	return UploadResult{
-		Path:        relativePath,
-		ContentType: contentType,
+	return stagedFile{
+		tmpPath: tmpPath,
+		result: UploadResult{
+			Path:        r.path(gid, fmt.Sprintf("%x", keyHasher.Sum(nil))),
+			ContentType: contentType,
+			Size:        size,
+		},
+		md5: md5Hasher.Sum(nil),
 	}, nil
 }
 
+// discardStaged removes the temporary key of a staged file.
+func (r *AttachmentRepo) discardStaged(ctx context.Context, bucket *blob.Bucket, staged stagedFile) {
+	if err := bucket.Delete(context.WithoutCancel(ctx), staged.tmpPath); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		log.Err(err).Str("path", staged.tmpPath).Msg("failed to delete temporary upload")
+	}
+}
+
+// storeStaged retains the blob of a staged file with blobs and copies the
+// file to its content address. The reference is taken before looking for the
+// file: the garbage collector leaves the file of a retained blob alone, and a
+// record that had to be created means a file found there may be on its way
+// out, so it is copied regardless.
+func (r *AttachmentRepo) storeStaged(ctx context.Context, bucket *blob.Bucket, blobs *ent.BlobClient, gid uuid.UUID, staged stagedFile) (UploadResult, error) {
+	res := staged.result
+	created, err := r.retainBlob(ctx, blobs, gid, res.Path, res.Size, res.ContentType)
+	if err != nil {
+		log.Err(err).Msg("failed to retain blob")
+		return UploadResult{}, err
+	}
+
+	fullPath := r.fullPath(res.Path)
+
+	// Identical content is already stored under the same key.
+	exists := false
+	if !created {
+		exists, err = bucket.Exists(ctx, fullPath)
+		if err != nil {
+			log.Err(err).Msg("failed to check for existing file in bucket")
+			return UploadResult{}, err
+		}
+	}
+	if !exists {
+		if err := bucket.Copy(ctx, fullPath, staged.tmpPath, nil); err != nil {
+			log.Err(err).Msg("failed to move file to its content address")
+			return UploadResult{}, err
+		}
+	}
+
+	attrs, err := bucket.Attributes(ctx, fullPath)
+	if err != nil {
+		log.Err(err).Msg("failed to read attributes of stored file")
+		return UploadResult{}, err
+	}
+	if len(attrs.MD5) > 0 && !bytes.Equal(attrs.MD5, staged.md5) {
+		return UploadResult{}, fmt.Errorf("stored file %s doesn't match the uploaded content", res.Path)
+	}
+
+	return res, nil
+}
+
 func isImageFile(mimetype string) bool {
 	// Check file extension for image types
 	return strings.Contains(mimetype, "image/jpeg") || strings.Contains(mimetype, "image/png") || strings.Contains(mimetype, "image/gif")
@@ -1025,20 +1044,12 @@ func calculateThumbnailDimensions(origWidth, origHeight, maxWidth, maxHeight int
 
 // processThumbnailFromImage handles the common thumbnail processing logic after image decoding
 // Returns the thumbnail file path or an error
-func (r *AttachmentRepo) processThumbnailFromImage(ctx context.Context, groupId uuid.UUID, img image.Image, title string, orientation uint16) (UploadResult, error) {
+func (r *AttachmentRepo) processThumbnailFromImage(ctx context.Context, blobs *ent.BlobClient, groupId uuid.UUID, img image.Image, title string) (UploadResult, error) {
 	ctx, span := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.processThumbnailFromImage")
 	defer span.End()
 
 	bounds := img.Bounds()
 
-	_, exifSpan := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.processThumbnailFromImage.exif")
-	// Apply EXIF orientation if needed
-	if orientation > 1 {
-		img = utils.ApplyOrientation(img, orientation)
-		bounds = img.Bounds()
-	}
-	exifSpan.End()
-
 	_, resizeSpan := otel.Tracer("data").Start(ctx, "repo.AttachmentRepo.processThumbnailFromImage.resize")
 	newWidth, newHeight := calculateThumbnailDimensions(bounds.Dx(), bounds.Dy(), r.thumbnail.Width, r.thumbnail.Height)
 	dst := image.NewRGBA(image.Rect(0, 0, newWidth, newHeight))
@@ -1061,7 +1072,7 @@ func (r *AttachmentRepo) processThumbnailFromImage(ctx context.Context, groupId
 		return UploadResult{}, err
 	}
 
-	uploadResult, err := r.UploadFile(ctx, group, ItemCreateAttachment{
+	uploadResult, err := r.UploadFile(ctx, blobs, group, ItemCreateAttachment{
 		Title:   fmt.Sprintf("%s-thumb", title),
 		Content: bytes.NewReader(contentBytes),
 	})
@@ -0,0 +1,252 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/loan"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+var (
+	// ErrOnLoan is returned when checking out an entity that is already out.
+	ErrOnLoan = errors.New("entity is already on loan")
+	// ErrNotOnLoan is returned when checking in an entity that isn't out.
+	ErrNotOnLoan = errors.New("entity is not on loan")
+	// ErrBorrowerRequired is returned by a checkout with neither a member
+	// nor a name for the borrower.
+	ErrBorrowerRequired = errors.New("a borrower is required")
+)
+
+// LoanRepository records entities lent out and returned.
+type LoanRepository struct {
+	db  *ent.Client
+	bus *eventbus.EventBus
+}
+
+type (
+	// LoanCheckout lends an entity to a member of the collection, given by
+	// BorrowerID, or to anyone else by name.
+	LoanCheckout struct {
+		BorrowerID      *uuid.UUID `json:"borrowerId,omitempty" extensions:"x-nullable"`
+		BorrowerName    string     `json:"borrowerName"         validate:"max=255"`
+		BorrowerContact string     `json:"borrowerContact"      validate:"max=255"`
+		DueDate         types.Date `json:"dueDate"`
+		Notes           string     `json:"notes"                validate:"max=1000"`
+	}
+
+	LoanCheckin struct {
+		// Notes replaces the loan's notes when set.
+		Notes string `json:"notes" validate:"max=1000"`
+	}
+
+	LoanOut struct {
+		ID              uuid.UUID  `json:"id"`
+		EntityID        uuid.UUID  `json:"entityId"`
+		BorrowerID      *uuid.UUID `json:"borrowerId,omitempty" extensions:"x-nullable,x-omitempty"`
+		BorrowerName    string     `json:"borrowerName"`
+		BorrowerContact string     `json:"borrowerContact"`
+		Notes           string     `json:"notes"`
+		CheckedOutAt    time.Time  `json:"checkedOutAt"`
+		DueDate         types.Date `json:"dueDate"`
+		ReturnedAt      *time.Time `json:"returnedAt,omitempty" extensions:"x-nullable,x-omitempty"`
+		Overdue         bool       `json:"overdue"`
+	}
+
+	// LoanReminder is an overdue loan due for a reminder.
+	LoanReminder struct {
+		LoanID      uuid.UUID  `json:"loanId"`
+		EntityID    uuid.UUID  `json:"entityId"`
+		Name        string     `json:"name"`
+		AssetID     AssetID    `json:"assetId,string"`
+		Borrower    string     `json:"borrower"`
+		DueDate     types.Date `json:"dueDate"`
+		DaysOverdue int        `json:"daysOverdue"`
+	}
+)
+
+func mapLoanOut(l *ent.Loan) LoanOut {
+	out := LoanOut{
+		ID:              l.ID,
+		EntityID:        l.EntityID,
+		BorrowerID:      l.BorrowerID,
+		BorrowerName:    l.BorrowerName,
+		BorrowerContact: l.BorrowerContact,
+		Notes:           l.Notes,
+		CheckedOutAt:    l.CheckedOutAt,
+		ReturnedAt:      l.ReturnedAt,
+	}
+	if l.DueAt != nil {
+		out.DueDate = types.DateFromTime(*l.DueAt)
+		out.Overdue = l.ReturnedAt == nil && l.DueAt.Before(types.DateFromTime(time.Now()).Time())
+	}
+	return out
+}
+
+func (r *LoanRepository) publishMutationEvent(ctx context.Context, gid, id uuid.UUID) {
+	if r.bus != nil {
+		r.bus.Publish(eventbus.EventEntityMutation, newMutationEvent(ctx, gid, eventbus.MutationUpdate, []uuid.UUID{id}))
+	}
+}
+
+// Checkout lends entity id out. It fails with ErrOnLoan while the entity
+// has an open loan. A member borrower must belong to the group; their name
+// is used unless data gives one.
+func (r *LoanRepository) Checkout(ctx context.Context, gid, id uuid.UUID, data LoanCheckout) (LoanOut, error) {
+	if err := assertEntityInGroup(ctx, r.db.Entity, gid, id); err != nil {
+		return LoanOut{}, err
+	}
+
+	name := strings.TrimSpace(data.BorrowerName)
+	if data.BorrowerID != nil {
+		member, err := r.db.UserGroup.Query().
+			Where(usergroup.UserID(*data.BorrowerID), usergroup.GroupID(gid)).
+			QueryUser().
+			Only(ctx)
+		if err != nil {
+			return LoanOut{}, err
+		}
+		if name == "" {
+			name = member.Name
+		}
+	}
+	if name == "" {
+		return LoanOut{}, ErrBorrowerRequired
+	}
+
+	open, err := r.db.Loan.Query().
+		Where(loan.EntityID(id), loan.ReturnedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+	if open {
+		return LoanOut{}, ErrOnLoan
+	}
+
+	q := r.db.Loan.Create().
+		SetEntityID(id).
+		SetNillableBorrowerID(data.BorrowerID).
+		SetBorrowerName(name).
+		SetBorrowerContact(data.BorrowerContact).
+		SetNotes(data.Notes).
+		SetCheckedOutAt(time.Now())
+	if due := data.DueDate.Time(); !due.IsZero() {
+		q.SetDueAt(due)
+	}
+
+	l, err := q.Save(ctx)
+	if err != nil {
+		// Lost a race with another checkout of the same entity.
+		if ent.IsConstraintError(err) {
+			return LoanOut{}, ErrOnLoan
+		}
+		return LoanOut{}, err
+	}
+
+	r.publishMutationEvent(ctx, gid, id)
+	return mapLoanOut(l), nil
+}
+
+// Checkin closes the open loan of entity id, failing with ErrNotOnLoan when
+// there is none.
+func (r *LoanRepository) Checkin(ctx context.Context, gid, id uuid.UUID, data LoanCheckin) (LoanOut, error) {
+	if err := assertEntityInGroup(ctx, r.db.Entity, gid, id); err != nil {
+		return LoanOut{}, err
+	}
+
+	l, err := r.db.Loan.Query().
+		Where(loan.EntityID(id), loan.ReturnedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return LoanOut{}, ErrNotOnLoan
+		}
+		return LoanOut{}, err
+	}
+
+	q := l.Update().SetReturnedAt(time.Now())
+	if data.Notes != "" {
+		q.SetNotes(data.Notes)
+	}
+	l, err = q.Save(ctx)
+	if err != nil {
+		return LoanOut{}, err
+	}
+
+	r.publishMutationEvent(ctx, gid, id)
+	return mapLoanOut(l), nil
+}
+
+// GetByEntity returns the loan history of entity id, newest first.
+func (r *LoanRepository) GetByEntity(ctx context.Context, gid, id uuid.UUID) ([]LoanOut, error) {
+	if err := assertEntityInGroup(ctx, r.db.Entity, gid, id); err != nil {
+		return nil, err
+	}
+
+	loans, err := r.db.Loan.Query().
+		Where(loan.EntityID(id)).
+		Order(ent.Desc(loan.FieldCheckedOutAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapEach(loans, mapLoanOut), nil
+}
+
+// GetOverdue returns the open loans of gid that were due before today and
+// haven't been reminded about yet, oldest due date first. Loans of archived
+// and trashed entities are left out.
+func (r *LoanRepository) GetOverdue(ctx context.Context, gid uuid.UUID, today time.Time) ([]LoanReminder, error) {
+	start := types.DateFromTime(today).Time()
+
+	loans, err := r.db.Loan.Query().
+		Where(
+			loan.ReturnedAtIsNil(),
+			loan.OverdueNotifiedAtIsNil(),
+			loan.DueAtLT(start),
+			loan.HasEntityWith(entity.HasGroupWith(group.ID(gid)), entity.Archived(false), entity.DeletedAtIsNil()),
+		).
+		WithEntity().
+		Order(ent.Asc(loan.FieldDueAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]LoanReminder, len(loans))
+	for i, l := range loans {
+		due := types.DateFromTime(*l.DueAt)
+		out[i] = LoanReminder{
+			LoanID:      l.ID,
+			EntityID:    l.EntityID,
+			Name:        l.Edges.Entity.Name,
+			AssetID:     AssetID(l.Edges.Entity.AssetID),
+			Borrower:    l.BorrowerName,
+			DueDate:     due,
+			DaysOverdue: int(start.Sub(due.Time()).Hours() / 24),
+		}
+	}
+	return out, nil
+}
+
+// MarkReminded records that reminders went out so GetOverdue won't return
+// their loans again.
+func (r *LoanRepository) MarkReminded(ctx context.Context, reminders []LoanReminder) error {
+	ids := make([]uuid.UUID, len(reminders))
+	for i, rem := range reminders {
+		ids[i] = rem.LoanID
+	}
+	return r.db.Loan.Update().
+		Where(loan.IDIn(ids...)).
+		SetOverdueNotifiedAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/usergroup"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+func TestLoanRepository_CheckoutCheckin(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+
+	drill, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Drill", EntityTypeID: itemType})
+	require.NoError(t, err)
+	ladder, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Ladder", EntityTypeID: itemType})
+	require.NoError(t, err)
+
+	_, err = tRepos.Loans.Checkout(ctx, gid, drill.ID, LoanCheckout{BorrowerName: "  "})
+	require.ErrorIs(t, err, ErrBorrowerRequired)
+
+	due := types.DateFromTime(time.Now().AddDate(0, 0, 7))
+	out, err := tRepos.Loans.Checkout(ctx, gid, drill.ID, LoanCheckout{BorrowerName: "Sam", BorrowerContact: "sam@example.com", DueDate: due})
+	require.NoError(t, err)
+	assert.Equal(t, "Sam", out.BorrowerName)
+	assert.Equal(t, due, out.DueDate)
+	assert.Nil(t, out.ReturnedAt)
+	assert.False(t, out.Overdue)
+
+	_, err = tRepos.Loans.Checkout(ctx, gid, drill.ID, LoanCheckout{BorrowerName: "Alex"})
+	require.ErrorIs(t, err, ErrOnLoan)
+	_, err = tRepos.Loans.Checkin(ctx, gid, ladder.ID, LoanCheckin{})
+	require.ErrorIs(t, err, ErrNotOnLoan)
+
+	// Other collections can't see or lend the entity.
+	_, err = tRepos.Loans.Checkout(ctx, tGroup.ID, ladder.ID, LoanCheckout{BorrowerName: "Sam"})
+	assert.True(t, ent.IsNotFound(err))
+
+	onLoan, err := tRepos.Entities.QueryByGroup(ctx, gid, EntityQuery{Page: -1, PageSize: -1, OnLoan: true})
+	require.NoError(t, err)
+	require.Len(t, onLoan.Items, 1)
+	assert.Equal(t, drill.ID, onLoan.Items[0].ID)
+
+	byQuery, err := tRepos.Entities.QueryByGroup(ctx, gid, EntityQuery{Page: -1, PageSize: -1, Search: "NOT has:loan"})
+	require.NoError(t, err)
+	require.Len(t, byQuery.Items, 1)
+	assert.Equal(t, ladder.ID, byQuery.Items[0].ID)
+
+	returned, err := tRepos.Loans.Checkin(ctx, gid, drill.ID, LoanCheckin{Notes: "battery flat"})
+	require.NoError(t, err)
+	require.NotNil(t, returned.ReturnedAt)
+	assert.Equal(t, "battery flat", returned.Notes)
+
+	// Returned entities can be lent again, and the history keeps both loans.
+	member := addGroupMember(t, gid, usergroup.RoleViewer)
+	second, err := tRepos.Loans.Checkout(ctx, gid, drill.ID, LoanCheckout{BorrowerID: &member})
+	require.NoError(t, err)
+	assert.NotEmpty(t, second.BorrowerName)
+
+	stranger := uuid.New()
+	_, err = tRepos.Loans.Checkout(ctx, gid, ladder.ID, LoanCheckout{BorrowerID: &stranger})
+	assert.True(t, ent.IsNotFound(err))
+
+	got, err := tRepos.Entities.GetOneByGroup(ctx, gid, drill.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Loans, 2)
+	assert.Equal(t, second.ID, got.Loans[0].ID)
+	assert.Equal(t, out.ID, got.Loans[1].ID)
+}
+
+func TestLoanRepository_GetOverdue(t *testing.T) {
+	ctx := context.Background()
+	gid, itemType := useSearchGroup(t)
+
+	saw, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Saw", EntityTypeID: itemType})
+	require.NoError(t, err)
+	tent, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: "Tent", EntityTypeID: itemType})
+	require.NoError(t, err)
+
+	today := time.Now()
+	_, err = tRepos.Loans.Checkout(ctx, gid, saw.ID, LoanCheckout{BorrowerName: "Sam", DueDate: types.DateFromTime(today.AddDate(0, 0, -3))})
+	require.NoError(t, err)
+	_, err = tRepos.Loans.Checkout(ctx, gid, tent.ID, LoanCheckout{BorrowerName: "Alex", DueDate: types.DateFromTime(today)})
+	require.NoError(t, err)
+
+	// Due today isn't overdue yet.
+	due, err := tRepos.Loans.GetOverdue(ctx, gid, today)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, saw.ID, due[0].EntityID)
+	assert.Equal(t, "Sam", due[0].Borrower)
+	assert.Equal(t, 3, due[0].DaysOverdue)
+
+	loans, err := tRepos.Loans.GetByEntity(ctx, gid, saw.ID)
+	require.NoError(t, err)
+	assert.True(t, loans[0].Overdue)
+
+	// A reminder goes out once.
+	require.NoError(t, tRepos.Loans.MarkReminded(ctx, due))
+	due, err = tRepos.Loans.GetOverdue(ctx, gid, today)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = tRepos.Loans.GetOverdue(ctx, gid, today.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, tent.ID, due[0].EntityID)
+
+	// Entities in the trash aren't reminded about.
+	require.NoError(t, tRepos.Entities.DeleteByGroup(ctx, gid, tent.ID))
+	due, err = tRepos.Loans.GetOverdue(ctx, gid, today.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Empty(t, due)
+}
@@ -48,6 +48,7 @@ func (r *MaintenanceEntryRepository) GetAllMaintenance(ctx context.Context, grou
 	query := r.db.MaintenanceEntry.Query().Where(
 		maintenanceentry.HasEntityWith(
 			entity.HasGroupWith(group.IDEQ(groupID)),
+			entity.DeletedAtIsNil(),
 		),
 	)
 
@@ -3,10 +3,12 @@ package repo
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
@@ -24,16 +26,21 @@ type MaintenanceEntryRepository struct {
 type MaintenanceEntryCreate struct {
 	CompletedDate types.Date `json:"completedDate"`
 	ScheduledDate types.Date `json:"scheduledDate"`
-	Name          string     `json:"name"          validate:"required"`
+	Name          string     `json:"name"           validate:"required"`
 	Description   string     `json:"description"`
 	Cost          float64    `json:"cost,string"`
+	// CostCurrency is empty for costs in the group's currency.
+	CostCurrency string `json:"costCurrency" validate:"max=16"`
+	// RecurrenceRule is an RRULE subset, e.g. "FREQ=MONTHLY;INTERVAL=3".
+	// See ParseRecurrence.
+	RecurrenceRule string `json:"recurrenceRule"`
 }
 
 func (mc MaintenanceEntryCreate) Validate() error {
 	if mc.CompletedDate.Time().IsZero() && mc.ScheduledDate.Time().IsZero() {
 		return errors.New("either completedDate or scheduledDate must be set")
 	}
-	return nil
+	return validateRecurrenceRule(mc.RecurrenceRule)
 }
 
 type MaintenanceEntryUpdate struct {
@@ -42,47 +49,68 @@ type MaintenanceEntryUpdate struct {
 	Name          string     `json:"name"`
 	Description   string     `json:"description"`
 	Cost          float64    `json:"cost,string"`
+	// RecurrenceRule replaces the rule of the entry when set, and an empty
+	// rule ends the series; omit it to leave the rule unchanged.
+	RecurrenceRule *string `json:"recurrenceRule,omitempty" extensions:"x-nullable,x-omitempty"`
+	// CostCurrency replaces the currency of the cost when set; omit it to
+	// leave it unchanged.
+	CostCurrency *string `json:"costCurrency,omitempty" validate:"omitempty,max=16" extensions:"x-nullable,x-omitempty"`
 }
 
 func (mu MaintenanceEntryUpdate) Validate() error {
 	if mu.CompletedDate.Time().IsZero() && mu.ScheduledDate.Time().IsZero() {
 		return errors.New("either completedDate or scheduledDate must be set")
 	}
-	return nil
+	if mu.RecurrenceRule == nil {
+		return nil
+	}
+	return validateRecurrenceRule(*mu.RecurrenceRule)
+}
+
+func validateRecurrenceRule(rule string) error {
+	if rule == "" {
+		return nil
+	}
+	_, err := ParseRecurrence(rule)
+	return err
 }
 
 type (
 	MaintenanceEntry struct {
-		ID            uuid.UUID  `json:"id"`
-		CompletedDate types.Date `json:"completedDate"`
-		ScheduledDate types.Date `json:"scheduledDate"`
-		Name          string     `json:"name"`
-		Description   string     `json:"description"`
-		Cost          float64    `json:"cost,string"`
+		ID             uuid.UUID  `json:"id"`
+		CompletedDate  types.Date `json:"completedDate"`
+		ScheduledDate  types.Date `json:"scheduledDate"`
+		Name           string     `json:"name"`
+		Description    string     `json:"description"`
+		Cost           float64    `json:"cost,string"`
+		CostCurrency   string     `json:"costCurrency,omitempty"`
+		RecurrenceRule string     `json:"recurrenceRule,omitempty"`
 	}
 )
 
 var (
-	mapMaintenanceEntryErr  = mapTErrFunc(mapMaintenanceEntry)
 	mapEachMaintenanceEntry = mapTEachFunc(mapMaintenanceEntry)
 )
 
 func mapMaintenanceEntry(entry *ent.MaintenanceEntry) MaintenanceEntry {
 	return MaintenanceEntry{
-		ID:            entry.ID,
-		CompletedDate: types.Date(entry.Date),
-		ScheduledDate: types.Date(entry.ScheduledDate),
-		Name:          entry.Name,
-		Description:   entry.Description,
-		Cost:          entry.Cost,
+		ID:             entry.ID,
+		CompletedDate:  types.Date(entry.Date),
+		ScheduledDate:  types.Date(entry.ScheduledDate),
+		Name:           entry.Name,
+		Description:    entry.Description,
+		Cost:           entry.Cost,
+		CostCurrency:   entry.CostCurrency,
+		RecurrenceRule: entry.RecurrenceRule,
 	}
 }
 
-func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, gid uuid.UUID, dt types.Date) ([]MaintenanceEntry, error) {
+func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, gid uuid.UUID, dt types.Date) ([]MaintenanceEntryWithDetails, error) {
 	entries, err := r.db.MaintenanceEntry.Query().
 		Where(
 			maintenanceentry.HasEntityWith(
 				entity.HasGroupWith(group.ID(gid)),
+				entity.DeletedAtIsNil(),
 			),
 			maintenanceentry.ScheduledDate(dt.Time()),
 			maintenanceentry.Or(
@@ -90,13 +118,14 @@ func (r *MaintenanceEntryRepository) GetScheduled(ctx context.Context, gid uuid.
 				maintenanceentry.DateEQ(time.Time{}),
 			),
 		).
+		WithEntity().
 		All(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return mapEachMaintenanceEntry(entries), nil
+	return mapEachMaintenanceEntryWithDetails(entries), nil
 }
 
 func (r *MaintenanceEntryRepository) Create(ctx context.Context, gid, itemID uuid.UUID, input MaintenanceEntryCreate) (MaintenanceEntry, error) {
@@ -114,39 +143,169 @@ func (r *MaintenanceEntryRepository) Create(ctx context.Context, gid, itemID uui
 		return MaintenanceEntry{}, &ent.NotFoundError{}
 	}
 
-	item, err := r.db.MaintenanceEntry.Create().
+	rule, err := normalizeRecurrenceRule(input.RecurrenceRule)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during maintenance entry creation")
+			}
+		}
+	}()
+
+	q := tx.MaintenanceEntry.Create().
 		SetEntityID(itemID).
 		SetDate(input.CompletedDate.Time()).
 		SetScheduledDate(input.ScheduledDate.Time()).
 		SetName(input.Name).
 		SetDescription(input.Description).
 		SetCost(input.Cost).
-		Save(ctx)
+		SetCostCurrency(strings.ToUpper(input.CostCurrency))
 
-	return mapMaintenanceEntryErr(item, err)
+	// The rule always sits on the open occurrence. Logging an already
+	// completed entry with a rule starts the series by scheduling the next one.
+	completed := !input.CompletedDate.Time().IsZero()
+	if rule != "" && !completed {
+		q.SetRecurrenceRule(rule)
+	}
+
+	item, err := q.Save(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+
+	if rule != "" && completed {
+		if err := scheduleNextOccurrence(ctx, tx, item, rule); err != nil {
+			return MaintenanceEntry{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return MaintenanceEntry{}, err
+	}
+	committed = true
+
+	return mapMaintenanceEntry(item), nil
 }
 
+// Update replaces an entry. When an entry with a recurrence rule goes from
+// scheduled to completed, the next occurrence is created in the same
+// transaction and the rule moves onto it, so completing (or re-saving) the
+// same occurrence twice never schedules a duplicate. An update without a rule
+// keeps the stored one.
 func (r *MaintenanceEntryRepository) Update(ctx context.Context, gid uuid.UUID, id uuid.UUID, input MaintenanceEntryUpdate) (MaintenanceEntry, error) {
-	owned, err := r.db.MaintenanceEntry.Query().Where(
+	current, err := r.db.MaintenanceEntry.Query().Where(
 		maintenanceentry.ID(id),
 		maintenanceentry.HasEntityWith(entity.HasGroupWith(group.ID(gid))),
-	).Exist(ctx)
+	).Only(ctx)
 	if err != nil {
 		return MaintenanceEntry{}, err
 	}
-	if !owned {
-		return MaintenanceEntry{}, &ent.NotFoundError{}
+
+	rule := current.RecurrenceRule
+	if input.RecurrenceRule != nil {
+		rule, err = normalizeRecurrenceRule(*input.RecurrenceRule)
+		if err != nil {
+			return MaintenanceEntry{}, err
+		}
+	}
+
+	completing := rule != "" && current.Date.IsZero() && !input.CompletedDate.Time().IsZero()
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
 	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during maintenance entry update")
+			}
+		}
+	}()
 
-	item, err := r.db.MaintenanceEntry.UpdateOneID(id).
+	q := tx.MaintenanceEntry.UpdateOneID(id).
 		SetDate(input.CompletedDate.Time()).
 		SetScheduledDate(input.ScheduledDate.Time()).
 		SetName(input.Name).
 		SetDescription(input.Description).
-		SetCost(input.Cost).
-		Save(ctx)
+		SetCost(input.Cost)
+
+	if input.CostCurrency != nil {
+		q.SetCostCurrency(strings.ToUpper(*input.CostCurrency))
+	}
+	if rule == "" || completing {
+		q.ClearRecurrenceRule()
+	} else {
+		q.SetRecurrenceRule(rule)
+	}
+
+	item, err := q.Save(ctx)
+	if err != nil {
+		return MaintenanceEntry{}, err
+	}
+
+	if completing {
+		if err := scheduleNextOccurrence(ctx, tx, item, rule); err != nil {
+			return MaintenanceEntry{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return MaintenanceEntry{}, err
+	}
+	committed = true
+
+	return mapMaintenanceEntry(item), nil
+}
+
+func normalizeRecurrenceRule(rule string) (string, error) {
+	if rule == "" {
+		return "", nil
+	}
+	rec, err := ParseRecurrence(rule)
+	if err != nil {
+		return "", err
+	}
+	return rec.String(), nil
+}
+
+// scheduleNextOccurrence creates the occurrence that follows the completed
+// entry done. The schedule is anchored on the scheduled date when there is
+// one, and occurrences that were missed while the task was overdue are
+// skipped. Nothing is created once the rule's UNTIL has passed.
+func scheduleNextOccurrence(ctx context.Context, tx *ent.Tx, done *ent.MaintenanceEntry, rule string) error {
+	rec, err := ParseRecurrence(rule)
+	if err != nil {
+		return err
+	}
+
+	anchor := done.ScheduledDate
+	if anchor.IsZero() {
+		anchor = done.Date
+	}
+
+	next, ok := rec.NextAfter(anchor, done.Date)
+	if !ok {
+		return nil
+	}
 
-	return mapMaintenanceEntryErr(item, err)
+	return tx.MaintenanceEntry.Create().
+		SetEntityID(done.EntityID).
+		SetScheduledDate(types.DateFromTime(next).Time()).
+		SetName(done.Name).
+		SetDescription(done.Description).
+		SetRecurrenceRule(rule).
+		Exec(ctx)
 }
 
 func (r *MaintenanceEntryRepository) GetMaintenanceByItemID(ctx context.Context, groupID, itemID uuid.UUID, filters MaintenanceFilters) ([]MaintenanceEntryWithDetails, error) {
@@ -80,3 +80,91 @@ func TestMaintenanceEntryRepository_GetLog(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestMaintenanceEntryRepository_RecurringCompletionSchedulesNext(t *testing.T) {
+	ctx := context.Background()
+	item := useEntities(t, 1)[0]
+
+	scheduled := types.DateFromTime(time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+	entry, err := tRepos.MaintEntry.Create(ctx, tGroup.ID, item.ID, MaintenanceEntryCreate{
+		ScheduledDate:  scheduled,
+		Name:           "Oil change",
+		RecurrenceRule: "freq=monthly;interval=3",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=3", entry.RecurrenceRule)
+
+	// The rule is left out, as when completing an entry from the list; the
+	// stored one still applies.
+	update := MaintenanceEntryUpdate{
+		CompletedDate: types.DateFromTime(time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)),
+		ScheduledDate: scheduled,
+		Name:          "Oil change",
+		Cost:          45,
+	}
+	done, err := tRepos.MaintEntry.Update(ctx, tGroup.ID, entry.ID, update)
+	require.NoError(t, err)
+	assert.Empty(t, done.RecurrenceRule, "the rule moves to the next occurrence")
+
+	// Saving the completed entry again must not schedule a second occurrence.
+	_, err = tRepos.MaintEntry.Update(ctx, tGroup.ID, entry.ID, update)
+	require.NoError(t, err)
+
+	upcoming, err := tRepos.MaintEntry.GetMaintenanceByItemID(ctx, tGroup.ID, item.ID, MaintenanceFilters{Status: MaintenanceFilterStatusScheduled})
+	require.NoError(t, err)
+	require.Len(t, upcoming, 1)
+	assert.Equal(t, "2026-04-10", upcoming[0].ScheduledDate.String())
+	assert.Equal(t, "Oil change", upcoming[0].Name)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=3", upcoming[0].RecurrenceRule)
+	assert.Zero(t, upcoming[0].Cost)
+
+	all, err := tRepos.MaintEntry.GetAllMaintenance(ctx, tGroup.ID, MaintenanceFilters{Status: MaintenanceFilterStatusScheduled})
+	require.NoError(t, err)
+	found := false
+	for _, e := range all {
+		if e.ID == upcoming[0].ID {
+			found = true
+		}
+	}
+	assert.True(t, found, "the next occurrence is listed by GET /v1/maintenance")
+
+	// An empty rule ends the series.
+	noRule := ""
+	next, err := tRepos.MaintEntry.Update(ctx, tGroup.ID, upcoming[0].ID, MaintenanceEntryUpdate{
+		ScheduledDate:  upcoming[0].ScheduledDate,
+		Name:           upcoming[0].Name,
+		RecurrenceRule: &noRule,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, next.RecurrenceRule)
+}
+
+func TestMaintenanceEntryRepository_CreateCompletedRecurringStartsSeries(t *testing.T) {
+	ctx := context.Background()
+	item := useEntities(t, 1)[0]
+
+	completed := types.DateFromTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	_, err := tRepos.MaintEntry.Create(ctx, tGroup.ID, item.ID, MaintenanceEntryCreate{
+		CompletedDate:  completed,
+		Name:           "Replace filter",
+		RecurrenceRule: "FREQ=WEEKLY;INTERVAL=6",
+	})
+	require.NoError(t, err)
+
+	upcoming, err := tRepos.MaintEntry.GetMaintenanceByItemID(ctx, tGroup.ID, item.ID, MaintenanceFilters{Status: MaintenanceFilterStatusScheduled})
+	require.NoError(t, err)
+	require.Len(t, upcoming, 1)
+	assert.Equal(t, "2026-03-15", upcoming[0].ScheduledDate.String())
+}
+
+func TestMaintenanceEntryCreate_ValidatesRecurrenceRule(t *testing.T) {
+	mc := MaintenanceEntryCreate{
+		ScheduledDate:  types.DateFromTime(time.Now()),
+		Name:           "Bad rule",
+		RecurrenceRule: "FREQ=WEEKLY;BYDAY=MO",
+	}
+	require.Error(t, mc.Validate())
+
+	mc.RecurrenceRule = ""
+	require.NoError(t, mc.Validate())
+}
@@ -2,13 +2,143 @@ package repo
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"text/template"
+	"text/template/parse"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifier"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/notifiersubscription"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+	"github.com/sysadminsmedia/homebox/backend/pkgs/set"
 )
 
+// NotifierEvent is a kind of event a notifier can subscribe to.
+type NotifierEvent string
+
+const (
+	NotifierEventMaintenanceDue   NotifierEvent = "maintenance_due"
+	NotifierEventWarrantyExpiring NotifierEvent = "warranty_expiring"
+	NotifierEventExportCompleted  NotifierEvent = "export_completed"
+	NotifierEventExportFailed     NotifierEvent = "export_failed"
+	NotifierEventImportFinished   NotifierEvent = "import_finished"
+	NotifierEventLowStock         NotifierEvent = "low_stock"
+	NotifierEventMemberJoined     NotifierEvent = "member_joined"
+	NotifierEventLoanOverdue      NotifierEvent = "loan_overdue"
+)
+
+// NotifierEvents lists every event kind, in display order.
+var NotifierEvents = []NotifierEvent{
+	NotifierEventMaintenanceDue,
+	NotifierEventWarrantyExpiring,
+	NotifierEventExportCompleted,
+	NotifierEventExportFailed,
+	NotifierEventImportFinished,
+	NotifierEventLowStock,
+	NotifierEventMemberJoined,
+	NotifierEventLoanOverdue,
+}
+
+// defaultNotifierSubscriptions is what a notifier created without an explicit
+// subscription list receives: the daily digests notifiers always sent.
+var defaultNotifierSubscriptions = []NotifierSubscription{
+	{Event: NotifierEventMaintenanceDue},
+	{Event: NotifierEventWarrantyExpiring},
+}
+
+func (e NotifierEvent) Valid() bool {
+	return notifiersubscription.EventValidator(notifiersubscription.Event(e)) == nil
+}
+
+// notifierRangeGuard is appended to the pipeline of every range action in a
+// notifier template.
+const notifierRangeGuard = "notifierRangeable"
+
+// ParseNotifierTemplate parses a notifier message template. Templates are Go
+// text/template bodies executed against the event being sent.
+//
+// Anyone in a collection can write templates, and a loop that writes nothing
+// runs until it ends whatever the size of the message, so templates may only
+// loop over the lists in an event: ranging over an integer or a function
+// fails, loops can't be nested and templates can't define or call other
+// templates.
+func ParseNotifierTemplate(body string) (*template.Template, error) {
+	tmpl, err := template.New("notifier").
+		Option("missingkey=zero").
+		Funcs(template.FuncMap{notifierRangeGuard: notifierRangeable}).
+		Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, errors.New("templates can't define other templates")
+	}
+	if tmpl.Tree == nil {
+		return tmpl, nil
+	}
+	if err := guardNotifierRanges(tmpl.Tree.Root, false); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// guardNotifierRanges rejects nested loops and template calls below node,
+// and appends the range guard to the pipeline of each loop.
+func guardNotifierRanges(node parse.Node, inRange bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, c := range n.Nodes {
+			if err := guardNotifierRanges(c, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return guardNotifierBranches(&n.BranchNode, inRange)
+	case *parse.WithNode:
+		return guardNotifierBranches(&n.BranchNode, inRange)
+	case *parse.RangeNode:
+		if inRange {
+			return errors.New("loops can't be nested")
+		}
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      n.Pipe.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(notifierRangeGuard).SetPos(n.Pipe.Pos)},
+		})
+		return guardNotifierBranches(&n.BranchNode, true)
+	case *parse.TemplateNode:
+		return errors.New("templates can't call other templates")
+	}
+	return nil
+}
+
+func guardNotifierBranches(n *parse.BranchNode, inRange bool) error {
+	if err := guardNotifierRanges(n.List, inRange); err != nil {
+		return err
+	}
+	return guardNotifierRanges(n.ElseList, inRange)
+}
+
+// notifierRangeable passes lists, arrays and maps on to range and refuses
+// anything else: ranging over an integer or a function would let a template
+// loop for as long as it likes.
+func notifierRangeable(v any) (any, error) {
+	switch reflect.Indirect(reflect.ValueOf(v)).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Invalid:
+		return v, nil
+	}
+	return nil, fmt.Errorf("can't range over %T", v)
+}
+
 type NotifierRepository struct {
 	db     *ent.Client
 	mapper MapFunc[*ent.Notifier, NotifierOut]
@@ -18,6 +148,18 @@ func NewNotifierRepository(db *ent.Client) *NotifierRepository {
 	return &NotifierRepository{
 		db: db,
 		mapper: func(n *ent.Notifier) NotifierOut {
+			subs := make([]NotifierSubscription, len(n.Edges.Subscriptions))
+			for i, s := range n.Edges.Subscriptions {
+				subs[i] = NotifierSubscription{
+					Event:         NotifierEvent(s.Event),
+					Template:      s.Template,
+					SavedSearchID: s.SavedSearchID,
+				}
+			}
+			slices.SortFunc(subs, func(a, b NotifierSubscription) int {
+				return slices.Index(NotifierEvents, a.Event) - slices.Index(NotifierEvents, b.Event)
+			})
+
 			return NotifierOut{
 				ID:        n.ID,
 				UserID:    n.UserID,
@@ -25,25 +167,42 @@ func NewNotifierRepository(db *ent.Client) *NotifierRepository {
 				CreatedAt: n.CreatedAt,
 				UpdatedAt: n.UpdatedAt,
 
-				Name:     n.Name,
-				IsActive: n.IsActive,
-				URL:      n.URL,
+				Name:          n.Name,
+				IsActive:      n.IsActive,
+				URL:           n.URL,
+				Subscriptions: subs,
 			}
 		},
 	}
 }
 
 type (
+	// NotifierSubscription opts a notifier into one event kind. An empty
+	// Template sends the event's default message. SavedSearchID limits
+	// events about entities (maintenance, warranties, stock) to the entities
+	// the saved search matches.
+	NotifierSubscription struct {
+		Event         NotifierEvent `json:"event"                   validate:"required"`
+		Template      string        `json:"template,omitempty"      validate:"max=4000"`
+		SavedSearchID *uuid.UUID    `json:"savedSearchId,omitempty" extensions:"x-nullable"`
+	}
+
 	NotifierCreate struct {
 		Name     string `json:"name"     validate:"required,min=1,max=255"`
 		IsActive bool   `json:"isActive"`
 		URL      string `json:"url"      validate:"required,shoutrrr"`
+		// Subscriptions defaults to maintenance and warranty reminders when
+		// omitted.
+		Subscriptions []NotifierSubscription `json:"subscriptions,omitempty" validate:"omitempty,dive"`
 	}
 
 	NotifierUpdate struct {
 		Name     string  `json:"name"     validate:"required,min=1,max=255"`
 		IsActive bool    `json:"isActive"`
 		URL      *string `json:"url"      validate:"omitempty,shoutrrr"     extensions:"x-nullable"`
+		// Subscriptions replaces the notifier's subscriptions when set; omit
+		// it to leave them unchanged.
+		Subscriptions []NotifierSubscription `json:"subscriptions,omitempty" validate:"omitempty,dive" extensions:"x-nullable"`
 	}
 
 	NotifierOut struct {
@@ -53,17 +212,47 @@ type (
 		CreatedAt time.Time `json:"createdAt"`
 		UpdatedAt time.Time `json:"updatedAt"`
 
-		Name     string `json:"name"`
-		IsActive bool   `json:"isActive"`
-		URL      string `json:"url"`
+		Name          string                 `json:"name"`
+		IsActive      bool                   `json:"isActive"`
+		URL           string                 `json:"url"`
+		Subscriptions []NotifierSubscription `json:"subscriptions"`
 	}
 )
 
+func (nc NotifierCreate) Validate() error {
+	return validateNotifierSubscriptions(nc.Subscriptions)
+}
+
+func (nu NotifierUpdate) Validate() error {
+	return validateNotifierSubscriptions(nu.Subscriptions)
+}
+
+func validateNotifierSubscriptions(subs []NotifierSubscription) error {
+	seen := make(map[NotifierEvent]struct{}, len(subs))
+	for _, s := range subs {
+		if !s.Event.Valid() {
+			return fmt.Errorf("unknown notifier event %q", s.Event)
+		}
+		if _, ok := seen[s.Event]; ok {
+			return fmt.Errorf("duplicate subscription for event %q", s.Event)
+		}
+		seen[s.Event] = struct{}{}
+
+		if s.Template != "" {
+			if _, err := ParseNotifierTemplate(s.Template); err != nil {
+				return fmt.Errorf("invalid template for event %q: %w", s.Event, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (r *NotifierRepository) GetByUser(ctx context.Context, userID uuid.UUID, tenantID uuid.UUID) ([]NotifierOut, error) {
 	notifier, err := r.db.Notifier.Query().
 		Where(notifier.UserID(userID)).
 		Where(notifier.GroupID(tenantID)).
 		Order(ent.Asc(notifier.FieldName)).
+		WithSubscriptions().
 		All(ctx)
 
 	return r.mapper.MapEachErr(notifier, err)
@@ -73,6 +262,7 @@ func (r *NotifierRepository) GetByGroup(ctx context.Context, groupID uuid.UUID)
 	notifier, err := r.db.Notifier.Query().
 		Where(notifier.GroupID(groupID)).
 		Order(ent.Asc(notifier.FieldName)).
+		WithSubscriptions().
 		All(ctx)
 
 	return r.mapper.MapEachErr(notifier, err)
@@ -82,13 +272,50 @@ func (r *NotifierRepository) GetActiveByGroup(ctx context.Context, groupID uuid.
 	notifier, err := r.db.Notifier.Query().
 		Where(notifier.GroupID(groupID), notifier.IsActive(true)).
 		Order(ent.Asc(notifier.FieldName)).
+		WithSubscriptions().
 		All(ctx)
 
 	return r.mapper.MapEachErr(notifier, err)
 }
 
+// GetActiveByEvent returns the group's active notifiers that subscribe to
+// event. Only the matching subscription is loaded on each notifier.
+func (r *NotifierRepository) GetActiveByEvent(ctx context.Context, groupID uuid.UUID, event NotifierEvent) ([]NotifierOut, error) {
+	notifiers, err := r.db.Notifier.Query().
+		Where(
+			notifier.GroupID(groupID),
+			notifier.IsActive(true),
+			notifier.HasSubscriptionsWith(notifiersubscription.EventEQ(notifiersubscription.Event(event))),
+		).
+		Order(ent.Asc(notifier.FieldName)).
+		WithSubscriptions(func(q *ent.NotifierSubscriptionQuery) {
+			q.Where(notifiersubscription.EventEQ(notifiersubscription.Event(event)))
+		}).
+		All(ctx)
+
+	return r.mapper.MapEachErr(notifiers, err)
+}
+
 func (r *NotifierRepository) Create(ctx context.Context, groupID, userID uuid.UUID, input NotifierCreate) (NotifierOut, error) {
-	notifier, err := r.db.Notifier.
+	subs := input.Subscriptions
+	if subs == nil {
+		subs = defaultNotifierSubscriptions
+	}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return NotifierOut{}, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during notifier creation")
+			}
+		}
+	}()
+
+	created, err := tx.Notifier.
 		Create().
 		SetGroupID(groupID).
 		SetUserID(userID).
@@ -96,20 +323,42 @@ func (r *NotifierRepository) Create(ctx context.Context, groupID, userID uuid.UU
 		SetIsActive(input.IsActive).
 		SetURL(input.URL).
 		Save(ctx)
+	if err != nil {
+		return NotifierOut{}, err
+	}
+
+	if err := setNotifierSubscriptions(ctx, tx, groupID, created.ID, subs); err != nil {
+		return NotifierOut{}, err
+	}
 
-	return r.mapper.MapErr(notifier, err)
+	if err := tx.Commit(); err != nil {
+		return NotifierOut{}, err
+	}
+	committed = true
+
+	return r.getOne(ctx, created.ID)
 }
 
 func (r *NotifierRepository) Update(ctx context.Context, userID uuid.UUID, id uuid.UUID, input NotifierUpdate) (NotifierOut, error) {
-	owned, err := r.db.Notifier.Query().Where(notifier.ID(id), notifier.UserID(userID)).Exist(ctx)
+	owned, err := r.db.Notifier.Query().Where(notifier.ID(id), notifier.UserID(userID)).Only(ctx)
 	if err != nil {
 		return NotifierOut{}, err
 	}
-	if !owned {
-		return NotifierOut{}, &ent.NotFoundError{}
+
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return NotifierOut{}, err
 	}
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil {
+				log.Warn().Err(err).Msg("failed to rollback transaction during notifier update")
+			}
+		}
+	}()
 
-	q := r.db.Notifier.
+	q := tx.Notifier.
 		UpdateOneID(id).
 		SetName(input.Name).
 		SetIsActive(input.IsActive)
@@ -118,9 +367,75 @@ func (r *NotifierRepository) Update(ctx context.Context, userID uuid.UUID, id uu
 		q.SetURL(*input.URL)
 	}
 
-	updated, err := q.Save(ctx)
+	if err := q.Exec(ctx); err != nil {
+		return NotifierOut{}, err
+	}
+
+	if input.Subscriptions != nil {
+		if err := setNotifierSubscriptions(ctx, tx, owned.GroupID, id, input.Subscriptions); err != nil {
+			return NotifierOut{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return NotifierOut{}, err
+	}
+	committed = true
+
+	return r.getOne(ctx, id)
+}
+
+func (r *NotifierRepository) getOne(ctx context.Context, id uuid.UUID) (NotifierOut, error) {
+	return r.mapper.MapErr(r.db.Notifier.Query().
+		Where(notifier.ID(id)).
+		WithSubscriptions().
+		Only(ctx))
+}
+
+// setNotifierSubscriptions replaces every subscription of notifierID. Saved
+// searches must belong to groupID.
+func setNotifierSubscriptions(ctx context.Context, tx *ent.Tx, groupID, notifierID uuid.UUID, subs []NotifierSubscription) error {
+	searches := set.Make[uuid.UUID](len(subs))
+	for _, s := range subs {
+		if s.SavedSearchID != nil {
+			searches.Insert(*s.SavedSearchID)
+		}
+	}
+	if searches.Len() > 0 {
+		n, err := tx.SavedSearch.Query().
+			Where(savedsearch.IDIn(searches.Slice()...), savedsearch.GroupID(groupID)).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if n != searches.Len() {
+			return &ent.NotFoundError{}
+		}
+	}
+
+	if _, err := tx.NotifierSubscription.Delete().
+		Where(notifiersubscription.NotifierID(notifierID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(subs) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.NotifierSubscriptionCreate, len(subs))
+	for i, s := range subs {
+		if !s.Event.Valid() {
+			return fmt.Errorf("unknown notifier event %q", s.Event)
+		}
+		builders[i] = tx.NotifierSubscription.Create().
+			SetNotifierID(notifierID).
+			SetEvent(notifiersubscription.Event(s.Event)).
+			SetTemplate(s.Template).
+			SetNillableSavedSearchID(s.SavedSearchID)
+	}
 
-	return r.mapper.MapErr(updated, err)
+	return tx.NotifierSubscription.CreateBulk(builders...).Exec(ctx)
 }
 
 func (r *NotifierRepository) Delete(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
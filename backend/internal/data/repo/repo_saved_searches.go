@@ -0,0 +1,190 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/savedsearch"
+)
+
+type SavedSearchRepository struct {
+	db *ent.Client
+}
+
+type (
+	// SavedSearchFilters is the stored part of an EntityQuery plus the table
+	// columns to show with it.
+	SavedSearchFilters struct {
+		Query            string      `json:"query"            validate:"max=2000"`
+		OrderBy          string      `json:"orderBy"          validate:"omitempty,oneof=name createdAt updatedAt assetId relevance"`
+		TagIDs           []uuid.UUID `json:"tagIds"`
+		ParentIDs        []uuid.UUID `json:"parentIds"`
+		NegateTags       bool        `json:"negateTags"`
+		OnlyWithPhoto    bool        `json:"onlyWithPhoto"`
+		OnlyWithoutPhoto bool        `json:"onlyWithoutPhoto"`
+		IncludeArchived  bool        `json:"includeArchived"`
+		Columns          []string    `json:"columns"          validate:"omitempty,max=16,dive,oneof=assetId name quantity insured purchasePrice location archived createdAt updatedAt"`
+	}
+
+	SavedSearchCreate struct {
+		Name        string `json:"name"        validate:"required,min=1,max=255"`
+		Description string `json:"description" validate:"max=1000"`
+		SavedSearchFilters
+	}
+
+	SavedSearchUpdate struct {
+		Name        string `json:"name"        validate:"required,min=1,max=255"`
+		Description string `json:"description" validate:"max=1000"`
+		SavedSearchFilters
+	}
+
+	SavedSearchOut struct {
+		ID          uuid.UUID `json:"id"`
+		GroupID     uuid.UUID `json:"groupId"`
+		CreatedAt   time.Time `json:"createdAt"`
+		UpdatedAt   time.Time `json:"updatedAt"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		SavedSearchFilters
+	}
+)
+
+// checkQuery rejects queries that can't be parsed, so a broken saved search
+// is caught when it's saved rather than every time it's used.
+func (f SavedSearchFilters) checkQuery() error {
+	_, err := parseEntityQuery(f.Query)
+	return err
+}
+
+// EntityQuery returns the query the saved search runs, unpaged.
+func (f SavedSearchFilters) EntityQuery() EntityQuery {
+	return EntityQuery{
+		Search:           f.Query,
+		OrderBy:          f.OrderBy,
+		TagIDs:           f.TagIDs,
+		ParentIDs:        f.ParentIDs,
+		NegateTags:       f.NegateTags,
+		OnlyWithPhoto:    f.OnlyWithPhoto,
+		OnlyWithoutPhoto: f.OnlyWithoutPhoto,
+		IncludeArchived:  f.IncludeArchived,
+		Page:             -1,
+		PageSize:         -1,
+	}
+}
+
+func mapSavedSearchOut(s *ent.SavedSearch) SavedSearchOut {
+	return SavedSearchOut{
+		ID:          s.ID,
+		GroupID:     s.GroupID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+		Name:        s.Name,
+		Description: s.Description,
+		SavedSearchFilters: SavedSearchFilters{
+			Query:            s.Query,
+			OrderBy:          s.OrderBy,
+			TagIDs:           emptyIfNil(s.TagIds),
+			ParentIDs:        emptyIfNil(s.ParentIds),
+			NegateTags:       s.NegateTags,
+			OnlyWithPhoto:    s.OnlyWithPhoto,
+			OnlyWithoutPhoto: s.OnlyWithoutPhoto,
+			IncludeArchived:  s.IncludeArchived,
+			Columns:          emptyIfNil(s.Columns),
+		},
+	}
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+var (
+	mapSavedSearchOutErr   = mapTErrFunc(mapSavedSearchOut)
+	mapSavedSearchesOutErr = mapTEachErrFunc(mapSavedSearchOut)
+)
+
+func (r *SavedSearchRepository) GetByGroup(ctx context.Context, gid uuid.UUID) ([]SavedSearchOut, error) {
+	return mapSavedSearchesOutErr(r.db.SavedSearch.Query().
+		Where(savedsearch.GroupID(gid)).
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx))
+}
+
+func (r *SavedSearchRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (SavedSearchOut, error) {
+	return mapSavedSearchOutErr(r.db.SavedSearch.Query().
+		Where(savedsearch.ID(id), savedsearch.GroupID(gid)).
+		Only(ctx))
+}
+
+// Create stores a saved search. A query that doesn't parse fails with an
+// *EntityQueryError.
+func (r *SavedSearchRepository) Create(ctx context.Context, gid uuid.UUID, data SavedSearchCreate) (SavedSearchOut, error) {
+	f := data.SavedSearchFilters
+	if err := f.checkQuery(); err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	return mapSavedSearchOutErr(r.db.SavedSearch.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(f.Query).
+		SetOrderBy(f.OrderBy).
+		SetTagIds(f.TagIDs).
+		SetParentIds(f.ParentIDs).
+		SetNegateTags(f.NegateTags).
+		SetOnlyWithPhoto(f.OnlyWithPhoto).
+		SetOnlyWithoutPhoto(f.OnlyWithoutPhoto).
+		SetIncludeArchived(f.IncludeArchived).
+		SetColumns(f.Columns).
+		Save(ctx))
+}
+
+func (r *SavedSearchRepository) Update(ctx context.Context, gid, id uuid.UUID, data SavedSearchUpdate) (SavedSearchOut, error) {
+	f := data.SavedSearchFilters
+	if err := f.checkQuery(); err != nil {
+		return SavedSearchOut{}, err
+	}
+
+	n, err := r.db.SavedSearch.Update().
+		Where(savedsearch.ID(id), savedsearch.GroupID(gid)).
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetQuery(f.Query).
+		SetOrderBy(f.OrderBy).
+		SetTagIds(f.TagIDs).
+		SetParentIds(f.ParentIDs).
+		SetNegateTags(f.NegateTags).
+		SetOnlyWithPhoto(f.OnlyWithPhoto).
+		SetOnlyWithoutPhoto(f.OnlyWithoutPhoto).
+		SetIncludeArchived(f.IncludeArchived).
+		SetColumns(f.Columns).
+		Save(ctx)
+	if err != nil {
+		return SavedSearchOut{}, err
+	}
+	if n == 0 {
+		return SavedSearchOut{}, &ent.NotFoundError{}
+	}
+	return r.GetOne(ctx, gid, id)
+}
+
+// Delete removes the saved search together with any notifier subscriptions
+// scoped to it.
+func (r *SavedSearchRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	n, err := r.db.SavedSearch.Delete().
+		Where(savedsearch.ID(id), savedsearch.GroupID(gid)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
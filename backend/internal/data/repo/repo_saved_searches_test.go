@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+)
+
+func TestSavedSearchRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	gid, etID := useSearchGroup(t)
+
+	for _, name := range []string{"Drill", "Saw", "Lamp"} {
+		_, err := tRepos.Entities.Create(ctx, gid, EntityCreate{Name: name, EntityTypeID: etID})
+		require.NoError(t, err)
+	}
+
+	s, err := tRepos.SavedSearches.Create(ctx, gid, SavedSearchCreate{
+		Name: "Tools",
+		SavedSearchFilters: SavedSearchFilters{
+			Query:   "drill OR saw",
+			OrderBy: "name",
+			Columns: []string{"name", "quantity"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{}, s.TagIDs)
+	assert.Equal(t, []string{"name", "quantity"}, s.Columns)
+
+	got, err := tRepos.Entities.GetAllMatching(ctx, gid, s.EntityQuery())
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "Drill", got[0].Name)
+	assert.Equal(t, "Saw", got[1].Name)
+
+	s, err = tRepos.SavedSearches.Update(ctx, gid, s.ID, SavedSearchUpdate{
+		Name:               "Lamps",
+		SavedSearchFilters: SavedSearchFilters{Query: "lamp"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Lamps", s.Name)
+
+	ids, err := tRepos.Entities.MatchingIDs(ctx, gid, s.EntityQuery())
+	require.NoError(t, err)
+	assert.Len(t, ids, 1)
+
+	// Another group can't see or change it.
+	other, _ := useSearchGroup(t)
+	_, err = tRepos.SavedSearches.GetOne(ctx, other, s.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = tRepos.SavedSearches.Update(ctx, other, s.ID, SavedSearchUpdate{Name: "x"})
+	assert.True(t, ent.IsNotFound(err))
+	assert.True(t, ent.IsNotFound(tRepos.SavedSearches.Delete(ctx, other, s.ID)))
+
+	require.NoError(t, tRepos.SavedSearches.Delete(ctx, gid, s.ID))
+	all, err := tRepos.SavedSearches.GetByGroup(ctx, gid)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func TestSavedSearchRepository_RejectsBadQuery(t *testing.T) {
+	gid, _ := useSearchGroup(t)
+
+	_, err := tRepos.SavedSearches.Create(context.Background(), gid, SavedSearchCreate{
+		Name:               "Broken",
+		SavedSearchFilters: SavedSearchFilters{Query: "price>"},
+	})
+	var qerr *EntityQueryError
+	assert.True(t, errors.As(err, &qerr))
+}
+
+func TestSavedSearchRepository_DeleteRemovesSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	gid, _ := useSearchGroup(t)
+	s, err := tRepos.SavedSearches.Create(ctx, gid, SavedSearchCreate{Name: "Garage"})
+	require.NoError(t, err)
+
+	n, err := tRepos.Notifiers.Create(ctx, gid, tUser.ID, NotifierCreate{
+		Name:     "scoped",
+		IsActive: true,
+		URL:      "discord://token@scoped",
+		Subscriptions: []NotifierSubscription{
+			{Event: NotifierEventLowStock, SavedSearchID: &s.ID},
+			{Event: NotifierEventMaintenanceDue},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, n.Subscriptions, 2)
+
+	// A saved search from another group is rejected.
+	other, _ := useSearchGroup(t)
+	foreign, err := tRepos.SavedSearches.Create(ctx, other, SavedSearchCreate{Name: "Foreign"})
+	require.NoError(t, err)
+	_, err = tRepos.Notifiers.Update(ctx, tUser.ID, n.ID, NotifierUpdate{
+		Name:          "scoped",
+		IsActive:      true,
+		Subscriptions: []NotifierSubscription{{Event: NotifierEventLowStock, SavedSearchID: &foreign.ID}},
+	})
+	assert.True(t, ent.IsNotFound(err))
+
+	require.NoError(t, tRepos.SavedSearches.Delete(ctx, gid, s.ID))
+
+	notifiers, err := tRepos.Notifiers.GetByGroup(ctx, gid)
+	require.NoError(t, err)
+	require.Len(t, notifiers, 1)
+	require.Len(t, notifiers[0].Subscriptions, 1)
+	assert.Equal(t, NotifierEventMaintenanceDue, notifiers[0].Subscriptions[0].Event)
+}
@@ -118,9 +118,9 @@ func mapTagOut(tag *ent.Tag) TagOut {
 	}
 }
 
-func (r *TagRepository) publishMutationEvent(gid uuid.UUID) {
+func (r *TagRepository) publishMutationEvent(ctx context.Context, gid uuid.UUID, action eventbus.MutationAction, ids ...uuid.UUID) {
 	if r.bus != nil {
-		r.bus.Publish(eventbus.EventTagMutation, eventbus.GroupMutationEvent{GID: gid})
+		r.bus.Publish(eventbus.EventTagMutation, newMutationEvent(ctx, gid, action, ids))
 	}
 }
 
@@ -373,7 +373,7 @@ func (r *TagRepository) Create(ctx context.Context, groupID uuid.UUID, data TagC
 		return TagOut{}, err
 	}
 
-	r.publishMutationEvent(groupID)
+	r.publishMutationEvent(ctx, groupID, eventbus.MutationCreate, createdTag.ID)
 	return freshTag, nil
 }
 
@@ -461,7 +461,7 @@ func (r *TagRepository) UpdateByGroup(ctx context.Context, gid uuid.UUID, data T
 		return TagOut{}, fmt.Errorf("tag not found or does not belong to group")
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationUpdate, data.ID)
 	return r.GetOne(ctx, gid, data.ID)
 }
 
@@ -486,7 +486,7 @@ func (r *TagRepository) DeleteByGroup(ctx context.Context, gid, id uuid.UUID) er
 		return err
 	}
 
-	r.publishMutationEvent(gid)
+	r.publishMutationEvent(ctx, gid, eventbus.MutationDelete, id)
 
 	return nil
 }
@@ -196,7 +196,7 @@ func membershipRole(isOwner bool) usergroup.Role {
 	if isOwner {
 		return usergroup.RoleOwner
 	}
-	return usergroup.RoleUser
+	return usergroup.RoleEditor
 }
 
 // createUserWithMembership inserts the user row and the (user, default_group)
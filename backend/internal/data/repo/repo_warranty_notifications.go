@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/warrantynotification"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/types"
+)
+
+// WarrantyNotificationRepository finds entities whose warranty is about to
+// run out and remembers which reminders have already gone out.
+type WarrantyNotificationRepository struct {
+	db *ent.Client
+}
+
+type WarrantyReminder struct {
+	EntityID        uuid.UUID  `json:"entityId"`
+	Name            string     `json:"name"`
+	AssetID         AssetID    `json:"assetId,string"`
+	WarrantyExpires types.Date `json:"warrantyExpires"`
+	DaysLeft        int        `json:"daysLeft"`
+	// LeadDays is the configured threshold this reminder is for: the
+	// smallest lead time that is still >= DaysLeft.
+	LeadDays int `json:"leadDays"`
+}
+
+// GetDue returns the reminders that should be sent for gid on today, given
+// the collection's lead times. An entity is due once per threshold it has
+// crossed; reminders already recorded with MarkSent are left out. Entities
+// with a lifetime warranty, archived entities and already expired
+// warranties are ignored.
+func (r *WarrantyNotificationRepository) GetDue(ctx context.Context, gid uuid.UUID, leadDays []int, today time.Time) ([]WarrantyReminder, error) {
+	if len(leadDays) == 0 {
+		return nil, nil
+	}
+
+	leads := slices.Clone(leadDays)
+	slices.Sort(leads)
+
+	start := types.DateFromTime(today).Time()
+	end := start.AddDate(0, 0, leads[len(leads)-1]+1)
+
+	entities, err := r.db.Entity.Query().
+		Where(
+			entity.HasGroupWith(group.ID(gid)),
+			entity.LifetimeWarranty(false),
+			entity.Archived(false),
+			entity.WarrantyExpiresGTE(start),
+			entity.WarrantyExpiresLT(end),
+		).
+		Order(ent.Asc(entity.FieldWarrantyExpires), ent.Asc(entity.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(entities))
+	for i, e := range entities {
+		ids[i] = e.ID
+	}
+
+	sent, err := r.db.WarrantyNotification.Query().
+		Where(warrantynotification.EntityIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type sentKey struct {
+		entityID uuid.UUID
+		lead     int
+		expires  time.Time
+	}
+	seen := make(map[sentKey]struct{}, len(sent))
+	for _, s := range sent {
+		seen[sentKey{s.EntityID, s.LeadDays, types.DateFromTime(s.ExpiresOn).Time()}] = struct{}{}
+	}
+
+	out := make([]WarrantyReminder, 0, len(entities))
+	for _, e := range entities {
+		expires := types.DateFromTime(e.WarrantyExpires)
+		daysLeft := int(expires.Time().Sub(start).Hours() / 24)
+
+		idx := slices.IndexFunc(leads, func(l int) bool { return l >= daysLeft })
+		if idx < 0 {
+			continue
+		}
+
+		if _, ok := seen[sentKey{e.ID, leads[idx], expires.Time()}]; ok {
+			continue
+		}
+
+		out = append(out, WarrantyReminder{
+			EntityID:        e.ID,
+			Name:            e.Name,
+			AssetID:         AssetID(e.AssetID),
+			WarrantyExpires: expires,
+			DaysLeft:        daysLeft,
+			LeadDays:        leads[idx],
+		})
+	}
+
+	return out, nil
+}
+
+// MarkSent records reminders as delivered so GetDue won't return them again.
+// Reminders that were already recorded are skipped.
+func (r *WarrantyNotificationRepository) MarkSent(ctx context.Context, reminders []WarrantyReminder) error {
+	for _, rem := range reminders {
+		err := r.db.WarrantyNotification.Create().
+			SetEntityID(rem.EntityID).
+			SetLeadDays(rem.LeadDays).
+			SetExpiresOn(rem.WarrantyExpires.Time()).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+	return nil
+}
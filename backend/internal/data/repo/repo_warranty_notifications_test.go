@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func remindersFor(reminders []WarrantyReminder, ids ...uuid.UUID) map[uuid.UUID]WarrantyReminder {
+	out := map[uuid.UUID]WarrantyReminder{}
+	for _, r := range reminders {
+		for _, id := range ids {
+			if r.EntityID == id {
+				out[id] = r
+			}
+		}
+	}
+	return out
+}
+
+func TestWarrantyNotificationRepository_GetDueAndMarkSent(t *testing.T) {
+	ctx := context.Background()
+	entities := useEntities(t, 4)
+	today := time.Date(2031, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	setWarranty := func(id uuid.UUID, expires time.Time, lifetime bool) {
+		err := tClient.Entity.UpdateOneID(id).
+			SetWarrantyExpires(expires).
+			SetLifetimeWarranty(lifetime).
+			Exec(ctx)
+		require.NoError(t, err)
+	}
+
+	soon, later, far, lifetime := entities[0], entities[1], entities[2], entities[3]
+	setWarranty(soon.ID, today.AddDate(0, 0, 5), false)
+	setWarranty(later.ID, today.AddDate(0, 0, 20), false)
+	setWarranty(far.ID, today.AddDate(0, 0, 45), false)
+	setWarranty(lifetime.ID, today.AddDate(0, 0, 3), true)
+
+	ids := []uuid.UUID{soon.ID, later.ID, far.ID, lifetime.ID}
+	leads := []int{30, 7}
+
+	due, err := tRepos.WarrantyNotifications.GetDue(ctx, tGroup.ID, leads, today)
+	require.NoError(t, err)
+
+	got := remindersFor(due, ids...)
+	require.Len(t, got, 2)
+	assert.Equal(t, 7, got[soon.ID].LeadDays)
+	assert.Equal(t, 5, got[soon.ID].DaysLeft)
+	assert.Equal(t, 30, got[later.ID].LeadDays)
+	assert.Equal(t, 20, got[later.ID].DaysLeft)
+
+	require.NoError(t, tRepos.WarrantyNotifications.MarkSent(ctx, due))
+	// Marking twice is harmless.
+	require.NoError(t, tRepos.WarrantyNotifications.MarkSent(ctx, due))
+
+	due, err = tRepos.WarrantyNotifications.GetDue(ctx, tGroup.ID, leads, today)
+	require.NoError(t, err)
+	assert.Empty(t, remindersFor(due, ids...), "each threshold is only notified once")
+
+	// Fifteen days later the 20-day item crosses the 7-day threshold and the
+	// 45-day item enters the 30-day window.
+	due, err = tRepos.WarrantyNotifications.GetDue(ctx, tGroup.ID, leads, today.AddDate(0, 0, 15))
+	require.NoError(t, err)
+	got = remindersFor(due, ids...)
+	require.Len(t, got, 2)
+	assert.Equal(t, 7, got[later.ID].LeadDays)
+	assert.Equal(t, 30, got[far.ID].LeadDays)
+
+	// Extending a warranty re-arms its reminders.
+	setWarranty(soon.ID, today.AddDate(0, 0, 6), false)
+	due, err = tRepos.WarrantyNotifications.GetDue(ctx, tGroup.ID, leads, today)
+	require.NoError(t, err)
+	got = remindersFor(due, ids...)
+	require.Contains(t, got, soon.ID)
+	assert.Equal(t, 7, got[soon.ID].LeadDays)
+}
+
+func TestWarrantyNotificationRepository_NoLeadDays(t *testing.T) {
+	due, err := tRepos.WarrantyNotifications.GetDue(context.Background(), tGroup.ID, nil, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, due)
+}
@@ -0,0 +1,322 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/group"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/webhook"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/webhookdelivery"
+)
+
+// WebhookEvents are the event bus topics a webhook can subscribe to.
+var WebhookEvents = []string{
+	string(eventbus.EventEntityMutation),
+	string(eventbus.EventTagMutation),
+	string(eventbus.EventExportMutation),
+	string(eventbus.EventImportMutation),
+}
+
+type WebhookRepository struct {
+	db *ent.Client
+}
+
+type (
+	WebhookCreate struct {
+		Name     string   `json:"name"     validate:"required,min=1,max=255"`
+		URL      string   `json:"url"      validate:"required,url,max=2083"`
+		Events   []string `json:"events"   validate:"required,min=1"`
+		IsActive bool     `json:"isActive"`
+	}
+
+	WebhookUpdate struct {
+		Name     string   `json:"name"     validate:"required,min=1,max=255"`
+		URL      string   `json:"url"      validate:"required,url,max=2083"`
+		Events   []string `json:"events"   validate:"required,min=1"`
+		IsActive bool     `json:"isActive"`
+	}
+
+	WebhookOut struct {
+		ID        uuid.UUID `json:"id"`
+		GroupID   uuid.UUID `json:"groupId"`
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+
+		Name     string   `json:"name"`
+		URL      string   `json:"url"`
+		Events   []string `json:"events"`
+		IsActive bool     `json:"isActive"`
+	}
+
+	WebhookDeliveryOut struct {
+		ID             uuid.UUID  `json:"id"`
+		WebhookID      uuid.UUID  `json:"webhookId"`
+		CreatedAt      time.Time  `json:"createdAt"`
+		Event          string     `json:"event"`
+		Payload        string     `json:"payload"`
+		Status         string     `json:"status"`
+		Attempts       int        `json:"attempts"`
+		NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty"  extensions:"x-nullable,x-omitempty"`
+		ResponseStatus int        `json:"responseStatus,omitempty"`
+		Error          string     `json:"error,omitempty"`
+		DeliveredAt    *time.Time `json:"deliveredAt,omitempty"    extensions:"x-nullable,x-omitempty"`
+	}
+
+	// PendingWebhookDelivery is a due delivery together with what the sender
+	// needs to make the request.
+	PendingWebhookDelivery struct {
+		WebhookDeliveryOut
+		GroupID uuid.UUID
+		URL     string
+		Secret  string
+	}
+
+	// WebhookAttempt is the outcome of one delivery attempt.
+	WebhookAttempt struct {
+		ResponseStatus int
+		Error          string
+		// NextAttemptAt schedules a retry; nil marks the delivery as
+		// succeeded when Error is empty and failed otherwise.
+		NextAttemptAt *time.Time
+	}
+)
+
+func (wc WebhookCreate) Validate() error {
+	return validateWebhookEvents(wc.Events)
+}
+
+func (wu WebhookUpdate) Validate() error {
+	return validateWebhookEvents(wu.Events)
+}
+
+func validateWebhookEvents(events []string) error {
+	for _, e := range events {
+		if !slices.Contains(WebhookEvents, e) {
+			return fmt.Errorf("unknown webhook event %q", e)
+		}
+	}
+	return nil
+}
+
+func mapWebhookOut(w *ent.Webhook) WebhookOut {
+	return WebhookOut{
+		ID:        w.ID,
+		GroupID:   w.GroupID,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+		Name:      w.Name,
+		URL:       w.URL,
+		Events:    w.Events,
+		IsActive:  w.IsActive,
+	}
+}
+
+var (
+	mapWebhookOutErr      = mapTErrFunc(mapWebhookOut)
+	mapWebhooksOutErr     = mapTEachErrFunc(mapWebhookOut)
+	mapWebhookDeliveryErr = mapTErrFunc(mapWebhookDeliveryOut)
+	mapWebhookDeliveries  = mapTEachErrFunc(mapWebhookDeliveryOut)
+)
+
+func mapWebhookDeliveryOut(d *ent.WebhookDelivery) WebhookDeliveryOut {
+	return WebhookDeliveryOut{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		CreatedAt:      d.CreatedAt,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+// GetSecret returns the group's webhook signing secret, or "" if none has
+// been generated yet.
+func (r *WebhookRepository) GetSecret(ctx context.Context, gid uuid.UUID) (string, error) {
+	g, err := r.db.Group.Query().
+		Where(group.ID(gid)).
+		Select(group.FieldWebhookSecret).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	return g.WebhookSecret, nil
+}
+
+func (r *WebhookRepository) SetSecret(ctx context.Context, gid uuid.UUID, secret string) error {
+	return r.db.Group.UpdateOneID(gid).SetWebhookSecret(secret).Exec(ctx)
+}
+
+func (r *WebhookRepository) GetByGroup(ctx context.Context, gid uuid.UUID) ([]WebhookOut, error) {
+	hooks, err := r.db.Webhook.Query().
+		Where(webhook.GroupID(gid)).
+		Order(ent.Asc(webhook.FieldName)).
+		All(ctx)
+	return mapWebhooksOutErr(hooks, err)
+}
+
+func (r *WebhookRepository) GetOne(ctx context.Context, gid, id uuid.UUID) (WebhookOut, error) {
+	return mapWebhookOutErr(r.db.Webhook.Query().
+		Where(webhook.ID(id), webhook.GroupID(gid)).
+		Only(ctx))
+}
+
+// GetActiveByEvent returns the group's active webhooks subscribed to event.
+func (r *WebhookRepository) GetActiveByEvent(ctx context.Context, gid uuid.UUID, event string) ([]WebhookOut, error) {
+	hooks, err := r.db.Webhook.Query().
+		Where(webhook.GroupID(gid), webhook.IsActive(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]WebhookOut, 0, len(hooks))
+	for _, h := range hooks {
+		if slices.Contains(h.Events, event) {
+			out = append(out, mapWebhookOut(h))
+		}
+	}
+	return out, nil
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, gid uuid.UUID, data WebhookCreate) (WebhookOut, error) {
+	return mapWebhookOutErr(r.db.Webhook.Create().
+		SetGroupID(gid).
+		SetName(data.Name).
+		SetURL(data.URL).
+		SetEvents(data.Events).
+		SetIsActive(data.IsActive).
+		Save(ctx))
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, gid, id uuid.UUID, data WebhookUpdate) (WebhookOut, error) {
+	n, err := r.db.Webhook.Update().
+		Where(webhook.ID(id), webhook.GroupID(gid)).
+		SetName(data.Name).
+		SetURL(data.URL).
+		SetEvents(data.Events).
+		SetIsActive(data.IsActive).
+		Save(ctx)
+	if err != nil {
+		return WebhookOut{}, err
+	}
+	if n == 0 {
+		return WebhookOut{}, &ent.NotFoundError{}
+	}
+	return r.GetOne(ctx, gid, id)
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, gid, id uuid.UUID) error {
+	n, err := r.db.Webhook.Delete().
+		Where(webhook.ID(id), webhook.GroupID(gid)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+// Enqueue records a delivery of payload to webhookID, due immediately.
+func (r *WebhookRepository) Enqueue(ctx context.Context, webhookID uuid.UUID, event string, payload []byte) (WebhookDeliveryOut, error) {
+	return mapWebhookDeliveryErr(r.db.WebhookDelivery.Create().
+		SetWebhookID(webhookID).
+		SetEvent(event).
+		SetPayload(string(payload)).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx))
+}
+
+// GetDue returns up to limit pending deliveries whose next attempt is at or
+// before now, oldest first. Deliveries of inactive webhooks are skipped and
+// stay pending until the webhook is re-enabled.
+func (r *WebhookRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]PendingWebhookDelivery, error) {
+	rows, err := r.db.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.IsActive(true)),
+		).
+		WithWebhook(func(q *ent.WebhookQuery) {
+			q.WithGroup(func(gq *ent.GroupQuery) {
+				gq.Select(group.FieldID, group.FieldWebhookSecret)
+			})
+		}).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]PendingWebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		w := d.Edges.Webhook
+		p := PendingWebhookDelivery{
+			WebhookDeliveryOut: mapWebhookDeliveryOut(d),
+			GroupID:            w.GroupID,
+			URL:                w.URL,
+		}
+		if w.Edges.Group != nil {
+			p.Secret = w.Edges.Group.WebhookSecret
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// RecordAttempt stores the outcome of an attempt on delivery id.
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, id uuid.UUID, attempt WebhookAttempt) error {
+	q := r.db.WebhookDelivery.UpdateOneID(id).
+		AddAttempts(1).
+		SetResponseStatus(attempt.ResponseStatus).
+		SetError(truncateUTF8(attempt.Error, 1000))
+
+	switch {
+	case attempt.NextAttemptAt != nil:
+		q.SetNextAttemptAt(*attempt.NextAttemptAt)
+	case attempt.Error == "":
+		q.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearNextAttemptAt()
+	default:
+		q.SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt()
+	}
+
+	return q.Exec(ctx)
+}
+
+// GetDeliveries returns the most recent deliveries of a webhook, newest first.
+func (r *WebhookRepository) GetDeliveries(ctx context.Context, gid, webhookID uuid.UUID, limit int) ([]WebhookDeliveryOut, error) {
+	rows, err := r.db.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.WebhookID(webhookID),
+			webhookdelivery.HasWebhookWith(webhook.GroupID(gid)),
+		).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	return mapWebhookDeliveries(rows, err)
+}
+
+// PurgeDeliveries removes finished deliveries created before the cutoff.
+func (r *WebhookRepository) PurgeDeliveries(ctx context.Context, before time.Time) (int, error) {
+	return r.db.WebhookDelivery.Delete().
+		Where(
+			webhookdelivery.StatusNEQ(webhookdelivery.StatusPending),
+			webhookdelivery.CreatedAtLT(before),
+		).
+		Exec(ctx)
+}
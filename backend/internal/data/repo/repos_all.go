@@ -4,41 +4,66 @@ package repo
 import (
 	"github.com/sysadminsmedia/homebox/backend/internal/core/services/reporting/eventbus"
 	"github.com/sysadminsmedia/homebox/backend/internal/data/ent"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/attachment"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/entity"
+	"github.com/sysadminsmedia/homebox/backend/internal/data/ent/maintenanceentry"
 	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
 )
 
 // AllRepos is a container for all the repository interfaces
 type AllRepos struct {
-	Users               *UserRepository
-	AuthTokens          *TokenRepository
-	PasswordResetTokens *PasswordResetTokenRepository
-	APIKeys             *APIKeyRepository
-	Groups              *GroupRepository
-	Entities            *EntityRepository
-	EntityTypes         *EntityTypeRepository
-	EntityTemplates     *EntityTemplatesRepository
-	Tags                *TagRepository
-	Attachments         *AttachmentRepo
-	MaintEntry          *MaintenanceEntryRepository
-	Notifiers           *NotifierRepository
-	Exports             *ExportRepository
+	Users                 *UserRepository
+	AuthTokens            *TokenRepository
+	PasswordResetTokens   *PasswordResetTokenRepository
+	APIKeys               *APIKeyRepository
+	Groups                *GroupRepository
+	Entities              *EntityRepository
+	Search                *EntitySearchRepository
+	EntityTypes           *EntityTypeRepository
+	EntityTemplates       *EntityTemplatesRepository
+	Tags                  *TagRepository
+	Attachments           *AttachmentRepo
+	MaintEntry            *MaintenanceEntryRepository
+	Notifiers             *NotifierRepository
+	Exports               *ExportRepository
+	AuditLog              *AuditLogRepository
+	WarrantyNotifications *WarrantyNotificationRepository
+	Webhooks              *WebhookRepository
+	SavedSearches         *SavedSearchRepository
+	Loans                 *LoanRepository
+	ExchangeRates         *ExchangeRateRepository
 }
 
 func New(db *ent.Client, bus *eventbus.EventBus, storage config.Storage, pubSubConn string, thumbnail config.Thumbnail) *AllRepos {
-	attachments := &AttachmentRepo{db, storage, pubSubConn, thumbnail}
+	db.Entity.Intercept(entityTrashInterceptor())
+	db.Entity.Use(entityTrashHook())
+	db.Intercept(entityScopeInterceptor())
+	db.Entity.Use(entityScopeHook(entity.FieldID))
+	db.Attachment.Use(entityScopeHook(attachment.EntityColumn))
+	db.MaintenanceEntry.Use(entityScopeHook(maintenanceentry.EntityColumn))
+
+	attachments := &AttachmentRepo{db, storage, pubSubConn, thumbnail, newThumbnailRenderers(thumbnail)}
+	audit := &AuditLogRepository{db}
 	return &AllRepos{
-		Users:               &UserRepository{db},
-		AuthTokens:          &TokenRepository{db},
-		PasswordResetTokens: &PasswordResetTokenRepository{db},
-		APIKeys:             NewAPIKeyRepository(db),
-		Groups:              NewGroupRepository(db, attachments),
-		Entities:            &EntityRepository{db, bus, attachments},
-		EntityTypes:         &EntityTypeRepository{db, bus},
-		EntityTemplates:     &EntityTemplatesRepository{db, bus},
-		Tags:                &TagRepository{db, bus},
-		Attachments:         attachments,
-		MaintEntry:          &MaintenanceEntryRepository{db},
-		Notifiers:           NewNotifierRepository(db),
-		Exports:             &ExportRepository{db},
+		Users:                 &UserRepository{db},
+		AuthTokens:            &TokenRepository{db},
+		PasswordResetTokens:   &PasswordResetTokenRepository{db},
+		APIKeys:               NewAPIKeyRepository(db),
+		Groups:                NewGroupRepository(db, attachments),
+		Entities:              &EntityRepository{db, bus, attachments, audit},
+		Search:                newEntitySearchRepository(db),
+		EntityTypes:           &EntityTypeRepository{db, bus},
+		EntityTemplates:       &EntityTemplatesRepository{db, bus},
+		Tags:                  &TagRepository{db, bus},
+		Attachments:           attachments,
+		MaintEntry:            &MaintenanceEntryRepository{db},
+		Notifiers:             NewNotifierRepository(db),
+		Exports:               &ExportRepository{db},
+		AuditLog:              audit,
+		WarrantyNotifications: &WarrantyNotificationRepository{db},
+		Webhooks:              &WebhookRepository{db},
+		SavedSearches:         &SavedSearchRepository{db},
+		Loans:                 &LoanRepository{db, bus},
+		ExchangeRates:         &ExchangeRateRepository{db},
 	}
 }
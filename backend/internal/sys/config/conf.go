@@ -73,12 +73,18 @@ type Options struct {
 	AllowAnalytics       bool   `yaml:"allow_analytics"         conf:"default:false"`
 	AllowLocalLogin      bool   `yaml:"allow_local_login"       conf:"default:true"`
 	TrustProxy           bool   `yaml:"trust_proxy"             conf:"default:false"`
+	TrashRetentionDays   int    `yaml:"trash_retention_days"    conf:"default:30"`
 }
 
 type Thumbnail struct {
 	Enabled bool `yaml:"enabled" conf:"default:true"`
 	Width   int  `yaml:"width"   conf:"default:500"`
 	Height  int  `yaml:"height"  conf:"default:500"`
+	// PDFCommand and VideoCommand render the first page of a PDF and a
+	// poster frame of a video as a PNG or JPEG on stdout. {{.Input}} is the
+	// file, {{.Width}} and {{.Height}} the thumbnail size. Empty disables them.
+	PDFCommand   string `yaml:"pdf_command"   conf:"default:pdftoppm -f 1 -l 1 -singlefile -png -scale-to {{.Width}} {{.Input}}"`
+	VideoCommand string `yaml:"video_command"`
 }
 
 type DebugConf struct {
@@ -97,6 +103,10 @@ type WebConfig struct {
 	// backup including attachments can be much larger than a single asset
 	// upload. Defaults to 1 GB.
 	MaxImportSize int64 `yaml:"max_import_size" conf:"default:1024"`
+	// MaxResumableUploadSize is the largest file (in MB) accepted by
+	// resumable attachment uploads, which arrive in chunks of at most
+	// MaxUploadSize each. Defaults to 10 GB.
+	MaxResumableUploadSize int64 `yaml:"max_resumable_upload_size" conf:"default:10240"`
 	// MaxParseMemory is the amount of memory used when parsing multipart form
 	// the data that does not fit into this memory will spil to temp files.
 	// Defaults to 64 MB.
@@ -119,6 +129,10 @@ type LabelMakerConf struct {
 	LabelServiceTimeout   *time.Duration `yaml:"label_service_timeout"`
 	RegularFontPath       *string        `yaml:"regular_font_path"`
 	BoldFontPath          *string        `yaml:"bold_font_path"`
+	PrintFormat           string         `yaml:"print_format"          conf:"default:png"`
+	PrinterAddress        *string        `yaml:"printer_address"`
+	PrinterMedia          string         `yaml:"printer_media"         conf:"default:62"`
+	PrinterWidth          int64          `yaml:"printer_width"`
 }
 
 type OIDCConf struct {
@@ -0,0 +1,164 @@
+package labelmaker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"slices"
+)
+
+// BrotherQLMedia is a Brother QL tape or die-cut label, in printer dots at
+// 300 dpi.
+type BrotherQLMedia struct {
+	// Width and Length are the tape width and label length in mm; the length
+	// of continuous tape is 0.
+	Width, Length int
+	// Dots and LengthDots are the printable width and label length.
+	Dots, LengthDots int
+	// RightMargin is the number of dots between the printable area and the
+	// right end of the print head.
+	RightMargin int
+	// Wide media only fits the QL-1000 series, with a wider print head.
+	Wide bool
+}
+
+// BrotherQLMedias are the supported media, by the name Brother prints on the
+// roll: the tape width for continuous tape, width x length for labels.
+var BrotherQLMedias = map[string]BrotherQLMedia{
+	"12":     {Width: 12, Dots: 106, RightMargin: 29},
+	"29":     {Width: 29, Dots: 306, RightMargin: 6},
+	"38":     {Width: 38, Dots: 413, RightMargin: 12},
+	"50":     {Width: 50, Dots: 554, RightMargin: 12},
+	"62":     {Width: 62, Dots: 696, RightMargin: 12},
+	"102":    {Width: 102, Dots: 1164, RightMargin: 12, Wide: true},
+	"17x54":  {Width: 17, Length: 54, Dots: 165, LengthDots: 566, RightMargin: 0},
+	"29x90":  {Width: 29, Length: 90, Dots: 306, LengthDots: 991, RightMargin: 6},
+	"62x29":  {Width: 62, Length: 29, Dots: 696, LengthDots: 271, RightMargin: 12},
+	"62x100": {Width: 62, Length: 100, Dots: 696, LengthDots: 1109, RightMargin: 12},
+}
+
+// Continuous reports whether m is tape rather than die-cut labels.
+func (m BrotherQLMedia) Continuous() bool {
+	return m.Length == 0
+}
+
+// headBytes is the size of one raster line of the print head.
+func (m BrotherQLMedia) headBytes() int {
+	if m.Wide {
+		return 162
+	}
+	return 90
+}
+
+const (
+	brotherContinuousMargin = 35 // dots fed before and after a label on tape
+	brotherMediaContinuous  = 0x0A
+	brotherMediaDieCut      = 0x0B
+)
+
+// EncodeBrotherQL writes img as a Brother QL raster job for media, one of
+// BrotherQLMedias. Landscape labels are turned to run along narrow tape, and
+// the label is scaled to the printable width, and to the label length of
+// die-cut media.
+func EncodeBrotherQL(w io.Writer, img image.Image, media string) error {
+	m, ok := BrotherQLMedias[media]
+	if !ok {
+		names := make([]string, 0, len(BrotherQLMedias))
+		for name := range BrotherQLMedias {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		return fmt.Errorf("unknown Brother QL media %q, expected one of %v", media, names)
+	}
+
+	bits := monochrome(brotherFit(img, m))
+
+	var b bytes.Buffer
+	b.Write(make([]byte, 200))              // invalidate
+	b.Write([]byte{0x1B, 0x40})             // ESC @: initialize
+	b.Write([]byte{0x1B, 0x69, 0x61, 0x01}) // ESC i a: raster mode
+
+	// ESC i z: print information. Quality priority, with valid media type,
+	// width and length.
+	mediaType := byte(brotherMediaContinuous)
+	if !m.Continuous() {
+		mediaType = brotherMediaDieCut
+	}
+	b.Write([]byte{0x1B, 0x69, 0x7A, 0x80 | 0x02 | 0x04 | 0x08, mediaType, byte(m.Width), byte(m.Length)})
+	_ = binary.Write(&b, binary.LittleEndian, uint32(bits.height))
+	b.Write([]byte{0x00, 0x00}) // first page
+
+	b.Write([]byte{0x1B, 0x69, 0x4D, 0x40}) // ESC i M: auto cut
+	b.Write([]byte{0x1B, 0x69, 0x41, 0x01}) // ESC i A: cut every label
+	b.Write([]byte{0x1B, 0x69, 0x4B, 0x08}) // ESC i K: cut at end
+
+	margin := 0
+	if m.Continuous() {
+		margin = brotherContinuousMargin
+	}
+	b.Write([]byte{0x1B, 0x69, 0x64})
+	_ = binary.Write(&b, binary.LittleEndian, uint16(margin))
+
+	// The print head prints right to left: the left edge of the label is at
+	// the end of the line, RightMargin dots from it.
+	head := m.headBytes()
+	line := make([]byte, head)
+	for y := 0; y < bits.height; y++ {
+		clear(line)
+		for x := 0; x < bits.width; x++ {
+			if bits.data[y*bits.stride+x/8]&(0x80>>(x%8)) == 0 {
+				continue
+			}
+			dot := m.RightMargin + bits.width - 1 - x
+			line[dot/8] |= 0x80 >> (dot % 8)
+		}
+		b.Write([]byte{0x67, 0x00, byte(head)}) // g: raster line
+		b.Write(line)
+	}
+	b.WriteByte(0x1A) // print with feed
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// brotherFit turns and scales img to the printable area of m. The result is
+// m.Dots wide; it is m.LengthDots long for die-cut labels.
+func brotherFit(img image.Image, m BrotherQLMedia) image.Image {
+	// A landscape label too wide for the tape runs along it, and one for a
+	// die-cut label longer than it is wide is turned to match.
+	if img.Bounds().Dx() > img.Bounds().Dy() {
+		if m.Continuous() && img.Bounds().Dx() > m.Dots || !m.Continuous() && m.LengthDots > m.Dots {
+			img = rotate90(img)
+		}
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if m.Continuous() {
+		return scale(img, m.Dots, max(1, height*m.Dots/width))
+	}
+
+	// Fit inside the label and center on white.
+	ratio := min(float64(m.Dots)/float64(width), float64(m.LengthDots)/float64(height))
+	fw, fh := max(1, int(float64(width)*ratio)), max(1, int(float64(height)*ratio))
+	dst := image.NewRGBA(image.Rect(0, 0, m.Dots, m.LengthDots))
+	draw.Draw(dst, dst.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	offset := image.Pt((m.Dots-fw)/2, (m.LengthDots-fh)/2)
+	draw.Draw(dst, image.Rectangle{Min: offset, Max: offset.Add(image.Pt(fw, fh))}, scale(img, fw, fh), image.Point{}, draw.Src)
+	return dst
+}
+
+// rotate90 turns img clockwise by a quarter.
+func rotate90(img image.Image) image.Image {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dy(), b.Dx()))
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			dst.Set(b.Max.Y-1-y, x-b.Min.X, img.At(x, y))
+		}
+	}
+	return dst
+}
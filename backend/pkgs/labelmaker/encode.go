@@ -0,0 +1,221 @@
+package labelmaker
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+	"golang.org/x/image/draw"
+
+	// Label services may answer with JPEG or GIF labels.
+	_ "image/gif"
+	_ "image/jpeg"
+)
+
+// Format is the output format of a label.
+type Format string
+
+const (
+	// FormatPNG is the label image, printed with the configured print command.
+	FormatPNG Format = "png"
+	// FormatZPL is Zebra Programming Language for Zebra and compatible printers.
+	FormatZPL Format = "zpl"
+	// FormatESCPOS is the ESC/POS raster format of receipt printers.
+	FormatESCPOS Format = "escpos"
+	// FormatBrotherQL is the raster format of Brother QL printers.
+	FormatBrotherQL Format = "brother-ql"
+)
+
+// ParseFormat returns the format named s; an empty s is FormatPNG.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case "":
+		return FormatPNG, nil
+	case FormatPNG, FormatZPL, FormatESCPOS, FormatBrotherQL:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown label format %q, expected png, zpl, escpos or brother-ql", s)
+	}
+}
+
+// ContentType is the media type of labels in format f.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPNG:
+		return "image/png"
+	case FormatZPL:
+		return "application/zpl"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Extension is the file name extension of labels in format f.
+func (f Format) Extension() string {
+	switch f {
+	case FormatPNG:
+		return "png"
+	case FormatZPL:
+		return "zpl"
+	default:
+		return "bin"
+	}
+}
+
+// RenderLabel generates the label of params like GenerateLabel and writes it
+// in format f. Printer formats use the printer settings of cfg.
+func RenderLabel(w io.Writer, params *GenerateParameters, cfg *config.Config, f Format) error {
+	if f == FormatPNG || f == "" {
+		return GenerateLabel(w, params, cfg)
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateLabel(&buf, params, cfg); err != nil {
+		return err
+	}
+	img, _, err := image.Decode(&buf)
+	if err != nil {
+		return fmt.Errorf("failed to decode label image: %w", err)
+	}
+
+	var width int
+	media := ""
+	if cfg != nil {
+		width = int(cfg.LabelMaker.PrinterWidth)
+		media = cfg.LabelMaker.PrinterMedia
+	}
+
+	switch f {
+	case FormatZPL:
+		return EncodeZPL(w, img, width)
+	case FormatESCPOS:
+		return EncodeESCPOS(w, img, width)
+	case FormatBrotherQL:
+		return EncodeBrotherQL(w, img, media)
+	default:
+		return fmt.Errorf("unknown label format %q", f)
+	}
+}
+
+// EncodeZPL writes img as a ZPL label with a single graphic field. Images
+// wider than width dots are scaled down to it; a width of 0 keeps the size.
+func EncodeZPL(w io.Writer, img image.Image, width int) error {
+	bits := monochrome(fitWidth(img, width))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "^XA^PW%d^LL%d^FO0,0", bits.width, bits.height)
+	fmt.Fprintf(&b, "^GFA,%d,%d,%d,", len(bits.data), len(bits.data), bits.stride)
+	fmt.Fprintf(&b, "%X", bits.data)
+	b.WriteString("^FS^XZ\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// escposBand is the number of raster rows sent per ESC/POS image command;
+// many printers can't buffer a whole label.
+const escposBand = 256
+
+// EncodeESCPOS writes img as an ESC/POS raster image followed by a feed and a
+// partial cut. Images wider than width dots are scaled down to it; a width of
+// 0 keeps the size.
+func EncodeESCPOS(w io.Writer, img image.Image, width int) error {
+	bits := monochrome(fitWidth(img, width))
+
+	var b bytes.Buffer
+	b.Write([]byte{0x1B, 0x40}) // ESC @: initialize
+	for y := 0; y < bits.height; y += escposBand {
+		rows := min(escposBand, bits.height-y)
+		// GS v 0: print raster bit image, normal size.
+		b.Write([]byte{0x1D, 0x76, 0x30, 0x00,
+			byte(bits.stride), byte(bits.stride >> 8),
+			byte(rows), byte(rows >> 8)})
+		b.Write(bits.data[y*bits.stride : (y+rows)*bits.stride])
+	}
+	b.Write([]byte{0x1B, 0x64, 0x04})       // ESC d 4: feed four lines
+	b.Write([]byte{0x1D, 0x56, 0x42, 0x00}) // GS V B 0: feed and partial cut
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// bitmap is a 1-bit image, most significant bit first, with set bits black.
+type bitmap struct {
+	width, height, stride int
+	data                  []byte
+}
+
+// monochrome thresholds img to black and white. Transparent pixels are white.
+func monochrome(img image.Image) bitmap {
+	bounds := img.Bounds()
+	bm := bitmap{width: bounds.Dx(), height: bounds.Dy(), stride: (bounds.Dx() + 7) / 8}
+	bm.data = make([]byte, bm.stride*bm.height)
+	for y := 0; y < bm.height; y++ {
+		for x := 0; x < bm.width; x++ {
+			if isBlack(img.At(bounds.Min.X+x, bounds.Min.Y+y)) {
+				bm.data[y*bm.stride+x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+	return bm
+}
+
+func isBlack(c color.Color) bool {
+	_, _, _, a := c.RGBA()
+	if a < 0x8000 {
+		return false
+	}
+	return color.GrayModel.Convert(c).(color.Gray).Y < 128
+}
+
+// fitWidth scales img down to width pixels, keeping its aspect ratio. Images
+// that are narrow enough, or a width of 0, are returned unchanged.
+func fitWidth(img image.Image, width int) image.Image {
+	if width <= 0 || img.Bounds().Dx() <= width {
+		return img
+	}
+	height := max(1, img.Bounds().Dy()*width/img.Bounds().Dx())
+	return scale(img, width, height)
+}
+
+// scale resizes img to width by height with nearest-neighbour sampling,
+// which keeps QR codes sharp on a 1-bit printer.
+func scale(img image.Image, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.NearestNeighbor.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+	return dst
+}
+
+// rawPrinterPort is the port of raw (JetDirect) printing.
+const rawPrinterPort = "9100"
+
+// rawPrinterTimeout bounds connecting and sending to a printer.
+const rawPrinterTimeout = 30 * time.Second
+
+// SendToPrinter sends data to the raw printing port of the printer at addr,
+// a host with an optional port that defaults to 9100.
+func SendToPrinter(addr string, data []byte) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, rawPrinterPort)
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, rawPrinterTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to printer %s: %w", addr, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(rawPrinterTimeout)); err != nil {
+		return err
+	}
+	if _, err := conn.Write(data); err != nil {
+		return fmt.Errorf("failed to send label to printer %s: %w", addr, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package labelmaker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+// testLabel is a white image of width by height with its first pixel black.
+func testLabel(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	img.Set(0, 0, color.Black)
+	return img
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatPNG, f)
+
+	f, err = ParseFormat(" ZPL ")
+	require.NoError(t, err)
+	assert.Equal(t, FormatZPL, f)
+
+	_, err = ParseFormat("pcl")
+	require.Error(t, err)
+}
+
+func TestEncodeZPL(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeZPL(&buf, testLabel(10, 2), 0))
+	// Two rows of two bytes, with the first dot set.
+	assert.Equal(t, "^XA^PW10^LL2^FO0,0^GFA,4,4,2,80000000^FS^XZ\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, EncodeZPL(&buf, testLabel(20, 4), 10))
+	assert.Contains(t, buf.String(), "^PW10^LL2")
+}
+
+func TestEncodeESCPOS(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeESCPOS(&buf, testLabel(16, 300), 0))
+	out := buf.Bytes()
+
+	// Initialize, then a full band and the rest of the rows.
+	assert.Equal(t, []byte{0x1B, 0x40, 0x1D, 0x76, 0x30, 0x00, 2, 0, 0, 1, 0x80}, out[:11])
+	second := 2 + 8 + 2*escposBand
+	assert.Equal(t, []byte{0x1D, 0x76, 0x30, 0x00, 2, 0, 44, 0}, out[second:second+8])
+	assert.Equal(t, []byte{0x1D, 0x56, 0x42, 0x00}, out[len(out)-4:])
+}
+
+func TestEncodeBrotherQL(t *testing.T) {
+	var buf bytes.Buffer
+	require.Error(t, EncodeBrotherQL(&buf, testLabel(10, 10), "63"))
+
+	// A landscape label wider than 29 mm tape runs along it.
+	require.NoError(t, EncodeBrotherQL(&buf, testLabel(600, 200), "29"))
+	out := buf.Bytes()
+	assert.Equal(t, make([]byte, 200), out[:200])
+
+	info := bytes.Index(out, []byte{0x1B, 0x69, 0x7A})
+	require.Positive(t, info)
+	assert.Equal(t, []byte{0x0A, 29, 0}, out[info+4:info+7])
+	lines := int(binary.LittleEndian.Uint32(out[info+7 : info+11]))
+	assert.Equal(t, 600*306/200, lines)
+
+	first := bytes.Index(out, []byte{0x67, 0x00, 90})
+	require.Positive(t, first)
+	assert.Equal(t, len(out)-1, first+lines*93)
+	assert.Equal(t, byte(0x1A), out[len(out)-1])
+
+	// Die-cut labels are always the full label length.
+	buf.Reset()
+	require.NoError(t, EncodeBrotherQL(&buf, testLabel(526, 200), "62x29"))
+	info = bytes.Index(buf.Bytes(), []byte{0x1B, 0x69, 0x7A})
+	assert.Equal(t, []byte{0x0B, 62, 29}, buf.Bytes()[info+4:info+7])
+	assert.Equal(t, uint32(271), binary.LittleEndian.Uint32(buf.Bytes()[info+7:info+11]))
+}
+
+func TestPrintLabelAs_RawPrinter(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			received <- nil
+			return
+		}
+		data, _ := io.ReadAll(conn)
+		_ = conn.Close()
+		received <- data
+	}()
+
+	addr := ln.Addr().String()
+	cfg := &config.Config{LabelMaker: config.LabelMakerConf{PrinterAddress: &addr}}
+	params := NewGenerateParams(526, 200, 32, 32, 32, "Drill", "Garage", "https://example.com/item/1", false, nil)
+	require.NoError(t, PrintLabelAs(cfg, &params, FormatZPL))
+
+	data := <-received
+	assert.True(t, bytes.HasPrefix(data, []byte("^XA^PW526^LL200")))
+	assert.True(t, bytes.HasSuffix(data, []byte("^XZ\n")))
+}
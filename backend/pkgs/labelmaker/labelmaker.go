@@ -2,6 +2,7 @@
 package labelmaker
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	"image/color"
@@ -469,8 +470,28 @@ func splitCommandTemplate(s string) []string {
 	return parts
 }
 
+// PrintLabel prints the label of params in the configured print format.
 func PrintLabel(cfg *config.Config, params *GenerateParameters) error {
-	tmpFile := filepath.Join(os.TempDir(), fmt.Sprintf("label-%d.png", time.Now().UnixNano()))
+	format, err := ParseFormat(cfg.LabelMaker.PrintFormat)
+	if err != nil {
+		return err
+	}
+	return PrintLabelAs(cfg, params, format)
+}
+
+// PrintLabelAs prints the label of params in format. Printer formats are sent
+// straight to the configured printer address when there is one; otherwise the
+// label file is handed to the print command.
+func PrintLabelAs(cfg *config.Config, params *GenerateParameters, format Format) error {
+	if format != FormatPNG && cfg.LabelMaker.PrinterAddress != nil && *cfg.LabelMaker.PrinterAddress != "" {
+		var buf bytes.Buffer
+		if err := RenderLabel(&buf, params, cfg, format); err != nil {
+			return err
+		}
+		return SendToPrinter(*cfg.LabelMaker.PrinterAddress, buf.Bytes())
+	}
+
+	tmpFile := filepath.Join(os.TempDir(), fmt.Sprintf("label-%d.%s", time.Now().UnixNano(), format.Extension()))
 	f, err := os.OpenFile(tmpFile, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
 	if err != nil {
 		return err
@@ -482,7 +503,7 @@ func PrintLabel(cfg *config.Config, params *GenerateParameters) error {
 		}
 	}()
 
-	err = GenerateLabel(f, params, cfg)
+	err = RenderLabel(f, params, cfg, format)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,175 @@
+package labelmaker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"codeberg.org/go-pdf/fpdf"
+	"github.com/sysadminsmedia/homebox/backend/internal/sys/config"
+)
+
+// SheetTemplate describes a sheet of label stock. All lengths are in
+// millimetres; the pitch is the distance between the same edge of
+// neighbouring labels, so it includes the gap between them.
+type SheetTemplate struct {
+	Name        string  `json:"name"`
+	PageWidth   float64 `json:"pageWidth"   validate:"gt=0"`
+	PageHeight  float64 `json:"pageHeight"  validate:"gt=0"`
+	Columns     int     `json:"columns"     validate:"min=1,max=20"`
+	Rows        int     `json:"rows"        validate:"min=1,max=40"`
+	LabelWidth  float64 `json:"labelWidth"  validate:"gt=0"`
+	LabelHeight float64 `json:"labelHeight" validate:"gt=0"`
+	MarginTop   float64 `json:"marginTop"   validate:"min=0"`
+	MarginLeft  float64 `json:"marginLeft"  validate:"min=0"`
+	PitchX      float64 `json:"pitchX"      validate:"min=0"`
+	PitchY      float64 `json:"pitchY"      validate:"min=0"`
+}
+
+const (
+	a4Width      = 210.0
+	a4Height     = 297.0
+	letterWidth  = 215.9
+	letterHeight = 279.4
+)
+
+// SheetPresets are common Avery and Herma label sheets, by ID.
+var SheetPresets = map[string]SheetTemplate{
+	"avery-l7159": {Name: "Avery L7159 (63.5 x 33.9 mm, 24 per A4 sheet)", PageWidth: a4Width, PageHeight: a4Height, Columns: 3, Rows: 8, LabelWidth: 63.5, LabelHeight: 33.9, MarginTop: 12.9, MarginLeft: 6.4, PitchX: 66.0, PitchY: 33.9},
+	"avery-l7160": {Name: "Avery L7160 (63.5 x 38.1 mm, 21 per A4 sheet)", PageWidth: a4Width, PageHeight: a4Height, Columns: 3, Rows: 7, LabelWidth: 63.5, LabelHeight: 38.1, MarginTop: 15.15, MarginLeft: 7.2, PitchX: 66.0, PitchY: 38.1},
+	"avery-l7163": {Name: "Avery L7163 (99.1 x 38.1 mm, 14 per A4 sheet)", PageWidth: a4Width, PageHeight: a4Height, Columns: 2, Rows: 7, LabelWidth: 99.1, LabelHeight: 38.1, MarginTop: 15.15, MarginLeft: 4.65, PitchX: 101.6, PitchY: 38.1},
+	"avery-l7651": {Name: "Avery L7651 (38.1 x 21.2 mm, 65 per A4 sheet)", PageWidth: a4Width, PageHeight: a4Height, Columns: 5, Rows: 13, LabelWidth: 38.1, LabelHeight: 21.2, MarginTop: 10.7, MarginLeft: 4.75, PitchX: 40.6, PitchY: 21.2},
+	"avery-5160":  {Name: "Avery 5160 (2.625 x 1 in, 30 per Letter sheet)", PageWidth: letterWidth, PageHeight: letterHeight, Columns: 3, Rows: 10, LabelWidth: 66.675, LabelHeight: 25.4, MarginTop: 12.7, MarginLeft: 4.7625, PitchX: 69.85, PitchY: 25.4},
+	"avery-5163":  {Name: "Avery 5163 (4 x 2 in, 10 per Letter sheet)", PageWidth: letterWidth, PageHeight: letterHeight, Columns: 2, Rows: 5, LabelWidth: 101.6, LabelHeight: 50.8, MarginTop: 12.7, MarginLeft: 3.96875, PitchX: 104.775, PitchY: 50.8},
+	"avery-5167":  {Name: "Avery 5167 (1.75 x 0.5 in, 80 per Letter sheet)", PageWidth: letterWidth, PageHeight: letterHeight, Columns: 4, Rows: 20, LabelWidth: 44.45, LabelHeight: 12.7, MarginTop: 12.7, MarginLeft: 7.62, PitchX: 52.07, PitchY: 12.7},
+	"herma-4360":  {Name: "Herma 4360 (70 x 36 mm, 24 per A4 sheet)", PageWidth: a4Width, PageHeight: a4Height, Columns: 3, Rows: 8, LabelWidth: 70, LabelHeight: 36, MarginTop: 4.5, MarginLeft: 0, PitchX: 70, PitchY: 36},
+}
+
+// SheetPresetIDs returns the IDs of SheetPresets in order.
+func SheetPresetIDs() []string {
+	ids := make([]string, 0, len(SheetPresets))
+	for id := range SheetPresets {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// sheetDpi is the resolution labels are rendered at for a sheet.
+const sheetDpi = 300.0
+
+// Validate checks that the labels of t fit on its page.
+func (t SheetTemplate) Validate() error {
+	if t.PageWidth <= 0 || t.PageHeight <= 0 || t.LabelWidth <= 0 || t.LabelHeight <= 0 {
+		return errors.New("page and label sizes must be positive")
+	}
+	if t.Columns < 1 || t.Rows < 1 {
+		return errors.New("a sheet needs at least one row and column")
+	}
+	if t.Columns > 1 && t.PitchX < t.LabelWidth || t.Rows > 1 && t.PitchY < t.LabelHeight {
+		return errors.New("labels overlap: the pitch must be at least the label size")
+	}
+
+	// Allow for rounding in sizes given in inches.
+	const tolerance = 0.5
+	if t.MarginLeft+float64(t.Columns-1)*t.PitchX+t.LabelWidth > t.PageWidth+tolerance {
+		return errors.New("labels don't fit the width of the page")
+	}
+	if t.MarginTop+float64(t.Rows-1)*t.PitchY+t.LabelHeight > t.PageHeight+tolerance {
+		return errors.New("labels don't fit the height of the page")
+	}
+	return nil
+}
+
+// PerSheet is the number of labels on one sheet.
+func (t SheetTemplate) PerSheet() int {
+	return t.Columns * t.Rows
+}
+
+// SheetLabel is the content of one label on a sheet.
+type SheetLabel struct {
+	Title       string
+	Description string
+	URL         string
+}
+
+// GenerateSheet lays labels out on sheets of template t and writes them as a
+// PDF. The first offset positions of the first sheet are left empty, so
+// partially used sheets can be fed again. Labels are drawn like
+// GenerateLabel's, with the configured margins, padding and font size scaled
+// from the configured label height to the label on the sheet.
+func GenerateSheet(w io.Writer, t SheetTemplate, offset int, labels []SheetLabel, cfg *config.Config) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	if offset < 0 || offset >= t.PerSheet() {
+		return fmt.Errorf("offset must be between 0 and %d", t.PerSheet()-1)
+	}
+
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: t.PageWidth, Ht: t.PageHeight},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	width := int(t.LabelWidth / 25.4 * sheetDpi)
+	height := int(t.LabelHeight / 25.4 * sheetDpi)
+	scale := 1.0
+	if cfg != nil && cfg.LabelMaker.Height > 0 {
+		scale = float64(height) / float64(cfg.LabelMaker.Height)
+	}
+
+	for i, label := range labels {
+		pos := offset + i
+		if pos > 0 && pos%t.PerSheet() == 0 {
+			pdf.AddPage()
+		}
+		cell := pos % t.PerSheet()
+		x := t.MarginLeft + float64(cell%t.Columns)*t.PitchX
+		y := t.MarginTop + float64(cell/t.Columns)*t.PitchY
+
+		params := sheetLabelParams(cfg, width, height, scale, label)
+		var buf bytes.Buffer
+		if err := GenerateLabel(&buf, &params, cfg); err != nil {
+			return err
+		}
+
+		var imageType string
+		switch http.DetectContentType(buf.Bytes()) {
+		case "image/png":
+			imageType = "PNG"
+		case "image/jpeg":
+			imageType = "JPG"
+		case "image/gif":
+			imageType = "GIF"
+		default:
+			return errors.New("labels must be PNG, JPEG or GIF images to be placed on a sheet")
+		}
+
+		name := fmt.Sprintf("label-%d", i)
+		opts := fpdf.ImageOptions{ImageType: imageType}
+		pdf.RegisterImageOptionsReader(name, opts, &buf)
+		pdf.ImageOptions(name, x, y, t.LabelWidth, t.LabelHeight, false, opts, 0, "")
+	}
+
+	return pdf.Output(w)
+}
+
+func sheetLabelParams(cfg *config.Config, width, height int, scale float64, label SheetLabel) GenerateParameters {
+	margin, padding, fontSize := 32.0, 32.0, 32.0
+	var additional *string
+	if cfg != nil {
+		margin = float64(cfg.LabelMaker.Margin)
+		padding = float64(cfg.LabelMaker.Padding)
+		fontSize = cfg.LabelMaker.FontSize
+		additional = cfg.LabelMaker.AdditionalInformation
+	}
+
+	// The label has the size of its place on the sheet.
+	return NewGenerateParams(width, height, int(margin*scale), int(padding*scale), fontSize*scale, label.Title, label.Description, label.URL, false, additional)
+}
@@ -0,0 +1,65 @@
+package labelmaker
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSheetPresets_Valid(t *testing.T) {
+	for _, id := range SheetPresetIDs() {
+		assert.NoError(t, SheetPresets[id].Validate(), id)
+	}
+}
+
+func TestSheetTemplate_Validate(t *testing.T) {
+	base := SheetPresets["avery-l7160"]
+
+	overlapping := base
+	overlapping.PitchX = base.LabelWidth - 1
+	require.Error(t, overlapping.Validate())
+
+	tooWide := base
+	tooWide.MarginLeft = 20
+	require.Error(t, tooWide.Validate())
+
+	tooTall := base
+	tooTall.Rows = 8
+	require.Error(t, tooTall.Validate())
+
+	// A single column doesn't need a horizontal pitch.
+	single := base
+	single.Columns = 1
+	single.PitchX = 0
+	require.NoError(t, single.Validate())
+}
+
+func TestGenerateSheet_Offset(t *testing.T) {
+	tmpl := SheetPresets["avery-l7163"]
+	labels := []SheetLabel{
+		{Title: "Drill", Description: "\nLocation: Garage", URL: "https://example.com/item/1"},
+		{Title: "Rake", URL: "https://example.com/item/2"},
+		{Title: "Laptop", URL: "https://example.com/item/3"},
+	}
+
+	tests := []struct {
+		name   string
+		offset int
+		pages  string
+	}{
+		{name: "fresh sheet", offset: 0, pages: "/Count 1"},
+		{name: "fills the last labels then continues", offset: tmpl.PerSheet() - 1, pages: "/Count 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, GenerateSheet(&buf, tmpl, tt.offset, labels, nil))
+			assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+			assert.Contains(t, buf.String(), tt.pages)
+		})
+	}
+
+	require.Error(t, GenerateSheet(&bytes.Buffer{}, tmpl, tmpl.PerSheet(), labels, nil))
+}
@@ -9,8 +9,14 @@ export enum ServerEvent {
   ImportMutation = "import.mutation",
 }
 
+export type MutationAction = "create" | "update" | "delete" | "move";
+
 export type EventMessage = {
   event: ServerEvent;
+  action?: MutationAction;
+  ids?: string[];
+  actorId?: string;
+  fromParentId?: string;
 };
 
 let socket: WebSocket | null = null;
@@ -32,6 +32,12 @@ export class ActionsAPI extends BaseAPI {
     });
   }
 
+  rebuildSearchIndex() {
+    return this.http.post<void, ActionAmountResult>({
+      url: route("/actions/rebuild-search-index"),
+    });
+  }
+
   wipeInventory(options?: { wipeTags?: boolean; wipeLocations?: boolean; wipeMaintenance?: boolean }) {
     return this.http.post<
       { wipeTags?: boolean; wipeLocations?: boolean; wipeMaintenance?: boolean },
@@ -1,5 +1,15 @@
 import { BaseAPI, route } from "../base";
-import type { EntityTypeCreate, EntityTypeSummary, EntityTypeUpdate } from "../types/data-contracts";
+import type {
+  EntityFieldDefinitionCreate,
+  EntityFieldDefinitionOut,
+  EntityFieldDefinitionUpdate,
+  EntityTypeCreate,
+  EntityTypeSummary,
+  EntityTypeUpdate,
+} from "../types/data-contracts";
+
+/** Depreciation defaults an entity type passes on to its entities. */
+export type EntityTypeDepreciation = Pick<EntityTypeUpdate, "depreciationMethod" | "usefulLifeYears" | "salvageValue">;
 
 export class EntityTypesApi extends BaseAPI {
   getAll() {
@@ -17,4 +27,26 @@ export class EntityTypesApi extends BaseAPI {
   delete(id: string) {
     return this.http.delete<void>({ url: route(`/entity-types/${id}`) });
   }
+
+  getFields(id: string) {
+    return this.http.get<EntityFieldDefinitionOut[]>({ url: route(`/entity-types/${id}/fields`) });
+  }
+
+  createField(id: string, body: EntityFieldDefinitionCreate) {
+    return this.http.post<EntityFieldDefinitionCreate, EntityFieldDefinitionOut>({
+      url: route(`/entity-types/${id}/fields`),
+      body,
+    });
+  }
+
+  updateField(id: string, fieldId: string, body: EntityFieldDefinitionUpdate) {
+    return this.http.put<EntityFieldDefinitionUpdate, EntityFieldDefinitionOut>({
+      url: route(`/entity-types/${id}/fields/${fieldId}`),
+      body,
+    });
+  }
+
+  deleteField(id: string, fieldId: string) {
+    return this.http.delete<void>({ url: route(`/entity-types/${id}/fields/${fieldId}`) });
+  }
 }
@@ -1,20 +1,29 @@
 import { BaseAPI, route } from "../base";
 import type {
   CurrenciesCurrency,
+  ExchangeRateCreate,
+  ExchangeRateOut,
   Group,
   GroupAcceptInvitationResponse,
   GroupInvitation,
   GroupInvitationCreate,
+  GroupMember,
+  GroupMemberLocationsUpdate,
+  GroupMemberRoleUpdate,
   GroupUpdate,
-  UserSummary,
+  StorageReport,
 } from "../types/data-contracts";
+import type { WithOptional } from "../types/non-generated";
+
+/** The roles a member can have; invitations can't grant "owner". */
+export type GroupRole = GroupMemberRoleUpdate["role"];
 
 export class GroupApi extends BaseAPI {
   /**
    * Create a new invitation for the current group.
    */
-  createInvitation(data: GroupInvitationCreate) {
-    return this.http.post<GroupInvitationCreate, GroupInvitation>({
+  createInvitation(data: WithOptional<GroupInvitationCreate, "role">) {
+    return this.http.post<WithOptional<GroupInvitationCreate, "role">, GroupInvitation>({
       url: route("/groups/invitations"),
       body: data,
     });
@@ -56,7 +65,7 @@ export class GroupApi extends BaseAPI {
           "X-Tenant": groupId,
         }
       : undefined;
-    return this.http.get<UserSummary[]>({
+    return this.http.get<GroupMember[]>({
       url: route(`/groups/members`),
       headers,
     });
@@ -77,16 +86,37 @@ export class GroupApi extends BaseAPI {
     });
   }
 
+  /**
+   * Change the role of a member of the current group. Only owners may do this.
+   */
+  updateMemberRole(userId: string, role: GroupRole) {
+    return this.http.put<GroupMemberRoleUpdate, void>({
+      url: route(`/groups/members/${userId}`),
+      body: { role },
+    });
+  }
+
+  /**
+   * Limit a member of the current group to some locations and everything below them.
+   * An empty list gives them the whole collection again. Only owners may do this.
+   */
+  updateMemberLocations(userId: string, locationIds: string[]) {
+    return this.http.put<GroupMemberLocationsUpdate, void>({
+      url: route(`/groups/members/${userId}/locations`),
+      body: { locationIds },
+    });
+  }
+
   /**
    * Update group name and currency.
    */
-  update(data: GroupUpdate, groupId?: string) {
+  update(data: WithOptional<GroupUpdate, "currencyConversion">, groupId?: string) {
     const headers = groupId
       ? {
           "X-Tenant": groupId,
         }
       : undefined;
-    return this.http.put<GroupUpdate, Group>({
+    return this.http.put<WithOptional<GroupUpdate, "currencyConversion">, Group>({
       url: route(`/groups`),
       headers,
       body: data,
@@ -147,6 +177,52 @@ export class GroupApi extends BaseAPI {
     });
   }
 
+  /**
+   * Get the report of orphaned and missing attachment files of the current group.
+   */
+  getStorageReport() {
+    return this.http.get<StorageReport>({
+      url: route("/groups/storage"),
+    });
+  }
+
+  /**
+   * Get the exchange rates of the current group.
+   */
+  getExchangeRates() {
+    return this.http.get<ExchangeRateOut[]>({
+      url: route("/groups/exchange-rates"),
+    });
+  }
+
+  /**
+   * Set an exchange rate, replacing any rate for the same pair and date.
+   */
+  setExchangeRate(data: ExchangeRateCreate) {
+    return this.http.post<ExchangeRateCreate, ExchangeRateOut>({
+      url: route("/groups/exchange-rates"),
+      body: data,
+    });
+  }
+
+  /**
+   * Import exchange rates from a JSON or CSV file with base, quote, rate and date.
+   */
+  importExchangeRates(file: File | Blob) {
+    const formData = new FormData();
+    formData.append("file", file);
+    return this.http.post<FormData, void>({
+      url: route("/groups/exchange-rates/import"),
+      data: formData,
+    });
+  }
+
+  deleteExchangeRate(id: string) {
+    return this.http.delete<void>({
+      url: route(`/groups/exchange-rates/${id}`),
+    });
+  }
+
   /**
    * Get all currencies.
    */
@@ -1,5 +1,8 @@
 import { BaseAPI, route } from "../base";
 import type {
+  AttachmentMetadataApply,
+  AttachmentUploadCreate,
+  AttachmentUploadOut,
   EntityCreate,
   EntityListResult,
   EntityOut,
@@ -13,8 +16,10 @@ import type {
   MaintenanceEntryWithDetails,
   TreeItem,
 } from "../types/data-contracts";
-import type { AttachmentTypes } from "../types/non-generated";
+import type { AttachmentTypes, WithOptional } from "../types/non-generated";
 import type { MaintenanceFilters } from "./maintenance.ts";
+import { ItemStockAPI } from "./stock";
+import { ItemLoansAPI } from "./loans";
 import type { Requests } from "~~/lib/requests";
 
 export type ItemsQuery = {
@@ -27,6 +32,7 @@ export type ItemsQuery = {
   negateTags?: boolean;
   onlyWithoutPhoto?: boolean;
   onlyWithPhoto?: boolean;
+  onLoan?: boolean;
   q?: string;
   fields?: string[];
 };
@@ -39,6 +45,9 @@ export type TreeQuery = {
   withItems: boolean;
 };
 
+/** Chunks must stay below the server's max_file_upload. */
+const uploadChunkSize = 8 * 1024 * 1024;
+
 export class AttachmentsAPI extends BaseAPI {
   add(id: string, file: File | Blob, filename: string, type: AttachmentTypes | null = null, primary?: boolean) {
     const formData = new FormData();
@@ -57,6 +66,84 @@ export class AttachmentsAPI extends BaseAPI {
     });
   }
 
+  createUpload(id: string, body: WithOptional<AttachmentUploadCreate, "type" | "primary">) {
+    return this.http.post<WithOptional<AttachmentUploadCreate, "type" | "primary">, AttachmentUploadOut>({
+      url: route(`/entities/${id}/uploads`),
+      body,
+    });
+  }
+
+  getUpload(id: string, uploadId: string) {
+    return this.http.get<AttachmentUploadOut>({ url: route(`/entities/${id}/uploads/${uploadId}`) });
+  }
+
+  uploadChunk(id: string, uploadId: string, offset: number, chunk: Blob) {
+    return this.http.patch<Blob, AttachmentUploadOut>({
+      url: route(`/entities/${id}/uploads/${uploadId}`),
+      data: chunk,
+      headers: {
+        "Content-Type": "application/offset+octet-stream",
+        "Upload-Offset": offset.toString(),
+      },
+    });
+  }
+
+  deleteUpload(id: string, uploadId: string) {
+    return this.http.delete<void>({ url: route(`/entities/${id}/uploads/${uploadId}`) });
+  }
+
+  /**
+   * addResumable uploads a large file in chunks, resuming from the bytes the
+   * server has after a failed chunk. It gives up after `retries` failures in a
+   * row and returns the last response.
+   */
+  async addResumable(
+    id: string,
+    file: File | Blob,
+    filename: string,
+    type: AttachmentTypes | null = null,
+    primary?: boolean,
+    onProgress?: (received: number, size: number) => void,
+    retries = 5
+  ) {
+    const created = await this.createUpload(id, {
+      title: filename,
+      type: (type ?? undefined) as AttachmentUploadCreate["type"] | undefined,
+      primary,
+      size: file.size,
+    });
+    if (created.error) {
+      return created;
+    }
+
+    let upload = created.data;
+    let failures = 0;
+    while (!upload.attachmentId) {
+      const res = await this.uploadChunk(
+        id,
+        upload.id,
+        upload.received,
+        file.slice(upload.received, upload.received + uploadChunkSize)
+      ).catch(() => undefined);
+
+      if (res && !res.error) {
+        upload = res.data;
+        failures = 0;
+        onProgress?.(upload.received, upload.size);
+        continue;
+      }
+
+      failures++;
+      const state = await this.getUpload(id, upload.id).catch(() => undefined);
+      if (failures > retries || !state || state.error) {
+        return res ?? state ?? created;
+      }
+      upload = state.data;
+    }
+
+    return { ...created, data: upload };
+  }
+
   delete(id: string, attachmentId: string) {
     return this.http.delete<void>({ url: route(`/entities/${id}/attachments/${attachmentId}`) });
   }
@@ -68,6 +155,13 @@ export class AttachmentsAPI extends BaseAPI {
     });
   }
 
+  applyMetadata(id: string, attachmentId: string, data: AttachmentMetadataApply) {
+    return this.http.post<AttachmentMetadataApply, EntityOut>({
+      url: route(`/entities/${id}/attachments/${attachmentId}/apply-metadata`),
+      body: data,
+    });
+  }
+
   addExternalLink(id: string, sourceType: string, externalId: string, title: string, attachmentType?: string) {
     return this.http.post<
       { source_type: string; external_id: string; title: string; attachment_type?: string },
@@ -96,8 +190,8 @@ export class ItemMaintenanceAPI extends BaseAPI {
     });
   }
 
-  create(itemId: string, data: MaintenanceEntryCreate) {
-    return this.http.post<MaintenanceEntryCreate, MaintenanceEntry>({
+  create(itemId: string, data: WithOptional<MaintenanceEntryCreate, "costCurrency" | "recurrenceRule">) {
+    return this.http.post<WithOptional<MaintenanceEntryCreate, "costCurrency" | "recurrenceRule">, MaintenanceEntry>({
       url: route(`/entities/${itemId}/maintenance`),
       body: data,
     });
@@ -107,6 +201,8 @@ export class ItemMaintenanceAPI extends BaseAPI {
 export class ItemsApi extends BaseAPI {
   attachments: AttachmentsAPI;
   maintenance: ItemMaintenanceAPI;
+  stock: ItemStockAPI;
+  loans: ItemLoansAPI;
   fields: FieldsAPI;
 
   constructor(http: Requests, token: string) {
@@ -114,6 +210,8 @@ export class ItemsApi extends BaseAPI {
     this.fields = new FieldsAPI(http);
     this.attachments = new AttachmentsAPI(http);
     this.maintenance = new ItemMaintenanceAPI(http);
+    this.stock = new ItemStockAPI(http);
+    this.loans = new ItemLoansAPI(http);
   }
 
   fullpath(id: string) {
@@ -183,12 +281,16 @@ export class ItemsApi extends BaseAPI {
     });
   }
 
-  exportURL(tenant?: string) {
+  exportURL(tenant?: string, savedSearch?: string) {
+    const params: Record<string, string> = {};
     if (tenant) {
-      return route("/entities/export", { tenant });
+      params.tenant = tenant;
+    }
+    if (savedSearch) {
+      params.savedSearch = savedSearch;
     }
 
-    return route("/entities/export");
+    return route("/entities/export", params);
   }
 
   // =========================================================================
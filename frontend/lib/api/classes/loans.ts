@@ -0,0 +1,22 @@
+import { BaseAPI, route } from "../base";
+import type { LoanCheckin, LoanCheckout, LoanOut } from "../types/data-contracts";
+
+export class ItemLoansAPI extends BaseAPI {
+  getAll(itemId: string) {
+    return this.http.get<LoanOut[]>({ url: route(`/entities/${itemId}/loans`) });
+  }
+
+  checkout(itemId: string, data: LoanCheckout) {
+    return this.http.post<LoanCheckout, LoanOut>({
+      url: route(`/entities/${itemId}/loans/checkout`),
+      body: data,
+    });
+  }
+
+  checkin(itemId: string, data: LoanCheckin = { notes: "" }) {
+    return this.http.post<LoanCheckin, LoanOut>({
+      url: route(`/entities/${itemId}/loans/checkin`),
+      body: data,
+    });
+  }
+}
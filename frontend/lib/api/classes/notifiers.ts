@@ -1,13 +1,17 @@
 import { BaseAPI, route } from "../base";
 import type { NotifierCreate, NotifierOut, NotifierUpdate } from "../types/data-contracts";
+import type { WithOptional } from "../types/non-generated";
 
 export class NotifiersAPI extends BaseAPI {
   getAll() {
     return this.http.get<NotifierOut[]>({ url: route("/notifiers") });
   }
 
-  create(body: NotifierCreate) {
-    return this.http.post<NotifierCreate, NotifierOut>({ url: route("/notifiers"), body });
+  create(body: WithOptional<NotifierCreate, "subscriptions">) {
+    return this.http.post<WithOptional<NotifierCreate, "subscriptions">, NotifierOut>({
+      url: route("/notifiers"),
+      body,
+    });
   }
 
   update(id: string, body: NotifierUpdate) {
@@ -1,11 +1,53 @@
 import { BaseAPI, route } from "../base";
+import type { LabelSheetPreset, LabelSheetRequest, LowStockEntry } from "../types/data-contracts";
 
 export class ReportsAPI extends BaseAPI {
-  billOfMaterialsURL(tenant?: string): string {
+  billOfMaterialsURL(tenant?: string, savedSearch?: string): string {
+    const params: Record<string, string> = {};
     if (tenant) {
-      return route("/reporting/bill-of-materials", { tenant });
+      params.tenant = tenant;
     }
+    if (savedSearch) {
+      params.savedSearch = savedSearch;
+    }
+
+    return route("/reporting/bill-of-materials", params);
+  }
+
+  insuranceReportURL(
+    tenant?: string,
+    filter: { locations?: string[]; tags?: string[]; insured?: boolean } = {}
+  ): string {
+    const params: Record<string, string | string[]> = {};
+    if (tenant) {
+      params.tenant = tenant;
+    }
+    if (filter.locations?.length) {
+      params.locations = filter.locations;
+    }
+    if (filter.tags?.length) {
+      params.tags = filter.tags;
+    }
+    if (filter.insured) {
+      params.insured = "true";
+    }
+
+    return route("/reporting/insurance", params);
+  }
+
+  labelSheetPresets() {
+    return this.http.get<LabelSheetPreset[]>({ url: route("/labelmaker/sheet/presets") });
+  }
+
+  /**
+   * Returns the label sheet PDF; read it with `response.blob()`. Set exactly one
+   * of entityIds, query and locationId, and one of preset and template.
+   */
+  labelSheet(body: Partial<LabelSheetRequest>) {
+    return this.http.post<Partial<LabelSheetRequest>, ReadableStream>({ url: route("/labelmaker/sheet"), body });
+  }
 
-    return route("/reporting/bill-of-materials");
+  lowStock() {
+    return this.http.get<LowStockEntry[]>({ url: route("/reporting/low-stock") });
   }
 }
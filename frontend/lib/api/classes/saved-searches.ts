@@ -0,0 +1,43 @@
+import { BaseAPI, route } from "../base";
+import type {
+  EntityListResult,
+  SavedSearchCreate,
+  SavedSearchLabelsOut,
+  SavedSearchOut,
+  SavedSearchUpdate,
+} from "../types/data-contracts";
+
+export type SavedSearchEntitiesQuery = {
+  page?: number;
+  pageSize?: number;
+};
+
+export class SavedSearchesAPI extends BaseAPI {
+  getAll() {
+    return this.http.get<SavedSearchOut[]>({ url: route("/saved-searches") });
+  }
+
+  get(id: string) {
+    return this.http.get<SavedSearchOut>({ url: route(`/saved-searches/${id}`) });
+  }
+
+  create(body: SavedSearchCreate) {
+    return this.http.post<SavedSearchCreate, SavedSearchOut>({ url: route("/saved-searches"), body });
+  }
+
+  update(id: string, body: SavedSearchUpdate) {
+    return this.http.put<SavedSearchUpdate, SavedSearchOut>({ url: route(`/saved-searches/${id}`), body });
+  }
+
+  delete(id: string) {
+    return this.http.delete<void>({ url: route(`/saved-searches/${id}`) });
+  }
+
+  entities(id: string, q: SavedSearchEntitiesQuery = {}) {
+    return this.http.get<EntityListResult>({ url: route(`/saved-searches/${id}/entities`, q) });
+  }
+
+  printLabels(id: string) {
+    return this.http.post<void, SavedSearchLabelsOut>({ url: route(`/labelmaker/saved-search/${id}`) });
+  }
+}
@@ -18,6 +18,16 @@ export class StatsAPI extends BaseAPI {
     });
   }
 
+  /**
+   * Returns the depreciated value of the group's items, sampled between
+   * start and end.
+   */
+  valueOverTime(start?: Date, end?: Date) {
+    return this.http.get<ValueOverTime>({
+      url: route("/groups/statistics/value", { start: YYYY_MM_DD(start), end: YYYY_MM_DD(end) }),
+    });
+  }
+
   /**
    * Returns ths general statistics for the group. This mostly just
    * includes the totals for various group properties.
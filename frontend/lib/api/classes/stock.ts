@@ -0,0 +1,22 @@
+import { BaseAPI, route } from "../base";
+import type { StockChange, StockMovement } from "../types/data-contracts";
+
+export class ItemStockAPI extends BaseAPI {
+  movements(itemId: string) {
+    return this.http.get<StockMovement[]>({ url: route(`/entities/${itemId}/stock/movements`) });
+  }
+
+  consume(itemId: string, data: StockChange) {
+    return this.http.post<StockChange, StockMovement>({
+      url: route(`/entities/${itemId}/stock/consume`),
+      body: data,
+    });
+  }
+
+  restock(itemId: string, data: StockChange) {
+    return this.http.post<StockChange, StockMovement>({
+      url: route(`/entities/${itemId}/stock/restock`),
+      body: data,
+    });
+  }
+}
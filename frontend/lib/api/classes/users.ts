@@ -1,6 +1,6 @@
 import { BaseAPI, route } from "../base";
 import type { APIKeyCreate, APIKeyCreatedOut, APIKeyOut, ChangePassword, UserOut } from "../types/data-contracts";
-import type { Result } from "../types/non-generated";
+import type { Result, WithOptional } from "../types/non-generated";
 
 export class UserApi extends BaseAPI {
   public self() {
@@ -48,8 +48,8 @@ export class UserApi extends BaseAPI {
     return this.http.get<APIKeyOut[]>({ url: route("/users/self/api-keys") });
   }
 
-  public createApiKey(body: APIKeyCreate) {
-    return this.http.post<APIKeyCreate, APIKeyCreatedOut>({
+  public createApiKey(body: WithOptional<APIKeyCreate, "scopes">) {
+    return this.http.post<WithOptional<APIKeyCreate, "scopes">, APIKeyCreatedOut>({
       url: route("/users/self/api-keys"),
       body,
     });
@@ -13,6 +13,7 @@ import { MaintenanceAPI } from "./classes/maintenance";
 import { ProductAPI } from "./classes/product";
 import { TemplatesApi } from "./classes/templates";
 import { BackupsAPI } from "./classes/backups";
+import { SavedSearchesAPI } from "./classes/saved-searches";
 import type { Requests } from "~~/lib/requests";
 
 export class UserClient extends BaseAPI {
@@ -30,6 +31,7 @@ export class UserClient extends BaseAPI {
   notifiers: NotifiersAPI;
   products: ProductAPI;
   backups: BackupsAPI;
+  savedSearches: SavedSearchesAPI;
 
   /** Backward-compat shim that delegates to the entities (items) API. */
   locations: {
@@ -58,6 +60,7 @@ export class UserClient extends BaseAPI {
     this.notifiers = new NotifiersAPI(requests);
     this.products = new ProductAPI(requests);
     this.backups = new BackupsAPI(requests);
+    this.savedSearches = new SavedSearchesAPI(requests);
 
     // Backward-compat shim: api.locations.* delegates to api.items.*
     this.locations = {
@@ -18,7 +18,7 @@ export interface TResponse<T> {
 export type RequestArgs<T> = {
   url: string;
   body?: T;
-  data?: FormData;
+  data?: FormData | Blob;
   headers?: Record<string, string>;
 };
 